@@ -3,12 +3,14 @@ package address
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/bech32"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -60,13 +62,116 @@ var (
 	ErrNoAddr = errors.New(
 		"address: no address found",
 	)
+
+	// ErrMissingFamilyKey is an error returned when we attempt to create a
+	// family address without specifying the family key.
+	ErrMissingFamilyKey = errors.New(
+		"address: family address requires a family key",
+	)
+
+	// ErrNotFamilyAddr is an error returned when we attempt to bind an
+	// address to an asset genesis that already pins an asset ID.
+	ErrNotFamilyAddr = errors.New(
+		"address: not a family address",
+	)
+
+	// ErrAssetTypeMismatch is an error returned when we attempt to bind a
+	// family address to the genesis of an asset of a different type.
+	ErrAssetTypeMismatch = errors.New(
+		"address: asset type mismatch",
+	)
+
+	// ErrInvalidAmountPolicy is an error returned when we attempt to
+	// create or decode a Taro address with an amount policy that is
+	// unknown or can't be used with the asset type or amount.
+	ErrInvalidAmountPolicy = errors.New(
+		"address: invalid amount policy",
+	)
+
+	// ErrAmountNotAccepted is an error returned when we attempt to bind an
+	// address to an amount that its amount policy doesn't accept.
+	ErrAmountNotAccepted = errors.New(
+		"address: amount not accepted by address",
+	)
+
+	// ErrMemoTooLong is an error returned when the memo of a Taro address
+	// exceeds the maximum memo length.
+	ErrMemoTooLong = errors.New(
+		"address: memo too long",
+	)
+
+	// ErrInvalidMemoSig is an error returned when the signature over the
+	// memo of a Taro address isn't valid for its internal key.
+	ErrInvalidMemoSig = errors.New(
+		"address: invalid memo signature",
+	)
+
+	// ErrUnknownScriptVersion is an error returned when we attempt to
+	// create or decode a Taro address with an unknown script version.
+	ErrUnknownScriptVersion = errors.New(
+		"address: unknown script version",
+	)
+
+	// ErrUnknownRequiredType is an error returned when we attempt to
+	// decode a Taro address that contains an even TLV type we don't know.
+	// Even types change how an address must be interpreted, so they can't
+	// be skipped.
+	ErrUnknownRequiredType = errors.New(
+		"address: unknown required TLV type",
+	)
+)
+
+var (
+	// MemoDigestTag is the tag of the tagged hash that is signed to
+	// create the memo signature of an address.
+	MemoDigestTag = []byte("taro/addr/memo")
+
+	// MemoKeyTweak is the Taproot tweak that is applied to the internal
+	// key of an address to derive the key that signs its memo.
+	MemoKeyTweak = chainhash.TaggedHash([]byte("taro/addr/memo-key"))
 )
 
 const (
 	// TaroScriptVersion is the highest version of Taro script supported.
 	TaroScriptVersion uint8 = 0
+
+	// MaxMemoLength is the maximum length of the memo of a Taro address
+	// in bytes.
+	MaxMemoLength = 256
 )
 
+// AmountPolicy describes which amounts a Taro address accepts in relation to
+// the amount it specifies.
+type AmountPolicy uint8
+
+const (
+	// AmountExact only accepts exactly the amount of the address. This is
+	// the default policy.
+	AmountExact AmountPolicy = 0
+
+	// AmountAtLeast accepts any amount that is equal to or greater than
+	// the amount of the address.
+	AmountAtLeast AmountPolicy = 1
+
+	// AmountOpen accepts any non-zero amount. The amount of an address
+	// with this policy must be zero.
+	AmountOpen AmountPolicy = 2
+)
+
+// String returns a human-readable description of the amount policy.
+func (p AmountPolicy) String() string {
+	switch p {
+	case AmountExact:
+		return "Exact"
+	case AmountAtLeast:
+		return "AtLeast"
+	case AmountOpen:
+		return "Open"
+	default:
+		return fmt.Sprintf("<unknown(%d)>", uint8(p))
+	}
+}
+
 // Taro represents a Taro address. Taro addresses specify an asset, pubkey, and
 // amount.
 type Taro struct {
@@ -86,6 +191,11 @@ type Taro struct {
 	// asset to be made possible.
 	FamilyKey *btcec.PublicKey
 
+	// FamilyOnly is true if the address is keyed on the family key only
+	// and accepts any asset ID within the family. The genesis of such an
+	// address only specifies the asset type.
+	FamilyOnly bool
+
 	// ScriptKey represents a tweaked Taproot output key encumbering the
 	// different ways an asset can be spent.
 	ScriptKey btcec.PublicKey
@@ -95,6 +205,24 @@ type Taro struct {
 
 	// Amount is the number of asset units being requested by the receiver.
 	Amount uint64
+
+	// AmountPolicy describes which amounts the address accepts in
+	// relation to Amount.
+	AmountPolicy AmountPolicy
+
+	// Memo is an optional free-form description of the payment the
+	// address requests, for example the invoice number of a merchant.
+	Memo string
+
+	// MemoSig is an optional signature over the memo, created with the
+	// memo key that is derived from the internal key of the address. It
+	// proves to the sender that the memo was set by the receiver.
+	MemoSig *schnorr.Signature
+
+	// ScriptVersion is the script version of the asset the address
+	// expects to receive. As it's part of the asset leaf the address
+	// commits to, it must match the script version of the sent asset.
+	ScriptVersion asset.ScriptVersion
 }
 
 // New creates an address for receiving a Taro asset.
@@ -102,38 +230,231 @@ func New(genesis asset.Genesis, familyKey *btcec.PublicKey,
 	scriptKey btcec.PublicKey, internalKey btcec.PublicKey, amt uint64,
 	net *ChainParams) (*Taro, error) {
 
-	// Check for invalid combinations of asset type and amount.
+	return NewPaymentRequest(
+		genesis, familyKey, scriptKey, internalKey, amt, AmountExact,
+		"", net,
+	)
+}
+
+// NewPaymentRequest creates an address for receiving a Taro asset that
+// accepts any amount allowed by the given amount policy and optionally
+// carries a memo describing the payment.
+func NewPaymentRequest(genesis asset.Genesis, familyKey *btcec.PublicKey,
+	scriptKey btcec.PublicKey, internalKey btcec.PublicKey, amt uint64,
+	policy AmountPolicy, memo string, net *ChainParams) (*Taro, error) {
+
+	if err := validateAmount(genesis.Type, amt, policy); err != nil {
+		return nil, err
+	}
+
+	if len(memo) > MaxMemoLength {
+		return nil, ErrMemoTooLong
+	}
+
+	if !IsBech32MTaroPrefix(net.TaroHRP + "1") {
+		return nil, ErrUnsupportedHRP
+	}
+
+	payload := Taro{
+		ChainParams:  net,
+		Version:      asset.V0,
+		Genesis:      genesis,
+		FamilyKey:    familyKey,
+		ScriptKey:    scriptKey,
+		InternalKey:  internalKey,
+		Amount:       amt,
+		AmountPolicy: policy,
+		Memo:         memo,
+	}
+	return &payload, nil
+}
+
+// validateAmount checks for invalid combinations of asset type, amount and
+// amount policy.
+func validateAmount(assetType asset.Type, amt uint64,
+	policy AmountPolicy) error {
+
 	// Collectible assets must have an amount of 1, and Normal assets must
-	// have a non-zero amount. We also reject invalid asset types.
-	switch genesis.Type {
+	// have a non-zero amount, unless they accept any amount. We also
+	// reject invalid asset types.
+	switch assetType {
 	case asset.Collectible:
 		if amt != 1 {
-			return nil, ErrInvalidAmountCollectible
+			return ErrInvalidAmountCollectible
+		}
+
+		// There's only a single unit of a collectible, so only the
+		// exact amount makes sense.
+		if policy != AmountExact {
+			return ErrInvalidAmountPolicy
 		}
 
 	case asset.Normal:
-		if amt == 0 {
-			return nil, ErrInvalidAmountNormal
+		switch policy {
+		case AmountExact, AmountAtLeast:
+			if amt == 0 {
+				return ErrInvalidAmountNormal
+			}
+
+		case AmountOpen:
+			if amt != 0 {
+				return ErrInvalidAmountPolicy
+			}
+
+		default:
+			return ErrInvalidAmountPolicy
 		}
 
 	default:
-		return nil, ErrUnsupportedAssetType
+		return ErrUnsupportedAssetType
 	}
 
-	if !IsBech32MTaroPrefix(net.TaroHRP + "1") {
-		return nil, ErrUnsupportedHRP
+	return nil
+}
+
+// FamilyGenesis returns the genesis used by family addresses. As a family
+// address accepts any asset ID within the family, the genesis only specifies
+// the type of the asset.
+func FamilyGenesis(assetType asset.Type) asset.Genesis {
+	return asset.Genesis{
+		Type: assetType,
 	}
+}
 
-	payload := Taro{
-		ChainParams: net,
-		Version:     asset.V0,
-		Genesis:     genesis,
-		FamilyKey:   familyKey,
-		ScriptKey:   scriptKey,
-		InternalKey: internalKey,
-		Amount:      amt,
+// NewFamily creates an address for receiving any Taro asset within the asset
+// family identified by the given family key.
+func NewFamily(familyKey *btcec.PublicKey, assetType asset.Type,
+	scriptKey btcec.PublicKey, internalKey btcec.PublicKey, amt uint64,
+	net *ChainParams) (*Taro, error) {
+
+	if familyKey == nil {
+		return nil, ErrMissingFamilyKey
 	}
-	return &payload, nil
+
+	famAddr, err := New(
+		FamilyGenesis(assetType), familyKey, scriptKey, internalKey,
+		amt, net,
+	)
+	if err != nil {
+		return nil, err
+	}
+	famAddr.FamilyOnly = true
+
+	return famAddr, nil
+}
+
+// IsFamilyAddr returns true if the address is keyed on the family key only
+// and therefore accepts any asset ID within the family.
+func (a *Taro) IsFamilyAddr() bool {
+	return a.FamilyOnly && a.FamilyKey != nil
+}
+
+// BindGenesis returns a copy of a family address that is bound to the given
+// genesis of an asset within the family. The returned address pins the asset
+// ID of that asset and can be used to construct a send like any other
+// address.
+//
+// NOTE: The caller must make sure that the asset of the genesis is actually
+// part of the family of the address.
+func (a *Taro) BindGenesis(genesis asset.Genesis) (*Taro, error) {
+	if !a.IsFamilyAddr() {
+		return nil, ErrNotFamilyAddr
+	}
+
+	if genesis.Type != a.Type {
+		return nil, ErrAssetTypeMismatch
+	}
+
+	boundAddr := a.Copy()
+	boundAddr.Genesis = genesis
+	boundAddr.FamilyOnly = false
+
+	return boundAddr, nil
+}
+
+// AcceptsAmount returns true if the amount policy of the address accepts the
+// given amount of asset units.
+func (a *Taro) AcceptsAmount(amt uint64) bool {
+	switch a.AmountPolicy {
+	case AmountExact:
+		return amt == a.Amount
+
+	case AmountAtLeast:
+		return amt >= a.Amount
+
+	case AmountOpen:
+		return amt > 0
+
+	default:
+		return false
+	}
+}
+
+// BindAmount returns a copy of the address that requests exactly the given
+// amount of asset units, as chosen by the sender within the amount policy of
+// the address. The returned address can be used to construct a send like any
+// other address.
+func (a *Taro) BindAmount(amt uint64) (*Taro, error) {
+	if !a.AcceptsAmount(amt) {
+		return nil, ErrAmountNotAccepted
+	}
+
+	boundAddr := a.Copy()
+	boundAddr.Amount = amt
+	boundAddr.AmountPolicy = AmountExact
+
+	return boundAddr, nil
+}
+
+// HasDynamicOutput returns true if the on-chain output of a transfer to the
+// address depends on the asset ID or amount chosen by the sender. The Taproot
+// output key of such a transfer can't be derived before the proof of the
+// transfer is known.
+func (a *Taro) HasDynamicOutput() bool {
+	return a.IsFamilyAddr() || a.AmountPolicy != AmountExact
+}
+
+// MemoDigest returns the digest of the memo that is signed by the memo key of
+// the address.
+func MemoDigest(memo string) [32]byte {
+	return *chainhash.TaggedHash(MemoDigestTag, []byte(memo))
+}
+
+// MemoSigMsg returns the message that results in the digest of the given memo
+// when hashed once with sha256. This allows signers that only support plain
+// sha256 message digests to create memo signatures.
+func MemoSigMsg(memo string) []byte {
+	tagHash := sha256.Sum256(MemoDigestTag)
+
+	msg := make([]byte, 0, 2*sha256.Size+len(memo))
+	msg = append(msg, tagHash[:]...)
+	msg = append(msg, tagHash[:]...)
+
+	return append(msg, memo...)
+}
+
+// MemoKey returns the key that signs the memo of an address with the given
+// internal key. It's derived by tweaking the internal key with MemoKeyTweak,
+// so the memo signature is bound to the address without the internal key
+// itself ever signing anything but the transfer.
+func MemoKey(internalKey *btcec.PublicKey) *btcec.PublicKey {
+	return txscript.ComputeTaprootOutputKey(internalKey, MemoKeyTweak[:])
+}
+
+// VerifyMemo makes sure that the signature over the memo, if present, is valid
+// for the memo key of the address.
+func (a *Taro) VerifyMemo() error {
+	if a.MemoSig == nil {
+		return nil
+	}
+
+	digest := MemoDigest(a.Memo)
+	memoKey := MemoKey(&a.InternalKey)
+	if a.Memo == "" || !a.MemoSig.Verify(digest[:], memoKey) {
+		return ErrInvalidMemoSig
+	}
+
+	return nil
 }
 
 // Copy returns a deep copy of an Address.
@@ -180,8 +501,8 @@ func (a *Taro) TaroCommitment() (*commitment.TaroCommitment, error) {
 		}
 	}
 	newAsset, err := asset.New(
-		a.Genesis, a.Amount, 0, 0, asset.NewScriptKey(&a.ScriptKey),
-		familyKey,
+		a.Genesis, a.Amount, 0, 0, a.ScriptVersion,
+		asset.NewScriptKey(&a.ScriptKey), familyKey,
 	)
 	if err != nil {
 		return nil, err
@@ -216,6 +537,10 @@ func (a *Taro) TaroCommitment() (*commitment.TaroCommitment, error) {
 }
 
 // TaprootOutputKey returns the on-chain Taproot output key.
+//
+// NOTE: The on-chain output of a transfer to a family address commits to the
+// asset ID chosen by the sender, so for family addresses the returned key only
+// serves as a unique identifier of the address and won't appear on chain.
 func (a *Taro) TaprootOutputKey(sibling *chainhash.Hash) (*btcec.PublicKey,
 	error) {
 
@@ -235,7 +560,7 @@ func (a *Taro) TaprootOutputKey(sibling *chainhash.Hash) (*btcec.PublicKey,
 // EncodeRecords determines the non-nil records to include when encoding an
 // address at runtime.
 func (a *Taro) EncodeRecords() []tlv.Record {
-	records := make([]tlv.Record, 0, 6)
+	records := make([]tlv.Record, 0, 10)
 	records = append(records, newAddressVersionRecord(&a.Version))
 	records = append(records, newAddressGenesisRecord(&a.Genesis))
 
@@ -247,6 +572,28 @@ func (a *Taro) EncodeRecords() []tlv.Record {
 	records = append(records, newAddressInternalKeyRecord(&a.InternalKey))
 	records = append(records, newAddressAmountRecord(&a.Amount))
 
+	if a.FamilyOnly {
+		records = append(
+			records, newAddressFamilyOnlyRecord(&a.FamilyOnly),
+		)
+	}
+	if a.Memo != "" {
+		records = append(records, newAddressMemoRecord(&a.Memo))
+	}
+	if a.AmountPolicy != AmountExact {
+		records = append(
+			records, newAddressAmountPolicyRecord(&a.AmountPolicy),
+		)
+	}
+	if a.MemoSig != nil {
+		records = append(records, newAddressMemoSigRecord(&a.MemoSig))
+	}
+	if a.ScriptVersion != asset.ScriptV0 {
+		records = append(
+			records, newAddressScriptVersionRecord(&a.ScriptVersion),
+		)
+	}
+
 	return records
 }
 
@@ -260,6 +607,11 @@ func (a *Taro) DecodeRecords() []tlv.Record {
 		newAddressScriptKeyRecord(&a.ScriptKey),
 		newAddressInternalKeyRecord(&a.InternalKey),
 		newAddressAmountRecord(&a.Amount),
+		newAddressFamilyOnlyRecord(&a.FamilyOnly),
+		newAddressMemoRecord(&a.Memo),
+		newAddressAmountPolicyRecord(&a.AmountPolicy),
+		newAddressMemoSigRecord(&a.MemoSig),
+		newAddressScriptVersionRecord(&a.ScriptVersion),
 	}
 }
 
@@ -272,13 +624,29 @@ func (a *Taro) Encode(w io.Writer) error {
 	return stream.Encode(w)
 }
 
-// Decode decodes an address from a TLV stream.
+// Decode decodes an address from a TLV stream. Unknown odd types are skipped,
+// while unknown even types result in an ErrUnknownRequiredType error.
 func (a *Taro) Decode(r io.Reader) error {
 	stream, err := tlv.NewStream(a.DecodeRecords()...)
 	if err != nil {
 		return err
 	}
-	return stream.Decode(r)
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	// Known types are mapped to a nil value, so any type with a value is
+	// one we don't know.
+	for tlvType, value := range parsedTypes {
+		if value != nil && tlvType%2 == 0 {
+			return fmt.Errorf("%w: %d", ErrUnknownRequiredType,
+				tlvType)
+		}
+	}
+
+	return nil
 }
 
 // EncodeAddress returns a bech32m string encoding of a Taro address.
@@ -355,6 +723,23 @@ func DecodeAddress(addr string, net *ChainParams) (*Taro, error) {
 		return nil, err
 	}
 
+	// We don't know how to interpret the amount of an address with an
+	// unknown amount policy, and a memo signature must be valid.
+	if a.AmountPolicy > AmountOpen {
+		return nil, ErrInvalidAmountPolicy
+	}
+	if a.FamilyOnly && a.FamilyKey == nil {
+		return nil, ErrMissingFamilyKey
+	}
+	if a.ScriptVersion != asset.ScriptV0 &&
+		a.ScriptVersion != asset.ScriptV1 {
+
+		return nil, ErrUnknownScriptVersion
+	}
+	if err := a.VerifyMemo(); err != nil {
+		return nil, err
+	}
+
 	a.ChainParams = net
 
 	return &a, nil
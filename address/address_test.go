@@ -1,13 +1,17 @@
 package address
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"math/rand"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/bech32"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/stretchr/testify/require"
 )
@@ -86,12 +90,17 @@ func assertAddressEqual(t *testing.T, a, b *Taro) {
 	t.Helper()
 
 	require.Equal(t, a.Version, b.Version)
-	require.Equal(t, a.ID, b.ID)
+	require.Equal(t, a.ID(), b.ID())
 	require.Equal(t, a.FamilyKey, b.FamilyKey)
+	require.Equal(t, a.FamilyOnly, b.FamilyOnly)
 	require.Equal(t, a.ScriptKey, b.ScriptKey)
 	require.Equal(t, a.InternalKey, b.InternalKey)
 	require.Equal(t, a.Amount, b.Amount)
 	require.Equal(t, a.Type, b.Type)
+	require.Equal(t, a.AmountPolicy, b.AmountPolicy)
+	require.Equal(t, a.Memo, b.Memo)
+	require.Equal(t, a.MemoSig, b.MemoSig)
+	require.Equal(t, a.ScriptVersion, b.ScriptVersion)
 }
 
 // TestNewAddress tests edge cases around creating a new address.
@@ -272,3 +281,249 @@ func TestAddressEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestFamilyAddress tests that family addresses can be created, encoded and
+// bound to the genesis of an asset within the family.
+func TestFamilyAddress(t *testing.T) {
+	t.Parallel()
+
+	// A family address can't be created without a family key.
+	_, err := NewFamily(
+		nil, asset.Normal, *pubKey, *pubKey, 10, &TestNet3Taro,
+	)
+	require.ErrorIs(t, err, ErrMissingFamilyKey)
+
+	famAddr, err := NewFamily(
+		pubKey, asset.Normal, *pubKey, *pubKey, 10, &TestNet3Taro,
+	)
+	require.NoError(t, err)
+	require.True(t, famAddr.IsFamilyAddr())
+
+	// The address should still be a family address after an encoding
+	// round trip.
+	encoded, err := famAddr.EncodeAddress()
+	require.NoError(t, err)
+	decoded, err := DecodeAddress(encoded, &TestNet3Taro)
+	require.NoError(t, err)
+	assertAddressEqual(t, famAddr, decoded)
+	require.True(t, decoded.IsFamilyAddr())
+
+	// Regular addresses are never family addresses, even if they specify
+	// a family key.
+	regularAddr, err := randAddress(
+		t, &TestNet3Taro, true, nil, asset.Normal,
+	)
+	require.NoError(t, err)
+	require.False(t, regularAddr.IsFamilyAddr())
+
+	// An address is only a family address if it's explicitly marked as
+	// one, even if its genesis is empty.
+	zeroGenAddr, err := New(
+		FamilyGenesis(asset.Normal), pubKey, *pubKey, *pubKey, 10,
+		&TestNet3Taro,
+	)
+	require.NoError(t, err)
+	require.False(t, zeroGenAddr.IsFamilyAddr())
+
+	// A family address without a family key is rejected when decoding.
+	noKeyAddr := famAddr.Copy()
+	noKeyAddr.FamilyKey = nil
+	encoded, err = noKeyAddr.EncodeAddress()
+	require.NoError(t, err)
+	_, err = DecodeAddress(encoded, &TestNet3Taro)
+	require.ErrorIs(t, err, ErrMissingFamilyKey)
+
+	// Binding the address to a genesis of a different type fails.
+	_, err = famAddr.BindGenesis(asset.RandGenesis(t, asset.Collectible))
+	require.ErrorIs(t, err, ErrAssetTypeMismatch)
+
+	// Binding it to a genesis of the same type pins its asset ID, but the
+	// address still maps to the same asset family.
+	genesis := asset.RandGenesis(t, asset.Normal)
+	boundAddr, err := famAddr.BindGenesis(genesis)
+	require.NoError(t, err)
+	require.False(t, boundAddr.IsFamilyAddr())
+	require.True(t, famAddr.IsFamilyAddr())
+	require.Equal(t, genesis.ID(), boundAddr.ID())
+	require.Equal(
+		t, famAddr.TaroCommitmentKey(), boundAddr.TaroCommitmentKey(),
+	)
+
+	// A bound address can't be bound again.
+	_, err = boundAddr.BindGenesis(genesis)
+	require.ErrorIs(t, err, ErrNotFamilyAddr)
+}
+
+// TestPaymentRequestAddress tests that addresses with an amount policy and a
+// signed memo can be created, encoded and bound to the amount chosen by the
+// sender.
+func TestPaymentRequestAddress(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	internalKey := *privKey.PubKey()
+
+	newAddr := func(assetType asset.Type, amt uint64, policy AmountPolicy,
+		memo string) (*Taro, error) {
+
+		return NewPaymentRequest(
+			asset.RandGenesis(t, assetType), nil, *pubKey,
+			internalKey, amt, policy, memo, &TestNet3Taro,
+		)
+	}
+
+	// Invalid combinations of asset type, amount and policy are rejected.
+	invalidParams := []struct {
+		assetType asset.Type
+		amt       uint64
+		policy    AmountPolicy
+		err       error
+	}{
+		{asset.Collectible, 1, AmountAtLeast, ErrInvalidAmountPolicy},
+		{asset.Collectible, 1, AmountOpen, ErrInvalidAmountPolicy},
+		{asset.Normal, 0, AmountAtLeast, ErrInvalidAmountNormal},
+		{asset.Normal, 10, AmountOpen, ErrInvalidAmountPolicy},
+		{asset.Normal, 10, AmountOpen + 1, ErrInvalidAmountPolicy},
+	}
+	for _, params := range invalidParams {
+		_, err := newAddr(
+			params.assetType, params.amt, params.policy, "",
+		)
+		require.ErrorIs(t, err, params.err)
+	}
+
+	_, err = newAddr(
+		asset.Normal, 10, AmountAtLeast,
+		string(make([]byte, MaxMemoLength+1)),
+	)
+	require.ErrorIs(t, err, ErrMemoTooLong)
+
+	// An address that accepts a minimum amount accepts any larger amount,
+	// while an open address accepts any non-zero amount.
+	atLeastAddr, err := newAddr(asset.Normal, 10, AmountAtLeast, "order 1")
+	require.NoError(t, err)
+	require.True(t, atLeastAddr.HasDynamicOutput())
+	require.False(t, atLeastAddr.AcceptsAmount(9))
+	require.True(t, atLeastAddr.AcceptsAmount(10))
+	require.True(t, atLeastAddr.AcceptsAmount(11))
+
+	openAddr, err := newAddr(asset.Normal, 0, AmountOpen, "")
+	require.NoError(t, err)
+	require.True(t, openAddr.HasDynamicOutput())
+	require.False(t, openAddr.AcceptsAmount(0))
+	require.True(t, openAddr.AcceptsAmount(1))
+
+	// Binding the address to an accepted amount results in a regular
+	// address for exactly that amount.
+	_, err = atLeastAddr.BindAmount(9)
+	require.ErrorIs(t, err, ErrAmountNotAccepted)
+
+	boundAddr, err := atLeastAddr.BindAmount(15)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), boundAddr.Amount)
+	require.Equal(t, AmountExact, boundAddr.AmountPolicy)
+	require.False(t, boundAddr.HasDynamicOutput())
+	require.Equal(t, AmountAtLeast, atLeastAddr.AmountPolicy)
+
+	// A memo signed by the internal key itself is rejected, as only the
+	// dedicated memo key may sign it.
+	digest := MemoDigest(atLeastAddr.Memo)
+	atLeastAddr.MemoSig, err = schnorr.Sign(privKey, digest[:])
+	require.NoError(t, err)
+
+	encoded, err := atLeastAddr.EncodeAddress()
+	require.NoError(t, err)
+	_, err = DecodeAddress(encoded, &TestNet3Taro)
+	require.ErrorIs(t, err, ErrInvalidMemoSig)
+
+	// A memo signed by the memo key survives an encoding round trip.
+	memoKey := txscript.TweakTaprootPrivKey(*privKey, MemoKeyTweak[:])
+	require.True(t, memoKey.PubKey().IsEqual(MemoKey(privKey.PubKey())))
+	atLeastAddr.MemoSig, err = schnorr.Sign(memoKey, digest[:])
+	require.NoError(t, err)
+
+	encoded, err = atLeastAddr.EncodeAddress()
+	require.NoError(t, err)
+	decoded, err := DecodeAddress(encoded, &TestNet3Taro)
+	require.NoError(t, err)
+	assertAddressEqual(t, atLeastAddr, decoded)
+
+	// The message passed to signers that only hash with sha256 results in
+	// the tagged memo digest.
+	require.Equal(
+		t, digest, sha256.Sum256(MemoSigMsg(atLeastAddr.Memo)),
+	)
+
+	// But a memo that doesn't match its signature is rejected.
+	atLeastAddr.Memo = "order 2"
+	encoded, err = atLeastAddr.EncodeAddress()
+	require.NoError(t, err)
+	_, err = DecodeAddress(encoded, &TestNet3Taro)
+	require.ErrorIs(t, err, ErrInvalidMemoSig)
+}
+
+// TestAddressScriptVersion tests that the script version of an address is
+// encoded, and that it changes the asset leaf the address commits to.
+func TestAddressScriptVersion(t *testing.T) {
+	t.Parallel()
+
+	amt := uint64(10)
+	v0Addr, err := randAddress(t, &TestNet3Taro, false, &amt, asset.Normal)
+	require.NoError(t, err)
+
+	v1Addr := *v0Addr
+	v1Addr.ScriptVersion = asset.ScriptV1
+
+	encoded, err := v1Addr.EncodeAddress()
+	require.NoError(t, err)
+	decoded, err := DecodeAddress(encoded, &TestNet3Taro)
+	require.NoError(t, err)
+	assertAddressEqual(t, &v1Addr, decoded)
+
+	v0Key, err := v0Addr.TaprootOutputKey(nil)
+	require.NoError(t, err)
+	v1Key, err := v1Addr.TaprootOutputKey(nil)
+	require.NoError(t, err)
+	require.False(t, v0Key.IsEqual(v1Key))
+
+	// An address with a script version we don't know is rejected.
+	v1Addr.ScriptVersion = asset.ScriptV1 + 1
+	encoded, err = v1Addr.EncodeAddress()
+	require.NoError(t, err)
+	_, err = DecodeAddress(encoded, &TestNet3Taro)
+	require.ErrorIs(t, err, ErrUnknownScriptVersion)
+}
+
+// TestAddressUnknownTypes tests that unknown odd TLV types are skipped when
+// decoding an address, while unknown even types cause it to be rejected.
+func TestAddressUnknownTypes(t *testing.T) {
+	t.Parallel()
+
+	amt := uint64(10)
+	addr, err := randAddress(t, &TestNet3Taro, false, &amt, asset.Normal)
+	require.NoError(t, err)
+
+	encodeWithType := func(tlvType byte) string {
+		var buf bytes.Buffer
+		require.NoError(t, addr.Encode(&buf))
+
+		// The type is larger than all known types, so we can append a
+		// record with a single byte value to the end of the stream.
+		buf.Write([]byte{tlvType, 1, 0})
+
+		converted, err := bech32.ConvertBits(buf.Bytes(), 8, 5, true)
+		require.NoError(t, err)
+		encoded, err := bech32.EncodeM(TestNet3Taro.TaroHRP, converted)
+		require.NoError(t, err)
+
+		return encoded
+	}
+
+	decoded, err := DecodeAddress(encodeWithType(101), &TestNet3Taro)
+	require.NoError(t, err)
+	assertAddressEqual(t, addr, decoded)
+
+	_, err = DecodeAddress(encodeWithType(100), &TestNet3Taro)
+	require.ErrorIs(t, err, ErrUnknownRequiredType)
+}
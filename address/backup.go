@@ -0,0 +1,301 @@
+package address
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// BackupVersion is the current version of the address backup format.
+	BackupVersion = 0
+)
+
+var (
+	// ErrInvalidBackup is returned when an address backup is malformed or
+	// one of its entries is inconsistent.
+	ErrInvalidBackup = errors.New("invalid address backup")
+)
+
+// BackupKeyDesc is the JSON representation of a key descriptor within an
+// address backup.
+type BackupKeyDesc struct {
+	// PubKey is the hex encoded compressed public key.
+	PubKey string `json:"pub_key"`
+
+	// Family is the key family of the key locator.
+	Family uint32 `json:"key_family"`
+
+	// Index is the key index of the key locator.
+	Index uint32 `json:"key_index"`
+}
+
+// BackupEntry describes a single address within an address backup.
+type BackupEntry struct {
+	// Addr is the bech32m encoded Taro address.
+	Addr string `json:"addr"`
+
+	// InternalKey is the key descriptor of the internal key of the
+	// address.
+	InternalKey BackupKeyDesc `json:"internal_key"`
+
+	// RawScriptKey is the key descriptor of the script key of the address
+	// before the script key tweak is applied.
+	RawScriptKey BackupKeyDesc `json:"raw_script_key"`
+
+	// ScriptKeyTweak is the hex encoded tweak that is applied on the raw
+	// script key. If this is empty, then a BIP 86 tweak is assumed.
+	ScriptKeyTweak string `json:"script_key_tweak,omitempty"`
+
+	// TapscriptLeaves is the hex encoded set of tapscript leaves the
+	// script key commits to, if any.
+	TapscriptLeaves string `json:"tapscript_leaves,omitempty"`
+
+	// CreationTime is the Unix timestamp at which the address was
+	// created.
+	CreationTime int64 `json:"creation_time"`
+
+	// ExpiryTime is the Unix timestamp after which the address no longer
+	// accepts inbound transfers, if it expires.
+	ExpiryTime int64 `json:"expiry_time,omitempty"`
+
+	// ManagedAfter is the Unix timestamp at which the address was
+	// imported into the wallet. It is zero if the address isn't managed,
+	// for example because it was retired after it expired.
+	ManagedAfter int64 `json:"managed_after,omitempty"`
+
+	// SingleUse indicates that the address only accepts a single inbound
+	// transfer.
+	SingleUse bool `json:"single_use,omitempty"`
+
+	// Label is the free-form label of the address.
+	Label string `json:"label,omitempty"`
+
+	// ExternalMetadata is the set of key/value pairs that describe the
+	// address in the context of an external system.
+	ExternalMetadata map[string]string `json:"external_metadata,omitempty"`
+}
+
+// Backup is a portable export of a set of addresses, including the wallet
+// specific key information that isn't part of the encoded address.
+type Backup struct {
+	// Version is the version of the address backup format.
+	Version uint32 `json:"version"`
+
+	// Addrs are the addresses contained in the backup.
+	Addrs []BackupEntry `json:"addrs"`
+}
+
+// WriteBackup writes the given addresses into `w` as an address backup. The
+// chain params of each address must be set so it can be encoded.
+func WriteBackup(w io.Writer, addrs []AddrWithKeyInfo) error {
+	backup := &Backup{
+		Version: BackupVersion,
+		Addrs:   make([]BackupEntry, 0, len(addrs)),
+	}
+
+	for idx := range addrs {
+		addr := &addrs[idx]
+
+		addrStr, err := addr.EncodeAddress()
+		if err != nil {
+			return fmt.Errorf("unable to encode addr: %w", err)
+		}
+
+		tapLeaves, err := asset.EncodeTapLeaves(
+			addr.ScriptKeyTweak.TapLeaves,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to encode tapscript leaves: "+
+				"%w", err)
+		}
+
+		entry := BackupEntry{
+			Addr:         addrStr,
+			InternalKey:  newBackupKeyDesc(addr.InternalKeyDesc),
+			RawScriptKey: newBackupKeyDesc(addr.ScriptKeyTweak.RawKey),
+			ScriptKeyTweak: hex.EncodeToString(
+				addr.ScriptKeyTweak.Tweak,
+			),
+			TapscriptLeaves:  hex.EncodeToString(tapLeaves),
+			CreationTime:     addr.CreationTime.Unix(),
+			SingleUse:        addr.SingleUse,
+			Label:            addr.Label,
+			ExternalMetadata: addr.ExternalMetadata,
+		}
+		if !addr.ExpiryTime.IsZero() {
+			entry.ExpiryTime = addr.ExpiryTime.Unix()
+		}
+		if !addr.ManagedAfter.IsZero() {
+			entry.ManagedAfter = addr.ManagedAfter.Unix()
+		}
+
+		backup.Addrs = append(backup.Addrs, entry)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(backup)
+}
+
+// ReadBackup parses an address backup created by WriteBackup. Every entry is
+// checked for consistency between the encoded address and its key
+// information. Addresses that aren't for the given network are rejected.
+func ReadBackup(r io.Reader, net *ChainParams) ([]AddrWithKeyInfo, error) {
+	var backup Backup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("%w: unable to decode backup: %v",
+			ErrInvalidBackup, err)
+	}
+
+	if backup.Version != BackupVersion {
+		return nil, fmt.Errorf("%w: unknown version %d",
+			ErrInvalidBackup, backup.Version)
+	}
+
+	addrs := make([]AddrWithKeyInfo, 0, len(backup.Addrs))
+	for idx := range backup.Addrs {
+		addr, err := parseBackupEntry(&backup.Addrs[idx], net)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %d: %v",
+				ErrInvalidBackup, idx, err)
+		}
+
+		addrs = append(addrs, *addr)
+	}
+
+	return addrs, nil
+}
+
+// parseBackupEntry turns a single address backup entry into an address with
+// its key information.
+func parseBackupEntry(entry *BackupEntry,
+	net *ChainParams) (*AddrWithKeyInfo, error) {
+
+	taroAddr, err := DecodeAddress(entry.Addr, net)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	internalKeyDesc, err := parseBackupKeyDesc(entry.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid internal key: %w", err)
+	}
+	if !internalKeyDesc.PubKey.IsEqual(&taroAddr.InternalKey) {
+		return nil, fmt.Errorf("internal key doesn't match addr")
+	}
+
+	rawScriptKey, err := parseBackupKeyDesc(entry.RawScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid raw script key: %w", err)
+	}
+	tweak, err := hex.DecodeString(entry.ScriptKeyTweak)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key tweak: %w", err)
+	}
+	leafBytes, err := hex.DecodeString(entry.TapscriptLeaves)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tapscript leaves: %w", err)
+	}
+	tapLeaves, err := asset.DecodeTapLeaves(leafBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tapscript leaves: %w", err)
+	}
+
+	scriptKeyTweak := asset.TweakedScriptKey{
+		RawKey:    rawScriptKey,
+		TapLeaves: tapLeaves,
+	}
+	if len(tweak) > 0 {
+		scriptKeyTweak.Tweak = tweak
+	}
+
+	// The tweaked raw script key must result in the script key of the
+	// address, otherwise we'd never be able to spend what we receive.
+	scriptKey := tweakScriptKey(&scriptKeyTweak)
+	if !equalXOnly(scriptKey, &taroAddr.ScriptKey) {
+		return nil, fmt.Errorf("script key doesn't match addr")
+	}
+
+	taprootOutputKey, err := taroAddr.TaprootOutputKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive Taproot output key: "+
+			"%w", err)
+	}
+
+	addr := &AddrWithKeyInfo{
+		Taro:             taroAddr,
+		ScriptKeyTweak:   scriptKeyTweak,
+		InternalKeyDesc:  internalKeyDesc,
+		TaprootOutputKey: *taprootOutputKey,
+		CreationTime:     time.Unix(entry.CreationTime, 0),
+		SingleUse:        entry.SingleUse,
+		Label:            entry.Label,
+		ExternalMetadata: entry.ExternalMetadata,
+	}
+	if entry.ExpiryTime != 0 {
+		addr.ExpiryTime = time.Unix(entry.ExpiryTime, 0)
+	}
+	if entry.ManagedAfter != 0 {
+		addr.ManagedAfter = time.Unix(entry.ManagedAfter, 0)
+	}
+
+	return addr, nil
+}
+
+// newBackupKeyDesc turns a key descriptor into its backup representation.
+func newBackupKeyDesc(keyDesc keychain.KeyDescriptor) BackupKeyDesc {
+	return BackupKeyDesc{
+		PubKey: hex.EncodeToString(
+			keyDesc.PubKey.SerializeCompressed(),
+		),
+		Family: uint32(keyDesc.Family),
+		Index:  keyDesc.Index,
+	}
+}
+
+// parseBackupKeyDesc parses a key descriptor from its backup representation.
+func parseBackupKeyDesc(desc BackupKeyDesc) (keychain.KeyDescriptor, error) {
+	keyBytes, err := hex.DecodeString(desc.PubKey)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+	pubKey, err := btcec.ParsePubKey(keyBytes)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(desc.Family),
+			Index:  desc.Index,
+		},
+		PubKey: pubKey,
+	}, nil
+}
+
+// tweakScriptKey applies the script key tweak on the raw script key. Without
+// a tweak, the raw key is tweaked BIP 86 style.
+func tweakScriptKey(t *asset.TweakedScriptKey) *btcec.PublicKey {
+	if len(t.Tweak) == 0 {
+		return txscript.ComputeTaprootKeyNoScript(t.RawKey.PubKey)
+	}
+
+	return txscript.ComputeTaprootOutputKey(t.RawKey.PubKey, t.Tweak)
+}
+
+// equalXOnly returns true if the two keys are equal in their x-only
+// representation.
+func equalXOnly(a, b *btcec.PublicKey) bool {
+	return string(schnorr.SerializePubKey(a)) ==
+		string(schnorr.SerializePubKey(b))
+}
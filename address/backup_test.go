@@ -0,0 +1,114 @@
+package address
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddrBackup tests that addresses can be written into an address backup
+// and read back, and that inconsistent backups are rejected.
+func TestAddrBackup(t *testing.T) {
+	t.Parallel()
+
+	// We create a few random addresses with all optional properties set
+	// for one of them.
+	const numAddrs = 3
+	addrs := make([]AddrWithKeyInfo, numAddrs)
+	for i := 0; i < numAddrs; i++ {
+		addr := RandAddr(t, &TestNet3Taro)
+
+		outputKey, err := addr.Taro.TaprootOutputKey(nil)
+		require.NoError(t, err)
+		addr.TaprootOutputKey = *outputKey
+		addr.CreationTime = time.Unix(addr.CreationTime.Unix(), 0)
+
+		addrs[i] = *addr
+	}
+	addrs[0].ExpiryTime = addrs[0].CreationTime.Add(time.Hour)
+	addrs[0].SingleUse = true
+	addrs[0].ManagedAfter = addrs[0].CreationTime.Add(time.Minute)
+	addrs[0].Label = "invoice"
+	addrs[0].ExternalMetadata = map[string]string{
+		"order": "1234",
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBackup(&buf, addrs))
+
+	backupAddrs, err := ReadBackup(
+		bytes.NewReader(buf.Bytes()), &TestNet3Taro,
+	)
+	require.NoError(t, err)
+	require.Len(t, backupAddrs, numAddrs)
+	for idx := range addrs {
+		a, b := addrs[idx], backupAddrs[idx]
+
+		assertAddressEqual(t, a.Taro, b.Taro)
+		require.Equal(t, a.InternalKeyDesc, b.InternalKeyDesc)
+		require.Equal(
+			t, a.ScriptKeyTweak.RawKey, b.ScriptKeyTweak.RawKey,
+		)
+		require.Equal(t, a.ScriptKeyTweak.Tweak, b.ScriptKeyTweak.Tweak)
+		require.Equal(
+			t, a.ScriptKeyTweak.TapLeaves,
+			b.ScriptKeyTweak.TapLeaves,
+		)
+		require.True(t, a.TaprootOutputKey.IsEqual(&b.TaprootOutputKey))
+		require.True(t, a.CreationTime.Equal(b.CreationTime))
+		require.True(t, a.ExpiryTime.Equal(b.ExpiryTime))
+		require.Equal(t, a.SingleUse, b.SingleUse)
+		require.Equal(t, a.Label, b.Label)
+		require.Equal(t, a.ExternalMetadata, b.ExternalMetadata)
+		require.True(t, a.ManagedAfter.Equal(b.ManagedAfter))
+	}
+
+	// A backup for a different network is rejected.
+	_, err = ReadBackup(bytes.NewReader(buf.Bytes()), &MainNetTaro)
+	require.ErrorIs(t, err, ErrInvalidBackup)
+
+	// If the key information of an entry doesn't match the address, the
+	// backup is rejected as well.
+	rewriteBackup := func(modify func(*Backup)) []byte {
+		var backup Backup
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &backup))
+		modify(&backup)
+
+		backupBytes, err := json.Marshal(&backup)
+		require.NoError(t, err)
+
+		return backupBytes
+	}
+
+	randKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	randKeyDesc := newBackupKeyDesc(keychain.KeyDescriptor{
+		PubKey: randKey.PubKey(),
+	})
+
+	invalidBackups := [][]byte{
+		rewriteBackup(func(b *Backup) {
+			b.Version = BackupVersion + 1
+		}),
+		rewriteBackup(func(b *Backup) {
+			b.Addrs[1].InternalKey = randKeyDesc
+		}),
+		rewriteBackup(func(b *Backup) {
+			b.Addrs[1].RawScriptKey = randKeyDesc
+		}),
+		rewriteBackup(func(b *Backup) {
+			b.Addrs[1].ScriptKeyTweak = "00"
+		}),
+	}
+	for _, backupBytes := range invalidBackups {
+		_, err := ReadBackup(
+			bytes.NewReader(backupBytes), &TestNet3Taro,
+		)
+		require.ErrorIs(t, err, ErrInvalidBackup)
+	}
+}
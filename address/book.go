@@ -2,12 +2,15 @@ package address
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro/asset"
@@ -38,6 +41,102 @@ type AddrWithKeyInfo struct {
 	// ManagedAfter is the time at which the address was imported into the
 	// wallet.
 	ManagedAfter time.Time
+
+	// ExpiryTime is the time after which the address no longer accepts
+	// inbound transfers. A zero value means the address never expires.
+	ExpiryTime time.Time
+
+	// SingleUse indicates that the address is only expected to receive a
+	// single inbound transfer.
+	SingleUse bool
+
+	// WatchOnly indicates that the address was imported from another
+	// address book, so its keys might not be held by our key ring. Inbound
+	// transfers to it are tracked, but the received assets aren't part of
+	// our balance and are never selected as inputs.
+	WatchOnly bool
+
+	// Label is an optional free-form label of the address that can be used
+	// to map it to an entity of an external system.
+	Label string
+
+	// ExternalMetadata is an optional set of key/value pairs that describe
+	// the address in the context of an external system.
+	ExternalMetadata map[string]string
+}
+
+// IsExpired returns true if the address has an expiry time and the given
+// point in time lies after it.
+func (a *AddrWithKeyInfo) IsExpired(now time.Time) bool {
+	return !a.ExpiryTime.IsZero() && now.After(a.ExpiryTime)
+}
+
+// NewAddrOption is a functional option that can be used to modify the
+// properties of a newly created address.
+type NewAddrOption func(*AddrWithKeyInfo)
+
+// WithExpiry returns an option that sets the time after which the new address
+// no longer accepts inbound transfers.
+func WithExpiry(expiry time.Time) NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.ExpiryTime = expiry
+	}
+}
+
+// WithSingleUse returns an option that marks the new address as only being
+// able to receive a single inbound transfer.
+func WithSingleUse() NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.SingleUse = true
+	}
+}
+
+// WithLabel returns an option that sets the free-form label of the new
+// address.
+func WithLabel(label string) NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.Label = label
+	}
+}
+
+// WithExternalMetadata returns an option that sets the external key/value
+// metadata of the new address.
+func WithExternalMetadata(metadata map[string]string) NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.ExternalMetadata = metadata
+	}
+}
+
+// WithAmountPolicy returns an option that sets the amount policy of the new
+// address, allowing it to accept amounts other than exactly the requested one.
+func WithAmountPolicy(policy AmountPolicy) NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.AmountPolicy = policy
+	}
+}
+
+// WithMemo returns an option that sets the memo of the new address. The memo
+// is signed with the internal key of the address.
+func WithMemo(memo string) NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.Memo = memo
+	}
+}
+
+// WithScriptVersion returns an option that sets the script version of the
+// asset the new address expects to receive.
+func WithScriptVersion(version asset.ScriptVersion) NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.ScriptVersion = version
+	}
+}
+
+// withFamilyOnly returns an option that marks the new address as accepting any
+// asset ID within the family of its family key.
+func withFamilyOnly() NewAddrOption {
+	return func(addr *AddrWithKeyInfo) {
+		addr.FamilyOnly = true
+	}
 }
 
 // QueryParams holds the set of query params for the address book.
@@ -58,8 +157,27 @@ type QueryParams struct {
 	Offset int32
 
 	// UnmanagedOnly is a boolean pointer indicating whether only addresses
-	// should be returned that are not yet managed by the wallet.
+	// should be returned that are not yet managed by the wallet. Single-use
+	// addresses that already received a transfer are never returned in
+	// this case, as they shouldn't be managed again.
 	UnmanagedOnly bool
+
+	// ExpiredBefore if set, only addresses with an expiry time before the
+	// given time will be returned.
+	ExpiredBefore time.Time
+
+	// Label if set, only addresses with exactly this label will be
+	// returned.
+	Label string
+
+	// ExternalMetadataKey if set, only addresses that have an external
+	// metadata entry with this key will be returned.
+	ExternalMetadataKey string
+
+	// ExternalMetadataValue if set, only addresses whose external metadata
+	// entry with the above key has this value will be returned. It is
+	// ignored if no ExternalMetadataKey is set.
+	ExternalMetadataValue string
 }
 
 // Storage is the main storage interface for the address book.
@@ -78,8 +196,14 @@ type Storage interface {
 	AddrByTaprootOutput(ctx context.Context,
 		key *btcec.PublicKey) (*AddrWithKeyInfo, error)
 
+	// AddrByScriptKey returns a single address based on its script key or
+	// an ErrNoAddr error if no such address exists.
+	AddrByScriptKey(ctx context.Context,
+		key *btcec.PublicKey) (*AddrWithKeyInfo, error)
+
 	// SetAddrManaged sets an address as being managed by the internal
-	// wallet.
+	// wallet. A zero managedFrom time marks the address as no longer being
+	// managed.
 	SetAddrManaged(ctx context.Context, addr *AddrWithKeyInfo,
 		managedFrom time.Time) error
 }
@@ -91,6 +215,16 @@ type KeyRing interface {
 	DeriveNextTaroKey(context.Context) (keychain.KeyDescriptor, error)
 }
 
+// MemoSigner is used to sign the memo of an address with the memo key that is
+// derived from its internal key.
+type MemoSigner interface {
+	// SignMemo signs the digest of the given memo, as returned by
+	// MemoDigest, with the memo key, as returned by MemoKey, of the
+	// internal key identified by the passed key descriptor.
+	SignMemo(ctx context.Context, keyDesc keychain.KeyDescriptor,
+		memo string) (*schnorr.Signature, error)
+}
+
 // BookConfig is the main config for the address.Book.
 type BookConfig struct {
 	// Store holds the set of created addresses.
@@ -99,6 +233,9 @@ type BookConfig struct {
 	// KeyRing points to an active key ring instance.
 	KeyRing KeyRing
 
+	// MemoSigner is used to sign the memo of new addresses.
+	MemoSigner MemoSigner
+
 	// Chain points to the chain the address.Book is active on.
 	Chain ChainParams
 
@@ -115,8 +252,13 @@ type Book struct {
 	// address events, keyed by their subscription ID.
 	subscribers map[uint64]*chanutils.EventReceiver[*AddrWithKeyInfo]
 
-	// subscriberMtx guards the subscribers map and access to the
-	// subscriptionID.
+	// eventSubscribers is a map of components that want to be notified on
+	// new or updated inbound asset transfer events, keyed by their
+	// subscription ID.
+	eventSubscribers map[uint64]*chanutils.EventReceiver[*Event]
+
+	// subscriberMtx guards the subscribers and eventSubscribers maps and
+	// access to the subscriptionID.
 	subscriberMtx sync.Mutex
 }
 
@@ -131,12 +273,20 @@ func NewBook(cfg BookConfig) *Book {
 		subscribers: make(
 			map[uint64]*chanutils.EventReceiver[*AddrWithKeyInfo],
 		),
+		eventSubscribers: make(
+			map[uint64]*chanutils.EventReceiver[*Event],
+		),
 	}
 }
 
 // NewAddress creates a new Taro address based on the input parameters.
+//
+// If any tapscript leaves are specified, then the script key of the address
+// commits to the tapscript tree assembled from them, allowing the received
+// assets to also be spent through the script path.
 func (b *Book) NewAddress(ctx context.Context, genesis asset.Genesis,
-	famKey *btcec.PublicKey, amount uint64) (*AddrWithKeyInfo, error) {
+	famKey *btcec.PublicKey, amount uint64, tapLeaves []txscript.TapLeaf,
+	opts ...NewAddrOption) (*AddrWithKeyInfo, error) {
 
 	rawScriptKeyDesc, err := b.cfg.KeyRing.DeriveNextTaroKey(ctx)
 	if err != nil {
@@ -145,34 +295,119 @@ func (b *Book) NewAddress(ctx context.Context, genesis asset.Genesis,
 
 	// Given the raw key desc for the script key, we'll map this to a BIP
 	// 86 tweaked key as by default we'll generate keys that can be used
-	// with a plain key spend.
-	scriptKey := asset.NewScriptKeyBIP0086(rawScriptKeyDesc)
+	// with a plain key spend. If tapscript leaves were specified, the key
+	// is tweaked with the root of their tree instead.
+	scriptKey := asset.NewScriptKeyTapscript(
+		rawScriptKeyDesc, tapLeaves...,
+	)
 
 	internalKeyDesc, err := b.cfg.KeyRing.DeriveNextTaroKey(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to gen key: %w", err)
 	}
 
-	baseAddr, err := New(
+	return b.NewAddressWithKeys(
+		ctx, genesis, famKey, amount, scriptKey, internalKeyDesc,
+		opts...,
+	)
+}
+
+// NewFamilyAddress creates a new Taro address that accepts any asset ID within
+// the asset family identified by the given family key. This allows receiving
+// assets that were issued in multiple tranches without knowing which of them
+// the sender holds.
+func (b *Book) NewFamilyAddress(ctx context.Context, famKey *btcec.PublicKey,
+	assetType asset.Type, amount uint64, tapLeaves []txscript.TapLeaf,
+	opts ...NewAddrOption) (*AddrWithKeyInfo, error) {
+
+	if famKey == nil {
+		return nil, ErrMissingFamilyKey
+	}
+
+	return b.NewAddress(
+		ctx, FamilyGenesis(assetType), famKey, amount, tapLeaves,
+		append(opts, withFamilyOnly())...,
+	)
+}
+
+// NewAddressWithKeys creates a new Taro address based on the input parameters
+// and the given script and internal keys instead of deriving new ones. This
+// can be used to receive assets to keys that aren't (fully) controlled by our
+// key ring, such as MuSig2 aggregate keys.
+func (b *Book) NewAddressWithKeys(ctx context.Context, genesis asset.Genesis,
+	famKey *btcec.PublicKey, amount uint64, scriptKey asset.ScriptKey,
+	internalKeyDesc keychain.KeyDescriptor,
+	opts ...NewAddrOption) (*AddrWithKeyInfo, error) {
+
+	if scriptKey.TweakedScriptKey == nil {
+		return nil, fmt.Errorf("script key is missing tweak info")
+	}
+
+	// Some of the options modify the encoded address itself, so we apply
+	// them to an empty address first.
+	addr := AddrWithKeyInfo{
+		Taro:         &Taro{},
+		CreationTime: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(&addr)
+	}
+
+	baseAddr, err := NewPaymentRequest(
 		genesis, famKey, *scriptKey.PubKey, *internalKeyDesc.PubKey,
-		amount, &b.cfg.Chain,
+		amount, addr.AmountPolicy, addr.Memo, &b.cfg.Chain,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make new addr: %w", err)
 	}
 
+	switch addr.ScriptVersion {
+	case asset.ScriptV0, asset.ScriptV1:
+		baseAddr.ScriptVersion = addr.ScriptVersion
+
+	default:
+		return nil, ErrUnknownScriptVersion
+	}
+
+	if addr.FamilyOnly {
+		if famKey == nil {
+			return nil, ErrMissingFamilyKey
+		}
+		baseAddr.FamilyOnly = true
+	}
+
+	if baseAddr.Memo != "" {
+		if b.cfg.MemoSigner == nil {
+			return nil, fmt.Errorf("no signer for addr memo")
+		}
+
+		baseAddr.MemoSig, err = b.cfg.MemoSigner.SignMemo(
+			ctx, internalKeyDesc, baseAddr.Memo,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign addr memo: %w",
+				err)
+		}
+		if err := baseAddr.VerifyMemo(); err != nil {
+			return nil, err
+		}
+	}
+
 	taprootOutputKey, err := baseAddr.TaprootOutputKey(nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to derive Taproot output key:"+
 			" %w", err)
 	}
 
-	addr := AddrWithKeyInfo{
-		Taro:             baseAddr,
-		ScriptKeyTweak:   *scriptKey.TweakedScriptKey,
-		InternalKeyDesc:  internalKeyDesc,
-		TaprootOutputKey: *taprootOutputKey,
-		CreationTime:     time.Now(),
+	addr.Taro = baseAddr
+	addr.ScriptKeyTweak = *scriptKey.TweakedScriptKey
+	addr.InternalKeyDesc = internalKeyDesc
+	addr.TaprootOutputKey = *taprootOutputKey
+
+	if !addr.ExpiryTime.IsZero() &&
+		!addr.ExpiryTime.After(addr.CreationTime) {
+
+		return nil, fmt.Errorf("expiry time must be in the future")
 	}
 
 	if err := b.cfg.Store.InsertAddrs(ctx, addr); err != nil {
@@ -189,6 +424,97 @@ func (b *Book) NewAddress(ctx context.Context, genesis asset.Genesis,
 	return &addr, nil
 }
 
+// ImportAddrs imports a set of addresses that were created by another address
+// book, for example from an address backup. The keys of the addresses might
+// not be held by our key ring, so they're imported as watch-only addresses.
+// The managed state of each address is kept as is, and only the addresses that
+// are still managed are handed to our subscribers, so inbound transfers to
+// them are tracked. Addresses that were retired by the other address book
+// stay retired. Addresses that are already known are skipped. The addresses
+// that were actually imported are returned.
+func (b *Book) ImportAddrs(ctx context.Context,
+	addrs []AddrWithKeyInfo) ([]AddrWithKeyInfo, error) {
+
+	return b.insertNewAddrs(ctx, addrs, true)
+}
+
+// RestoreAddrs restores a set of addresses whose keys were derived by our key
+// ring, for example addresses that were lost from the database and recovered
+// from the chain. The addresses are handed to our subscribers just like newly
+// created ones. Addresses that are already known are skipped. The addresses
+// that were actually restored are returned.
+func (b *Book) RestoreAddrs(ctx context.Context,
+	addrs []AddrWithKeyInfo) ([]AddrWithKeyInfo, error) {
+
+	return b.insertNewAddrs(ctx, addrs, false)
+}
+
+// insertNewAddrs inserts all addresses that aren't known yet and informs our
+// subscribers about them. Unmanaged watch-only addresses were retired by the
+// address book they were imported from, so our subscribers aren't informed
+// about them.
+func (b *Book) insertNewAddrs(ctx context.Context, addrs []AddrWithKeyInfo,
+	watchOnly bool) ([]AddrWithKeyInfo, error) {
+
+	var (
+		newAddrs = make([]AddrWithKeyInfo, 0, len(addrs))
+		seen     = make(map[[32]byte]struct{}, len(addrs))
+	)
+	for idx := range addrs {
+		addr := addrs[idx]
+
+		var outputKey [32]byte
+		copy(outputKey[:], schnorr.SerializePubKey(
+			&addr.TaprootOutputKey,
+		))
+		if _, ok := seen[outputKey]; ok {
+			continue
+		}
+		seen[outputKey] = struct{}{}
+
+		_, err := b.cfg.Store.AddrByTaprootOutput(
+			ctx, &addr.TaprootOutputKey,
+		)
+		switch {
+		// We already know this address, so there's nothing to import.
+		case err == nil:
+			continue
+
+		case !errors.Is(err, ErrNoAddr):
+			return nil, fmt.Errorf("unable to look up addr: %w",
+				err)
+		}
+
+		addr.WatchOnly = watchOnly
+		newAddrs = append(newAddrs, addr)
+	}
+
+	if len(newAddrs) == 0 {
+		return nil, nil
+	}
+
+	if err := b.cfg.Store.InsertAddrs(ctx, newAddrs...); err != nil {
+		return nil, fmt.Errorf("unable to insert addrs: %w", err)
+	}
+
+	// Inform our subscribers about the new addresses.
+	b.subscriberMtx.Lock()
+	for idx := range newAddrs {
+		if newAddrs[idx].WatchOnly &&
+			newAddrs[idx].ManagedAfter.IsZero() {
+
+			continue
+		}
+
+		for _, sub := range b.subscribers {
+			sub.NewItemCreated.ChanIn() <- &newAddrs[idx]
+		}
+	}
+	b.subscriberMtx.Unlock()
+
+	return newAddrs, nil
+}
+
 // ListAddrs lists a set of addresses based on the expressed query params.
 func (b *Book) ListAddrs(ctx context.Context,
 	params QueryParams) ([]AddrWithKeyInfo, error) {
@@ -204,8 +530,16 @@ func (b *Book) AddrByTaprootOutput(ctx context.Context,
 	return b.cfg.Store.AddrByTaprootOutput(ctx, key)
 }
 
+// AddrByScriptKey returns a single address based on its script key or an
+// ErrNoAddr error if no such address exists.
+func (b *Book) AddrByScriptKey(ctx context.Context,
+	key *btcec.PublicKey) (*AddrWithKeyInfo, error) {
+
+	return b.cfg.Store.AddrByScriptKey(ctx, key)
+}
+
 // SetAddrManaged sets an address as being managed by the internal
-// wallet.
+// wallet. A zero managedFrom time marks the address as no longer being managed.
 func (b *Book) SetAddrManaged(ctx context.Context, addr *AddrWithKeyInfo,
 	managedFrom time.Time) error {
 
@@ -219,9 +553,16 @@ func (b *Book) GetOrCreateEvent(ctx context.Context, status Status,
 	addr *AddrWithKeyInfo, walletTx *lndclient.Transaction,
 	outputIdx uint32, tapscriptSibling *chainhash.Hash) (*Event, error) {
 
-	return b.cfg.Store.GetOrCreateEvent(
+	event, err := b.cfg.Store.GetOrCreateEvent(
 		ctx, status, addr, walletTx, outputIdx, tapscriptSibling,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	b.publishEvent(event)
+
+	return event, nil
 }
 
 // GetPendingEvents returns all events that are not yet in status complete from
@@ -248,7 +589,67 @@ func (b *Book) QueryEvents(ctx context.Context,
 func (b *Book) CompleteEvent(ctx context.Context, event *Event,
 	status Status, anchorPoint wire.OutPoint) error {
 
-	return b.cfg.Store.CompleteEvent(ctx, event, status, anchorPoint)
+	err := b.cfg.Store.CompleteEvent(ctx, event, status, anchorPoint)
+	if err != nil {
+		return err
+	}
+
+	completedEvent := *event
+	completedEvent.Status = status
+	completedEvent.Outpoint = anchorPoint
+	completedEvent.HasProof = true
+	b.publishEvent(&completedEvent)
+
+	return nil
+}
+
+// SetEventStatus updates the status of an existing address event, for example
+// once its transaction reached the number of confirmations required to be
+// considered final.
+func (b *Book) SetEventStatus(ctx context.Context, event *Event,
+	status Status) error {
+
+	if err := b.cfg.Store.SetEventStatus(ctx, event, status); err != nil {
+		return err
+	}
+
+	updatedEvent := *event
+	updatedEvent.Status = status
+	b.publishEvent(&updatedEvent)
+
+	return nil
+}
+
+// InsertRecoveryOutput stores a wallet output that doesn't belong to any known
+// address, but might belong to one that was lost from the database. Until its
+// proof tells, the output is listed as an event without an address.
+func (b *Book) InsertRecoveryOutput(ctx context.Context,
+	walletTx *lndclient.Transaction, outputIdx uint32) error {
+
+	return b.cfg.Store.InsertRecoveryOutput(ctx, walletTx, outputIdx)
+}
+
+// QueryRecoveryEvents returns all wallet outputs that might belong to a lost
+// address as events without an address that await their proof.
+func (b *Book) QueryRecoveryEvents(ctx context.Context) ([]*Event, error) {
+	return b.cfg.Store.QueryRecoveryOutputs(ctx)
+}
+
+// DeleteRecoveryOutput deletes a wallet output that might belong to a lost
+// address, once it's clear whether it does.
+func (b *Book) DeleteRecoveryOutput(ctx context.Context,
+	op wire.OutPoint) error {
+
+	return b.cfg.Store.DeleteRecoveryOutput(ctx, op)
+}
+
+// publishEvent informs our event subscribers about a new or updated event.
+func (b *Book) publishEvent(event *Event) {
+	b.subscriberMtx.Lock()
+	for _, sub := range b.eventSubscribers {
+		sub.NewItemCreated.ChanIn() <- event
+	}
+	b.subscriberMtx.Unlock()
 }
 
 // RegisterSubscriber adds a new subscriber for receiving events. The
@@ -309,3 +710,62 @@ func (b *Book) RemoveSubscriber(
 
 	return nil
 }
+
+// RegisterEventSubscriber adds a new subscriber for receiving new or updated
+// inbound asset transfer events. Each time the status of an event changes, the
+// event is delivered to the NewItemCreated channel again. The deliverExisting
+// boolean indicates whether already existing events should be delivered when
+// the subscription is started, in which case deliverFrom can be used to limit
+// them, for example to the ones created after a certain time.
+func (b *Book) RegisterEventSubscriber(
+	receiver *chanutils.EventReceiver[*Event], deliverExisting bool,
+	deliverFrom EventQueryParams) error {
+
+	b.subscriberMtx.Lock()
+	defer b.subscriberMtx.Unlock()
+
+	b.eventSubscribers[receiver.ID()] = receiver
+
+	// No delivery of existing items requested, we're done here.
+	if !deliverExisting {
+		return nil
+	}
+
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), b.cfg.StoreTimeout,
+	)
+	defer cancel()
+
+	existingEvents, err := b.QueryEvents(ctxt, deliverFrom)
+	if err != nil {
+		return fmt.Errorf("error querying existing events: %w", err)
+	}
+
+	// Deliver each existing event to the new item queue of the
+	// subscriber.
+	for i := range existingEvents {
+		receiver.NewItemCreated.ChanIn() <- existingEvents[i]
+	}
+
+	return nil
+}
+
+// RemoveEventSubscriber removes the given event subscriber and also stops it
+// from processing events.
+func (b *Book) RemoveEventSubscriber(
+	subscriber *chanutils.EventReceiver[*Event]) error {
+
+	b.subscriberMtx.Lock()
+	defer b.subscriberMtx.Unlock()
+
+	_, ok := b.eventSubscribers[subscriber.ID()]
+	if !ok {
+		return fmt.Errorf("event subscriber with ID %d not found",
+			subscriber.ID())
+	}
+
+	subscriber.Stop()
+	delete(b.eventSubscribers, subscriber.ID())
+
+	return nil
+}
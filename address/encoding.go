@@ -1,9 +1,12 @@
 package address
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taro/asset"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -40,3 +43,94 @@ func compressedPubKeyDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error
 		val, "*btcec.PublicKey", l, btcec.PubKeyBytesLenCompressed,
 	)
 }
+
+func boolEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*bool); ok {
+		var intVal uint8
+		if *t {
+			intVal = 1
+		}
+
+		return tlv.EUint8(w, &intVal, buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "bool")
+}
+
+func boolDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if typ, ok := val.(*bool); ok {
+		var intVal uint8
+		if err := tlv.DUint8(r, &intVal, buf, l); err != nil {
+			return err
+		}
+
+		*typ = intVal == 1
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "bool", l, 1)
+}
+
+func amountPolicyEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*AmountPolicy); ok {
+		return tlv.EUint8T(w, uint8(*t), buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "AmountPolicy")
+}
+
+func amountPolicyDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if typ, ok := val.(*AmountPolicy); ok {
+		var t uint8
+		if err := tlv.DUint8(r, &t, buf, l); err != nil {
+			return err
+		}
+		*typ = AmountPolicy(t)
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "AmountPolicy", l, 1)
+}
+
+func memoEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*string); ok {
+		memoBytes := []byte(*t)
+		return tlv.EVarBytes(w, &memoBytes, buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "string")
+}
+
+func memoDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if typ, ok := val.(*string); ok {
+		// We limit the size of the memo to prevent memory blow ups.
+		if l > MaxMemoLength {
+			return fmt.Errorf("%w: %v", ErrMemoTooLong, l)
+		}
+
+		var memoBytes []byte
+		if err := tlv.DVarBytes(r, &memoBytes, buf, l); err != nil {
+			return err
+		}
+		*typ = string(memoBytes)
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "string", l, l)
+}
+
+func memoSigEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(**schnorr.Signature); ok {
+		return asset.SchnorrSignatureEncoder(w, *t, buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "*schnorr.Signature")
+}
+
+func memoSigDecoder(r io.Reader, val any, buf *[8]byte, l uint64) error {
+	if typ, ok := val.(**schnorr.Signature); ok {
+		var sig schnorr.Signature
+		err := asset.SchnorrSignatureDecoder(r, &sig, buf, l)
+		if err != nil {
+			return err
+		}
+		*typ = &sig
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(
+		val, "*schnorr.Signature", l, schnorr.SignatureSize,
+	)
+}
@@ -21,18 +21,31 @@ const (
 	StatusTransactionDetected Status = 0
 
 	// StatusTransactionConfirmed denotes that the transaction for an
-	// incoming asset transfer was confirmed. The transfer now requires the
-	// proof to be imported to proceed.
+	// incoming asset transfer was confirmed but hasn't yet reached the
+	// number of confirmations required to be considered final.
 	StatusTransactionConfirmed Status = 1
 
+	// StatusTransactionFinal denotes that the transaction for an incoming
+	// asset transfer reached the number of confirmations required to be
+	// considered final. The transfer now requires the proof to be imported
+	// to proceed.
+	StatusTransactionFinal Status = 2
+
 	// StatusProofReceived denotes that the proof for an incoming asset
 	// transfer was received and is now being validated and processed.
-	StatusProofReceived Status = 2
+	StatusProofReceived Status = 3
 
 	// StatusCompleted denotes that an incoming asset transfer was completed
 	// successfully and the local node has taken over custody of the assets
 	// that were transferred.
-	StatusCompleted Status = 3
+	StatusCompleted Status = 4
+
+	// StatusLatePayment denotes that an incoming asset transfer was detected
+	// for an address that already expired or, in case of a single-use
+	// address, already received a transfer before. The local node doesn't
+	// take custody of the assets, the transfer needs to be refunded
+	// manually instead.
+	StatusLatePayment Status = 5
 )
 
 // EventQueryParams holds the set of query params for address events.
@@ -49,6 +62,27 @@ type EventQueryParams struct {
 	// StatusTo is the largest status to query for (inclusive). Can be
 	// set to nil to return events of all states.
 	StatusTo *Status
+
+	// CreatedAfter if set, only events created at or after the time will
+	// be returned.
+	CreatedAfter time.Time
+
+	// UpdatedAfter if set, only events whose status last changed at or
+	// after the time will be returned.
+	UpdatedAfter time.Time
+
+	// AddrLabel if set, only events of addresses with exactly this label
+	// will be returned.
+	AddrLabel string
+
+	// AddrExternalMetadataKey if set, only events of addresses that have
+	// an external metadata entry with this key will be returned.
+	AddrExternalMetadataKey string
+
+	// AddrExternalMetadataValue if set, only events of addresses whose
+	// external metadata entry with the above key has this value will be
+	// returned. It is ignored if no AddrExternalMetadataKey is set.
+	AddrExternalMetadataValue string
 }
 
 // Event represents a single incoming asset transfer that was initiated by
@@ -63,7 +97,12 @@ type Event struct {
 	// CreationTime is the time the event was first created.
 	CreationTime time.Time
 
-	// Addr is the Taro address that was used to receive the assets.
+	// UpdateTime is the time the status of the event last changed.
+	UpdateTime time.Time
+
+	// Addr is the Taro address that was used to receive the assets. It is
+	// nil for wallet outputs found in recovery mode that await the proof
+	// telling whether they belong to a lost address.
 	Addr *AddrWithKeyInfo
 
 	// Status represents the current status of the incoming assets.
@@ -74,10 +113,15 @@ type Event struct {
 	Outpoint wire.OutPoint
 
 	// Amt is the amount of satoshis that were transferred in the Bitcoin
-	// on-chain transaction. This is independent of the asset amount, which
-	// can be looked up through the Addr field.
+	// on-chain transaction. This is independent of the asset amount.
 	Amt btcutil.Amount
 
+	// AssetAmount is the amount of asset units that were actually
+	// received. Depending on the amount policy of the address, this can
+	// differ from the amount requested by the address. It is only known
+	// once the proof of the transfer was imported and is zero before.
+	AssetAmount uint64
+
 	// InternalKey is the key used as the internal key for the on-chain
 	// Taproot output. The internal key tweaked with the Taro commitment
 	// (when NO tapscript sibling if present) is equal to the
@@ -89,10 +133,6 @@ type Event struct {
 	// can only be achieved by importing a proof and then scanning for the
 	// Taproot output on chain retroactively, since at the time a Taro
 	// address is created the sibling might not yet be known.
-	//
-	// NOTE: The functionality described above (importing the proof and re-
-	// scanning the chain) is not yet implemented, so this should always be
-	// empty or nil at the moment.
 	TapscriptSibling []byte
 
 	// ConfirmationHeight is the block height at which the incoming asset
@@ -126,4 +166,22 @@ type EventStorage interface {
 	// with the proof and asset that was imported/created for it.
 	CompleteEvent(ctx context.Context, event *Event, status Status,
 		anchorPoint wire.OutPoint) error
+
+	// SetEventStatus updates the status of an existing address event
+	// without changing any of its other fields.
+	SetEventStatus(ctx context.Context, event *Event, status Status) error
+
+	// InsertRecoveryOutput stores a wallet output that doesn't belong to
+	// any known address, but might belong to one that was lost from the
+	// database. Storing an output that is already known is a no-op.
+	InsertRecoveryOutput(ctx context.Context,
+		walletTx *lndclient.Transaction, outputIdx uint32) error
+
+	// QueryRecoveryOutputs returns all wallet outputs that might belong to
+	// a lost address as events without an address that await their proof.
+	QueryRecoveryOutputs(ctx context.Context) ([]*Event, error)
+
+	// DeleteRecoveryOutput deletes a wallet output that might belong to a
+	// lost address, once it's clear whether it does.
+	DeleteRecoveryOutput(ctx context.Context, op wire.OutPoint) error
 }
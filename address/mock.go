@@ -35,9 +35,25 @@ func RandAddr(t testing.TB, params *ChainParams) *AddrWithKeyInfo {
 		famKey = famKeyPriv.PubKey()
 	}
 
-	scriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+	// Half of the addresses get a script key that commits to a random
+	// tapscript tree.
+	var tapLeaves []txscript.TapLeaf
+	if rand.Int31()%2 == 0 {
+		numLeaves := rand.Intn(3) + 1
+		for i := 0; i < numLeaves; i++ {
+			script := make([]byte, rand.Intn(64)+1)
+			_, err := rand.Read(script)
+			require.NoError(t, err)
+
+			tapLeaves = append(
+				tapLeaves, txscript.NewBaseTapLeaf(script),
+			)
+		}
+	}
+
+	scriptKey := asset.NewScriptKeyTapscript(keychain.KeyDescriptor{
 		PubKey: scriptKeyPriv.PubKey(),
-	})
+	}, tapLeaves...)
 
 	taprootOutputKey, _ := schnorr.ParsePubKey(schnorr.SerializePubKey(
 		txscript.ComputeTaprootOutputKey(internalKey.PubKey(), nil),
@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightningnetwork/lnd/tlv"
 )
@@ -29,6 +30,31 @@ const (
 
 	// addrAmountType is the TLV type of the amount of the asset.
 	addrAmountType addressTLVType = 8
+
+	// addrFamilyOnlyType is the TLV type of the flag that marks an address
+	// as accepting any asset ID within the family of its family key.
+	// Senders that don't understand this even type must reject the
+	// address, as they'd otherwise send to a non-existent asset ID.
+	addrFamilyOnlyType addressTLVType = 10
+
+	// addrMemoType is the TLV type of the memo of the address.
+	addrMemoType addressTLVType = 11
+
+	// addrAmountPolicyType is the TLV type of the amount policy of the
+	// address. Senders that don't understand this even type must reject
+	// the address, as they'd otherwise treat it as an exact amount
+	// address and send to the wrong output key.
+	addrAmountPolicyType addressTLVType = 12
+
+	// addrMemoSigType is the TLV type of the signature over the memo of
+	// the address.
+	addrMemoSigType addressTLVType = 13
+
+	// addrScriptVersionType is the TLV type of the script version of the
+	// asset the address expects to receive. As the script version is part
+	// of the asset leaf, senders that don't understand this even type must
+	// reject the address.
+	addrScriptVersionType addressTLVType = 14
 )
 
 func newAddressVersionRecord(version *asset.Version) tlv.Record {
@@ -85,3 +111,39 @@ func newAddressAmountRecord(amount *uint64) tlv.Record {
 		asset.VarIntEncoder, asset.VarIntDecoder,
 	)
 }
+
+func newAddressFamilyOnlyRecord(familyOnly *bool) tlv.Record {
+	return tlv.MakeStaticRecord(
+		addrFamilyOnlyType, familyOnly, 1, boolEncoder, boolDecoder,
+	)
+}
+
+func newAddressAmountPolicyRecord(policy *AmountPolicy) tlv.Record {
+	return tlv.MakeStaticRecord(
+		addrAmountPolicyType, policy, 1, amountPolicyEncoder,
+		amountPolicyDecoder,
+	)
+}
+
+func newAddressMemoRecord(memo *string) tlv.Record {
+	recordSize := func() uint64 {
+		return uint64(len(*memo))
+	}
+	return tlv.MakeDynamicRecord(
+		addrMemoType, memo, recordSize, memoEncoder, memoDecoder,
+	)
+}
+
+func newAddressScriptVersionRecord(version *asset.ScriptVersion) tlv.Record {
+	return tlv.MakeStaticRecord(
+		addrScriptVersionType, version, 2, asset.ScriptVersionEncoder,
+		asset.ScriptVersionDecoder,
+	)
+}
+
+func newAddressMemoSigRecord(sig **schnorr.Signature) tlv.Record {
+	return tlv.MakeStaticRecord(
+		addrMemoSigType, sig, schnorr.SignatureSize, memoSigEncoder,
+		memoSigDecoder,
+	)
+}
@@ -383,6 +383,12 @@ const (
 	// key, allowing the ability for an asset to indirectly commit to
 	// multiple spending conditions.
 	ScriptV0 ScriptVersion = 0
+
+	// ScriptV1 extends ScriptV0 with asset-aware opcodes that allow a
+	// tapscript leaf to introspect the asset created by a state transition,
+	// such as its asset ID, amount and script key. These opcodes re-use the
+	// upgradable OP_NOP opcodes, so the scripts remain valid tapscript.
+	ScriptV1 ScriptVersion = 1
 )
 
 // FamilyKey is the tweaked public key that is used to associate assets together
@@ -451,6 +457,59 @@ type TweakedScriptKey struct {
 	// Tweak is the tweak that is applied on the raw script key to get the
 	// public key. If this is nil, then a BIP 86 tweak is assumed.
 	Tweak []byte
+
+	// TapLeaves is the optional set of tapscript leaves the script key
+	// commits to. If this is non-empty, then Tweak is the root hash of
+	// the tapscript tree assembled from these leaves, and the asset can
+	// either be spent with a key spend or through one of the leaves.
+	TapLeaves []txscript.TapLeaf
+}
+
+// TapscriptTree returns the indexed tapscript tree assembled from the leaves
+// of the script key, or nil if the script key doesn't commit to any scripts.
+func (t *TweakedScriptKey) TapscriptTree() *txscript.IndexedTapScriptTree {
+	if len(t.TapLeaves) == 0 {
+		return nil
+	}
+
+	return txscript.AssembleTaprootScriptTree(t.TapLeaves...)
+}
+
+// EncodeTapLeaves serializes the given set of tapscript leaves so it can be
+// persisted along side the script key.
+func EncodeTapLeaves(leaves []txscript.TapLeaf) ([]byte, error) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	if err := TapLeavesEncoder(&b, &leaves, &buf); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeTapLeaves parses a set of tapscript leaves that was serialized with
+// EncodeTapLeaves.
+func DecodeTapLeaves(leafBytes []byte) ([]txscript.TapLeaf, error) {
+	if len(leafBytes) == 0 {
+		return nil, nil
+	}
+
+	var (
+		leaves []txscript.TapLeaf
+		buf    [8]byte
+	)
+	err := TapLeavesDecoder(bytes.NewReader(leafBytes), &leaves, &buf, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return leaves, nil
 }
 
 // ScriptKey represents a tweaked Taproot output key encumbering the different
@@ -496,6 +555,43 @@ func NewScriptKeyBIP0086(rawKey keychain.KeyDescriptor) ScriptKey {
 	}
 }
 
+// NewScriptKeyTapscript constructs a ScriptKey that commits to the tapscript
+// tree assembled from the given leaves. The resulting key can either be spent
+// with a key spend using the raw key tweaked with the tapscript root, or
+// through any of the leaves with a script spend.
+func NewScriptKeyTapscript(rawKey keychain.KeyDescriptor,
+	leaves ...txscript.TapLeaf) ScriptKey {
+
+	// Without any leaves, this is just a normal BIP 86 script key.
+	if len(leaves) == 0 {
+		return NewScriptKeyBIP0086(rawKey)
+	}
+
+	tapTree := txscript.AssembleTaprootScriptTree(leaves...)
+	rootHash := tapTree.RootNode.TapHash()
+	tweakedPubKey := txscript.ComputeTaprootOutputKey(
+		rawKey.PubKey, rootHash[:],
+	)
+
+	// Just like for the BIP 86 case, we only ever use the x-only
+	// representation of the final key.
+	tweakedPubKey, _ = schnorr.ParsePubKey(
+		schnorr.SerializePubKey(tweakedPubKey),
+	)
+
+	leavesCopy := make([]txscript.TapLeaf, len(leaves))
+	copy(leavesCopy, leaves)
+
+	return ScriptKey{
+		PubKey: tweakedPubKey,
+		TweakedScriptKey: &TweakedScriptKey{
+			RawKey:    rawKey,
+			Tweak:     rootHash[:],
+			TapLeaves: leavesCopy,
+		},
+	}
+}
+
 // GenesisSigner is used to sign the assetID using the family key public key
 // for a given asset.
 type GenesisSigner interface {
@@ -614,7 +710,8 @@ type Asset struct {
 
 // New instantiates a new asset with a genesis asset witness.
 func New(genesis Genesis, amount, locktime, relativeLocktime uint64,
-	scriptKey ScriptKey, familyKey *FamilyKey) (*Asset, error) {
+	scriptVersion ScriptVersion, scriptKey ScriptKey,
+	familyKey *FamilyKey) (*Asset, error) {
 
 	// Collectible assets can only ever be issued once.
 	if genesis.Type != Normal && amount != 1 {
@@ -622,6 +719,11 @@ func New(genesis Genesis, amount, locktime, relativeLocktime uint64,
 			genesis.Type)
 	}
 
+	if scriptVersion != ScriptV0 && scriptVersion != ScriptV1 {
+		return nil, fmt.Errorf("unknown script version %v",
+			scriptVersion)
+	}
+
 	return &Asset{
 		Version:          V0,
 		Genesis:          genesis,
@@ -635,7 +737,7 @@ func New(genesis Genesis, amount, locktime, relativeLocktime uint64,
 			SplitCommitment: nil,
 		}},
 		SplitCommitmentRoot: nil,
-		ScriptVersion:       ScriptV0,
+		ScriptVersion:       scriptVersion,
 		ScriptKey:           scriptKey,
 		FamilyKey:           familyKey,
 	}, nil
@@ -725,6 +827,61 @@ func (a *Asset) IsUnspendable() bool {
 		a.Amount == 0
 }
 
+// DeriveBurnKey derives the provably unspendable script key that assets are
+// burned to. The key is the NUMS key tweaked with the hash of the PrevID of the
+// first input being burned, so no one knows its private key while anyone with
+// the proof of the burn can verify it's indeed unspendable:
+//
+//	burnKey = NUMSPubKey + sha256(outpoint || assetID || scriptKey)*G
+func DeriveBurnKey(firstPrevID PrevID) *btcec.PublicKey {
+	var b bytes.Buffer
+
+	// The following writes to the buffer can never fail.
+	_ = wire.WriteOutPoint(&b, 0, 0, &firstPrevID.OutPoint)
+	_, _ = b.Write(firstPrevID.ID[:])
+	_, _ = b.Write(firstPrevID.ScriptKey.SchnorrSerialized())
+
+	h := sha256.Sum256(b.Bytes())
+
+	return txscript.ComputeTaprootOutputKey(NUMSPubKey, h[:])
+}
+
+// IsBurnKey returns true if the given script key is the burn key derived from
+// the first input of the given witness. For split assets, the first input of
+// the split root asset is used.
+func IsBurnKey(scriptKey *btcec.PublicKey, witness Witness) bool {
+	prevID := witness.PrevID
+	if witness.SplitCommitment != nil {
+		rootWitnesses := witness.SplitCommitment.RootAsset.PrevWitnesses
+		if len(rootWitnesses) == 0 {
+			return false
+		}
+
+		prevID = rootWitnesses[0].PrevID
+	}
+
+	if scriptKey == nil || prevID == nil {
+		return false
+	}
+
+	burnKey := DeriveBurnKey(*prevID)
+
+	return bytes.Equal(
+		schnorr.SerializePubKey(scriptKey),
+		schnorr.SerializePubKey(burnKey),
+	)
+}
+
+// IsBurn returns true if an asset was burned, which means its script key is
+// the provably unspendable burn key derived from its first input.
+func (a *Asset) IsBurn() bool {
+	if len(a.PrevWitnesses) == 0 {
+		return false
+	}
+
+	return IsBurnKey(a.ScriptKey.PubKey, a.PrevWitnesses[0])
+}
+
 // Copy returns a deep copy of an Asset.
 func (a *Asset) Copy() *Asset {
 	assetCopy := *a
@@ -781,6 +938,16 @@ func (a *Asset) Copy() *Asset {
 		assetCopy.ScriptKey.RawKey = a.ScriptKey.RawKey
 		assetCopy.ScriptKey.Tweak = make([]byte, len(a.ScriptKey.Tweak))
 		copy(assetCopy.ScriptKey.Tweak, a.ScriptKey.Tweak)
+
+		if len(a.ScriptKey.TapLeaves) > 0 {
+			assetCopy.ScriptKey.TapLeaves = make(
+				[]txscript.TapLeaf, len(a.ScriptKey.TapLeaves),
+			)
+			copy(
+				assetCopy.ScriptKey.TapLeaves,
+				a.ScriptKey.TapLeaves,
+			)
+		}
 	}
 
 	if a.FamilyKey != nil {
@@ -311,17 +311,26 @@ func TestAssetType(t *testing.T) {
 	}
 	scriptKey := NewScriptKey(pubKey)
 
-	normal, err := New(normalGen, 741, 0, 0, scriptKey, nil)
+	normal, err := New(normalGen, 741, 0, 0, ScriptV0, scriptKey, nil)
 	require.NoError(t, err)
 	require.EqualValues(t, 741, normal.Amount)
 
-	_, err = New(collectibleGen, 741, 0, 0, scriptKey, nil)
+	_, err = New(collectibleGen, 741, 0, 0, ScriptV0, scriptKey, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "amount must be 1 for asset")
 
-	collectible, err := New(collectibleGen, 1, 0, 0, scriptKey, nil)
+	collectible, err := New(
+		collectibleGen, 1, 0, 0, ScriptV0, scriptKey, nil,
+	)
 	require.NoError(t, err)
 	require.EqualValues(t, 1, collectible.Amount)
+
+	scriptV1, err := New(normalGen, 741, 0, 0, ScriptV1, scriptKey, nil)
+	require.NoError(t, err)
+	require.Equal(t, ScriptV1, scriptV1.ScriptVersion)
+
+	_, err = New(normalGen, 741, 0, 0, ScriptV1+1, scriptKey, nil)
+	require.ErrorContains(t, err, "unknown script version")
 }
 
 // TestAssetID makes sure that the asset ID is derived correctly.
@@ -407,6 +416,95 @@ func TestAssetFamilyKey(t *testing.T) {
 	)
 }
 
+// TestScriptKeyTapscript tests that a tapscript script key commits to the
+// root of its tapscript tree, and that its leaves survive an encoding round
+// trip.
+func TestScriptKeyTapscript(t *testing.T) {
+	t.Parallel()
+
+	rawKey := keychain.KeyDescriptor{
+		PubKey: pubKey,
+	}
+	leaves := []txscript.TapLeaf{
+		txscript.NewBaseTapLeaf([]byte{txscript.OP_TRUE}),
+		txscript.NewBaseTapLeaf(hashBytes1[:]),
+		txscript.NewTapLeaf(0xc2, hashBytes2[:]),
+	}
+
+	// Without any leaves, we should end up with a plain BIP 86 key.
+	require.Equal(
+		t, NewScriptKeyBIP0086(rawKey), NewScriptKeyTapscript(rawKey),
+	)
+
+	scriptKey := NewScriptKeyTapscript(rawKey, leaves...)
+	rootHash := txscript.AssembleTaprootScriptTree(
+		leaves...,
+	).RootNode.TapHash()
+	require.Equal(t, rootHash[:], scriptKey.Tweak)
+	require.Equal(
+		t, schnorr.SerializePubKey(txscript.ComputeTaprootOutputKey(
+			pubKey, rootHash[:],
+		)), schnorr.SerializePubKey(scriptKey.PubKey),
+	)
+	require.Equal(
+		t, rootHash, scriptKey.TapscriptTree().RootNode.TapHash(),
+	)
+
+	leafBytes, err := EncodeTapLeaves(scriptKey.TapLeaves)
+	require.NoError(t, err)
+	decodedLeaves, err := DecodeTapLeaves(leafBytes)
+	require.NoError(t, err)
+	require.Equal(t, leaves, decodedLeaves)
+
+	// An asset copy must keep the leaves around as well.
+	a := &Asset{ScriptKey: scriptKey}
+	require.Equal(t, leaves, a.Copy().ScriptKey.TapLeaves)
+}
+
+// TestBurnKey tests that burned assets are recognized by their script key,
+// both when spent directly and when created as part of a split.
+func TestBurnKey(t *testing.T) {
+	t.Parallel()
+
+	prevID := PrevID{
+		OutPoint: wire.OutPoint{
+			Hash:  hashBytes1,
+			Index: 1,
+		},
+		ID:        hashBytes2,
+		ScriptKey: ToSerialized(pubKey),
+	}
+	otherPrevID := prevID
+	otherPrevID.OutPoint.Index = 2
+
+	burnKey := DeriveBurnKey(prevID)
+	require.False(t, burnKey.IsEqual(DeriveBurnKey(otherPrevID)))
+
+	// The burn key is the NUMS key tweaked with the hash of the prev ID.
+	require.True(t, IsBurnKey(burnKey, Witness{PrevID: &prevID}))
+	require.False(t, IsBurnKey(burnKey, Witness{PrevID: &otherPrevID}))
+	require.False(t, IsBurnKey(NUMSPubKey, Witness{PrevID: &prevID}))
+	require.False(t, IsBurnKey(burnKey, Witness{}))
+
+	rootAsset := &Asset{
+		ScriptKey:     NUMSScriptKey,
+		PrevWitnesses: []Witness{{PrevID: &prevID}},
+	}
+	burnedAsset := &Asset{
+		Amount:    10,
+		ScriptKey: NewScriptKey(burnKey),
+		PrevWitnesses: []Witness{{
+			PrevID: &PrevID{},
+			SplitCommitment: &SplitCommitment{
+				RootAsset: *rootAsset,
+			},
+		}},
+	}
+	require.True(t, burnedAsset.IsBurn())
+	require.False(t, rootAsset.IsBurn())
+	require.False(t, (&Asset{ScriptKey: NewScriptKey(burnKey)}).IsBurn())
+}
+
 func FuzzAssetDecode(f *testing.F) {
 	f.Fuzz(func(t *testing.T, data []byte) {
 		r := bytes.NewReader(data)
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taro/mssmt"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -24,6 +25,10 @@ var (
 	// ErrByteSliceTooLarge is returned when an encoded byte slice is too
 	// large.
 	ErrByteSliceTooLarge = errors.New("bytes: too large")
+
+	// ErrTooManyTapLeaves is returned when an encoded tapscript tree
+	// contains too many leaves.
+	ErrTooManyTapLeaves = errors.New("tapscript: too many leaves")
 )
 
 func VarIntEncoder(w io.Writer, val any, buf *[8]byte) error {
@@ -390,6 +395,62 @@ func TxWitnessDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error {
 	return tlv.NewTypeForEncodingErr(val, "*wire.TxWitness")
 }
 
+func TapLeavesEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*[]txscript.TapLeaf); ok {
+		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
+			return err
+		}
+		for _, leaf := range *t {
+			leafVersion := uint8(leaf.LeafVersion)
+			if err := tlv.EUint8(w, &leafVersion, buf); err != nil {
+				return err
+			}
+			script := leaf.Script
+			if err := VarBytesEncoder(w, &script, buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*[]txscript.TapLeaf")
+}
+
+func TapLeavesDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error {
+	if typ, ok := val.(*[]txscript.TapLeaf); ok {
+		numLeaves, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		// A tapscript tree can't be deeper than 128 levels, but we'll
+		// apply the same generous limit we use for witness elements
+		// on the number of leaves.
+		if numLeaves > math.MaxUint16 {
+			return ErrTooManyTapLeaves
+		}
+
+		leaves := make([]txscript.TapLeaf, 0, numLeaves)
+		for i := uint64(0); i < numLeaves; i++ {
+			var leafVersion uint8
+			err := tlv.DUint8(r, &leafVersion, buf, 1)
+			if err != nil {
+				return err
+			}
+
+			var script []byte
+			if err := VarBytesDecoder(r, &script, buf, 0); err != nil {
+				return err
+			}
+			leaves = append(leaves, txscript.NewTapLeaf(
+				txscript.TapscriptLeafVersion(leafVersion), script,
+			))
+		}
+		*typ = leaves
+		return nil
+	}
+	return tlv.NewTypeForDecodingErr(val, "*[]txscript.TapLeaf", 0, 0)
+}
+
 func WitnessEncoder(w io.Writer, val any, buf *[8]byte) error {
 	if t, ok := val.(*[]Witness); ok {
 		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
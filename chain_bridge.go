@@ -1,14 +1,18 @@
 package taro
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
@@ -16,31 +20,49 @@ import (
 // interface backed by an active remote lnd node.
 type LndRpcChainBridge struct {
 	lnd *lndclient.LndServices
+
+	// chainNotifier is a raw chain notifier RPC client. We use it for
+	// confirmation notifications directly, as the lndclient wrapper
+	// doesn't deliver re-org events.
+	chainNotifier chainrpc.ChainNotifierClient
 }
 
 // NewLndRpcChainBridge creates a new chain bridge from an active lnd services
-// client.
-func NewLndRpcChainBridge(lnd *lndclient.LndServices) *LndRpcChainBridge {
+// client and a raw chain notifier client that must be connected to the same
+// lnd node.
+func NewLndRpcChainBridge(lnd *lndclient.LndServices,
+	chainNotifier chainrpc.ChainNotifierClient) *LndRpcChainBridge {
+
 	return &LndRpcChainBridge{
-		lnd: lnd,
+		lnd:           lnd,
+		chainNotifier: chainNotifier,
 	}
 }
 
 // RegisterConfirmationsNtfn registers an intent to be notified once
-// txid reaches numConfs confirmations.
+// txid reaches numConfs confirmations. The returned event stays active until
+// it is canceled: if the transaction is re-organized out of the chain, a
+// negative confirmation is delivered, followed by a new confirmation once the
+// transaction confirms again. Done is signaled once lnd considers the
+// transaction safe from re-orgs.
 func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint uint32,
 	includeBlock bool) (*chainntnfs.ConfirmationEvent, chan error, error) {
 
-	var opts []lndclient.NotifierOption
-	if includeBlock {
-		opts = append(opts, lndclient.WithIncludeBlock())
+	var txidSlice []byte
+	if txid != nil {
+		txidSlice = txid[:]
 	}
 
 	ctx, cancel := context.WithCancel(ctx) // nolint:govet
-	confChan, errChan, err := l.lnd.ChainNotifier.RegisterConfirmationsNtfn(
-		ctx, txid, pkScript, int32(numConfs), int32(heightHint),
-		opts...,
+	confStream, err := l.chainNotifier.RegisterConfirmationsNtfn(
+		ctx, &chainrpc.ConfRequest{
+			Txid:         txidSlice,
+			Script:       pkScript,
+			NumConfs:     numConfs,
+			HeightHint:   heightHint,
+			IncludeBlock: includeBlock,
+		},
 	)
 	if err != nil {
 		cancel()
@@ -49,10 +71,111 @@ func (l *LndRpcChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 			err)
 	}
 
-	return &chainntnfs.ConfirmationEvent{
-		Confirmed: confChan,
-		Cancel:    cancel,
-	}, errChan, nil
+	confEvent := &chainntnfs.ConfirmationEvent{
+		Confirmed:    make(chan *chainntnfs.TxConfirmation, 1),
+		NegativeConf: make(chan int32, 1),
+		Done:         make(chan struct{}, 1),
+		Cancel:       cancel,
+	}
+	errChan := make(chan error, 1)
+
+	go func() {
+		for {
+			event, err := confStream.Recv()
+
+			// The stream is closed by lnd once the transaction
+			// reached a depth at which it's considered final.
+			if errors.Is(err, io.EOF) {
+				select {
+				case confEvent.Done <- struct{}{}:
+				case <-ctx.Done():
+				}
+
+				return
+			}
+			if err != nil {
+				// Canceling the context is the normal way of
+				// tearing down the stream.
+				if ctx.Err() == nil {
+					errChan <- err
+				}
+
+				return
+			}
+
+			switch e := event.Event.(type) {
+			case *chainrpc.ConfEvent_Conf:
+				conf, err := unmarshalConf(e.Conf, includeBlock)
+				if err != nil {
+					errChan <- err
+					return
+				}
+
+				select {
+				case confEvent.Confirmed <- conf:
+				case <-ctx.Done():
+					return
+				}
+
+			// lnd doesn't tell us how deep the re-org was, all we
+			// know is that the transaction is no longer confirmed.
+			case *chainrpc.ConfEvent_Reorg:
+				select {
+				case confEvent.NegativeConf <- 0:
+				case <-ctx.Done():
+					return
+				}
+
+			default:
+				errChan <- fmt.Errorf("unexpected conf event "+
+					"type %T", e)
+				return
+			}
+		}
+	}()
+
+	return confEvent, errChan, nil
+}
+
+// unmarshalConf parses a confirmation received over the RPC interface.
+func unmarshalConf(rpcConf *chainrpc.ConfDetails,
+	includeBlock bool) (*chainntnfs.TxConfirmation, error) {
+
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(rpcConf.RawTx)); err != nil {
+		return nil, fmt.Errorf("unable to decode conf tx: %w", err)
+	}
+
+	blockHash, err := chainhash.NewHash(rpcConf.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid conf block hash: %w", err)
+	}
+
+	var block *wire.MsgBlock
+	if includeBlock {
+		block = &wire.MsgBlock{}
+		err := block.Deserialize(bytes.NewReader(rpcConf.RawBlock))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode conf "+
+				"block: %w", err)
+		}
+	}
+
+	return &chainntnfs.TxConfirmation{
+		BlockHash:   blockHash,
+		BlockHeight: rpcConf.BlockHeight,
+		TxIndex:     rpcConf.TxIndex,
+		Tx:          tx,
+		Block:       block,
+	}, nil
+}
+
+// RegisterBlockEpochNtfn registers an intent to be notified of each new block
+// connected to the tip of the main chain.
+func (l *LndRpcChainBridge) RegisterBlockEpochNtfn(
+	ctx context.Context) (chan int32, chan error, error) {
+
+	return l.lnd.ChainNotifier.RegisterBlockEpochNtfn(ctx)
 }
 
 // CurrentHeight return the current height of the main chain.
@@ -4,9 +4,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
 )
 
@@ -21,6 +23,9 @@ var addrCommands = []cli.Command{
 			queryAddrsCommand,
 			decodeAddrCommand,
 			receivesAddrCommand,
+			subscribeReceivesCommand,
+			exportAddrsCommand,
+			importAddrsCommand,
 		},
 	},
 }
@@ -31,8 +36,48 @@ const (
 	keyFamName = "key_fam"
 
 	amtName = "amt"
+
+	tapLeafName = "tapscript_leaf"
+
+	expiryName = "expiry"
+
+	singleUseName = "single_use"
+
+	labelName = "label"
+
+	metadataName = "metadata"
+
+	metadataKeyName = "metadata_key"
+
+	metadataValueName = "metadata_value"
+
+	familyOnlyName = "family_only"
+
+	amountPolicyName = "amount_policy"
+
+	memoName = "memo"
+
+	startTimestampName = "start_timestamp"
 )
 
+// parseAmountPolicy parses the amount policy of an address from its CLI
+// representation.
+func parseAmountPolicy(policy string) (tarorpc.AmountPolicy, error) {
+	switch policy {
+	case "", "exact":
+		return tarorpc.AmountPolicy_AMOUNT_POLICY_EXACT, nil
+
+	case "at_least":
+		return tarorpc.AmountPolicy_AMOUNT_POLICY_AT_LEAST, nil
+
+	case "open":
+		return tarorpc.AmountPolicy_AMOUNT_POLICY_OPEN, nil
+
+	default:
+		return 0, fmt.Errorf("unknown amount policy: %v", policy)
+	}
+}
+
 var newAddrCommand = cli.Command{
 	Name:        "new",
 	ShortName:   "n",
@@ -52,6 +97,60 @@ var newAddrCommand = cli.Command{
 			Name:  amtName,
 			Usage: "the amt of the asset to receive",
 		},
+		cli.StringSliceFlag{
+			Name: tapLeafName,
+			Usage: "optional, the hex encoded script of a " +
+				"tapscript leaf the script key should commit " +
+				"to, can be specified multiple times",
+		},
+		cli.DurationFlag{
+			Name: expiryName,
+			Usage: "optional, the duration after which the " +
+				"address no longer accepts inbound " +
+				"transfers (e.g. 24h)",
+		},
+		cli.BoolFlag{
+			Name: singleUseName,
+			Usage: "optional, if set the address only accepts a " +
+				"single inbound transfer",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "optional, a free-form label of the address",
+		},
+		cli.StringSliceFlag{
+			Name: metadataName,
+			Usage: "optional, an external metadata entry of the " +
+				"address in the form key=value, can be " +
+				"specified multiple times",
+		},
+		cli.BoolFlag{
+			Name: familyOnlyName,
+			Usage: "optional, if set the address accepts any " +
+				"asset within the family of the key family " +
+				"instead of a single asset ID",
+		},
+		cli.StringFlag{
+			Name: assetTypeName,
+			Usage: "the type of asset a family address accepts, " +
+				"must either be: normal, or collectible",
+		},
+		cli.StringFlag{
+			Name: amountPolicyName,
+			Usage: "optional, the amounts the address accepts, " +
+				"must either be: exact, at_least, or open",
+			Value: "exact",
+		},
+		cli.StringFlag{
+			Name: memoName,
+			Usage: "optional, a memo that is encoded in the " +
+				"address and signed by the receiver",
+		},
+		cli.IntFlag{
+			Name: scriptVersionName,
+			Usage: "optional, the script version of the asset " +
+				"the address expects to receive",
+		},
 	},
 	Action: newAddr,
 }
@@ -61,8 +160,13 @@ func newAddr(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	familyOnly := ctx.Bool(familyOnlyName)
 	switch {
-	case ctx.String(genesisBootstrapInfo) == "":
+	case familyOnly && ctx.String(keyFamName) == "":
+		_ = cli.ShowCommandHelp(ctx, "new")
+		return nil
+
+	case !familyOnly && ctx.String(genesisBootstrapInfo) == "":
 		_ = cli.ShowCommandHelp(ctx, "new")
 		return nil
 	}
@@ -77,10 +181,40 @@ func newAddr(ctx *cli.Context) error {
 		return fmt.Errorf("unable to decode key fam: %w", err)
 	}
 
+	tapLeaves, err := parseTapLeaves(ctx.StringSlice(tapLeafName))
+	if err != nil {
+		return err
+	}
+
+	metadata, err := parseExternalMetadata(ctx.StringSlice(metadataName))
+	if err != nil {
+		return err
+	}
+
+	amountPolicy, err := parseAmountPolicy(ctx.String(amountPolicyName))
+	if err != nil {
+		return err
+	}
+
+	var expiryTime int64
+	if expiry := ctx.Duration(expiryName); expiry != 0 {
+		expiryTime = time.Now().Add(expiry).Unix()
+	}
+
 	addr, err := client.NewAddr(ctxc, &tarorpc.NewAddrRequest{
 		GenesisBootstrapInfo: genInfo,
 		FamKey:               keyFam,
 		Amt:                  ctx.Int64(amtName),
+		TapscriptLeaves:      tapLeaves,
+		ExpiryTimeUnix:       expiryTime,
+		SingleUse:            ctx.Bool(singleUseName),
+		Label:                ctx.String(labelName),
+		ExternalMetadata:     metadata,
+		FamilyOnly:           familyOnly,
+		AssetType:            parseAssetType(ctx),
+		AmountPolicy:         amountPolicy,
+		Memo:                 ctx.String(memoName),
+		ScriptVersion:        int32(ctx.Int(scriptVersionName)),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to make addr: %w", err)
@@ -90,6 +224,61 @@ func newAddr(ctx *cli.Context) error {
 	return nil
 }
 
+// parseTapLeaves decodes a set of hex encoded tapscript leaf scripts.
+func parseTapLeaves(leafStrs []string) ([]*tarorpc.TapLeaf, error) {
+	tapLeaves := make([]*tarorpc.TapLeaf, 0, len(leafStrs))
+	for _, leafStr := range leafStrs {
+		script, err := hex.DecodeString(leafStr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode tapscript "+
+				"leaf: %w", err)
+		}
+		tapLeaves = append(tapLeaves, &tarorpc.TapLeaf{
+			Script: script,
+		})
+	}
+
+	return tapLeaves, nil
+}
+
+// parseExternalMetadata parses a set of external metadata entries in the form
+// key=value.
+func parseExternalMetadata(entries []string) (map[string]string, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	metadata := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key, value, found := strings.Cut(entry, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid metadata entry %q, "+
+				"must be in the form key=value", entry)
+		}
+		metadata[key] = value
+	}
+
+	return metadata, nil
+}
+
+// metadataFilterFlags are the flags used to filter by label and external
+// metadata.
+var metadataFilterFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  labelName,
+		Usage: "only show entries with exactly this label",
+	},
+	cli.StringFlag{
+		Name:  metadataKeyName,
+		Usage: "only show entries with this external metadata key",
+	},
+	cli.StringFlag{
+		Name: metadataValueName,
+		Usage: "only show entries whose external metadata entry " +
+			"with the given key has this value",
+	},
+}
+
 const (
 	createdAfterName = "created_after"
 
@@ -105,7 +294,7 @@ var queryAddrsCommand = cli.Command{
 	ShortName:   "q",
 	Usage:       "Query for the set of created addresses",
 	Description: "Query for the set of created addresses, supports pagination",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  createdAfterName,
 			Usage: "a duration short hand (-1h, 2d, 2w, etc)",
@@ -122,7 +311,7 @@ var queryAddrsCommand = cli.Command{
 			Name:  offsetName,
 			Usage: "the number of addrs to skip before returning the first addr",
 		},
-	},
+	}, metadataFilterFlags...),
 	Action: queryAddr,
 }
 
@@ -157,6 +346,10 @@ func queryAddr(ctx *cli.Context) error {
 		CreatedBefore: int64(end),
 		Limit:         int32(ctx.Int64(limitName)),
 		Offset:        int32(ctx.Int64(offsetName)),
+		Label:         ctx.String(labelName),
+
+		ExternalMetadataKey:   ctx.String(metadataKeyName),
+		ExternalMetadataValue: ctx.String(metadataValueName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to make addrs: %v", err)
@@ -216,12 +409,12 @@ var receivesAddrCommand = cli.Command{
 	ShortName: "r",
 	ArgsUsage: "[--addr | addr]",
 	Usage:     "Show all inbound asset transfers",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  addrName,
 			Usage: "show transfers of a single address only",
 		},
-	},
+	}, metadataFilterFlags...),
 	Action: addrReceives,
 }
 
@@ -240,7 +433,11 @@ func addrReceives(ctx *cli.Context) error {
 	}
 
 	resp, err := client.AddrReceives(ctxc, &tarorpc.AddrReceivesRequest{
-		FilterAddr: addr,
+		FilterAddr:  addr,
+		FilterLabel: ctx.String(labelName),
+
+		FilterExternalMetadataKey:   ctx.String(metadataKeyName),
+		FilterExternalMetadataValue: ctx.String(metadataValueName),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to query addr receives: %w", err)
@@ -249,3 +446,150 @@ func addrReceives(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var subscribeReceivesCommand = cli.Command{
+	Name:      "subscribe",
+	ShortName: "s",
+	ArgsUsage: "[--addr | addr]",
+	Usage:     "Subscribe to status updates of inbound asset transfers",
+	Description: `
+	Stream an event each time the status of an inbound asset transfer
+	changes. If a start timestamp is given, all transfers received since
+	then are shown with their current status first.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  addrName,
+			Usage: "show transfers of a single address only",
+		},
+		cli.Int64Flag{
+			Name: startTimestampName,
+			Usage: "if set, first show all transfers whose " +
+				"status changed at or after this unix " +
+				"timestamp",
+		},
+	},
+	Action: subscribeReceives,
+}
+
+func subscribeReceives(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var addr string
+	switch {
+	case ctx.String(addrName) != "":
+		addr = ctx.String(addrName)
+
+	case len(ctx.Args()) > 0:
+		addr = ctx.Args().First()
+	}
+
+	stream, err := client.SubscribeReceiveEvents(
+		ctxc, &tarorpc.SubscribeReceiveEventsRequest{
+			FilterAddr:     addr,
+			StartTimestamp: ctx.Int64(startTimestampName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to addr receives: %w",
+			err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive event: %w", err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+const backupPathName = "backup_file"
+
+var exportAddrsCommand = cli.Command{
+	Name:      "export",
+	ShortName: "e",
+	Usage:     "Export the addresses as an address backup",
+	Description: "Export the addresses of the address book, including " +
+		"their key locators, as a single address backup that can " +
+		"be imported into another node to watch for inbound transfers",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: backupPathName,
+			Usage: "the file to write the address backup to; use " +
+				"the dash character (-) to write to stdout",
+		},
+	}, metadataFilterFlags...),
+	Action: exportAddrs,
+}
+
+func exportAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(backupPathName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "export")
+		return nil
+	}
+
+	resp, err := client.ExportAddrs(ctxc, &tarorpc.ExportAddrsRequest{
+		Label:                 ctx.String(labelName),
+		ExternalMetadataKey:   ctx.String(metadataKeyName),
+		ExternalMetadataValue: ctx.String(metadataValueName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export addrs: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(backupPathName))
+	return writeToFile(filePath, resp.AddrBackup)
+}
+
+var importAddrsCommand = cli.Command{
+	Name:      "import",
+	ShortName: "i",
+	Usage:     "Import the addresses of an address backup",
+	Description: "Import all addresses of an address backup and watch " +
+		"them for inbound transfers, even if the keys of the " +
+		"addresses aren't held by this node",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: backupPathName,
+			Usage: "the path to the address backup on disk; use " +
+				"the dash character (-) to read from stdin " +
+				"instead",
+		},
+	},
+	Action: importAddrs,
+}
+
+func importAddrs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(backupPathName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "import")
+		return nil
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(backupPathName))
+	backup, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read file: %v", err)
+	}
+
+	resp, err := client.ImportAddrs(ctxc, &tarorpc.ImportAddrsRequest{
+		AddrBackup: backup,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import addrs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -17,8 +17,10 @@ var assetsCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			mintAssetCommand,
 			listAssetsCommand,
+			listCollectiblesCommand,
 			listAssetBalancesCommand,
 			sendAssetsCommand,
+			burnAssetCommand,
 			listTransfersCommand,
 		},
 	},
@@ -31,8 +33,15 @@ var (
 	assetMetaName     = "meta"
 	assetEmissionName = "enable_emission"
 	skipBatchName     = "skip_batch"
+	lockTimeName      = "lock_time"
+	relLockTimeName   = "relative_lock_time"
 	groupByFamilyName = "by_family"
 	assetIDName       = "asset_id"
+	witnessItemName   = "witness_item"
+	skipSigName       = "skip_signature"
+	numConfsName      = "num_confs"
+	minConfsName      = "min_confs"
+	scriptVersionName = "script_version"
 )
 
 var mintAssetCommand = cli.Command{
@@ -66,6 +75,39 @@ var mintAssetCommand = cli.Command{
 			Name:  skipBatchName,
 			Usage: "if true, then the asset will be minted immediately",
 		},
+		cli.Int64Flag{
+			Name: lockTimeName,
+			Usage: "if set, the asset can't be moved before the " +
+				"block with this height",
+		},
+		cli.Int64Flag{
+			Name: relLockTimeName,
+			Usage: "if set, the asset can't be moved until this " +
+				"number of blocks has passed since its last " +
+				"transfer confirmed",
+		},
+		cli.Uint64Flag{
+			Name: numConfsName,
+			Usage: "if set, overrides the number of confirmations " +
+				"the minting transaction needs before the " +
+				"batch is considered final",
+		},
+		cli.IntFlag{
+			Name: scriptVersionName,
+			Usage: "the script version of the asset, version 1 " +
+				"is experimental and must be enabled by the " +
+				"nodes spending the asset",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "optional, a free-form label of the mint",
+		},
+		cli.StringSliceFlag{
+			Name: metadataName,
+			Usage: "optional, an external metadata entry of the " +
+				"mint in the form key=value, can be specified " +
+				"multiple times",
+		},
 	},
 	Action: mintAsset,
 }
@@ -92,6 +134,11 @@ func mintAsset(ctx *cli.Context) error {
 		return nil
 	}
 
+	metadata, err := parseExternalMetadata(ctx.StringSlice(metadataName))
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.MintAsset(ctxc, &tarorpc.MintAssetRequest{
 		AssetType:      parseAssetType(ctx),
 		Name:           ctx.String(assetTagName),
@@ -99,6 +146,14 @@ func mintAsset(ctx *cli.Context) error {
 		Amount:         ctx.Int64(assetSupplyName),
 		EnableEmission: ctx.Bool(assetEmissionName),
 		SkipBatch:      ctx.Bool(skipBatchName),
+		LockTime:       int32(ctx.Int64(lockTimeName)),
+		RelativeLockTime: int32(
+			ctx.Int64(relLockTimeName),
+		),
+		NumConfs:         uint32(ctx.Uint64(numConfsName)),
+		Label:            ctx.String(labelName),
+		ExternalMetadata: metadata,
+		ScriptVersion:    int32(ctx.Int(scriptVersionName)),
 	})
 	if err != nil {
 		return fmt.Errorf("unable to mint asset: %w", err)
@@ -113,7 +168,15 @@ var listAssetsCommand = cli.Command{
 	ShortName:   "l",
 	Usage:       "list all assets",
 	Description: "list all pending and mined assets",
-	Action:      listAssets,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: minConfsName,
+			Usage: "if set, only assets whose anchor transaction " +
+				"has at least this number of confirmations " +
+				"are listed",
+		},
+	},
+	Action: listAssets,
 }
 
 func listAssets(ctx *cli.Context) error {
@@ -123,7 +186,9 @@ func listAssets(ctx *cli.Context) error {
 
 	// TODO(roasbeef): need to reverse txid
 
-	resp, err := client.ListAssets(ctxc, &tarorpc.ListAssetRequest{})
+	resp, err := client.ListAssets(ctxc, &tarorpc.ListAssetRequest{
+		MinConfs: uint32(ctx.Uint64(minConfsName)),
+	})
 	if err != nil {
 		return fmt.Errorf("unable to list assets: %w", err)
 	}
@@ -131,6 +196,29 @@ func listAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var listCollectiblesCommand = cli.Command{
+	Name:  "collectibles",
+	Usage: "list all collectibles",
+	Description: "list all owned collectibles grouped by family key, " +
+		"along with every previous owner of each collectible",
+	Action: listCollectibles,
+}
+
+func listCollectibles(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListCollectibles(
+		ctxc, &tarorpc.ListCollectiblesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list collectibles: %w", err)
+	}
+	printRespJSON(resp)
+	return nil
+}
+
 var listAssetBalancesCommand = cli.Command{
 	Name:        "balance",
 	ShortName:   "b",
@@ -214,6 +302,46 @@ var sendAssetsCommand = cli.Command{
 		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
+		cli.StringFlag{
+			Name: tapLeafName,
+			Usage: "if set, the hex encoded script of the tapscript " +
+				"leaf to spend the input asset through, " +
+				"instead of the key path",
+		},
+		cli.StringSliceFlag{
+			Name: witnessItemName,
+			Usage: "a hex encoded witness item the tapscript " +
+				"leaf requires, can be specified multiple " +
+				"times",
+		},
+		cli.BoolFlag{
+			Name: skipSigName,
+			Usage: "if true, then no signature is added to the " +
+				"witness of the tapscript leaf spend",
+		},
+		cli.Uint64Flag{
+			Name: numConfsName,
+			Usage: "if set, overrides the number of confirmations " +
+				"the transfer transaction needs before the " +
+				"transfer is considered complete",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "optional, a free-form label of the transfer",
+		},
+		cli.StringSliceFlag{
+			Name: metadataName,
+			Usage: "optional, an external metadata entry of the " +
+				"transfer in the form key=value, can be " +
+				"specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: amtName,
+			Usage: "the amount of the asset to send, required " +
+				"for addresses that accept any amount and " +
+				"optional for addresses that accept a " +
+				"minimum amount",
+		},
 	},
 	Action: sendAssets,
 }
@@ -229,9 +357,46 @@ func sendAssets(ctx *cli.Context) error {
 		return nil
 	}
 
-	resp, err := client.SendAsset(ctxc, &tarorpc.SendAssetRequest{
-		TaroAddr: ctx.String(addrName),
-	})
+	metadata, err := parseExternalMetadata(ctx.StringSlice(metadataName))
+	if err != nil {
+		return err
+	}
+
+	req := &tarorpc.SendAssetRequest{
+		TaroAddr:         ctx.String(addrName),
+		NumConfs:         uint32(ctx.Uint64(numConfsName)),
+		Label:            ctx.String(labelName),
+		ExternalMetadata: metadata,
+		Amount:           ctx.Uint64(amtName),
+	}
+
+	if ctx.IsSet(tapLeafName) {
+		script, err := hex.DecodeString(ctx.String(tapLeafName))
+		if err != nil {
+			return fmt.Errorf("unable to decode tapscript leaf: %w",
+				err)
+		}
+
+		var witnessItems [][]byte
+		for _, itemStr := range ctx.StringSlice(witnessItemName) {
+			item, err := hex.DecodeString(itemStr)
+			if err != nil {
+				return fmt.Errorf("unable to decode witness "+
+					"item: %w", err)
+			}
+			witnessItems = append(witnessItems, item)
+		}
+
+		req.TapscriptSpend = &tarorpc.TapscriptSpend{
+			Leaf: &tarorpc.TapLeaf{
+				Script: script,
+			},
+			WitnessItems:  witnessItems,
+			SkipSignature: ctx.Bool(skipSigName),
+		}
+	}
+
+	resp, err := client.SendAsset(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
 	}
@@ -240,6 +405,60 @@ func sendAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var burnAssetCommand = cli.Command{
+	Name:  "burn",
+	Usage: "burn an amount of an asset",
+	Description: "Provably burn an amount of an asset by sending it to " +
+		"an unspendable script key. THE BURNED ASSETS CAN NEVER BE " +
+		"SPENT AGAIN.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to burn",
+		},
+		cli.Uint64Flag{
+			Name:  amtName,
+			Usage: "the amount of the asset to burn",
+		},
+		cli.Uint64Flag{
+			Name: numConfsName,
+			Usage: "if set, overrides the number of confirmations " +
+				"the burn transaction needs before the burn is " +
+				"considered complete",
+		},
+	},
+	Action: burnAsset,
+}
+
+func burnAsset(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(assetIDName) == "" || ctx.Uint64(amtName) == 0:
+		_ = cli.ShowCommandHelp(ctx, "burn")
+		return nil
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("invalid asset ID: %w", err)
+	}
+
+	resp, err := client.BurnAsset(ctxc, &tarorpc.BurnAssetRequest{
+		AssetId:      assetID,
+		AmountToBurn: int64(ctx.Uint64(amtName)),
+		NumConfs:     uint32(ctx.Uint64(numConfsName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to burn asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listTransfersCommand = cli.Command{
 	Name:      "transfers",
 	ShortName: "t",
@@ -247,13 +466,13 @@ var listTransfersCommand = cli.Command{
 	Description: "list outgoing transfers of all assets or a selected " +
 		"asset",
 	Action: listTransfers,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name: assetIDName,
 			Usage: "A specific asset ID to list outgoing " +
 				"transfers for",
 		},
-	},
+	}, metadataFilterFlags...),
 }
 
 func listTransfers(ctx *cli.Context) error {
@@ -261,7 +480,11 @@ func listTransfers(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &tarorpc.ListTransfersRequest{}
+	req := &tarorpc.ListTransfersRequest{
+		Label:                 ctx.String(labelName),
+		ExternalMetadataKey:   ctx.String(metadataKeyName),
+		ExternalMetadataValue: ctx.String(metadataValueName),
+	}
 	resp, err := client.ListTransfers(ctxc, req)
 	if err != nil {
 		return fmt.Errorf("unable to list asset transfers: %w", err)
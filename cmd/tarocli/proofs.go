@@ -22,6 +22,13 @@ var proofCommands = []cli.Command{
 			verifyProofCommand,
 			exportProofCommand,
 			importProofCommand,
+			compactProofCommand,
+			trustCheckpointCommand,
+			auditProofsCommand,
+			listProofsCommand,
+			exportAllProofsCommand,
+			importAllProofsCommand,
+			debugStateTransitionCommand,
 		},
 	},
 }
@@ -72,8 +79,54 @@ func verifyProof(ctx *cli.Context) error {
 	return nil
 }
 
+var debugStateTransitionCommand = cli.Command{
+	Name:  "debug",
+	Usage: "trace the last state transition of a proof file",
+	Description: "Verify all but the last proof of a taro proof file, " +
+		"then execute the state transition of the last proof with " +
+		"tracing enabled and print each step the VM executed",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: proofPathName,
+			Usage: "the path to the proof file on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+	},
+	Action: debugStateTransition,
+}
+
+func debugStateTransition(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(proofPathName) == "":
+		_ = cli.ShowCommandHelp(ctx, "debug")
+		return nil
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
+	rawFile, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read proof file: %w", err)
+	}
+
+	resp, err := client.DebugStateTransition(ctxc, &tarorpc.ProofFile{
+		RawProof: rawFile,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to debug state transition: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 const (
 	scriptKeyName = "script_key"
+
+	sinceHashName = "since_hash"
 )
 
 var exportProofCommand = cli.Command{
@@ -96,6 +149,12 @@ var exportProofCommand = cli.Command{
 				"the raw binary proof to stdout instead of " +
 				"the default JSON format",
 		},
+		cli.StringFlag{
+			Name: sinceHashName,
+			Usage: "(optional) the hash of the last proof that is " +
+				"already known; if set, only the suffix of the " +
+				"proof file following that proof is exported",
+		},
 	},
 	Action: exportProof,
 }
@@ -123,9 +182,18 @@ func exportProof(ctx *cli.Context) error {
 		return fmt.Errorf("unable to asset ID: %v", err)
 	}
 
+	var sinceHash []byte
+	if ctx.IsSet(sinceHashName) {
+		sinceHash, err = hex.DecodeString(ctx.String(sinceHashName))
+		if err != nil {
+			return fmt.Errorf("unable to decode since hash: %v", err)
+		}
+	}
+
 	resp, err := client.ExportProof(ctxc, &tarorpc.ExportProofRequest{
 		AssetId:   assetID,
 		ScriptKey: scriptKeyBytes,
+		SinceHash: sinceHash,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to verify file: %w", err)
@@ -185,6 +253,327 @@ func importProof(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	numKeepName = "num_keep"
+)
+
+var compactProofCommand = cli.Command{
+	Name:      "compact",
+	ShortName: "c",
+	Description: "prune the history of an archived taro proof file, " +
+		"replacing all but the most recent state transitions with a " +
+		"trusted checkpoint",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to compact the proof of",
+		},
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key of the asset to compact the proof of",
+		},
+		cli.Uint64Flag{
+			Name: numKeepName,
+			Usage: "the number of most recent state transitions to " +
+				"keep in the proof file",
+			Value: 1,
+		},
+	},
+	Action: compactProof,
+}
+
+func compactProof(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(scriptKeyName) == "",
+		ctx.String(assetIDName) == "":
+
+		_ = cli.ShowCommandHelp(ctx, "compact")
+		return nil
+	}
+
+	scriptKeyBytes, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode script key: %v", err)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to asset ID: %v", err)
+	}
+
+	resp, err := client.CompactProof(ctxc, &tarorpc.CompactProofRequest{
+		AssetId:   assetID,
+		ScriptKey: scriptKeyBytes,
+		NumKeep:   uint32(ctx.Uint64(numKeepName)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to compact proof: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	checkpointRootName = "checkpoint_root"
+
+	numPrunedName = "num_pruned"
+)
+
+var trustCheckpointCommand = cli.Command{
+	Name:      "trust",
+	ShortName: "t",
+	Description: "mark the checkpoint root of a compacted taro proof " +
+		"file created by another party as trusted",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  checkpointRootName,
+			Usage: "the checkpoint root to trust",
+		},
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset the checkpoint is for",
+		},
+		cli.StringFlag{
+			Name:  scriptKeyName,
+			Usage: "the script key of the asset the checkpoint is for",
+		},
+		cli.Uint64Flag{
+			Name: numPrunedName,
+			Usage: "the number of state transitions the checkpoint " +
+				"replaces",
+		},
+	},
+	Action: trustCheckpoint,
+}
+
+func trustCheckpoint(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	switch {
+	case ctx.String(checkpointRootName) == "",
+		ctx.String(scriptKeyName) == "",
+		ctx.String(assetIDName) == "":
+
+		_ = cli.ShowCommandHelp(ctx, "trust")
+		return nil
+	}
+
+	root, err := hex.DecodeString(ctx.String(checkpointRootName))
+	if err != nil {
+		return fmt.Errorf("unable to decode checkpoint root: %v", err)
+	}
+
+	scriptKeyBytes, err := hex.DecodeString(ctx.String(scriptKeyName))
+	if err != nil {
+		return fmt.Errorf("unable to decode script key: %v", err)
+	}
+
+	assetID, err := hex.DecodeString(ctx.String(assetIDName))
+	if err != nil {
+		return fmt.Errorf("unable to asset ID: %v", err)
+	}
+
+	resp, err := client.TrustCheckpoint(
+		ctxc, &tarorpc.TrustCheckpointRequest{
+			CheckpointRoot: root,
+			AssetId:        assetID,
+			ScriptKey:      scriptKeyBytes,
+			NumPruned:      ctx.Uint64(numPrunedName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to trust checkpoint: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var auditProofsCommand = cli.Command{
+	Name:      "audit",
+	ShortName: "a",
+	Description: "check that all stored proofs are valid and that all " +
+		"owned assets have a matching proof",
+	Action: auditProofs,
+}
+
+func auditProofs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AuditProofs(ctxc, &tarorpc.AuditProofsRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to audit proofs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+const (
+	familyKeyName = "family_key"
+
+	bundlePathName = "bundle_file"
+)
+
+// proofFilterFlags are the flags used to filter proofs by asset ID and/or
+// family key.
+var proofFilterFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  assetIDName,
+		Usage: "(optional) only include proofs of this asset ID",
+	},
+	cli.StringFlag{
+		Name:  familyKeyName,
+		Usage: "(optional) only include proofs of this family key",
+	},
+}
+
+// parseProofFilterFlags parses the optional asset ID and family key flags.
+func parseProofFilterFlags(ctx *cli.Context) ([]byte, []byte, error) {
+	var (
+		assetID, familyKey []byte
+		err                error
+	)
+	if ctx.IsSet(assetIDName) {
+		assetID, err = hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode asset "+
+				"ID: %v", err)
+		}
+	}
+	if ctx.IsSet(familyKeyName) {
+		familyKey, err = hex.DecodeString(ctx.String(familyKeyName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode family "+
+				"key: %v", err)
+		}
+	}
+
+	return assetID, familyKey, nil
+}
+
+var listProofsCommand = cli.Command{
+	Name:        "list",
+	ShortName:   "l",
+	Description: "list the proofs in the archive",
+	Flags:       proofFilterFlags,
+	Action:      listProofs,
+}
+
+func listProofs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	assetID, familyKey, err := parseProofFilterFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListProofs(ctxc, &tarorpc.ListProofsRequest{
+		AssetId:   assetID,
+		FamilyKey: familyKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list proofs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var exportAllProofsCommand = cli.Command{
+	Name:      "exportall",
+	ShortName: "ea",
+	Description: "export the proofs in the archive as a single proof " +
+		"bundle",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: bundlePathName,
+			Usage: "the file to write the proof bundle to; use " +
+				"the dash character (-) to write to stdout",
+		},
+	}, proofFilterFlags...),
+	Action: exportAllProofs,
+}
+
+func exportAllProofs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(bundlePathName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "exportall")
+		return nil
+	}
+
+	assetID, familyKey, err := parseProofFilterFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ExportProofs(ctxc, &tarorpc.ExportProofsRequest{
+		AssetId:   assetID,
+		FamilyKey: familyKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to export proofs: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(bundlePathName))
+	return writeToFile(filePath, resp.ProofBundle)
+}
+
+var importAllProofsCommand = cli.Command{
+	Name:        "importall",
+	ShortName:   "ia",
+	Description: "import all proofs of a proof bundle",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: bundlePathName,
+			Usage: "the path to the proof bundle on disk; use the " +
+				"dash character (-) to read from stdin instead",
+		},
+	},
+	Action: importAllProofs,
+}
+
+func importAllProofs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(bundlePathName) == "" {
+		_ = cli.ShowCommandHelp(ctx, "importall")
+		return nil
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(bundlePathName))
+	bundle, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read file: %v", err)
+	}
+
+	resp, err := client.ImportProofs(ctxc, &tarorpc.ImportProofsRequest{
+		ProofBundle: bundle,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to import proofs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // readFile attempts to read a file from disk. If the passed fileName is equal
 // to the dash character, then this function reads from stdin instead.
 func readFile(fileName string) ([]byte, error) {
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lightninglabs/taro/vm"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var vmCommands = []cli.Command{
+	{
+		Name:     "vm",
+		Usage:    "Validate Taro state transitions offline.",
+		Category: "VM",
+		Subcommands: []cli.Command{
+			validateTransitionCommand,
+		},
+	},
+}
+
+const (
+	transitionPathName = "transition_file"
+
+	traceName = "trace"
+
+	scriptV1Name = "experimental_scriptv1"
+)
+
+var validateTransitionCommand = cli.Command{
+	Name:  "validate",
+	Usage: "validate a state transition without a daemon connection",
+	Description: `
+	Validate a Taro asset state transition locally in the Taro VM, without
+	connecting to tarod. The state transition is read from a JSON file of
+	the following form, where all assets are hex encoded TLV serializations:
+
+	{
+	    "new_asset": "<asset, or root asset of a split>",
+	    "split_asset": "<optional split asset>",
+	    "split_output_index": <optional anchor output index of the split>,
+	    "prev_assets": [{
+	        "out_point": "<txid:index of the input anchor output>",
+	        "asset": "<input asset>",
+	        "anchor_height": <optional height the input confirmed at>
+	    }],
+	    "block_height": <optional height to enforce lock times at>
+	}
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: transitionPathName,
+			Usage: "the path to the state transition JSON file; " +
+				"use the dash character (-) to read from " +
+				"stdin instead",
+		},
+		cli.BoolFlag{
+			Name: traceName,
+			Usage: "if set, the steps executed by the VM are " +
+				"included in the output",
+		},
+		cli.BoolFlag{
+			Name: scriptV1Name,
+			Usage: "if set, inputs committing to the " +
+				"experimental script version 1 are accepted",
+		},
+	},
+	Action: validateTransition,
+}
+
+// validateTraceStep is a single step executed by the VM.
+type validateTraceStep struct {
+	Name    string   `json:"name"`
+	Details []string `json:"details,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// validateTransitionResponse is the result of validating a state transition.
+type validateTransitionResponse struct {
+	Valid bool                `json:"valid"`
+	Error string              `json:"error,omitempty"`
+	Steps []validateTraceStep `json:"steps,omitempty"`
+}
+
+func validateTransition(ctx *cli.Context) error {
+	switch {
+	case ctx.String(transitionPathName) == "":
+		_ = cli.ShowCommandHelp(ctx, "validate")
+		return nil
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(transitionPathName))
+	rawTransition, err := readFile(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to read state transition: %w", err)
+	}
+
+	var transition vm.StateTransition
+	if err := json.Unmarshal(rawTransition, &transition); err != nil {
+		return fmt.Errorf("unable to decode state transition: %w", err)
+	}
+
+	var opts []vm.EngineOption
+	if ctx.Bool(scriptV1Name) {
+		opts = append(opts, vm.WithScriptV1())
+	}
+	if ctx.Bool(traceName) {
+		opts = append(opts, vm.WithTrace())
+	}
+	engine, err := vm.New(
+		transition.NewAsset, transition.SplitAsset,
+		transition.PrevAssets, transition.ChainCtx, opts...,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create VM: %w", err)
+	}
+
+	var resp validateTransitionResponse
+	if err := engine.Execute(); err != nil {
+		resp.Error = err.Error()
+	} else {
+		resp.Valid = true
+	}
+
+	if trace := engine.Trace(); trace != nil {
+		for _, step := range trace.Steps {
+			respStep := validateTraceStep{
+				Name:    step.Name,
+				Details: step.Details,
+			}
+			if step.Err != nil {
+				respStep.Error = step.Err.Error()
+			}
+			resp.Steps = append(resp.Steps, respStep)
+		}
+	}
+
+	printJSON(resp)
+	return nil
+}
@@ -82,7 +82,8 @@ func randAsset(t *testing.T, genesis asset.Genesis,
 	}
 
 	a, err := asset.New(
-		genesis, units, 0, 0, asset.NewScriptKey(pubKey), familyKey,
+		genesis, units, 0, 0, asset.ScriptV0, asset.NewScriptKey(pubKey),
+		familyKey,
 	)
 	require.NoError(t, err)
 	return a
@@ -493,7 +494,34 @@ func TestSplitCommitment(t *testing.T) {
 				}}
 				return input, root, external
 			},
-			err: ErrInvalidSplitLocatorCount,
+			err: ErrCollectibleSplit,
+		},
+		{
+			name: "collectible split with non-zero root locator",
+			f: func() (*asset.Asset, *SplitLocator, []*SplitLocator) {
+				input := randAsset(
+					t, genesisCollectible,
+					familyKeyCollectible,
+				)
+				root := &SplitLocator{
+					OutputIndex: 0,
+					AssetID:     genesisCollectible.ID(),
+					ScriptKey: asset.ToSerialized(
+						input.ScriptKey.PubKey,
+					),
+					Amount: input.Amount,
+				}
+				external := []*SplitLocator{{
+					OutputIndex: 1,
+					AssetID:     genesisCollectible.ID(),
+					ScriptKey: asset.ToSerialized(
+						randKey(t).PubKey(),
+					),
+					Amount: 0,
+				}}
+				return input, root, external
+			},
+			err: ErrCollectibleSplit,
 		},
 		{
 			name: "collectible split commitment",
@@ -31,12 +31,6 @@ var (
 		"at least one locator should be specified",
 	)
 
-	// ErrInvalidSplitLocatorCount is returned if a collectible split is
-	// attempted with a count of external split locators not equal to one.
-	ErrInvalidSplitLocatorCount = errors.New(
-		"exactly one locator should be specified",
-	)
-
 	// ErrInvalidScriptKey is an error returned when a root locator has zero
 	// value but does not use the correct unspendable script key.
 	ErrInvalidScriptKey = errors.New(
@@ -54,6 +48,14 @@ var (
 	ErrNonZeroSplitAmount = errors.New(
 		"unspendable root locator has non-zero amount",
 	)
+
+	// ErrCollectibleSplit is an error returned when a split of a
+	// collectible would not move the whole collectible to a single
+	// external locator, leaving an unspendable zero-value root.
+	ErrCollectibleSplit = errors.New(
+		"collectibles cannot be split, they can only be sent whole " +
+			"to a single output",
+	)
 )
 
 // SplitLocator encodes the data that uniquely identifies an asset split within
@@ -151,14 +153,13 @@ func NewSplitCommitment(input *asset.Asset, outPoint wire.OutPoint,
 	}
 
 	// To transfer a collectible with a split, the split root must be
-	// unspendable, and there can only be only one external locator.
+	// unspendable, and there can only be only one external locator which
+	// carries the collectible.
 	if input.Type == asset.Collectible {
-		if rootLocator.Amount != 0 {
-			return nil, ErrNonZeroSplitAmount
-		}
+		if rootLocator.Amount != 0 || len(externalLocators) != 1 ||
+			externalLocators[0].Amount != input.Amount {
 
-		if len(externalLocators) != 1 {
-			return nil, ErrInvalidSplitLocatorCount
+			return nil, ErrCollectibleSplit
 		}
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/lightninglabs/taro/tarodb"
 	"github.com/lightninglabs/taro/tarofreighter"
 	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/lightninglabs/taro/vm"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
@@ -67,10 +68,18 @@ type Config struct {
 
 	AssetCustodian *tarogarden.Custodian
 
+	ReOrgWatcher *tarogarden.ReOrgWatcher
+
+	ChainBridge tarogarden.ChainBridge
+
 	AddrBook *address.Book
 
 	ProofArchive proof.Archiver
 
+	// VMOptions are the options each VM instance that validates an asset
+	// state transition is created with.
+	VMOptions []vm.EngineOption
+
 	ChainPorter tarofreighter.Porter
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -15,7 +15,7 @@ import (
 
 var (
 	statusDetected  = tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED
-	statusConfirmed = tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED
+	statusFinal     = tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FINAL
 	statusCompleted = tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED
 )
 
@@ -108,7 +108,8 @@ func testAddresses(t *harnessTest) {
 	// Mine a block to make sure the events are marked as confirmed.
 	_ = mineBlocks(t, t.lndHarness, 1, len(rpcAssets))[0]
 
-	// Eventually the events should be marked as confirmed.
+	// Eventually the events should be marked as final, as a single
+	// confirmation is required by default.
 	err := wait.NoError(func() error {
 		resp, err := secondTarod.AddrReceives(
 			ctxt, &tarorpc.AddrReceivesRequest{},
@@ -117,9 +118,9 @@ func testAddresses(t *harnessTest) {
 		require.Len(t.t, resp.Events, len(rpcAssets))
 
 		for _, event := range resp.Events {
-			if event.Status != statusConfirmed {
+			if event.Status != statusFinal {
 				return fmt.Errorf("got status %v, wanted %v",
-					resp.Events[0].Status, statusConfirmed)
+					resp.Events[0].Status, statusFinal)
 			}
 		}
 
@@ -161,6 +162,23 @@ func testAddresses(t *harnessTest) {
 	}, defaultWaitTimeout/2)
 	require.NoError(t.t, err)
 
+	// A subscription that starts from the creation time of the first event
+	// should first deliver all events with their current status.
+	subCtx, subCancel := context.WithCancel(ctxt)
+	defer subCancel()
+	eventStream, err := secondTarod.SubscribeReceiveEvents(
+		subCtx, &tarorpc.SubscribeReceiveEventsRequest{
+			StartTimestamp: int64(events[0].CreationTimeUnixSeconds),
+		},
+	)
+	require.NoError(t.t, err)
+	for range rpcAssets {
+		event, err := eventStream.Recv()
+		require.NoError(t.t, err)
+		require.Equal(t.t, statusCompleted, event.Status)
+		require.True(t.t, event.HasProof)
+	}
+
 	// Now sanity check that we can actually list the transfer.
 	err = wait.NoError(func() error {
 		resp, err := t.tarod.ListTransfers(
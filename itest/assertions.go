@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarorpc"
@@ -192,12 +193,50 @@ func verifyProofBlob(t *testing.T, tarod *tarodHarness,
 	require.NoError(t, err)
 	require.True(t, verifyResp.Valid)
 
-	snapshot, err := f.Verify(ctxt)
+	snapshot, err := f.Verify(ctxt, minerHeaderVerifier(tarod.ht))
 	require.NoError(t, err)
 
 	return f, snapshot
 }
 
+// minerHeaderVerifier returns a header verifier that checks block headers
+// against the chain of the harness miner.
+func minerHeaderVerifier(ht *harnessTest) proof.HeaderVerifier {
+	return func(_ context.Context, header wire.BlockHeader, height uint32,
+		_ *wire.MsgTx) (uint32, error) {
+
+		miner := ht.lndHarness.Miner.Client
+
+		// Proofs without a block height are looked up by their block
+		// hash instead.
+		headerHash := header.BlockHash()
+		if height == 0 {
+			block, err := miner.GetBlockVerbose(&headerHash)
+			if err != nil {
+				return 0, err
+			}
+			if block.Confirmations < 0 {
+				return 0, fmt.Errorf("block %v not in main "+
+					"chain", headerHash)
+			}
+
+			return uint32(block.Height), nil
+		}
+
+		blockHash, err := miner.GetBlockHash(int64(height))
+		if err != nil {
+			return 0, err
+		}
+
+		if headerHash != *blockHash {
+			return 0, fmt.Errorf("block header at height %d "+
+				"doesn't match chain", height)
+		}
+
+		return height, nil
+	}
+}
+
 // assertAddrCreated makes sure an address was created correctly for the given
 // asset.
 func assertAddrCreated(t *testing.T, tarod *tarodHarness,
@@ -0,0 +1,62 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// testBurnAssets tests that we're able to provably burn a part of an asset,
+// and that anyone can verify the burn with the resulting proof file.
+func testBurnAssets(t *harnessTest) {
+	rpcAssets := mintAssetsConfirmBatch(
+		t, t.tarod, []*tarorpc.MintAssetRequest{simpleAssets[0]},
+	)
+
+	genInfo := rpcAssets[0].AssetGenesis
+	fullAmount := rpcAssets[0].Amount
+	burnAmount := fullAmount / 4
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	burnResp, err := t.tarod.BurnAsset(ctxt, &tarorpc.BurnAssetRequest{
+		AssetId:      genInfo.AssetId,
+		AmountToBurn: burnAmount,
+	})
+	require.NoError(t.t, err)
+
+	_ = mineBlocks(t, t.lndHarness, 1, 1)
+
+	// Once the burn is confirmed, the proof of the burn should be in our
+	// archive, and it should be recognized as a burn.
+	var burnProof *tarorpc.ProofFile
+	waitErr := wait.NoError(func() error {
+		resp, err := t.tarod.ExportProof(
+			ctxb, &tarorpc.ExportProofRequest{
+				AssetId:   genInfo.AssetId,
+				ScriptKey: burnResp.BurnKey,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		burnProof = resp
+		return nil
+	}, defaultWaitTimeout)
+	require.NoError(t.t, waitErr)
+
+	verifyResp, err := t.tarod.VerifyProof(ctxb, burnProof)
+	require.NoError(t.t, err)
+	require.True(t.t, verifyResp.Valid)
+	require.True(t.t, verifyResp.IsBurn)
+
+	// The burned amount should no longer count towards our balance.
+	assertBalance(
+		t.t, t.tarod, genInfo.AssetId, fullAmount-burnAmount,
+	)
+}
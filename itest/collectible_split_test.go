@@ -88,4 +88,29 @@ func testCollectibleSend(t *harnessTest) {
 
 	assertTransfers(t.t, secondTarod, []int64{0})
 	assertBalance(t.t, secondTarod, genInfo.AssetId, int64(fullAmount))
+
+	// Bob should list the collectible under its family key, with the
+	// minting node and every receiver as previous owners.
+	collectibles, err := secondTarod.ListCollectibles(
+		ctxb, &tarorpc.ListCollectiblesRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, collectibles.Families, 1)
+
+	family := collectibles.Families[0]
+	require.Equal(t.t, familyKey, family.TweakedFamilyKey)
+	require.Len(t.t, family.Collectibles, 1)
+
+	collectible := family.Collectibles[0]
+	require.Equal(
+		t.t, genInfo.AssetId, collectible.Asset.AssetGenesis.AssetId,
+	)
+	require.Len(t.t, collectible.Provenance, numSends+1)
+
+	lastOwner := collectible.Provenance[numSends]
+	require.Equal(
+		t.t, collectible.Asset.ChainAnchor.AnchorOutpoint,
+		lastOwner.AnchorOutpoint,
+	)
+	require.Equal(t.t, collectible.Asset.ScriptKey, lastOwner.ScriptKey)
 }
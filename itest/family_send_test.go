@@ -0,0 +1,94 @@
+package itest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/require"
+)
+
+// testFamilySend tests that we can send an asset to a family address, which
+// accepts any asset ID within the family of its family key.
+func testFamilySend(t *harnessTest) {
+	// First, we'll make a normal asset with an asset family, so further
+	// issuance of the asset is possible.
+	rpcAssets := mintAssetsConfirmBatch(
+		t, t.tarod, []*tarorpc.MintAssetRequest{issuableAssets[0]},
+	)
+
+	genInfo := rpcAssets[0].AssetGenesis
+	famKey := rpcAssets[0].AssetFamily.TweakedFamilyKey
+
+	ctxb := context.Background()
+
+	// Now that we have the asset created, we'll make a new node that'll
+	// serve as the node which'll receive the assets.
+	secondTarod := setupTarodHarness(
+		t.t, t, t.lndHarness.BackendCfg, t.lndHarness.Bob, t.universeServer,
+	)
+	defer func() {
+		require.NoError(t.t, secondTarod.stop(true))
+	}()
+
+	// Bob creates a family address, which doesn't commit to the asset ID
+	// of the asset he's going to receive.
+	const numUnits = 10
+	bobAddr, err := secondTarod.NewAddr(ctxb, &tarorpc.NewAddrRequest{
+		FamKey:     famKey,
+		FamilyOnly: true,
+		AssetType:  tarorpc.AssetType_NORMAL,
+		Amt:        numUnits,
+	})
+	require.NoError(t.t, err)
+	require.True(t.t, bobAddr.FamilyOnly)
+	require.Empty(t.t, bobAddr.AssetId)
+	require.Equal(t.t, famKey, bobAddr.FamilyKey)
+
+	// The family flag must survive decoding the address.
+	decodedAddr, err := t.tarod.DecodeAddr(ctxb, &tarorpc.DecodeAddrRequest{
+		Addr: bobAddr.Encoded,
+	})
+	require.NoError(t.t, err)
+	require.True(t.t, decodedAddr.FamilyOnly)
+
+	sendResp := sendAssetsToAddr(t, t.tarod, bobAddr)
+	sendRespJSON, err := formatProtoJSON(sendResp)
+	require.NoError(t.t, err)
+	t.Logf("Got response from sending assets: %v", sendRespJSON)
+
+	// Bob can't know the on-chain output of the transfer before he knows
+	// which asset ID was sent, so he only learns about it from the proof.
+	_ = mineBlocks(t, t.lndHarness, 1, 1)
+	_ = sendProof(t, t.tarod, secondTarod, &tarorpc.Addr{
+		AssetId:   genInfo.AssetId,
+		ScriptKey: bobAddr.ScriptKey,
+	}, genInfo)
+
+	err = wait.NoError(func() error {
+		resp, err := secondTarod.AddrReceives(
+			ctxb, &tarorpc.AddrReceivesRequest{
+				FilterAddr: bobAddr.Encoded,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if len(resp.Events) != 1 {
+			return fmt.Errorf("expected 1 receive, got %d",
+				len(resp.Events))
+		}
+
+		status := resp.Events[0].Status
+		if status != tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED {
+			return fmt.Errorf("unexpected receive status %v",
+				status)
+		}
+
+		return nil
+	}, defaultTimeout/2)
+	require.NoError(t.t, err)
+
+	assertBalance(t.t, secondTarod, genInfo.AssetId, numUnits)
+}
@@ -28,4 +28,12 @@ var testCases = []*testCase{
 		name: "collectible send",
 		test: testCollectibleSend,
 	},
+	{
+		name: "family send",
+		test: testFamilySend,
+	},
+	{
+		name: "burn assets",
+		test: testBurnAssets,
+	},
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightningnetwork/lnd/keychain"
 )
@@ -76,6 +78,36 @@ func (l *LndRpcKeyRing) DeriveKey(ctx context.Context,
 	return *keyDesc, nil
 }
 
+// SignMemo signs the digest of the given memo with the memo key of the
+// internal key identified by the passed key descriptor.
+func (l *LndRpcKeyRing) SignMemo(ctx context.Context,
+	keyDesc keychain.KeyDescriptor, memo string) (*schnorr.Signature,
+	error) {
+
+	taroLog.Debugf("Signing addr memo, key_loc=%v",
+		spew.Sdump(keyDesc.KeyLocator))
+
+	// The signer hashes the message with sha256 before signing, which
+	// results in the tagged memo digest of the address. The Taproot tweak
+	// makes sure we sign with the dedicated memo key instead of the
+	// internal key itself.
+	sig, err := l.lnd.Signer.SignMessage(
+		ctx, address.MemoSigMsg(memo), keyDesc.KeyLocator,
+		lndclient.SignSchnorr(address.MemoKeyTweak[:]),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign memo: %w", err)
+	}
+
+	schnorrSig, err := schnorr.ParseSignature(sig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse schnorr sig: %w", err)
+	}
+
+	return schnorrSig, nil
+}
+
 // A compile time assertion to ensure LndRpcKeyRing meets the
-// tarogarden.KeyRing interface.
+// tarogarden.KeyRing and address.MemoSigner interfaces.
 var _ tarogarden.KeyRing = (*LndRpcKeyRing)(nil)
+var _ address.MemoSigner = (*LndRpcKeyRing)(nil)
@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/vm"
 )
 
 // TransitionParams holds the set of chain level information needed to append a
@@ -41,9 +42,11 @@ type TransitionParams struct {
 // encoded proof file. Because multiple assets can be committed to in the same
 // on-chain output, this function takes the script key of the asset to return
 // the proof for. This method returns both the encoded full provenance (proof
-// chain) and the added latest proof.
-func AppendTransition(blob Blob, params *TransitionParams) (Blob, *Proof,
-	error) {
+// chain) and the added latest proof. The passed header verifier and VM options
+// are used to validate the full file once the new proof is appended.
+func AppendTransition(blob Blob, params *TransitionParams,
+	headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (Blob, *Proof, error) {
 
 	// Decode the proof blob into a proper file structure first.
 	f := NewEmptyFile(V0)
@@ -76,12 +79,20 @@ func AppendTransition(blob Blob, params *TransitionParams) (Blob, *Proof,
 	}
 
 	// Before we encode and return the proof, we want to validate it. For
-	// that we need to start at the beginning.
+	// that we need to start at the beginning, or at the checkpoint if the
+	// file was compacted by us before.
 	ctx := context.Background()
 	if err := f.AppendProof(*newProof); err != nil {
 		return nil, nil, fmt.Errorf("error appending proof: %w", err)
 	}
-	if _, err := f.Verify(ctx); err != nil {
+	if checkpoint := f.Checkpoint(); checkpoint != nil {
+		_, err = f.VerifyFromCheckpoint(
+			ctx, checkpoint.Root(), headerVerifier, vmOpts...,
+		)
+	} else {
+		_, err = f.Verify(ctx, headerVerifier, vmOpts...)
+	}
+	if err != nil {
 		return nil, nil, fmt.Errorf("error verifying proof: %w", err)
 	}
 
@@ -99,8 +110,9 @@ func AppendTransition(blob Blob, params *TransitionParams) (Blob, *Proof,
 // proof parameters, and updates a proof to be anchored at the given anchor
 // transaction. This is needed to reflect confirmation of an anchor transaction.
 func (p *Proof) UpdateTransitionProof(params *BaseProofParams) error {
-	// We only use the block, transaction, and transaction index parameters,
-	// so we only need to check the nil-ness of the block and transaction.
+	// We only use the block, block height, transaction, and transaction
+	// index parameters, so we only need to check the nil-ness of the block
+	// and transaction.
 	if params.Block == nil || params.Tx == nil {
 		return fmt.Errorf("Missing block or TX to update proof")
 	}
@@ -112,6 +124,7 @@ func (p *Proof) UpdateTransitionProof(params *BaseProofParams) error {
 	}
 
 	p.BlockHeader = proofHeader.BlockHeader
+	p.BlockHeight = proofHeader.BlockHeight
 	p.AnchorTx = proofHeader.AnchorTx
 	p.TxMerkleProof = proofHeader.TxMerkleProof
 	return nil
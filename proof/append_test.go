@@ -15,6 +15,7 @@ import (
 	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/taroscript"
+	"github.com/lightninglabs/taro/vm"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,7 +28,7 @@ func genTaprootKeySpend(t testing.TB, privKey btcec.PrivateKey,
 		virtualTx, input, idx, nil,
 	)
 	sigHash, err := taroscript.InputKeySpendSigHash(
-		virtualTxCopy, input, idx,
+		virtualTxCopy, input, idx, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 
@@ -186,13 +187,31 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 
 	// Append the new transition to the genesis blob.
 	transitionBlob, transitionProof, err := AppendTransition(
-		genesisBlob, transitionParams,
+		genesisBlob, transitionParams, MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(transitionBlob), len(genesisBlob))
 	require.Equal(t, txMerkleProof, &transitionProof.TxMerkleProof)
 	verifyBlob(t, transitionBlob)
 
+	// If we tamper with the witness of the transition, a trace of it should
+	// point us to the failing script execution.
+	invalidFile := NewEmptyFile(V0)
+	require.NoError(t, invalidFile.Decode(bytes.NewReader(transitionBlob)))
+	invalidProof := *transitionProof
+	invalidProof.Asset = *transitionProof.Asset.Copy()
+	invalidSig := append(
+		[]byte{}, invalidProof.Asset.PrevWitnesses[0].TxWitness[0]...,
+	)
+	invalidSig[0] ^= 0x01
+	invalidProof.Asset.PrevWitnesses[0].TxWitness = wire.TxWitness{
+		invalidSig,
+	}
+	require.NoError(t, invalidFile.ReplaceLastProof(invalidProof))
+	var invalidBlob bytes.Buffer
+	require.NoError(t, invalidFile.Encode(&invalidBlob))
+	assertTraceFailure(t, invalidBlob.Bytes())
+
 	// Stop here if we don't test asset splitting.
 	if !withSplit {
 		return
@@ -321,7 +340,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 	}
 
 	split1Blob, split1Proof, err := AppendTransition(
-		transitionBlob, split1Params,
+		transitionBlob, split1Params, MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(split1Blob), len(transitionBlob))
@@ -356,7 +375,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 	}
 
 	split2Blob, split2Proof, err := AppendTransition(
-		transitionBlob, split2Params,
+		transitionBlob, split2Params, MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(split2Blob), len(transitionBlob))
@@ -415,8 +434,35 @@ func verifyBlob(t testing.TB, blob Blob) *AssetSnapshot {
 	f := NewEmptyFile(V0)
 	require.NoError(t, f.Decode(bytes.NewReader(blob)))
 
-	finalSnapshot, err := f.Verify(context.Background())
+	finalSnapshot, err := f.Verify(context.Background(), MockHeaderVerifier)
+	require.NoError(t, err)
+
+	// A traced execution of the last state transition must succeed as
+	// well.
+	trace, err := f.TraceLastTransition(
+		context.Background(), MockHeaderVerifier,
+	)
 	require.NoError(t, err)
+	require.NotEmpty(t, trace.Steps)
 
 	return finalSnapshot
 }
+
+// assertTraceFailure asserts that the traced execution of the last state
+// transition of the given proof blob fails with an invalid witness.
+func assertTraceFailure(t testing.TB, blob Blob) {
+	f := NewEmptyFile(V0)
+	require.NoError(t, f.Decode(bytes.NewReader(blob)))
+
+	trace, err := f.TraceLastTransition(
+		context.Background(), MockHeaderVerifier,
+	)
+	var vmErr vm.Error
+	require.ErrorAs(t, err, &vmErr)
+	require.Equal(t, vm.ErrInvalidTransferWitness, vmErr.Kind)
+	require.NotNil(t, trace)
+	require.Error(t, trace.Err())
+
+	lastStep := trace.Steps[len(trace.Steps)-1]
+	require.Equal(t, vm.TraceStepScript, lastStep.Name)
+}
@@ -69,8 +69,6 @@ type AnnotatedProof struct {
 
 // Archiver is the main storage backend the ProofArchiver uses to store and
 // query for proof files.
-//
-// TODO(roasbeef): other queries like fetching all proofs for a given asset?
 type Archiver interface {
 	// FetchProof fetches a proof for an asset uniquely identified by the
 	// passed ProofIdentifier.
@@ -79,6 +77,12 @@ type Archiver interface {
 	// returned.
 	FetchProof(ctx context.Context, id Locator) (Blob, error)
 
+	// FetchProofs fetches all proofs of assets with the given asset ID
+	// and/or family key. If neither is specified, all proofs of the
+	// archive are returned.
+	FetchProofs(ctx context.Context, assetID *asset.ID,
+		familyKey *btcec.PublicKey) ([]*AnnotatedProof, error)
+
 	// ImportProofs attempts to store fully populated proofs on disk. The
 	// previous outpoint of the first state transition will be used as the
 	// Genesis point. The final resting place of the asset will be used as
@@ -86,6 +90,15 @@ type Archiver interface {
 	ImportProofs(ctx context.Context, proofs ...*AnnotatedProof) error
 }
 
+// Rewriter is an optional interface an Archiver can implement to replace the
+// proof file of an asset it already stores, without importing the asset a
+// second time.
+type Rewriter interface {
+	// ReplaceProofs replaces the stored proof files of the assets
+	// identified by the locators of the passed proofs.
+	ReplaceProofs(ctx context.Context, proofs ...*AnnotatedProof) error
+}
+
 // FileArchiver implements proof Archiver backed by an on-disk file system. The
 // archiver takes a single root directory then creates the following overlap
 // mapping:
@@ -120,6 +133,52 @@ func NewFileArchiver(dirName string) (*FileArchiver, error) {
 	}, nil
 }
 
+// LocatorFromBlob decodes the given proof file and returns the locator of the
+// final resting place of the asset it proves.
+//
+// NOTE: The proof file is NOT verified.
+func LocatorFromBlob(blob Blob) (*Locator, error) {
+	var proofFile File
+	if err := proofFile.Decode(bytes.NewReader(blob)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, err
+	}
+
+	finalAsset := lastProof.Asset
+	assetID := finalAsset.ID()
+	loc := &Locator{
+		AssetID:   &assetID,
+		ScriptKey: *finalAsset.ScriptKey.PubKey,
+	}
+	if finalAsset.FamilyKey != nil {
+		loc.FamilyKey = &finalAsset.FamilyKey.FamKey
+	}
+
+	return loc, nil
+}
+
+// MatchesFilter returns true if the locator matches the given asset ID and
+// family key filter. A nil filter value matches any locator.
+func (l *Locator) MatchesFilter(assetID *asset.ID,
+	familyKey *btcec.PublicKey) bool {
+
+	if assetID != nil && (l.AssetID == nil || *l.AssetID != *assetID) {
+		return false
+	}
+
+	if familyKey != nil && (l.FamilyKey == nil ||
+		!l.FamilyKey.IsEqual(familyKey)) {
+
+		return false
+	}
+
+	return true
+}
+
 // genProofFilePath generates the full proof file path based on a rootPath and
 // a valid locator. The final path is: root/assetID/scriptKey.taro
 func genProofFilePath(rootPath string, loc Locator) (string, error) {
@@ -194,10 +253,99 @@ func (f *FileArchiver) ImportProofs(ctx context.Context,
 	return nil
 }
 
+// FetchProofs fetches all proofs of assets with the given asset ID and/or
+// family key. If neither is specified, all proofs of the archive are returned.
+//
+// NOTE: This implements the Archiver interface.
+func (f *FileArchiver) FetchProofs(ctx context.Context, assetID *asset.ID,
+	familyKey *btcec.PublicKey) ([]*AnnotatedProof, error) {
+
+	// The proofs are grouped by asset ID on disk, so if we're looking for
+	// a specific asset, we only need to look into a single directory.
+	var assetDirs []string
+	if assetID != nil {
+		assetDirs = []string{hex.EncodeToString(assetID[:])}
+	} else {
+		entries, err := os.ReadDir(f.proofPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read proof dir: %w",
+				err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() {
+				assetDirs = append(assetDirs, entry.Name())
+			}
+		}
+	}
+
+	var proofs []*AnnotatedProof
+	for _, assetDir := range assetDirs {
+		entries, err := os.ReadDir(filepath.Join(f.proofPath, assetDir))
+		switch {
+		case os.IsNotExist(err):
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("unable to read proof dir: %w",
+				err)
+		}
+
+		for _, entry := range entries {
+			fileName := entry.Name()
+			if entry.IsDir() ||
+				filepath.Ext(fileName) != TaroFileSuffix {
+
+				continue
+			}
+
+			blob, err := os.ReadFile(
+				filepath.Join(f.proofPath, assetDir, fileName),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read proof: "+
+					"%w", err)
+			}
+
+			// We need to decode the proof file anyway to find out
+			// about the family key of the asset.
+			loc, err := LocatorFromBlob(blob)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode proof "+
+					"%v: %w", fileName, err)
+			}
+			if !loc.MatchesFilter(assetID, familyKey) {
+				continue
+			}
+
+			proofs = append(proofs, &AnnotatedProof{
+				Locator: *loc,
+				Blob:    blob,
+			})
+		}
+	}
+
+	return proofs, nil
+}
+
+// ReplaceProofs replaces the stored proof files of the assets identified by
+// the locators of the passed proofs. As every proof lives in its own file on
+// disk, this is the same as importing the proofs.
+//
+// NOTE: This implements the Rewriter interface.
+func (f *FileArchiver) ReplaceProofs(ctx context.Context,
+	proofs ...*AnnotatedProof) error {
+
+	return f.ImportProofs(ctx, proofs...)
+}
+
 // A compile-time interface to ensure FileArchiver meets the Archiver
 // interface.
 var _ Archiver = (*FileArchiver)(nil)
 
+// A compile-time interface to ensure FileArchiver meets the Rewriter
+// interface.
+var _ Rewriter = (*FileArchiver)(nil)
+
 // MultiArchiver is an archive of archives. It contains several archives and
 // attempts to use them either as a look-aside cache, or a write through cache
 // for all incoming requests.
@@ -259,6 +407,49 @@ func (m *MultiArchiver) FetchProof(ctx context.Context,
 	return nil, ErrProofNotFound
 }
 
+// FetchProofs fetches all proofs of assets with the given asset ID and/or
+// family key from all backends. If neither is specified, all proofs of the
+// archive are returned. If multiple backends have a proof for the same asset,
+// the proof of the first backend is returned.
+func (m *MultiArchiver) FetchProofs(ctx context.Context, assetID *asset.ID,
+	familyKey *btcec.PublicKey) ([]*AnnotatedProof, error) {
+
+	type proofKey struct {
+		assetID   asset.ID
+		scriptKey asset.SerializedKey
+	}
+
+	var (
+		proofs []*AnnotatedProof
+		known  = make(map[proofKey]struct{})
+	)
+	for _, archive := range m.backends {
+		backendProofs, err := archive.FetchProofs(
+			ctx, assetID, familyKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range backendProofs {
+			var key proofKey
+			if p.AssetID != nil {
+				key.assetID = *p.AssetID
+			}
+			key.scriptKey = asset.ToSerialized(&p.ScriptKey)
+
+			if _, ok := known[key]; ok {
+				continue
+			}
+
+			known[key] = struct{}{}
+			proofs = append(proofs, p)
+		}
+	}
+
+	return proofs, nil
+}
+
 // ImportProofs attempts to store fully populated proofs on disk. The previous
 // outpoint of the first state transition will be used as the Genesis point.
 // The final resting place of the asset will be used as the script key itself.
@@ -329,6 +520,45 @@ func (m *MultiArchiver) ImportProofs(ctx context.Context,
 	return nil
 }
 
+// ReplaceProofs replaces the proof files of assets that are already stored in
+// the archive, for example after compacting them. The new proofs are verified
+// first and then written to all backends, using the Rewriter interface of a
+// backend if it implements it.
+func (m *MultiArchiver) ReplaceProofs(ctx context.Context,
+	proofs ...*AnnotatedProof) error {
+
+	f := func(c context.Context, proof *AnnotatedProof) error {
+		snapshot, err := m.proofVerifier.Verify(
+			c, bytes.NewReader(proof.Blob),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to verify proof: %w", err)
+		}
+
+		proof.AssetSnapshot = snapshot
+
+		return nil
+	}
+	if err := chanutils.ErrGroup(ctx, proofs, f); err != nil {
+		return err
+	}
+
+	for _, archive := range m.backends {
+		var err error
+		switch rewriter := archive.(type) {
+		case Rewriter:
+			err = rewriter.ReplaceProofs(ctx, proofs...)
+		default:
+			err = archive.ImportProofs(ctx, proofs...)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RegisterSubscriber adds a new subscriber for receiving events. The
 // deliverExisting boolean indicates whether already existing items should be
 // sent to the NewItemCreated channel when the subscription is started. An
@@ -391,3 +621,7 @@ func (m *MultiArchiver) RemoveSubscriber(
 // A compile-time assertion to make sure MultiArchiver satisfies the
 // chanutils.EventPublisher interface.
 var _ chanutils.EventPublisher[Blob, []*Locator] = (*MultiArchiver)(nil)
+
+// A compile-time assertion to make sure MultiArchiver satisfies the Rewriter
+// interface.
+var _ Rewriter = (*MultiArchiver)(nil)
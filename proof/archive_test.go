@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/stretchr/testify/require"
@@ -126,3 +127,116 @@ func TestFileArchiver(t *testing.T) {
 		})
 	}
 }
+
+// staticArchiver is an Archiver that serves a fixed set of proofs.
+type staticArchiver struct {
+	proofs []*AnnotatedProof
+}
+
+func (s *staticArchiver) FetchProof(_ context.Context, _ Locator) (Blob,
+	error) {
+
+	return nil, ErrProofNotFound
+}
+
+func (s *staticArchiver) FetchProofs(_ context.Context, _ *asset.ID,
+	_ *btcec.PublicKey) ([]*AnnotatedProof, error) {
+
+	return s.proofs, nil
+}
+
+func (s *staticArchiver) ImportProofs(_ context.Context,
+	_ ...*AnnotatedProof) error {
+
+	return nil
+}
+
+// TestMultiArchiverAudit tests that the audit of the proof archive detects
+// proof files that are invalid or stored under the wrong locator.
+func TestMultiArchiverAudit(t *testing.T) {
+	t.Parallel()
+
+	blob := genTransferChain(t, 1)
+	snapshot := verifyBlob(t, blob)
+
+	assetID := snapshot.Asset.ID()
+	loc := Locator{
+		AssetID:   &assetID,
+		ScriptKey: *snapshot.Asset.ScriptKey.PubKey,
+	}
+
+	// The same proof file stored under a different script key or asset
+	// ID doesn't belong there.
+	wrongKeyLoc := Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	wrongIDLoc := Locator{
+		AssetID:   randAssetID(t),
+		ScriptKey: *snapshot.Asset.ScriptKey.PubKey,
+	}
+
+	// A proof file that can't be decoded isn't valid either.
+	corruptLoc := Locator{
+		AssetID:   randAssetID(t),
+		ScriptKey: *test.RandPubKey(t),
+	}
+	corruptBlob := append(Blob{}, blob[:len(blob)/2]...)
+
+	archive := NewMultiArchiver(
+		&BaseVerifier{
+			HeaderVerifier: MockHeaderVerifier,
+		}, testTimeout, &staticArchiver{
+			proofs: []*AnnotatedProof{{
+				Locator: loc,
+				Blob:    blob,
+			}, {
+				Locator: wrongKeyLoc,
+				Blob:    blob,
+			}, {
+				Locator: wrongIDLoc,
+				Blob:    blob,
+			}, {
+				Locator: corruptLoc,
+				Blob:    corruptBlob,
+			}},
+		},
+	)
+
+	results, err := archive.AuditProofs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.Equal(t, loc, results[0].Locator)
+	require.NoError(t, results[0].Err)
+	require.NotNil(t, results[0].Snapshot)
+	require.Equal(t, assetID, results[0].Snapshot.Asset.ID())
+
+	require.Equal(t, wrongKeyLoc, results[1].Locator)
+	require.ErrorIs(t, results[1].Err, ErrProofLocatorMismatch)
+	require.Nil(t, results[1].Snapshot)
+
+	require.Equal(t, wrongIDLoc, results[2].Locator)
+	require.ErrorIs(t, results[2].Err, ErrProofLocatorMismatch)
+	require.Nil(t, results[2].Snapshot)
+
+	require.Equal(t, corruptLoc, results[3].Locator)
+	require.Error(t, results[3].Err)
+	require.Nil(t, results[3].Snapshot)
+
+	// The final state of the valid proof file must also match the state
+	// we expect the asset to be in.
+	require.NoError(t, CheckSnapshot(
+		results[0].Snapshot, snapshot.OutPoint, snapshot.Asset,
+	))
+
+	otherOutPoint := snapshot.OutPoint
+	otherOutPoint.Index++
+	err = CheckSnapshot(results[0].Snapshot, otherOutPoint, snapshot.Asset)
+	require.ErrorIs(t, err, ErrProofStateMismatch)
+
+	otherAsset := snapshot.Asset.Copy()
+	otherAsset.Amount++
+	err = CheckSnapshot(results[0].Snapshot, snapshot.OutPoint, otherAsset)
+	require.ErrorIs(t, err, ErrProofStateMismatch)
+}
@@ -0,0 +1,146 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+)
+
+var (
+	// ErrProofStateMismatch is returned when the final state of a valid
+	// proof file doesn't match the state of the asset we expect.
+	ErrProofStateMismatch = errors.New("proof state mismatch")
+
+	// ErrProofLocatorMismatch is returned when a proof file is stored
+	// under a locator that doesn't match the final state of the file.
+	ErrProofLocatorMismatch = errors.New("proof locator mismatch")
+)
+
+// AuditResult is the result of checking the integrity of a single proof file
+// of a proof archive.
+type AuditResult struct {
+	// Locator is the locator the proof file is stored under.
+	Locator Locator
+
+	// Snapshot is the final state of the proof file, or nil if the proof
+	// file isn't valid.
+	Snapshot *AssetSnapshot
+
+	// Err is the reason the proof file isn't valid, or nil if it is.
+	Err error
+}
+
+// Auditor is an optional interface an Archiver can implement to check the
+// integrity of the proof files it stores.
+type Auditor interface {
+	// AuditProofs decodes and verifies every proof file of the archive
+	// and checks that each one is stored under the locator of its final
+	// state.
+	AuditProofs(ctx context.Context) ([]*AuditResult, error)
+}
+
+// AuditProofs decodes and verifies every proof file of the archive and checks
+// that each one is stored under the locator of its final state. A proof file
+// that fails any of these checks is reported in the returned result instead of
+// aborting the audit.
+func (m *MultiArchiver) AuditProofs(ctx context.Context) ([]*AuditResult,
+	error) {
+
+	proofs, err := m.FetchProofs(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proofs: %w", err)
+	}
+
+	results := make([]*AuditResult, 0, len(proofs))
+	for _, p := range proofs {
+		result := &AuditResult{
+			Locator: p.Locator,
+		}
+
+		snapshot, err := m.proofVerifier.Verify(
+			ctx, bytes.NewReader(p.Blob),
+		)
+		if err == nil {
+			err = checkLocator(p.Locator, snapshot.Asset)
+		}
+
+		if err != nil {
+			result.Err = err
+		} else {
+			result.Snapshot = snapshot
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// checkLocator makes sure a proof file with the given final asset is stored
+// under the given locator.
+func checkLocator(loc Locator, finalAsset *asset.Asset) error {
+	finalID := finalAsset.ID()
+	switch {
+	case loc.AssetID != nil && *loc.AssetID != finalID:
+		return fmt.Errorf("%w: stored under asset ID %x, proof is for "+
+			"asset ID %x", ErrProofLocatorMismatch, loc.AssetID[:],
+			finalID[:])
+
+	case !loc.ScriptKey.IsEqual(finalAsset.ScriptKey.PubKey):
+		return fmt.Errorf("%w: stored under script key %x, proof is "+
+			"for script key %x", ErrProofLocatorMismatch,
+			loc.ScriptKey.SerializeCompressed(),
+			finalAsset.ScriptKey.PubKey.SerializeCompressed())
+
+	case loc.FamilyKey != nil && (finalAsset.FamilyKey == nil ||
+		!loc.FamilyKey.IsEqual(&finalAsset.FamilyKey.FamKey)):
+
+		return fmt.Errorf("%w: stored under family key %x, proof "+
+			"has a different family key", ErrProofLocatorMismatch,
+			loc.FamilyKey.SerializeCompressed())
+	}
+
+	return nil
+}
+
+// CheckSnapshot makes sure the final state of a proof file matches the
+// expected asset anchored at the expected outpoint.
+func CheckSnapshot(snapshot *AssetSnapshot, expectedOutPoint wire.OutPoint,
+	expectedAsset *asset.Asset) error {
+
+	finalAsset := snapshot.Asset
+	switch {
+	case snapshot.OutPoint != expectedOutPoint:
+		return fmt.Errorf("%w: anchored at %v instead of %v",
+			ErrProofStateMismatch, snapshot.OutPoint,
+			expectedOutPoint)
+
+	case finalAsset.ID() != expectedAsset.ID():
+		return fmt.Errorf("%w: asset ID %x instead of %x",
+			ErrProofStateMismatch, finalAsset.ID(),
+			expectedAsset.ID())
+
+	case finalAsset.Amount != expectedAsset.Amount:
+		return fmt.Errorf("%w: amount %d instead of %d",
+			ErrProofStateMismatch, finalAsset.Amount,
+			expectedAsset.Amount)
+
+	case !finalAsset.ScriptKey.PubKey.IsEqual(
+		expectedAsset.ScriptKey.PubKey,
+	):
+		return fmt.Errorf("%w: script key %x instead of %x",
+			ErrProofStateMismatch,
+			finalAsset.ScriptKey.PubKey.SerializeCompressed(),
+			expectedAsset.ScriptKey.PubKey.SerializeCompressed())
+	}
+
+	return nil
+}
+
+// A compile-time assertion to ensure MultiArchiver meets the Auditor
+// interface.
+var _ Auditor = (*MultiArchiver)(nil)
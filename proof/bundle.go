@@ -0,0 +1,286 @@
+package proof
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taro/asset"
+)
+
+const (
+	// BundleManifestName is the name of the manifest file within a proof
+	// bundle.
+	BundleManifestName = "manifest.json"
+
+	// BundleVersion is the current version of the proof bundle format.
+	BundleVersion = 0
+
+	// maxBundleEntrySize is the maximum size of a single file within a
+	// proof bundle we're willing to read.
+	maxBundleEntrySize = 1 << 30
+)
+
+var (
+	// ErrInvalidBundle is returned when a proof bundle is malformed or its
+	// content doesn't match its manifest.
+	ErrInvalidBundle = errors.New("invalid proof bundle")
+)
+
+// BundleEntry describes a single proof file within a proof bundle.
+type BundleEntry struct {
+	// AssetID is the hex encoded asset ID of the asset.
+	AssetID string `json:"asset_id"`
+
+	// FamilyKey is the hex encoded family key of the asset, if it has one.
+	FamilyKey string `json:"family_key,omitempty"`
+
+	// ScriptKey is the hex encoded script key of the asset.
+	ScriptKey string `json:"script_key"`
+
+	// FileName is the name of the proof file within the bundle.
+	FileName string `json:"file_name"`
+
+	// NumProofs is the number of state transitions in the proof file.
+	NumProofs int `json:"num_proofs"`
+
+	// LastHash is the hex encoded chained checksum of the last proof of
+	// the proof file.
+	LastHash string `json:"last_hash"`
+}
+
+// BundleManifest lists the content of a proof bundle.
+type BundleManifest struct {
+	// Version is the version of the proof bundle format.
+	Version uint32 `json:"version"`
+
+	// Entries are the proof files contained in the bundle.
+	Entries []BundleEntry `json:"entries"`
+}
+
+// WriteBundle writes the given proofs into `w` as a proof bundle. A proof
+// bundle is a tar archive that contains a manifest and one .taro file per
+// proof, laid out in the same way as in the FileArchiver.
+func WriteBundle(w io.Writer, proofs []*AnnotatedProof) (*BundleManifest,
+	error) {
+
+	manifest := &BundleManifest{
+		Version: BundleVersion,
+		Entries: make([]BundleEntry, 0, len(proofs)),
+	}
+
+	for _, p := range proofs {
+		if p.AssetID == nil {
+			return nil, ErrInvalidLocatorID
+		}
+
+		var proofFile File
+		if err := proofFile.Decode(bytes.NewReader(p.Blob)); err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: %w",
+				err)
+		}
+		lastHash, err := proofFile.LastHash()
+		if err != nil {
+			return nil, err
+		}
+
+		scriptKey := p.ScriptKey.SerializeCompressed()
+		entry := BundleEntry{
+			AssetID:   hex.EncodeToString(p.AssetID[:]),
+			ScriptKey: hex.EncodeToString(scriptKey),
+			NumProofs: proofFile.NumProofs(),
+			LastHash:  hex.EncodeToString(lastHash[:]),
+		}
+		if p.FamilyKey != nil {
+			entry.FamilyKey = hex.EncodeToString(
+				p.FamilyKey.SerializeCompressed(),
+			)
+		}
+		entry.FileName = path.Join(
+			ProofDirName, entry.AssetID,
+			entry.ScriptKey+TaroFileSuffix,
+		)
+
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	tw := tar.NewWriter(w)
+	err = writeBundleFile(tw, BundleManifestName, manifestBytes)
+	if err != nil {
+		return nil, err
+	}
+	for idx, p := range proofs {
+		fileName := manifest.Entries[idx].FileName
+		if err := writeBundleFile(tw, fileName, p.Blob); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// writeBundleFile writes a single file into a proof bundle.
+func writeBundleFile(tw *tar.Writer, name string, content []byte) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(content)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to write bundle header: %w", err)
+	}
+
+	if _, err := tw.Write(content); err != nil {
+		return fmt.Errorf("unable to write bundle file: %w", err)
+	}
+
+	return nil
+}
+
+// ReadBundle reads a proof bundle from `r`. Every proof file listed in the
+// manifest must be present and match the manifest entry. The proof files are
+// NOT verified.
+func ReadBundle(r io.Reader) ([]*AnnotatedProof, error) {
+	var (
+		manifest *BundleManifest
+		files    = make(map[string][]byte)
+		tr       = tar.NewReader(r)
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > maxBundleEntrySize {
+			return nil, fmt.Errorf("%w: file %v too large",
+				ErrInvalidBundle, header.Name)
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+
+		if header.Name == BundleManifestName {
+			manifest = &BundleManifest{}
+			if err := json.Unmarshal(content, manifest); err != nil {
+				return nil, fmt.Errorf("%w: invalid manifest: %v",
+					ErrInvalidBundle, err)
+			}
+
+			continue
+		}
+
+		files[header.Name] = content
+	}
+
+	switch {
+	case manifest == nil:
+		return nil, fmt.Errorf("%w: missing manifest", ErrInvalidBundle)
+
+	case manifest.Version != BundleVersion:
+		return nil, fmt.Errorf("%w: unknown version %d",
+			ErrInvalidBundle, manifest.Version)
+	}
+
+	proofs := make([]*AnnotatedProof, 0, len(manifest.Entries))
+	for _, entry := range manifest.Entries {
+		blob, ok := files[entry.FileName]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing file %v",
+				ErrInvalidBundle, entry.FileName)
+		}
+
+		p, err := bundleEntryToProof(entry, blob)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %v: %v",
+				ErrInvalidBundle, entry.FileName, err)
+		}
+
+		proofs = append(proofs, p)
+	}
+
+	return proofs, nil
+}
+
+// bundleEntryToProof parses a manifest entry and makes sure the proof file
+// matches it.
+func bundleEntryToProof(entry BundleEntry, blob []byte) (*AnnotatedProof,
+	error) {
+
+	assetIDBytes, err := hex.DecodeString(entry.AssetID)
+	if err != nil || len(assetIDBytes) != len(asset.ID{}) {
+		return nil, ErrInvalidLocatorID
+	}
+	var assetID asset.ID
+	copy(assetID[:], assetIDBytes)
+
+	scriptKeyBytes, err := hex.DecodeString(entry.ScriptKey)
+	if err != nil {
+		return nil, ErrInvalidLocatorKey
+	}
+	scriptKey, err := btcec.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return nil, ErrInvalidLocatorKey
+	}
+
+	loc := Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	}
+	if entry.FamilyKey != "" {
+		famKeyBytes, err := hex.DecodeString(entry.FamilyKey)
+		if err != nil {
+			return nil, err
+		}
+		loc.FamilyKey, err = btcec.ParsePubKey(famKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var proofFile File
+	if err := proofFile.Decode(bytes.NewReader(blob)); err != nil {
+		return nil, err
+	}
+	lastHash, err := proofFile.LastHash()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case proofFile.NumProofs() != entry.NumProofs:
+		return nil, fmt.Errorf("expected %d proofs, got %d",
+			entry.NumProofs, proofFile.NumProofs())
+
+	case hex.EncodeToString(lastHash[:]) != entry.LastHash:
+		return nil, fmt.Errorf("last hash mismatch")
+	}
+
+	return &AnnotatedProof{
+		Locator: loc,
+		Blob:    blob,
+	}, nil
+}
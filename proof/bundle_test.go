@@ -0,0 +1,134 @@
+package proof
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestProofBundle tests that proofs can be exported into a proof bundle and
+// read back, and that tampered bundles are rejected.
+func TestProofBundle(t *testing.T) {
+	t.Parallel()
+
+	fileArchive, err := NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// We store two proofs of different assets in the archive.
+	var proofs []*AnnotatedProof
+	for i := 0; i < 2; i++ {
+		blob := genTransferChain(t, i+1)
+		loc, err := LocatorFromBlob(blob)
+		require.NoError(t, err)
+
+		proofs = append(proofs, &AnnotatedProof{
+			Locator: *loc,
+			Blob:    blob,
+		})
+	}
+	require.NoError(t, fileArchive.ImportProofs(ctx, proofs...))
+
+	// Listing all proofs should return both of them, filtering by asset
+	// ID only the matching one.
+	allProofs, err := fileArchive.FetchProofs(ctx, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, allProofs, 2)
+
+	filtered, err := fileArchive.FetchProofs(ctx, proofs[1].AssetID, nil)
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.Equal(t, proofs[1].Blob, filtered[0].Blob)
+
+	// Filtering by an asset ID we don't know returns nothing.
+	filtered, err = fileArchive.FetchProofs(ctx, randAssetID(t), nil)
+	require.NoError(t, err)
+	require.Empty(t, filtered)
+
+	// Now we export the proofs into a bundle and read them back.
+	var buf bytes.Buffer
+	manifest, err := WriteBundle(&buf, allProofs)
+	require.NoError(t, err)
+	require.Len(t, manifest.Entries, 2)
+
+	bundleProofs, err := ReadBundle(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, bundleProofs, 2)
+	for idx := range allProofs {
+		require.Equal(t, allProofs[idx].Blob, bundleProofs[idx].Blob)
+		require.Equal(
+			t, allProofs[idx].Locator, bundleProofs[idx].Locator,
+		)
+	}
+
+	// A bundle that is missing one of the proof files listed in the
+	// manifest is invalid.
+	truncated := rewriteBundle(
+		t, buf.Bytes(), func(name string, content []byte) []byte {
+			if name == manifest.Entries[1].FileName {
+				return nil
+			}
+
+			return content
+		},
+	)
+	_, err = ReadBundle(bytes.NewReader(truncated))
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	// A bundle whose proof files don't match the manifest is invalid too.
+	swapped := rewriteBundle(
+		t, buf.Bytes(), func(name string, content []byte) []byte {
+			switch name {
+			case manifest.Entries[0].FileName:
+				return allProofs[1].Blob
+			case manifest.Entries[1].FileName:
+				return allProofs[0].Blob
+			}
+
+			return content
+		},
+	)
+	_, err = ReadBundle(bytes.NewReader(swapped))
+	require.ErrorIs(t, err, ErrInvalidBundle)
+}
+
+// rewriteBundle re-creates the given proof bundle, passing the content of
+// every file through the given function. Files for which the function returns
+// nil are dropped from the bundle.
+func rewriteBundle(t *testing.T, bundle []byte,
+	rewrite func(string, []byte) []byte) []byte {
+
+	var (
+		result bytes.Buffer
+		tr     = tar.NewReader(bytes.NewReader(bundle))
+		tw     = tar.NewWriter(&result)
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		content = rewrite(header.Name, content)
+		if content == nil {
+			continue
+		}
+		header.Size = int64(len(content))
+
+		require.NoError(t, tw.WriteHeader(header))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	return result.Bytes()
+}
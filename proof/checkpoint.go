@@ -0,0 +1,219 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/vm"
+)
+
+var (
+	// ErrUntrustedCheckpoint is returned when a proof file that starts at
+	// a checkpoint is verified, but the checkpoint root isn't known to be
+	// trusted.
+	ErrUntrustedCheckpoint = errors.New("proof file checkpoint not trusted")
+
+	// ErrCheckpointMismatch is returned when a proof file is verified
+	// against a checkpoint root that doesn't match the checkpoint of the
+	// file.
+	ErrCheckpointMismatch = errors.New("proof file checkpoint mismatch")
+
+	// ErrNoCheckpoint is returned when a checkpoint related operation is
+	// attempted on a proof file that starts at the asset's genesis.
+	ErrNoCheckpoint = errors.New("proof file has no checkpoint")
+)
+
+// Checkpoint is a snapshot of an asset's provenance at a given state
+// transition. A proof file that carries a checkpoint only contains the state
+// transitions after it, the pruned history is represented by the checkpoint
+// root alone. Because the root is the chained checksum of the last pruned
+// proof, it commits to the full pruned history.
+type Checkpoint struct {
+	// NumPruned is the total number of state transitions that were pruned
+	// from the proof file, including the checkpoint proof itself.
+	NumPruned uint64
+
+	// PrevHash is the chained checksum of the proof that preceded the
+	// checkpoint proof, or the zero hash if the checkpoint proof is the
+	// genesis proof.
+	PrevHash [sha256.Size]byte
+
+	// proofBytes is the encoded last pruned proof. We retain it as it
+	// describes the state of the asset the remaining proofs in the file
+	// build upon.
+	proofBytes []byte
+}
+
+// Root returns the checkpoint root, which is the chained checksum of the last
+// pruned proof: SHA256(prev_hash || proof_bytes). A party that attests to
+// this root attests to the full pruned history of the asset.
+func (c *Checkpoint) Root() [sha256.Size]byte {
+	return hashProof(c.proofBytes, c.PrevHash)
+}
+
+// Encode encodes the checkpoint into `w`.
+func (c *Checkpoint) Encode(w io.Writer) error {
+	var tlvBuf [8]byte
+	return encodeCheckpoint(w, c, &tlvBuf)
+}
+
+// Decode decodes a checkpoint from `r`.
+func (c *Checkpoint) Decode(r io.Reader) error {
+	var tlvBuf [8]byte
+	checkpoint, err := decodeCheckpoint(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+
+	*c = *checkpoint
+	return nil
+}
+
+// Proof decodes and returns the last pruned proof of the checkpoint.
+func (c *Checkpoint) Proof() (*Proof, error) {
+	var p Proof
+	if err := p.Decode(bytes.NewReader(c.proofBytes)); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoint proof: %w",
+			err)
+	}
+
+	return &p, nil
+}
+
+// Snapshot returns the asset snapshot of the checkpoint, which is the state
+// the first remaining proof of the file builds upon.
+//
+// NOTE: The state transition of the checkpoint proof is NOT verified, as the
+// checkpoint root needs to be trusted by the caller in the first place.
+func (c *Checkpoint) Snapshot() (*AssetSnapshot, error) {
+	p, err := c.Proof()
+	if err != nil {
+		return nil, err
+	}
+
+	// We still make sure the asset is actually committed to in the anchor
+	// output, as we need the Taro commitment for the snapshot anyway.
+	taroCommitment, err := p.verifyInclusionProof()
+	if err != nil {
+		return nil, fmt.Errorf("invalid checkpoint inclusion proof: %w",
+			err)
+	}
+
+	return &AssetSnapshot{
+		Asset: &p.Asset,
+		OutPoint: wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		},
+		AnchorBlockHash:   p.BlockHeader.BlockHash(),
+		AnchorBlockHeight: p.BlockHeight,
+		AnchorTx:          &p.AnchorTx,
+		OutputIndex:       p.InclusionProof.OutputIndex,
+		InternalKey:       p.InclusionProof.InternalKey,
+		ScriptRoot:        taroCommitment,
+		SplitAsset:        p.Asset.HasSplitCommitmentWitness(),
+	}, nil
+}
+
+// CheckpointArchive keeps track of the set of checkpoint roots that are
+// trusted, either because we created them ourselves after verifying the full
+// history of an asset, or because they were attested to by a party we trust.
+type CheckpointArchive interface {
+	// IsTrustedCheckpoint returns true if the given checkpoint root is
+	// known to be trusted.
+	IsTrustedCheckpoint(ctx context.Context,
+		root [sha256.Size]byte) (bool, error)
+
+	// AddTrustedCheckpoint marks the given checkpoint root of the asset
+	// identified by the locator as trusted. The root either belongs to a
+	// checkpoint we created ourselves or was imported from a party we
+	// trust, numPruned is the number of state transitions it replaces.
+	AddTrustedCheckpoint(ctx context.Context, loc Locator,
+		root [sha256.Size]byte, numPruned uint64) error
+}
+
+// Checkpoint returns the checkpoint the proof file starts at, or nil if the
+// file starts at the asset's genesis.
+func (f *File) Checkpoint() *Checkpoint {
+	return f.checkpoint
+}
+
+// Compact prunes all but the last numKeep proofs from the file and replaces
+// them with a checkpoint. The chained checksums of the remaining proofs stay
+// the same. If the file already starts at a checkpoint, the new checkpoint
+// will account for the previously pruned proofs as well.
+func (f *File) Compact(numKeep uint32) (*Checkpoint, error) {
+	switch {
+	case numKeep == 0:
+		return nil, fmt.Errorf("must keep at least one proof")
+
+	case int(numKeep) >= f.NumProofs():
+		return nil, fmt.Errorf("cannot compact file with %d proofs "+
+			"to %d proofs", f.NumProofs(), numKeep)
+	}
+
+	// The checkpoint proof is the last one we prune, the one preceding it
+	// (if any) determines the previous hash.
+	checkpointIdx := f.NumProofs() - int(numKeep) - 1
+	prevHash := f.prevHashAt(checkpointIdx)
+
+	var numPruned uint64
+	if f.checkpoint != nil {
+		numPruned = f.checkpoint.NumPruned
+	}
+
+	checkpoint := &Checkpoint{
+		NumPruned:  numPruned + uint64(checkpointIdx) + 1,
+		PrevHash:   prevHash,
+		proofBytes: f.proofs[checkpointIdx].proofBytes,
+	}
+
+	f.Version = V1
+	f.checkpoint = checkpoint
+	f.proofs = f.proofs[checkpointIdx+1:]
+
+	return checkpoint, nil
+}
+
+// prevHashAt returns the chained checksum that precedes the proof at the given
+// index, taking into account the checkpoint of the file if it has one.
+func (f *File) prevHashAt(index int) [sha256.Size]byte {
+	switch {
+	case index > 0:
+		return f.proofs[index-1].hash
+
+	case f.checkpoint != nil:
+		return f.checkpoint.Root()
+
+	default:
+		return [sha256.Size]byte{}
+	}
+}
+
+// VerifyFromCheckpoint verifies a proof file that starts at a checkpoint. The
+// passed root is the checkpoint root the caller trusts, which is used as the
+// starting point of the verification instead of the asset's genesis.
+func (f *File) VerifyFromCheckpoint(ctx context.Context,
+	root [sha256.Size]byte, headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*AssetSnapshot, error) {
+
+	if f.checkpoint == nil {
+		return nil, ErrNoCheckpoint
+	}
+
+	if f.checkpoint.Root() != root {
+		return nil, ErrCheckpointMismatch
+	}
+
+	prev, err := f.checkpoint.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.verifyFrom(ctx, prev, headerVerifier, vmOpts...)
+}
@@ -0,0 +1,225 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCheckpointArchive is a simple in-memory implementation of the
+// CheckpointArchive interface.
+type mockCheckpointArchive struct {
+	roots map[[sha256.Size]byte]struct{}
+}
+
+func newMockCheckpointArchive() *mockCheckpointArchive {
+	return &mockCheckpointArchive{
+		roots: make(map[[sha256.Size]byte]struct{}),
+	}
+}
+
+func (m *mockCheckpointArchive) IsTrustedCheckpoint(_ context.Context,
+	root [sha256.Size]byte) (bool, error) {
+
+	_, ok := m.roots[root]
+	return ok, nil
+}
+
+func (m *mockCheckpointArchive) AddTrustedCheckpoint(_ context.Context,
+	_ Locator, root [sha256.Size]byte, _ uint64) error {
+
+	m.roots[root] = struct{}{}
+	return nil
+}
+
+var _ CheckpointArchive = (*mockCheckpointArchive)(nil)
+
+// genTransferChain creates a proof file that starts with a freshly minted
+// asset which is then transferred to a new owner numTransfers times.
+func genTransferChain(t testing.TB, numTransfers int) Blob {
+	t.Helper()
+
+	amt := uint64(5000)
+	prevProof, senderPrivKey := genRandomGenesisWithProof(
+		t, asset.Normal, &amt,
+	)
+	blob, err := encodeAsProofFile(&prevProof)
+	require.NoError(t, err)
+
+	for i := 0; i < numTransfers; i++ {
+		recipientPrivKey := test.RandPrivKey(t)
+		newAsset := *prevProof.Asset.Copy()
+		newAsset.ScriptKey = asset.NewScriptKeyBIP0086(
+			test.PubToKeyDesc(recipientPrivKey.PubKey()),
+		)
+		internalKey := test.SchnorrPubKey(t, recipientPrivKey)
+
+		signAssetTransfer(t, &prevProof, &newAsset, senderPrivKey, nil)
+
+		assetCommitment, err := commitment.NewAssetCommitment(&newAsset)
+		require.NoError(t, err)
+		taroCommitment, err := commitment.NewTaroCommitment(
+			assetCommitment,
+		)
+		require.NoError(t, err)
+
+		tapscriptRoot := taroCommitment.TapscriptRoot(nil)
+		taprootKey := txscript.ComputeTaprootOutputKey(
+			internalKey, tapscriptRoot[:],
+		)
+		chainTx := &wire.MsgTx{
+			Version: 2,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{
+					Hash:  prevProof.AnchorTx.TxHash(),
+					Index: prevProof.InclusionProof.OutputIndex,
+				},
+			}},
+			TxOut: []*wire.TxOut{{
+				PkScript: test.ComputeTaprootScript(
+					t, taprootKey,
+				),
+				Value: 330,
+			}},
+		}
+
+		merkleTree := blockchain.BuildMerkleTreeStore(
+			[]*btcutil.Tx{btcutil.NewTx(chainTx)}, false,
+		)
+		merkleRoot := merkleTree[len(merkleTree)-1]
+		prevHash := prevProof.BlockHeader.BlockHash()
+		blockHeader := wire.NewBlockHeader(
+			0, &prevHash, merkleRoot, 0, 0,
+		)
+
+		var transitionProof *Proof
+		blob, transitionProof, err = AppendTransition(
+			blob, &TransitionParams{
+				BaseProofParams: BaseProofParams{
+					Block: &wire.MsgBlock{
+						Header: *blockHeader,
+						Transactions: []*wire.MsgTx{
+							chainTx,
+						},
+					},
+					Tx:          chainTx,
+					TxIndex:     0,
+					OutputIndex: 0,
+					InternalKey: internalKey,
+					TaroRoot:    taroCommitment,
+				},
+				NewAsset: &newAsset,
+			}, MockHeaderVerifier,
+		)
+		require.NoError(t, err)
+
+		prevProof = *transitionProof
+		senderPrivKey = recipientPrivKey
+	}
+
+	return blob
+}
+
+// TestFileCompaction tests that a proof file can be compacted into a
+// checkpoint and the remaining proofs, and that the compacted file can only be
+// verified if the checkpoint is trusted.
+func TestFileCompaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	blob := genTransferChain(t, 4)
+
+	var f File
+	require.NoError(t, f.Decode(bytes.NewReader(blob)))
+	require.Equal(t, 5, f.NumProofs())
+	require.Nil(t, f.Checkpoint())
+
+	fullSnapshot, err := f.Verify(ctx, MockHeaderVerifier)
+	require.NoError(t, err)
+
+	lastHash := f.proofs[f.NumProofs()-1].hash
+	expectedRoot := f.proofs[2].hash
+
+	// We can't prune all proofs, or keep more proofs than we have.
+	_, err = f.Compact(0)
+	require.Error(t, err)
+	_, err = f.Compact(5)
+	require.Error(t, err)
+
+	// Keep the last two proofs, which means the first three are pruned and
+	// the third one becomes the checkpoint proof.
+	checkpoint, err := f.Compact(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), checkpoint.NumPruned)
+	require.Equal(t, expectedRoot, checkpoint.Root())
+	require.Equal(t, 2, f.NumProofs())
+	require.Equal(t, V1, f.Version)
+
+	// The chained checksum of the last proof must not have changed.
+	require.Equal(t, lastHash, f.proofs[f.NumProofs()-1].hash)
+
+	// The checkpoint must survive an encode/decode round trip.
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+	var decoded File
+	require.NoError(t, decoded.Decode(bytes.NewReader(buf.Bytes())))
+	require.NotNil(t, decoded.Checkpoint())
+	require.Equal(t, checkpoint.Root(), decoded.Checkpoint().Root())
+	require.Equal(t, checkpoint.NumPruned, decoded.Checkpoint().NumPruned)
+	require.Equal(t, 2, decoded.NumProofs())
+
+	// A checkpointed file can't be verified on its own.
+	_, err = decoded.Verify(ctx, MockHeaderVerifier)
+	require.ErrorIs(t, err, ErrUntrustedCheckpoint)
+
+	// Verifying against the wrong root also fails.
+	_, err = decoded.VerifyFromCheckpoint(
+		ctx, [sha256.Size]byte{1}, MockHeaderVerifier,
+	)
+	require.ErrorIs(t, err, ErrCheckpointMismatch)
+
+	// But with the correct root, we arrive at the same final state.
+	snapshot, err := decoded.VerifyFromCheckpoint(
+		ctx, checkpoint.Root(), MockHeaderVerifier,
+	)
+	require.NoError(t, err)
+	require.Equal(t, fullSnapshot.OutPoint, snapshot.OutPoint)
+	require.Equal(t, fullSnapshot.Asset, snapshot.Asset)
+
+	// The base verifier only accepts the file once the checkpoint is
+	// trusted.
+	checkpoints := newMockCheckpointArchive()
+	verifier := &BaseVerifier{
+		Checkpoints:    checkpoints,
+		HeaderVerifier: MockHeaderVerifier,
+	}
+	_, err = verifier.Verify(ctx, bytes.NewReader(buf.Bytes()))
+	require.ErrorIs(t, err, ErrUntrustedCheckpoint)
+
+	require.NoError(t, checkpoints.AddTrustedCheckpoint(
+		ctx, Locator{}, checkpoint.Root(), checkpoint.NumPruned,
+	))
+	_, err = verifier.Verify(ctx, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	// Compacting a second time accounts for the previously pruned proofs.
+	checkpoint2, err := decoded.Compact(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), checkpoint2.NumPruned)
+	require.Equal(t, lastHash, decoded.proofs[0].hash)
+
+	_, err = decoded.VerifyFromCheckpoint(
+		ctx, checkpoint2.Root(), MockHeaderVerifier,
+	)
+	require.NoError(t, err)
+}
@@ -355,14 +355,22 @@ func (h *HashMailCourier) ReceiveProof(ctx context.Context, addr address.Taro,
 		return nil, err
 	}
 
-	// Finally, we'll return the proof state back to the caller.
-	assetID := addr.ID()
-	return &AnnotatedProof{
-		Locator: Locator{
+	// Finally, we'll return the proof state back to the caller. The asset
+	// ID of an asset sent to a family address is only known from the proof
+	// itself, so we leave it to the archive to fill in the locator from
+	// the verified proof when importing it.
+	var locator Locator
+	if !addr.IsFamilyAddr() {
+		assetID := addr.ID()
+		locator = Locator{
 			AssetID:   &assetID,
 			ScriptKey: addr.ScriptKey,
-		},
-		Blob: Blob(proof),
+		}
+	}
+
+	return &AnnotatedProof{
+		Locator: locator,
+		Blob:    Blob(proof),
 	}, nil
 }
 
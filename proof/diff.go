@@ -0,0 +1,209 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	// ErrUnknownProofHash is returned when a proof file suffix is requested
+	// or merged relative to a chained checksum that isn't part of the
+	// proof file.
+	ErrUnknownProofHash = errors.New("proof hash not found in file")
+
+	// ErrSuffixConflict is returned when a proof file suffix is merged
+	// into a file that already contains different proofs after the
+	// suffix's starting point.
+	ErrSuffixConflict = errors.New("proof file suffix conflicts with file")
+
+	// ErrSuffixDiscontinuity is returned when the proofs of a suffix don't
+	// spend the on-chain outpoint of the proof preceding them.
+	ErrSuffixDiscontinuity = errors.New("proof file suffix does not " +
+		"continue the asset's chain of outpoints")
+)
+
+// Suffix is the tail of a proof file, starting right after the proof with the
+// chained checksum PrevHash. A party that already has the first part of a
+// proof file only needs to fetch the suffix to bring its file up to date.
+type Suffix struct {
+	// PrevHash is the chained checksum of the proof that precedes the
+	// first proof of the suffix.
+	PrevHash [sha256.Size]byte
+
+	// proofs are the proofs of the suffix, chained to PrevHash.
+	proofs []*hashedProof
+}
+
+// NumProofs returns the number of proofs contained in the suffix.
+func (s *Suffix) NumProofs() int {
+	return len(s.proofs)
+}
+
+// LastHash returns the chained checksum of the last proof of the suffix. If
+// the suffix is empty, this is PrevHash.
+func (s *Suffix) LastHash() [sha256.Size]byte {
+	if len(s.proofs) == 0 {
+		return s.PrevHash
+	}
+
+	return s.proofs[len(s.proofs)-1].hash
+}
+
+// Encode encodes the suffix into `w`.
+func (s *Suffix) Encode(w io.Writer) error {
+	if _, err := w.Write(s.PrevHash[:]); err != nil {
+		return err
+	}
+
+	var tlvBuf [8]byte
+	return encodeHashedProofs(w, s.proofs, &tlvBuf)
+}
+
+// Decode decodes a suffix from `r`, making sure its chain of checksums is
+// valid.
+func (s *Suffix) Decode(r io.Reader) error {
+	if _, err := io.ReadFull(r, s.PrevHash[:]); err != nil {
+		return err
+	}
+
+	var tlvBuf [8]byte
+	proofs, err := decodeHashedProofs(r, s.PrevHash, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	s.proofs = proofs
+
+	return nil
+}
+
+// LastHash returns the chained checksum of the last proof in the file. This is
+// the value a party that holds the file passes to obtain a suffix of a more
+// recent version of the file.
+func (f *File) LastHash() ([sha256.Size]byte, error) {
+	if f.IsEmpty() {
+		return [sha256.Size]byte{}, ErrNoProofAvailable
+	}
+
+	return f.proofs[len(f.proofs)-1].hash, nil
+}
+
+// startIndexOf returns the index of the first proof that follows the proof
+// with the given chained checksum. The zero hash (or the checkpoint root of a
+// checkpointed file) refers to the very beginning of the file.
+func (f *File) startIndexOf(prevHash [sha256.Size]byte) (int, error) {
+	if f.prevHashAt(0) == prevHash {
+		return 0, nil
+	}
+
+	for idx := range f.proofs {
+		if f.proofs[idx].hash == prevHash {
+			return idx + 1, nil
+		}
+	}
+
+	return 0, ErrUnknownProofHash
+}
+
+// Suffix returns all proofs of the file that follow the proof with the given
+// chained checksum. If the hash belongs to the last proof of the file, the
+// returned suffix is empty.
+func (f *File) Suffix(sinceHash [sha256.Size]byte) (*Suffix, error) {
+	startIdx, err := f.startIndexOf(sinceHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]*hashedProof, len(f.proofs)-startIdx)
+	copy(proofs, f.proofs[startIdx:])
+
+	return &Suffix{
+		PrevHash: sinceHash,
+		proofs:   proofs,
+	}, nil
+}
+
+// MergeSuffix extends the file with the given suffix. The suffix must start at
+// a proof that is part of the file. Any proofs the file already has after that
+// point must match the beginning of the suffix, only the remaining proofs are
+// appended. Each appended proof must spend the anchor outpoint of the proof
+// preceding it.
+//
+// NOTE: The appended state transitions are NOT verified, which is up to the
+// caller once the file is complete.
+func (f *File) MergeSuffix(s *Suffix) error {
+	startIdx, err := f.startIndexOf(s.PrevHash)
+	if err != nil {
+		return err
+	}
+
+	// The proofs we already have after the starting point need to be
+	// identical to the first proofs of the suffix. As both chains start at
+	// the same hash, comparing the chained checksums is enough.
+	numOverlap := len(f.proofs) - startIdx
+	if numOverlap > len(s.proofs) {
+		numOverlap = len(s.proofs)
+	}
+	for i := 0; i < numOverlap; i++ {
+		if f.proofs[startIdx+i].hash != s.proofs[i].hash {
+			return fmt.Errorf("%w: proof %d differs", ErrSuffixConflict,
+				startIdx+i)
+		}
+	}
+
+	newProofs := s.proofs[numOverlap:]
+	if len(newProofs) == 0 {
+		return nil
+	}
+
+	// Before we append anything, we make sure the new proofs actually
+	// continue the asset's chain of outpoints.
+	var (
+		prevOutPoint *wire.OutPoint
+		lastProof    *Proof
+	)
+	switch {
+	case !f.IsEmpty():
+		lastProof, err = f.LastProof()
+
+	case f.checkpoint != nil:
+		lastProof, err = f.checkpoint.Proof()
+	}
+	if err != nil {
+		return err
+	}
+	if lastProof != nil {
+		prevOutPoint = &wire.OutPoint{
+			Hash:  lastProof.AnchorTx.TxHash(),
+			Index: lastProof.InclusionProof.OutputIndex,
+		}
+	}
+	for idx := range newProofs {
+		var (
+			p      Proof
+			reader = bytes.NewReader(newProofs[idx].proofBytes)
+		)
+		if err := p.Decode(reader); err != nil {
+			return fmt.Errorf("error decoding suffix proof: %w", err)
+		}
+
+		if prevOutPoint != nil && p.PrevOut != *prevOutPoint {
+			return fmt.Errorf("%w: proof %d spends %v instead of %v",
+				ErrSuffixDiscontinuity, len(f.proofs)+idx,
+				p.PrevOut, prevOutPoint)
+		}
+
+		prevOutPoint = &wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		}
+	}
+
+	f.proofs = append(f.proofs, newProofs...)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileSuffix tests that the suffix of a proof file can be computed
+// relative to a known proof hash and merged back into an older version of the
+// same file.
+func TestFileSuffix(t *testing.T) {
+	t.Parallel()
+
+	blob := genTransferChain(t, 3)
+
+	var fullFile File
+	require.NoError(t, fullFile.Decode(bytes.NewReader(blob)))
+	require.Equal(t, 4, fullFile.NumProofs())
+
+	// The receiver only has the first two proofs of the file.
+	oldFile := &File{
+		Version: fullFile.Version,
+		proofs:  fullFile.proofs[:2],
+	}
+	sinceHash, err := oldFile.LastHash()
+	require.NoError(t, err)
+
+	// An unknown hash can't be used as a starting point.
+	_, err = fullFile.Suffix([sha256.Size]byte{1})
+	require.ErrorIs(t, err, ErrUnknownProofHash)
+
+	suffix, err := fullFile.Suffix(sinceHash)
+	require.NoError(t, err)
+	require.Equal(t, 2, suffix.NumProofs())
+
+	fullHash, err := fullFile.LastHash()
+	require.NoError(t, err)
+	require.Equal(t, fullHash, suffix.LastHash())
+
+	// The suffix must survive an encode/decode round trip.
+	var buf bytes.Buffer
+	require.NoError(t, suffix.Encode(&buf))
+	var decoded Suffix
+	require.NoError(t, decoded.Decode(bytes.NewReader(buf.Bytes())))
+	require.Equal(t, suffix, &decoded)
+
+	// A suffix with a broken hash chain is rejected when decoding.
+	corrupted := append([]byte{}, buf.Bytes()...)
+	corrupted[0] ^= 1
+	require.ErrorIs(
+		t, new(Suffix).Decode(bytes.NewReader(corrupted)),
+		ErrInvalidChecksum,
+	)
+
+	// Merging the suffix brings the old file up to date, and the result
+	// is a fully valid proof file again.
+	require.NoError(t, oldFile.MergeSuffix(&decoded))
+	require.Equal(t, 4, oldFile.NumProofs())
+	oldHash, err := oldFile.LastHash()
+	require.NoError(t, err)
+	require.Equal(t, fullHash, oldHash)
+	_, err = oldFile.Verify(context.Background(), MockHeaderVerifier)
+	require.NoError(t, err)
+
+	// Merging the same suffix again is a no-op.
+	require.NoError(t, oldFile.MergeSuffix(&decoded))
+	require.Equal(t, 4, oldFile.NumProofs())
+
+	// A suffix that overlaps with what we already have only appends the
+	// missing proofs.
+	overlapFile := &File{
+		Version: fullFile.Version,
+		proofs:  fullFile.proofs[:3],
+	}
+	fromStart, err := fullFile.Suffix([sha256.Size]byte{})
+	require.NoError(t, err)
+	require.Equal(t, 4, fromStart.NumProofs())
+	require.NoError(t, overlapFile.MergeSuffix(fromStart))
+	require.Equal(t, 4, overlapFile.NumProofs())
+
+	// A suffix of an unrelated file can't be merged.
+	otherBlob := genTransferChain(t, 1)
+	var otherFile File
+	require.NoError(t, otherFile.Decode(bytes.NewReader(otherBlob)))
+	otherSuffix, err := otherFile.Suffix([sha256.Size]byte{})
+	require.NoError(t, err)
+
+	conflictFile := &File{
+		Version: fullFile.Version,
+		proofs:  fullFile.proofs[:1],
+	}
+	require.ErrorIs(
+		t, conflictFile.MergeSuffix(otherSuffix), ErrSuffixConflict,
+	)
+
+	// A suffix whose chain of checksums continues the file but which
+	// spends an unrelated outpoint is rejected as well.
+	otherTail := &Suffix{
+		PrevHash: sinceHash,
+	}
+	prevHash := sinceHash
+	for _, p := range otherFile.proofs[1:] {
+		hash := hashProof(p.proofBytes, prevHash)
+		otherTail.proofs = append(otherTail.proofs, &hashedProof{
+			proofBytes: p.proofBytes,
+			hash:       hash,
+		})
+		prevHash = hash
+	}
+	discontinuousFile := &File{
+		Version: fullFile.Version,
+		proofs:  fullFile.proofs[:2],
+	}
+	require.ErrorIs(
+		t, discontinuousFile.MergeSuffix(otherTail),
+		ErrSuffixDiscontinuity,
+	)
+	require.Equal(t, 2, discontinuousFile.NumProofs())
+}
@@ -32,6 +32,10 @@ type Version uint32
 const (
 	// V0 is the first version of the proof file.
 	V0 Version = 0
+
+	// V1 is the version of a proof file that starts at a checkpoint
+	// instead of the asset's genesis.
+	V1 Version = 1
 )
 
 // hashedProof is a struct that contains an encoded proof and its chained
@@ -50,8 +54,12 @@ type File struct {
 	// Version is the version of the proof file.
 	Version Version
 
+	// checkpoint is the optional checkpoint the proof file starts at. If
+	// this is nil, the file starts at the asset's genesis.
+	checkpoint *Checkpoint
+
 	// proofs are the proofs contained within the proof file starting from
-	// the genesis proof.
+	// the genesis proof, or the first proof after the checkpoint.
 	proofs []*hashedProof
 }
 
@@ -103,36 +111,21 @@ func (f *File) Encode(w io.Writer) error {
 	}
 
 	var tlvBuf [8]byte
-	if err := tlv.WriteVarInt(w, uint64(len(f.proofs)), &tlvBuf); err != nil {
-		return err
-	}
-	for _, proof := range f.proofs {
-		proof := proof
 
-		// To the file we write the proof, followed by its hash, which
-		// is SHA256(prev_hash || proof). That way if we serially read
-		// the whole file using the zero hash as the first prev_hash,
-		// then we can make sure we have no data corruption if the
-		// serially built hash is equal to the last proof's hash. On the
-		// other hand, if we want to append a proof to a file, we just
-		// need to read the last proof, use its hash as the prev_hash
-		// for the one to append, and we're done.
-		err := tlv.WriteVarInt(w, uint64(len(proof.proofBytes)), &tlvBuf)
-		if err != nil {
-			return err
-		}
-		if _, err := w.Write(proof.proofBytes); err != nil {
-			return err
-		}
+	// A checkpointed file carries the checkpoint right after the version,
+	// the first proof following it then chains to the checkpoint root.
+	switch {
+	case f.checkpoint != nil && f.Version != V1:
+		return fmt.Errorf("checkpoint not supported in proof file "+
+			"version %d", f.Version)
 
-		// The hash is not part of the proof's TLV stream, so we didn't
-		// count it above.
-		if _, err := w.Write(proof.hash[:]); err != nil {
+	case f.checkpoint != nil:
+		if err := encodeCheckpoint(w, f.checkpoint, &tlvBuf); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return encodeHashedProofs(w, f.proofs, &tlvBuf)
 }
 
 // Decode decodes a proof file from `r`.
@@ -143,51 +136,63 @@ func (f *File) Decode(r io.Reader) error {
 	}
 	f.Version = Version(version)
 
-	var tlvBuf [8]byte
-	numProofs, err := tlv.ReadVarInt(r, &tlvBuf)
-	if err != nil {
-		return err
-	}
+	var (
+		tlvBuf   [8]byte
+		prevHash [sha256.Size]byte
+	)
 
-	var prevHash, currentHash, proofHash [sha256.Size]byte
-	f.proofs = make([]*hashedProof, numProofs)
-	for i := uint64(0); i < numProofs; i++ {
-		// We need to find out how many bytes we expect for the proof,
-		// so we can limit the TLV reader.
-		numProofBytes, err := tlv.ReadVarInt(r, &tlvBuf)
+	f.checkpoint = nil
+	if f.Version == V1 {
+		checkpoint, err := decodeCheckpoint(r, &tlvBuf)
 		if err != nil {
 			return err
 		}
 
-		// Read all bytes that belong to the proof. We don't decode the
-		// proof itself as we usually only need the last proof anyway.
-		proofBytes := make([]byte, numProofBytes)
-		if _, err := io.ReadFull(r, proofBytes); err != nil {
-			return err
-		}
+		f.checkpoint = checkpoint
+		prevHash = checkpoint.Root()
+	}
 
-		// We now read the proof's hash in the file which reflects the
-		// current checksum.
-		if _, err := io.ReadFull(r, proofHash[:]); err != nil {
-			return err
-		}
+	proofs, err := decodeHashedProofs(r, prevHash, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	f.proofs = proofs
 
-		// Now that we have read both the proof and the expected
-		// checksum of it, we calculate our own checksum and verify they
-		// match.
-		currentHash = hashProof(proofBytes, prevHash)
-		if proofHash != currentHash {
-			return ErrInvalidChecksum
-		}
+	return nil
+}
 
-		f.proofs[i] = &hashedProof{
-			proofBytes: proofBytes,
-			hash:       currentHash,
+// NewFileFromRawProofs returns a new proof file given a version, the optional
+// checkpoint it starts at and a series of already encoded state transition
+// proofs.
+func NewFileFromRawProofs(v Version, checkpoint *Checkpoint,
+	rawProofs ...[]byte) *File {
+
+	f := &File{
+		Version:    v,
+		checkpoint: checkpoint,
+		proofs:     make([]*hashedProof, len(rawProofs)),
+	}
+
+	prevHash := f.prevHashAt(0)
+	for idx := range rawProofs {
+		f.proofs[idx] = &hashedProof{
+			proofBytes: rawProofs[idx],
+			hash:       hashProof(rawProofs[idx], prevHash),
 		}
-		prevHash = currentHash
+		prevHash = f.proofs[idx].hash
 	}
 
-	return nil
+	return f
+}
+
+// RawProofs returns the encoded proofs contained in this file, in order.
+func (f *File) RawProofs() [][]byte {
+	rawProofs := make([][]byte, len(f.proofs))
+	for idx := range f.proofs {
+		rawProofs[idx] = f.proofs[idx].proofBytes
+	}
+
+	return rawProofs
 }
 
 // IsEmpty returns true if the file does not contain any proofs.
@@ -234,10 +239,7 @@ func (f *File) LastProof() (*Proof, error) {
 
 // AppendProof appends a proof to the file and calculates its chained hash.
 func (f *File) AppendProof(proof Proof) error {
-	var prevHash [sha256.Size]byte
-	if !f.IsEmpty() {
-		prevHash = f.proofs[len(f.proofs)-1].hash
-	}
+	prevHash := f.prevHashAt(len(f.proofs))
 
 	proofBytes, err := encodeProof(&proof)
 	if err != nil {
@@ -259,13 +261,10 @@ func (f *File) ReplaceLastProof(proof Proof) error {
 		return fmt.Errorf("file is empty")
 	}
 
-	var prevHash [sha256.Size]byte
-	if f.NumProofs() > 1 {
-		// We want the prev_hash of the last proof, so we need to go
-		// back 2. If we're replacing the single proof of the file, then
-		// the prev_hash is the zero hash.
-		prevHash = f.proofs[len(f.proofs)-2].hash
-	}
+	// We want the prev_hash of the last proof. If we're replacing the
+	// single proof of the file, then the prev_hash is either the zero hash
+	// or the checkpoint root.
+	prevHash := f.prevHashAt(len(f.proofs) - 1)
 
 	proofBytes, err := encodeProof(&proof)
 	if err != nil {
@@ -280,6 +279,194 @@ func (f *File) ReplaceLastProof(proof Proof) error {
 	return nil
 }
 
+// UpdateAnchorBlock updates the block header, block height and merkle proof
+// of all proofs in the file that are anchored in the transaction of the
+// given params. This is used to re-anchor a proof file after its anchor
+// transaction was re-confirmed in a different block because of a chain
+// re-organization. The chained hashes of all proofs following the first
+// updated proof are re-computed. The number of updated proofs is returned.
+func (f *File) UpdateAnchorBlock(params *BaseProofParams) (int, error) {
+	if params.Block == nil || params.Tx == nil {
+		return 0, fmt.Errorf("missing block or TX to update proofs")
+	}
+
+	var (
+		txHash    = params.Tx.TxHash()
+		numUpdate int
+		firstIdx  = -1
+	)
+	for idx := range f.proofs {
+		proof, err := f.ProofAt(uint32(idx))
+		if err != nil {
+			return 0, err
+		}
+
+		if proof.AnchorTx.TxHash() != txHash {
+			continue
+		}
+
+		if err := proof.UpdateTransitionProof(params); err != nil {
+			return 0, err
+		}
+
+		proofBytes, err := encodeProof(proof)
+		if err != nil {
+			return 0, err
+		}
+
+		f.proofs[idx].proofBytes = proofBytes
+		numUpdate++
+
+		if firstIdx == -1 {
+			firstIdx = idx
+		}
+	}
+
+	// If nothing changed, the hash chain is still intact.
+	if firstIdx == -1 {
+		return 0, nil
+	}
+
+	// Otherwise we need to re-compute the chained hashes, starting at the
+	// first proof we modified.
+	for idx := firstIdx; idx < len(f.proofs); idx++ {
+		proof := f.proofs[idx]
+		proof.hash = hashProof(proof.proofBytes, f.prevHashAt(idx))
+	}
+
+	return numUpdate, nil
+}
+
+// encodeHashedProofs encodes the given list of chained proofs into `w`.
+func encodeHashedProofs(w io.Writer, proofs []*hashedProof,
+	tlvBuf *[8]byte) error {
+
+	if err := tlv.WriteVarInt(w, uint64(len(proofs)), tlvBuf); err != nil {
+		return err
+	}
+	for _, proof := range proofs {
+		proof := proof
+
+		// To the file we write the proof, followed by its hash, which
+		// is SHA256(prev_hash || proof). That way if we serially read
+		// the whole file using the zero hash as the first prev_hash,
+		// then we can make sure we have no data corruption if the
+		// serially built hash is equal to the last proof's hash. On the
+		// other hand, if we want to append a proof to a file, we just
+		// need to read the last proof, use its hash as the prev_hash
+		// for the one to append, and we're done.
+		err := tlv.WriteVarInt(w, uint64(len(proof.proofBytes)), tlvBuf)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(proof.proofBytes); err != nil {
+			return err
+		}
+
+		// The hash is not part of the proof's TLV stream, so we didn't
+		// count it above.
+		if _, err := w.Write(proof.hash[:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeHashedProofs decodes a list of chained proofs from `r`, making sure
+// the chain of checksums starting at the given prevHash is valid.
+func decodeHashedProofs(r io.Reader, prevHash [sha256.Size]byte,
+	tlvBuf *[8]byte) ([]*hashedProof, error) {
+
+	numProofs, err := tlv.ReadVarInt(r, tlvBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentHash, proofHash [sha256.Size]byte
+	proofs := make([]*hashedProof, numProofs)
+	for i := uint64(0); i < numProofs; i++ {
+		// We need to find out how many bytes we expect for the proof,
+		// so we can limit the TLV reader.
+		numProofBytes, err := tlv.ReadVarInt(r, tlvBuf)
+		if err != nil {
+			return nil, err
+		}
+
+		// Read all bytes that belong to the proof. We don't decode the
+		// proof itself as we usually only need the last proof anyway.
+		proofBytes := make([]byte, numProofBytes)
+		if _, err := io.ReadFull(r, proofBytes); err != nil {
+			return nil, err
+		}
+
+		// We now read the proof's hash in the file which reflects the
+		// current checksum.
+		if _, err := io.ReadFull(r, proofHash[:]); err != nil {
+			return nil, err
+		}
+
+		// Now that we have read both the proof and the expected
+		// checksum of it, we calculate our own checksum and verify they
+		// match.
+		currentHash = hashProof(proofBytes, prevHash)
+		if proofHash != currentHash {
+			return nil, ErrInvalidChecksum
+		}
+
+		proofs[i] = &hashedProof{
+			proofBytes: proofBytes,
+			hash:       currentHash,
+		}
+		prevHash = currentHash
+	}
+
+	return proofs, nil
+}
+
+// encodeCheckpoint encodes the given checkpoint into `w`.
+func encodeCheckpoint(w io.Writer, c *Checkpoint, tlvBuf *[8]byte) error {
+	if err := tlv.WriteVarInt(w, c.NumPruned, tlvBuf); err != nil {
+		return err
+	}
+	if _, err := w.Write(c.PrevHash[:]); err != nil {
+		return err
+	}
+
+	err := tlv.WriteVarInt(w, uint64(len(c.proofBytes)), tlvBuf)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(c.proofBytes)
+	return err
+}
+
+// decodeCheckpoint decodes a checkpoint from `r`.
+func decodeCheckpoint(r io.Reader, tlvBuf *[8]byte) (*Checkpoint, error) {
+	var (
+		c   Checkpoint
+		err error
+	)
+	c.NumPruned, err = tlv.ReadVarInt(r, tlvBuf)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, c.PrevHash[:]); err != nil {
+		return nil, err
+	}
+
+	numProofBytes, err := tlv.ReadVarInt(r, tlvBuf)
+	if err != nil {
+		return nil, err
+	}
+	c.proofBytes = make([]byte, numProofBytes)
+	if _, err := io.ReadFull(r, c.proofBytes); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
 // encodeProof encodes the given proof and returns its raw bytes.
 func encodeProof(proof *Proof) ([]byte, error) {
 	var buf bytes.Buffer
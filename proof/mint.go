@@ -26,6 +26,9 @@ type BaseProofParams struct {
 	// specified assets.
 	Block *wire.MsgBlock
 
+	// BlockHeight is the height of the block above.
+	BlockHeight uint32
+
 	// Tx is the transaction that created the assets.
 	Tx *wire.MsgTx
 
@@ -78,8 +81,11 @@ func encodeAsProofFile(proof *Proof) (Blob, error) {
 
 // NewMintingBlobs takes a set of minting parameters, and produces a series of
 // serialized proof files, which proves the creation/existence of each of the
-// assets within the batch.
-func NewMintingBlobs(params *MintParams) (AssetBlobs, error) {
+// assets within the batch. The passed header verifier is used to validate the
+// generated proofs.
+func NewMintingBlobs(params *MintParams,
+	headerVerifier HeaderVerifier) (AssetBlobs, error) {
+
 	base, err := baseProof(&params.BaseProofParams, params.GenesisPoint)
 	if err != nil {
 		return nil, err
@@ -97,7 +103,7 @@ func NewMintingBlobs(params *MintParams) (AssetBlobs, error) {
 
 		// Before we encode the proof file, we'll verify that we
 		// generate a valid proof.
-		if _, err := proof.Verify(ctx, nil); err != nil {
+		if _, err := proof.Verify(ctx, nil, headerVerifier); err != nil {
 			return nil, fmt.Errorf("invalid proof file generated: "+
 				"%w", err)
 		}
@@ -146,6 +152,7 @@ func coreProof(params *BaseProofParams) (*Proof, error) {
 
 	return &Proof{
 		BlockHeader:   params.Block.Header,
+		BlockHeight:   params.BlockHeight,
 		AnchorTx:      *params.Tx,
 		TxMerkleProof: *merkleProof,
 	}, nil
@@ -94,6 +94,6 @@ func TestNewMintingBlobs(t *testing.T) {
 			}},
 		},
 		GenesisPoint: genesisTx.TxIn[0].PreviousOutPoint,
-	})
+	}, MockHeaderVerifier)
 	require.NoError(t, err)
 }
@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/internal/test"
 )
@@ -37,3 +38,11 @@ func (m *MockVerifier) Verify(_ context.Context, _ io.Reader) (*AssetSnapshot,
 		},
 	}, nil
 }
+
+// MockHeaderVerifier is a HeaderVerifier that accepts any block header at the
+// claimed height.
+func MockHeaderVerifier(_ context.Context, _ wire.BlockHeader, height uint32,
+	_ *wire.MsgTx) (uint32, error) {
+
+	return height, nil
+}
@@ -25,6 +25,14 @@ var (
 	// ErrMissingSplitRootProof is an error returned upon noticing an
 	// inclusion proof for a split root asset is missing.
 	ErrMissingSplitRootProof = errors.New("missing split root proof")
+
+	// ErrNoHeaderVerifier is returned if a proof is verified without a
+	// way to check its block header against the chain.
+	ErrNoHeaderVerifier = errors.New("no block header verifier")
+
+	// ErrInvalidBlockHeader is returned if the block header of a proof is
+	// not part of the main chain at the height the proof claims.
+	ErrInvalidBlockHeader = errors.New("invalid block header")
 )
 
 // Proof encodes all of the data necessary to prove a valid state transition for
@@ -66,11 +74,17 @@ type Proof struct {
 	// AdditionalInputs is a nested full proof for any additional inputs
 	// found within the resulting asset.
 	AdditionalInputs []File
+
+	// BlockHeight is the height of the block identified by BlockHeader. It
+	// is used to enforce the lock times of the asset's inputs, so it is
+	// checked against the main chain along with the header when the proof
+	// is verified.
+	BlockHeight uint32
 }
 
 // EncodeRecords returns the set of known TLV records to encode a Proof.
 func (p *Proof) EncodeRecords() []tlv.Record {
-	records := make([]tlv.Record, 0, 9)
+	records := make([]tlv.Record, 0, 10)
 	records = append(records, PrevOutRecord(&p.PrevOut))
 	records = append(records, BlockHeaderRecord(&p.BlockHeader))
 	records = append(records, AnchorTxRecord(&p.AnchorTx))
@@ -92,6 +106,9 @@ func (p *Proof) EncodeRecords() []tlv.Record {
 			&p.AdditionalInputs,
 		))
 	}
+	if p.BlockHeight > 0 {
+		records = append(records, BlockHeightRecord(&p.BlockHeight))
+	}
 	return records
 }
 
@@ -107,6 +124,7 @@ func (p *Proof) DecodeRecords() []tlv.Record {
 		ExclusionProofsRecord(&p.ExclusionProofs),
 		SplitRootProofRecord(&p.SplitRootProof),
 		AdditionalInputsRecord(&p.AdditionalInputs),
+		BlockHeightRecord(&p.BlockHeight),
 	}
 }
 
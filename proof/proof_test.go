@@ -3,6 +3,7 @@ package proof
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/btcsuite/btcd/blockchain"
@@ -10,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taro/asset"
@@ -47,6 +49,7 @@ func assertEqualProof(t *testing.T, expected, actual *Proof) {
 	t.Helper()
 	require.Equal(t, expected.PrevOut, actual.PrevOut)
 	require.Equal(t, expected.BlockHeader, actual.BlockHeader)
+	require.Equal(t, expected.BlockHeight, actual.BlockHeight)
 	require.Equal(t, expected.AnchorTx, actual.AnchorTx)
 	require.Equal(t, expected.TxMerkleProof, actual.TxMerkleProof)
 	require.Equal(t, expected.Asset, actual.Asset)
@@ -189,6 +192,7 @@ func TestProofEncoding(t *testing.T) {
 			},
 		},
 		AdditionalInputs: []File{},
+		BlockHeight:      1337,
 	}
 	file, err := NewFile(V0, proof, proof)
 	require.NoError(t, err)
@@ -278,10 +282,52 @@ func TestGenesisProofVerification(t *testing.T) {
 	t.Parallel()
 
 	genesisProof, _ := genRandomGenesisWithProof(t, asset.Collectible, nil)
-	_, err := genesisProof.Verify(context.Background(), nil)
+	_, err := genesisProof.Verify(
+		context.Background(), nil, MockHeaderVerifier,
+	)
 	require.NoError(t, err)
 }
 
+// TestProofWithoutBlockHeight tests that a proof that was encoded before the
+// block height was recorded can still be verified, with the height being
+// looked up by the header verifier.
+func TestProofWithoutBlockHeight(t *testing.T) {
+	t.Parallel()
+
+	genesisProof, _ := genRandomGenesisWithProof(t, asset.Collectible, nil)
+
+	// A proof without a block height is encoded without the height record,
+	// just like the proofs created before the record existed.
+	var withoutHeight, withHeight bytes.Buffer
+	require.NoError(t, genesisProof.Encode(&withoutHeight))
+
+	heightProof := genesisProof
+	heightProof.BlockHeight = 777
+	require.NoError(t, heightProof.Encode(&withHeight))
+	require.Less(t, withoutHeight.Len(), withHeight.Len())
+
+	var decoded Proof
+	require.NoError(t, decoded.Decode(&withoutHeight))
+	require.Zero(t, decoded.BlockHeight)
+
+	// The header verifier only looks up the height if it's unknown.
+	headerVerifier := func(_ context.Context, header wire.BlockHeader,
+		height uint32, _ *wire.MsgTx) (uint32, error) {
+
+		require.Equal(t, genesisProof.BlockHeader, header)
+		if height != 0 {
+			return 0, fmt.Errorf("unexpected height %d", height)
+		}
+
+		return 777, nil
+	}
+	snapshot, err := decoded.Verify(
+		context.Background(), nil, headerVerifier,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 777, snapshot.AnchorBlockHeight)
+}
+
 func BenchmarkProofEncoding(b *testing.B) {
 	amt := uint64(5000)
 
@@ -321,3 +367,140 @@ func BenchmarkProofEncoding(b *testing.B) {
 }
 
 // TODO(roasbeef): additional tests for the diff sibling preimage combinations
+
+// TestTapscriptPreimageTapHash tests that the TapHash of a tapscript sibling
+// pre-image is computed correctly for all types of siblings.
+func TestTapscriptPreimageTapHash(t *testing.T) {
+	t.Parallel()
+
+	leaf := txscript.NewBaseTapLeaf([]byte{txscript.OP_TRUE})
+	leafHash := leaf.TapHash()
+	leafPreimage := append(
+		[]byte{byte(leaf.LeafVersion)}, leaf.Script...,
+	)
+
+	otherLeaf := txscript.NewBaseTapLeaf([]byte{txscript.OP_FALSE})
+	branch := txscript.NewTapBranch(leaf, otherLeaf)
+	branchHash := branch.TapHash()
+	left, right := branch.Left().TapHash(), branch.Right().TapHash()
+	branchPreimage := append(left[:], right[:]...)
+
+	testCases := []struct {
+		name     string
+		preimage *TapscriptPreimage
+		expected *chainhash.Hash
+		err      error
+	}{{
+		name: "empty pre-image",
+	}, {
+		name: "leaf pre-image",
+		preimage: &TapscriptPreimage{
+			SiblingPreimage: leafPreimage,
+			SiblingType:     LeafPreimage,
+		},
+		expected: &leafHash,
+	}, {
+		name: "branch pre-image",
+		preimage: &TapscriptPreimage{
+			SiblingPreimage: branchPreimage,
+			SiblingType:     BranchPreimage,
+		},
+		expected: &branchHash,
+	}, {
+		name: "invalid branch pre-image",
+		preimage: &TapscriptPreimage{
+			SiblingPreimage: leafPreimage,
+			SiblingType:     BranchPreimage,
+		},
+		err: ErrInvalidTaprootProof,
+	}, {
+		name: "leaf pre-image with taro commitment",
+		preimage: &TapscriptPreimage{
+			SiblingPreimage: append(
+				[]byte{byte(leaf.LeafVersion)},
+				commitment.TaroMarker[:]...,
+			),
+			SiblingType: LeafPreimage,
+		},
+		err: ErrInvalidTaprootProof,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			tapHash, err := testCase.preimage.TapHash()
+			require.ErrorIs(t, err, testCase.err)
+			require.Equal(t, testCase.expected, tapHash)
+		})
+	}
+}
+
+// TestUpdateAnchorBlock tests that the proofs of a file can be re-anchored in
+// a different block after a chain re-organization.
+func TestUpdateAnchorBlock(t *testing.T) {
+	t.Parallel()
+
+	genesisProof, _ := genRandomGenesisWithProof(t, asset.Collectible, nil)
+	f, err := NewFile(V0, genesisProof)
+	require.NoError(t, err)
+
+	// The anchor transaction is now confirmed in a different block at a
+	// different index.
+	anchorTx := genesisProof.AnchorTx
+	otherTx := &wire.MsgTx{
+		Version: 2,
+		TxIn:    []*wire.TxIn{{}},
+		TxOut:   []*wire.TxOut{{Value: 1}},
+	}
+	txns := []*wire.MsgTx{otherTx, &anchorTx}
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(otherTx), btcutil.NewTx(&anchorTx)},
+		false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 1,
+	)
+	params := &BaseProofParams{
+		Block: &wire.MsgBlock{
+			Header:       *blockHeader,
+			Transactions: txns,
+		},
+		BlockHeight: 123,
+		Tx:          &anchorTx,
+		TxIndex:     1,
+	}
+
+	// Proofs anchored in other transactions are left untouched.
+	numUpdated, err := f.UpdateAnchorBlock(&BaseProofParams{
+		Block: params.Block,
+		Tx:    otherTx,
+	})
+	require.NoError(t, err)
+	require.Zero(t, numUpdated)
+
+	numUpdated, err = f.UpdateAnchorBlock(params)
+	require.NoError(t, err)
+	require.Equal(t, 1, numUpdated)
+
+	// The file must still decode with a valid hash chain and the proof
+	// must now commit to the new block.
+	var buf bytes.Buffer
+	require.NoError(t, f.Encode(&buf))
+	f2 := NewEmptyFile(V0)
+	require.NoError(t, f2.Decode(bytes.NewReader(buf.Bytes())))
+
+	updatedProof, err := f2.LastProof()
+	require.NoError(t, err)
+	require.Equal(t, *blockHeader, updatedProof.BlockHeader)
+	require.EqualValues(t, 123, updatedProof.BlockHeight)
+
+	txMerkleProof, err := NewTxMerkleProof(txns, 1)
+	require.NoError(t, err)
+	require.Equal(t, *txMerkleProof, updatedProof.TxMerkleProof)
+
+	_, err = f2.Verify(context.Background(), MockHeaderVerifier)
+	require.NoError(t, err)
+}
@@ -21,6 +21,7 @@ const (
 	ExclusionProofsType  tlv.Type = 6
 	SplitRootProofType   tlv.Type = 7
 	AdditionalInputsType tlv.Type = 8
+	BlockHeightType      tlv.Type = 9
 
 	TaprootProofOutputIndexType     tlv.Type = 0
 	TaprootProofInternalKeyType     tlv.Type = 1
@@ -154,6 +155,10 @@ func AdditionalInputsRecord(inputs *[]File) tlv.Record {
 	)
 }
 
+func BlockHeightRecord(height *uint32) tlv.Record {
+	return tlv.MakePrimitiveRecord(BlockHeightType, height)
+}
+
 func TaprootProofOutputIndexRecord(idx *uint32) tlv.Record {
 	return tlv.MakePrimitiveRecord(TaprootProofOutputIndexType, idx)
 }
@@ -78,6 +78,36 @@ func (t *TapscriptPreimage) IsEmpty() bool {
 	return len(t.SiblingPreimage) == 0
 }
 
+// TapHash returns the TapHash of the sibling pre-image, which is the tap node
+// hashed together with the Taro commitment leaf to arrive at the tapscript
+// root. Nil is returned for an empty pre-image.
+func (t *TapscriptPreimage) TapHash() (*chainhash.Hash, error) {
+	if t.IsEmpty() {
+		return nil, nil
+	}
+
+	switch t.SiblingType {
+	// The sibling is a leaf pre-image, so we'll verify that it isn't a
+	// Taro commitment before hashing it.
+	case LeafPreimage:
+		return tapLeafHash(t.SiblingPreimage)
+
+	// The sibling is a branch pre-image, which must consist of the two
+	// 32-byte hashes of the left and right nodes.
+	case BranchPreimage:
+		branch := tapBranchHash(t.SiblingPreimage)
+		if branch == nil {
+			return nil, ErrInvalidTaprootProof
+		}
+
+		return branch, nil
+
+	default:
+		return nil, fmt.Errorf("unknown sibling type: %v",
+			t.SiblingType)
+	}
+}
+
 // CommitmentProof represents a full commitment proof for an asset. It can
 // either prove inclusion or exclusion of an asset within a Taro commitment.
 type CommitmentProof struct {
@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightninglabs/taro/vm"
 	"golang.org/x/sync/errgroup"
 )
@@ -24,9 +25,68 @@ type Verifier interface {
 	Verify(c context.Context, blobReader io.Reader) (*AssetSnapshot, error)
 }
 
+// HeaderVerifier is a callback function which returns an error if the given
+// block header is not part of the main chain at the given height. The anchor
+// transaction the proof claims to be confirmed in that block is passed along,
+// so implementations can look up the block through the transaction's
+// confirmation. Proofs created before the block height was recorded have a
+// height of zero, in which case the height is unknown and only the header is
+// verified. The height of the block in the main chain is returned.
+type HeaderVerifier func(ctx context.Context, header wire.BlockHeader,
+	height uint32, anchorTx *wire.MsgTx) (uint32, error)
+
+// VerificationError is returned when a proof within a proof file fails
+// verification. It identifies the failing proof and the asset it proves.
+type VerificationError struct {
+	// ProofIndex is the index of the failing proof within the file.
+	ProofIndex uint32
+
+	// AssetID is the ID of the asset the failing proof proves.
+	AssetID asset.ID
+
+	// ScriptKey is the script key of the asset the failing proof proves.
+	ScriptKey asset.SerializedKey
+
+	// Err is the reason the proof failed verification.
+	Err error
+}
+
+// newVerificationError returns a new error for the proof at the given index
+// of a proof file.
+func newVerificationError(idx int, p *Proof, err error) *VerificationError {
+	return &VerificationError{
+		ProofIndex: uint32(idx),
+		AssetID:    p.Asset.Genesis.ID(),
+		ScriptKey:  asset.ToSerialized(p.Asset.ScriptKey.PubKey),
+		Err:        err,
+	}
+}
+
+// Error returns a human readable version of the error.
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("proof %d invalid: %v", e.ProofIndex, e.Err)
+}
+
+// Unwrap returns the reason the proof failed verification.
+func (e *VerificationError) Unwrap() error {
+	return e.Err
+}
+
 // BaseVerifier implements a simple verifier that loads the entire proof file
 // into memory and then verifies it all at once.
 type BaseVerifier struct {
+	// Checkpoints is an optional archive of trusted checkpoint roots. If
+	// it is nil, only proof files that start at the asset's genesis can be
+	// verified.
+	Checkpoints CheckpointArchive
+
+	// HeaderVerifier is used to check that the block header and height of
+	// each proof in the file are part of the main chain.
+	HeaderVerifier HeaderVerifier
+
+	// VMOptions are the options each VM instance that validates an asset
+	// state transition of a proof file is created with.
+	VMOptions []vm.EngineOption
 }
 
 // Verify takes the passed serialized proof file, and returns a nil
@@ -41,7 +101,29 @@ func (b *BaseVerifier) Verify(ctx context.Context,
 		return nil, fmt.Errorf("unable to parse proof: %w", err)
 	}
 
-	return proofFile.Verify(ctx)
+	// If the file was compacted, we can only verify it if we trust the
+	// checkpoint it starts at.
+	checkpoint := proofFile.Checkpoint()
+	if checkpoint == nil {
+		return proofFile.Verify(ctx, b.HeaderVerifier, b.VMOptions...)
+	}
+
+	if b.Checkpoints == nil {
+		return nil, ErrUntrustedCheckpoint
+	}
+
+	root := checkpoint.Root()
+	trusted, err := b.Checkpoints.IsTrustedCheckpoint(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up checkpoint: %w", err)
+	}
+	if !trusted {
+		return nil, ErrUntrustedCheckpoint
+	}
+
+	return proofFile.VerifyFromCheckpoint(
+		ctx, root, b.HeaderVerifier, b.VMOptions...,
+	)
 }
 
 // verifyTaprootProof attempts to verify a TaprootProof for inclusion or
@@ -157,7 +239,59 @@ func (p *Proof) verifyExclusionProofs() error {
 // state transition. This method returns the split asset information if this
 // state transition represents an asset split.
 func (p *Proof) verifyAssetStateTransition(ctx context.Context,
-	prev *AssetSnapshot) (*commitment.SplitAsset, error) {
+	prev *AssetSnapshot, blockHeight uint32, headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*commitment.SplitAsset, error) {
+
+	engine, splitAsset, err := p.stateTransitionVM(
+		ctx, prev, blockHeight, headerVerifier, false, vmOpts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitAsset, engine.Execute()
+}
+
+// TraceStateTransition executes the asset state transition of the proof on
+// top of the passed snapshot of the previous state with tracing enabled. The
+// returned error is the result of the execution, while the returned trace
+// records each step that led to it.
+func (p *Proof) TraceStateTransition(ctx context.Context,
+	prev *AssetSnapshot, headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*vm.Trace, error) {
+
+	// The lock times of the inputs can only be enforced if we know the
+	// height of the block the proof is anchored in.
+	blockHeight := p.BlockHeight
+	if blockHeight == 0 {
+		var err error
+		blockHeight, err = p.verifyBlockHeader(ctx, headerVerifier)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	engine, _, err := p.stateTransitionVM(
+		ctx, prev, blockHeight, headerVerifier, true, vmOpts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = engine.Execute()
+
+	return engine.Trace(), err
+}
+
+// stateTransitionVM creates a new VM instance that validates the asset state
+// transition of the proof at the given block height, along with the split
+// asset information if the state transition represents an asset split. If
+// trace is true, tracing is only enabled for the returned VM, not for the
+// verification of the additional inputs.
+func (p *Proof) stateTransitionVM(ctx context.Context, prev *AssetSnapshot,
+	blockHeight uint32, headerVerifier HeaderVerifier, trace bool,
+	vmOpts ...vm.EngineOption) (*vm.Engine, *commitment.SplitAsset,
+	error) {
 
 	// Determine whether we have an asset split based on the resulting
 	// asset's witness. If so, extract the root asset from the split asset.
@@ -174,18 +308,26 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 		newAsset = &splitAsset.PrevWitnesses[0].SplitCommitment.RootAsset
 	}
 
-	// Gather the set of asset inputs leading to the state transition.
+	// Gather the set of asset inputs leading to the state transition,
+	// along with the height each of them was confirmed at, so the VM can
+	// enforce their lock times.
 	var prevAssets commitment.InputSet
+	chainCtx := &taroscript.ChainContext{
+		BlockHeight:  blockHeight,
+		InputHeights: make(map[asset.PrevID]uint32),
+	}
 	if prev != nil {
+		prevID := asset.PrevID{
+			OutPoint: p.PrevOut,
+			ID:       prev.Asset.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(
+				prev.Asset.ScriptKey.PubKey,
+			),
+		}
 		prevAssets = commitment.InputSet{
-			asset.PrevID{
-				OutPoint: p.PrevOut,
-				ID:       prev.Asset.Genesis.ID(),
-				ScriptKey: asset.ToSerialized(
-					prev.Asset.ScriptKey.PubKey,
-				),
-			}: prev.Asset,
+			prevID: prev.Asset,
 		}
+		chainCtx.InputHeights[prevID] = prev.AnchorBlockHeight
 	}
 
 	// We'll use an err group to be able to validate all the inputs in
@@ -201,7 +343,9 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 		inputProof := inputProof
 
 		errGroup.Go(func() error {
-			result, err := inputProof.Verify(ctx)
+			result, err := inputProof.Verify(
+				ctx, headerVerifier, vmOpts...,
+			)
 			if err != nil {
 				return err
 			}
@@ -216,34 +360,65 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 				),
 			}
 			prevAssets[prevID] = result.Asset
+			chainCtx.InputHeights[prevID] = result.AnchorBlockHeight
 
 			return nil
 		})
 	}
 	if err := errGroup.Wait(); err != nil {
-		return nil, fmt.Errorf("inputs invalid: %w", err)
+		return nil, nil, fmt.Errorf("inputs invalid: %w", err)
 	}
 
 	// Spawn a new VM instance to verify the asset's state transition.
-	engine, err := vm.New(newAsset, splitAsset, prevAssets)
+	if trace {
+		vmOpts = append(
+			append([]vm.EngineOption{}, vmOpts...), vm.WithTrace(),
+		)
+	}
+	engine, err := vm.New(
+		newAsset, splitAsset, prevAssets, chainCtx, vmOpts...,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return splitAsset, engine.Execute()
+
+	return engine, splitAsset, nil
+}
+
+// verifyBlockHeader makes sure the block header of the proof is part of the
+// main chain and returns the height of the block. If the proof doesn't specify
+// the height, it is looked up by the header verifier.
+func (p *Proof) verifyBlockHeader(ctx context.Context,
+	headerVerifier HeaderVerifier) (uint32, error) {
+
+	if headerVerifier == nil {
+		return 0, ErrNoHeaderVerifier
+	}
+
+	blockHeight, err := headerVerifier(
+		ctx, p.BlockHeader, p.BlockHeight, &p.AnchorTx,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidBlockHeader, err)
+	}
+
+	return blockHeight, nil
 }
 
 // Verify verifies the proof by ensuring that:
 //
 //  1. A transaction that spends the previous asset output has a valid merkle
-//     proof within a block in the chain.
+//     proof within a block that is part of the main chain at the claimed
+//     height.
 //  2. A valid inclusion proof for the resulting asset is included.
 //  3. A valid inclusion proof for the split root, if the resulting asset
 //     is a split asset.
 //  4. A set of valid exclusion proofs for the resulting asset are included.
 //  5. A set of asset inputs with valid witnesses are included that satisfy the
 //     resulting state transition.
-func (p *Proof) Verify(ctx context.Context,
-	prev *AssetSnapshot) (*AssetSnapshot, error) {
+func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
+	headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*AssetSnapshot, error) {
 
 	// 1. A transaction that spends the previous asset output has a valid
 	// merkle proof within a block in the chain.
@@ -253,7 +428,14 @@ func (p *Proof) Verify(ctx context.Context,
 	if !txSpendsPrevOut(&p.AnchorTx, &p.PrevOut) {
 		return nil, ErrInvalidTaprootProof // TODO
 	}
-	// TODO: Cross check BlockHeader with a bitcoin node.
+
+	// The block height is used to enforce the lock times of the inputs, so
+	// we can't take the prover's word for it. Make sure the block header
+	// is part of the main chain at the claimed height.
+	blockHeight, err := p.verifyBlockHeader(ctx, headerVerifier)
+	if err != nil {
+		return nil, err
+	}
 	if !p.TxMerkleProof.Verify(&p.AnchorTx, p.BlockHeader.MerkleRoot) {
 		return nil, ErrInvalidTxMerkleProof
 	}
@@ -284,12 +466,14 @@ func (p *Proof) Verify(ctx context.Context,
 
 	// 5. A set of asset inputs with valid witnesses are included that
 	// satisfy the resulting state transition.
-	splitAsset, err := p.verifyAssetStateTransition(ctx, prev)
+	splitAsset, err := p.verifyAssetStateTransition(
+		ctx, prev, blockHeight, headerVerifier, vmOpts...,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO(roasbeef): need tx index and block height as well
+	// TODO(roasbeef): need tx index as well
 
 	return &AssetSnapshot{
 		Asset: &p.Asset,
@@ -297,30 +481,83 @@ func (p *Proof) Verify(ctx context.Context,
 			Hash:  p.AnchorTx.TxHash(),
 			Index: p.InclusionProof.OutputIndex,
 		},
-		AnchorBlockHash: p.BlockHeader.BlockHash(),
-		AnchorTx:        &p.AnchorTx,
-		OutputIndex:     p.InclusionProof.OutputIndex,
-		InternalKey:     p.InclusionProof.InternalKey,
-		ScriptRoot:      taroCommitment,
-		SplitAsset:      splitAsset != nil,
+		AnchorBlockHash:   p.BlockHeader.BlockHash(),
+		AnchorBlockHeight: blockHeight,
+		AnchorTx:          &p.AnchorTx,
+		OutputIndex:       p.InclusionProof.OutputIndex,
+		InternalKey:       p.InclusionProof.InternalKey,
+		ScriptRoot:        taroCommitment,
+		SplitAsset:        splitAsset != nil,
 	}, nil
 }
 
 // Verify attempts to verify a full proof file starting from the asset's
-// genesis.
+// genesis. A file that starts at a checkpoint can only be verified with
+// VerifyFromCheckpoint.
 //
 // The passed context can be used to exit early from the inner proof
 // verification loop.
 //
 // TODO(roasbeef): pass in the expected genesis point here?
-func (f *File) Verify(ctx context.Context) (*AssetSnapshot, error) {
+func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*AssetSnapshot, error) {
+
+	if f.checkpoint != nil {
+		return nil, ErrUntrustedCheckpoint
+	}
+
+	return f.verifyFrom(ctx, nil, headerVerifier, vmOpts...)
+}
+
+// TraceLastTransition verifies all but the last proof of the file, then
+// executes the asset state transition of the last proof with tracing enabled.
+// If the trace is nil, then the error refers to one of the earlier proofs.
+// Otherwise, it's the result of the traced state transition.
+func (f *File) TraceLastTransition(ctx context.Context,
+	headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*vm.Trace, error) {
+
+	if f.checkpoint != nil {
+		return nil, ErrUntrustedCheckpoint
+	}
+
+	lastProof, err := f.LastProof()
+	if err != nil {
+		return nil, err
+	}
+
+	var prev *AssetSnapshot
+	for idx := 0; idx < f.NumProofs()-1; idx++ {
+		decodedProof, err := f.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err = decodedProof.Verify(
+			ctx, prev, headerVerifier, vmOpts...,
+		)
+		if err != nil {
+			return nil, newVerificationError(idx, decodedProof, err)
+		}
+	}
+
+	return lastProof.TraceStateTransition(
+		ctx, prev, headerVerifier, vmOpts...,
+	)
+}
+
+// verifyFrom verifies all proofs of the file, starting with the passed
+// snapshot as the state the first proof builds upon.
+func (f *File) verifyFrom(ctx context.Context, prev *AssetSnapshot,
+	headerVerifier HeaderVerifier,
+	vmOpts ...vm.EngineOption) (*AssetSnapshot, error) {
+
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
 	}
 
-	var prev *AssetSnapshot
 	for idx := range f.proofs {
 		select {
 		case <-ctx.Done():
@@ -333,9 +570,11 @@ func (f *File) Verify(ctx context.Context) (*AssetSnapshot, error) {
 			return nil, err
 		}
 
-		result, err := decodedProof.Verify(ctx, prev)
+		result, err := decodedProof.Verify(
+			ctx, prev, headerVerifier, vmOpts...,
+		)
 		if err != nil {
-			return nil, err
+			return nil, newVerificationError(idx, decodedProof, err)
 		}
 		prev = result
 	}
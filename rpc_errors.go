@@ -0,0 +1,166 @@
+package taro
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/proof"
+	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightninglabs/taro/vm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// vmErrorCodes maps the kinds of errors returned by the Taro VM to their RPC
+// validation error codes.
+var vmErrorCodes = map[vm.ErrorKind]tarorpc.ValidationErrorCode{
+	vm.ErrNoSplitCommitment:             tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_NO_SPLIT_COMMITMENT,
+	vm.ErrIDMismatch:                    tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_ID_MISMATCH,
+	vm.ErrTypeMismatch:                  tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_TYPE_MISMATCH,
+	vm.ErrScriptKeyMismatch:             tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_SCRIPT_KEY_MISMATCH,
+	vm.ErrAmountMismatch:                tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_AMOUNT_MISMATCH,
+	vm.ErrInvalidSigHashFlag:            tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SIG_HASH_FLAG,
+	vm.ErrInvalidGenesisStateTransition: tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_GENESIS_STATE_TRANSITION,
+	vm.ErrInvalidTransferWitness:        tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_TRANSFER_WITNESS,
+	vm.ErrInvalidSplitAssetType:         tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SPLIT_ASSET_TYPE,
+	vm.ErrInvalidSplitCommitmentWitness: tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_WITNESS,
+	vm.ErrInvalidSplitCommitmentProof:   tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_PROOF,
+	vm.ErrInvalidRootAsset:              tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_ROOT_ASSET,
+	vm.ErrLockTimeNotReached:            tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_LOCK_TIME_NOT_REACHED,
+	vm.ErrRelativeLockTimeNotReached:    tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_RELATIVE_LOCK_TIME_NOT_REACHED,
+	vm.ErrMissingInputHeight:            tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_MISSING_INPUT_HEIGHT,
+	vm.ErrInvalidAssetOpcode:            tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_ASSET_OPCODE,
+	vm.ErrAssetCovenantViolation:        tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_ASSET_COVENANT_VIOLATION,
+	vm.ErrCollectibleMerge:              tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_COLLECTIBLE_MERGE,
+	vm.ErrCollectibleSplit:              tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_COLLECTIBLE_SPLIT,
+}
+
+// validationErrorCodes maps the sentinel errors of the VM, proof verification
+// and asset commitments to their RPC validation error codes.
+var validationErrorCodes = []struct {
+	err  error
+	code tarorpc.ValidationErrorCode
+}{
+	{vm.ErrInvalidScriptVersion, tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SCRIPT_VERSION},
+	{vm.ErrInputMismatch, tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INPUT_MISMATCH},
+	{vm.ErrNoInputs, tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_NO_INPUTS},
+
+	{proof.ErrInvalidTaprootProof, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_TAPROOT_PROOF},
+	{proof.ErrInvalidTxMerkleProof, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_TX_MERKLE_PROOF},
+	{proof.ErrMissingExclusionProofs, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_MISSING_EXCLUSION_PROOFS},
+	{proof.ErrMissingSplitRootProof, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_MISSING_SPLIT_ROOT_PROOF},
+	{proof.ErrInvalidCommitmentProof, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_COMMITMENT_PROOF},
+	{proof.ErrInvalidTapscriptProof, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_TAPSCRIPT_PROOF},
+	{proof.ErrInvalidChecksum, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_CHECKSUM},
+	{proof.ErrUntrustedCheckpoint, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_UNTRUSTED_CHECKPOINT},
+	{proof.ErrCheckpointMismatch, tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_CHECKPOINT_MISMATCH},
+
+	{commitment.ErrMissingAssetProof, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_MISSING_ASSET_PROOF},
+	{commitment.ErrDuplicateSplitOutputIndex, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_DUPLICATE_SPLIT_OUTPUT_INDEX},
+	{commitment.ErrInvalidSplitAmount, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_AMOUNT},
+	{commitment.ErrInvalidSplitLocator, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_LOCATOR},
+	{commitment.ErrInvalidScriptKey, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_INVALID_SCRIPT_KEY},
+	{commitment.ErrZeroSplitAmount, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_ZERO_SPLIT_AMOUNT},
+	{commitment.ErrNonZeroSplitAmount, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_NON_ZERO_SPLIT_AMOUNT},
+	{commitment.ErrCollectibleSplit, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_COLLECTIBLE_SPLIT},
+	{commitment.ErrNoAssets, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_NO_ASSETS},
+	{commitment.ErrAssetGenesisMismatch, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_GENESIS_MISMATCH},
+	{commitment.ErrAssetFamilyKeyMismatch, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_FAMILY_KEY_MISMATCH},
+	{commitment.ErrAssetDuplicateScriptKey, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_DUPLICATE_SCRIPT_KEY},
+	{commitment.ErrAssetGenesisInvalidSig, tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_GENESIS_INVALID_SIG},
+}
+
+// marshalValidationError converts an error returned by the VM, proof
+// verification or asset commitments to its RPC representation, along with the
+// input, asset and proof it refers to. Nil is returned for other errors.
+func marshalValidationError(err error) *tarorpc.ValidationError {
+	if err == nil {
+		return nil
+	}
+
+	rpcErr := &tarorpc.ValidationError{
+		Message: err.Error(),
+	}
+
+	var vmErr vm.Error
+	if errors.As(err, &vmErr) {
+		rpcErr.Code = vmErrorCodes[vmErr.Kind]
+
+		if vmErr.Context != nil {
+			assetID := vmErr.Context.AssetID
+			rpcErr.AssetId = assetID[:]
+			rpcErr.ScriptKey = vmErr.Context.ScriptKey[:]
+			rpcErr.HasInputIndex = true
+			rpcErr.InputIndex = vmErr.Context.InputIndex
+		}
+	} else {
+		for _, errCode := range validationErrorCodes {
+			if errors.Is(err, errCode.err) {
+				rpcErr.Code = errCode.code
+				break
+			}
+		}
+	}
+
+	// If the error occurred while verifying a proof file, we also point to
+	// the failing proof. Errors of a specific input take precedence when
+	// it comes to the asset the error refers to.
+	var verifyErr *proof.VerificationError
+	if errors.As(err, &verifyErr) {
+		rpcErr.HasProofIndex = true
+		rpcErr.ProofIndex = verifyErr.ProofIndex
+
+		if !rpcErr.HasInputIndex {
+			rpcErr.AssetId = verifyErr.AssetID[:]
+			rpcErr.ScriptKey = verifyErr.ScriptKey[:]
+		}
+	}
+
+	if rpcErr.Code == tarorpc.ValidationErrorCode_VALIDATION_ERROR_UNKNOWN {
+		return nil
+	}
+
+	return rpcErr
+}
+
+// validationStatusError converts a validation error to a gRPC status error
+// with the code INVALID_ARGUMENT that carries the RPC representation of the
+// error in its details. Other errors are returned as is.
+func validationStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	rpcErr := marshalValidationError(err)
+	if rpcErr == nil {
+		return err
+	}
+
+	st, detailsErr := status.New(
+		codes.InvalidArgument, err.Error(),
+	).WithDetails(rpcErr)
+	if detailsErr != nil {
+		return err
+	}
+
+	return st.Err()
+}
+
+// validationErrorUnaryServerInterceptor is a UnaryServerInterceptor that
+// converts validation errors returned by an RPC into gRPC status errors with
+// machine-readable details, so clients don't need to match error strings.
+func validationErrorUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
+		error) {
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, validationStatusError(err)
+		}
+
+		return resp, nil
+	}
+}
@@ -0,0 +1,153 @@
+package taro
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/proof"
+	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightninglabs/taro/vm"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestVMErrorCodes asserts that every kind of VM error has a validation error
+// code.
+func TestVMErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	for kind := vm.ErrNoSplitCommitment; kind <= vm.ErrCollectibleSplit; kind++ {
+		code, ok := vmErrorCodes[kind]
+		require.True(t, ok, "missing code for %v", kind)
+		require.NotEqual(
+			t, tarorpc.ValidationErrorCode_VALIDATION_ERROR_UNKNOWN,
+			code,
+		)
+	}
+}
+
+// TestMarshalValidationError tests that validation errors are mapped to their
+// codes along with the input, asset and proof they refer to.
+func TestMarshalValidationError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		inputAssetID = asset.ID{1}
+		proofAssetID = asset.ID{2}
+		inputKey     = asset.SerializedKey{3}
+		proofKey     = asset.SerializedKey{4}
+	)
+	inputErr := vm.Error{
+		Kind: vm.ErrInvalidTransferWitness,
+		Context: &vm.ErrorContext{
+			InputIndex: 1,
+			AssetID:    inputAssetID,
+			ScriptKey:  inputKey,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected *tarorpc.ValidationError
+	}{{
+		name: "vm error of input",
+		err:  inputErr,
+		expected: &tarorpc.ValidationError{
+			Code:          tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_TRANSFER_WITNESS,
+			Message:       inputErr.Error(),
+			AssetId:       inputAssetID[:],
+			ScriptKey:     inputKey[:],
+			HasInputIndex: true,
+			InputIndex:    1,
+		},
+	}, {
+		name: "vm error of proof",
+		err: &proof.VerificationError{
+			ProofIndex: 2,
+			AssetID:    proofAssetID,
+			ScriptKey:  proofKey,
+			Err:        inputErr,
+		},
+		expected: &tarorpc.ValidationError{
+			Code:          tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_TRANSFER_WITNESS,
+			Message:       "proof 2 invalid: " + inputErr.Error(),
+			AssetId:       inputAssetID[:],
+			ScriptKey:     inputKey[:],
+			HasInputIndex: true,
+			InputIndex:    1,
+			HasProofIndex: true,
+			ProofIndex:    2,
+		},
+	}, {
+		name: "proof error",
+		err: &proof.VerificationError{
+			ProofIndex: 3,
+			AssetID:    proofAssetID,
+			ScriptKey:  proofKey,
+			Err:        proof.ErrMissingSplitRootProof,
+		},
+		expected: &tarorpc.ValidationError{
+			Code: tarorpc.ValidationErrorCode_VALIDATION_ERROR_PROOF_MISSING_SPLIT_ROOT_PROOF,
+			Message: "proof 3 invalid: " +
+				proof.ErrMissingSplitRootProof.Error(),
+			AssetId:       proofAssetID[:],
+			ScriptKey:     proofKey[:],
+			HasProofIndex: true,
+			ProofIndex:    3,
+		},
+	}, {
+		name: "wrapped commitment error",
+		err: fmt.Errorf("unable to create split commit: %w",
+			commitment.ErrCollectibleSplit),
+		expected: &tarorpc.ValidationError{
+			Code: tarorpc.ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_COLLECTIBLE_SPLIT,
+			Message: "unable to create split commit: " +
+				commitment.ErrCollectibleSplit.Error(),
+		},
+	}, {
+		name: "other error",
+		err:  errors.New("other error"),
+	}, {
+		name: "no error",
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rpcErr := marshalValidationError(testCase.err)
+			require.Equal(t, testCase.expected, rpcErr)
+		})
+	}
+}
+
+// TestValidationStatusError tests that validation errors are converted to
+// gRPC status errors carrying the validation error in their details.
+func TestValidationStatusError(t *testing.T) {
+	t.Parallel()
+
+	vmErr := vm.Error{Kind: vm.ErrCollectibleMerge}
+	st, ok := status.FromError(validationStatusError(vmErr))
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, vmErr.Error(), st.Message())
+
+	details := st.Details()
+	require.Len(t, details, 1)
+	rpcErr, ok := details[0].(*tarorpc.ValidationError)
+	require.True(t, ok)
+	require.Equal(
+		t, tarorpc.ValidationErrorCode_VALIDATION_ERROR_VM_COLLECTIBLE_MERGE,
+		rpcErr.Code,
+	)
+
+	// Other errors are returned as is.
+	otherErr := errors.New("other error")
+	require.Equal(t, otherErr, validationStatusError(otherErr))
+}
@@ -3,8 +3,11 @@ package taro
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
 	"sync"
@@ -13,15 +16,20 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/chanutils"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/rpcperms"
+	"github.com/lightninglabs/taro/tarodb"
 	"github.com/lightninglabs/taro/tarofreighter"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightninglabs/taro/tarorpc"
+	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
 	"google.golang.org/grpc"
@@ -80,6 +88,18 @@ var (
 			Entity: "addresses",
 			Action: "read",
 		}},
+		"/tarorpc.Taro/SubscribeReceiveEvents": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ExportAddrs": {{
+			Entity: "addresses",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ImportAddrs": {{
+			Entity: "addresses",
+			Action: "write",
+		}},
 		"/tarorpc.Taro/VerifyProof": {{
 			Entity: "proofs",
 			Action: "read",
@@ -92,10 +112,46 @@ var (
 			Entity: "proofs",
 			Action: "write",
 		}},
+		"/tarorpc.Taro/CompactProof": {{
+			Entity: "proofs",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/TrustCheckpoint": {{
+			Entity: "proofs",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/AuditProofs": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ListProofs": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ExportProofs": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/ImportProofs": {{
+			Entity: "proofs",
+			Action: "write",
+		}},
 		"/tarorpc.Taro/SendAsset": {{
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/tarorpc.Taro/DebugStateTransition": {{
+			Entity: "proofs",
+			Action: "read",
+		}},
+		"/tarorpc.Taro/BurnAsset": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/tarorpc.Taro/ListCollectibles": {{
+			Entity: "assets",
+			Action: "read",
+		}},
 	}
 )
 
@@ -286,13 +342,26 @@ func (r *rpcServer) DebugLevel(ctx context.Context,
 func (r *rpcServer) MintAsset(ctx context.Context,
 	req *tarorpc.MintAssetRequest) (*tarorpc.MintAssetResponse, error) {
 
+	if req.LockTime < 0 || req.RelativeLockTime < 0 {
+		return nil, fmt.Errorf("lock times must not be negative")
+	}
+	if req.ScriptVersion < 0 || req.ScriptVersion > math.MaxUint16 {
+		return nil, tarogarden.ErrInvalidScriptVersion
+	}
+
 	seedling := &tarogarden.Seedling{
-		AssetType:      asset.Type(req.AssetType),
-		AssetName:      req.Name,
-		Metadata:       req.MetaData,
-		Amount:         uint64(req.Amount),
-		EnableEmission: req.EnableEmission,
-		NoBatch:        req.SkipBatch,
+		AssetType:        asset.Type(req.AssetType),
+		AssetName:        req.Name,
+		Metadata:         req.MetaData,
+		Amount:           uint64(req.Amount),
+		LockTime:         uint64(req.LockTime),
+		RelativeLockTime: uint64(req.RelativeLockTime),
+		ScriptVersion:    asset.ScriptVersion(req.ScriptVersion),
+		EnableEmission:   req.EnableEmission,
+		NoBatch:          req.SkipBatch,
+		NumConfs:         req.NumConfs,
+		Label:            req.Label,
+		ExternalMetadata: req.ExternalMetadata,
 	}
 	updates, err := r.cfg.AssetMinter.QueueNewSeedling(seedling)
 	if err != nil {
@@ -326,63 +395,207 @@ func (r *rpcServer) ListAssets(ctx context.Context,
 		return nil, fmt.Errorf("unable to read chain assets: %w", err)
 	}
 
-	rpcAssets := make([]*tarorpc.Asset, len(assets))
-	for i, a := range assets {
-		assetID := a.Genesis.ID()
+	// If the caller only wants assets that are buried deep enough in the
+	// chain, we need to know the current height to find out how many
+	// confirmations each anchor transaction has.
+	var currentHeight uint32
+	if req.MinConfs > 0 {
+		currentHeight, err = r.cfg.ChainBridge.CurrentHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query current "+
+				"height: %w", err)
+		}
+	}
+
+	rpcAssets := make([]*tarorpc.Asset, 0, len(assets))
+	for _, a := range assets {
+		if req.MinConfs > 0 &&
+			numConfs(a.AnchorBlockHeight, currentHeight) <
+				req.MinConfs {
 
-		var bootstrapInfoBuf bytes.Buffer
-		if err := a.Genesis.Encode(&bootstrapInfoBuf); err != nil {
-			return nil, fmt.Errorf("unable to encode genesis: %w",
-				err)
+			continue
 		}
 
-		var anchorTxBytes []byte
-		if a.AnchorTx != nil {
-			var anchorTxBuf bytes.Buffer
-			err := a.AnchorTx.Serialize(&anchorTxBuf)
-			if err != nil {
-				return nil, fmt.Errorf("unable to serialize "+
-					"anchor tx: %w", err)
-			}
-			anchorTxBytes = anchorTxBuf.Bytes()
+		rpcAsset, err := marshalChainAsset(a)
+		if err != nil {
+			return nil, err
 		}
-		rpcAssets[i] = &tarorpc.Asset{
-			Version: int32(a.Version),
-			AssetGenesis: &tarorpc.GenesisInfo{
-				GenesisPoint:         a.Genesis.FirstPrevOut.String(),
-				Name:                 a.Genesis.Tag,
-				Meta:                 a.Genesis.Metadata,
-				AssetId:              assetID[:],
-				OutputIndex:          a.Genesis.OutputIndex,
-				GenesisBootstrapInfo: bootstrapInfoBuf.Bytes(),
-			},
-			AssetType:        tarorpc.AssetType(a.Type),
-			Amount:           int64(a.Amount),
-			LockTime:         int32(a.LockTime),
-			RelativeLockTime: int32(a.RelativeLockTime),
-			ScriptVersion:    int32(a.ScriptVersion),
-			ScriptKey:        a.ScriptKey.PubKey.SerializeCompressed(),
-			ChainAnchor: &tarorpc.AnchorInfo{
-				AnchorTx:        anchorTxBytes,
-				AnchorTxid:      a.AnchorTxid[:],
-				AnchorBlockHash: a.AnchorBlockHash[:],
-				AnchorOutpoint:  a.AnchorOutpoint.String(),
-				InternalKey:     a.AnchorInternalKey.SerializeCompressed(),
-			},
+		rpcAssets = append(rpcAssets, rpcAsset)
+	}
+
+	return &tarorpc.ListAssetResponse{
+		Assets: rpcAssets,
+	}, nil
+}
+
+// numConfs returns the number of confirmations a transaction that was included
+// in the block with the given height has at the given current height. An
+// unconfirmed transaction (height zero) has no confirmations.
+func numConfs(blockHeight, currentHeight uint32) uint32 {
+	if blockHeight == 0 || blockHeight > currentHeight {
+		return 0
+	}
+
+	return currentHeight - blockHeight + 1
+}
+
+// marshalChainAsset converts an asset owned by the daemon to its RPC
+// representation.
+func marshalChainAsset(a *tarodb.ChainAsset) (*tarorpc.Asset, error) {
+	assetID := a.Genesis.ID()
+
+	var bootstrapInfoBuf bytes.Buffer
+	if err := a.Genesis.Encode(&bootstrapInfoBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode genesis: %w", err)
+	}
+
+	var anchorTxBytes []byte
+	if a.AnchorTx != nil {
+		var anchorTxBuf bytes.Buffer
+		if err := a.AnchorTx.Serialize(&anchorTxBuf); err != nil {
+			return nil, fmt.Errorf("unable to serialize anchor "+
+				"tx: %w", err)
+		}
+		anchorTxBytes = anchorTxBuf.Bytes()
+	}
+	rpcAsset := &tarorpc.Asset{
+		Version: int32(a.Version),
+		AssetGenesis: &tarorpc.GenesisInfo{
+			GenesisPoint:         a.Genesis.FirstPrevOut.String(),
+			Name:                 a.Genesis.Tag,
+			Meta:                 a.Genesis.Metadata,
+			AssetId:              assetID[:],
+			OutputIndex:          a.Genesis.OutputIndex,
+			GenesisBootstrapInfo: bootstrapInfoBuf.Bytes(),
+		},
+		AssetType:        tarorpc.AssetType(a.Type),
+		Amount:           int64(a.Amount),
+		LockTime:         int32(a.LockTime),
+		RelativeLockTime: int32(a.RelativeLockTime),
+		ScriptVersion:    int32(a.ScriptVersion),
+		ScriptKey:        a.ScriptKey.PubKey.SerializeCompressed(),
+		ChainAnchor: &tarorpc.AnchorInfo{
+			AnchorTx:        anchorTxBytes,
+			AnchorTxid:      a.AnchorTxid[:],
+			AnchorBlockHash: a.AnchorBlockHash[:],
+			AnchorOutpoint:  a.AnchorOutpoint.String(),
+			InternalKey:     a.AnchorInternalKey.SerializeCompressed(),
+		},
+	}
+
+	if a.FamilyKey != nil {
+		rpcAsset.AssetFamily = &tarorpc.AssetFamily{
+			RawFamilyKey:     a.FamilyKey.RawKey.PubKey.SerializeCompressed(),
+			TweakedFamilyKey: a.FamilyKey.FamKey.SerializeCompressed(),
+			AssetIdSig:       a.FamilyKey.Sig.Serialize(),
+		}
+	}
+
+	return rpcAsset, nil
+}
+
+// ListCollectibles lists the collectibles owned by the target daemon, grouped
+// by their family key, along with the provenance of each collectible.
+func (r *rpcServer) ListCollectibles(ctx context.Context,
+	_ *tarorpc.ListCollectiblesRequest) (*tarorpc.ListCollectiblesResponse,
+	error) {
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
+
+	var (
+		resp     tarorpc.ListCollectiblesResponse
+		families = make(map[string]*tarorpc.CollectibleFamily)
+	)
+	for _, a := range assets {
+		// The zero-value root assets left behind by sending a
+		// collectible with a split aren't owned collectibles.
+		if a.Type != asset.Collectible || a.Amount == 0 {
+			continue
+		}
+
+		rpcAsset, err := marshalChainAsset(a)
+		if err != nil {
+			return nil, err
+		}
+
+		provenance, err := r.collectibleProvenance(ctx, a.Asset)
+		if err != nil {
+			return nil, err
 		}
 
+		var familyKey []byte
 		if a.FamilyKey != nil {
-			rpcAssets[i].AssetFamily = &tarorpc.AssetFamily{
-				RawFamilyKey:     a.FamilyKey.RawKey.PubKey.SerializeCompressed(),
-				TweakedFamilyKey: a.FamilyKey.FamKey.SerializeCompressed(),
-				AssetIdSig:       a.FamilyKey.Sig.Serialize(),
+			familyKey = a.FamilyKey.FamKey.SerializeCompressed()
+		}
+		family, ok := families[string(familyKey)]
+		if !ok {
+			family = &tarorpc.CollectibleFamily{
+				TweakedFamilyKey: familyKey,
 			}
+			families[string(familyKey)] = family
+			resp.Families = append(resp.Families, family)
 		}
+
+		family.Collectibles = append(
+			family.Collectibles, &tarorpc.Collectible{
+				Asset:      rpcAsset,
+				Provenance: provenance,
+			},
+		)
 	}
 
-	return &tarorpc.ListAssetResponse{
-		Assets: rpcAssets,
-	}, nil
+	return &resp, nil
+}
+
+// collectibleProvenance derives every owner of the given collectible from its
+// proof file, starting with the genesis of the collectible. If we don't have
+// a proof file for the collectible yet, an empty provenance is returned.
+func (r *rpcServer) collectibleProvenance(ctx context.Context,
+	a *asset.Asset) ([]*tarorpc.CollectibleOwner, error) {
+
+	assetID := a.Genesis.ID()
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *a.ScriptKey.PubKey,
+	})
+	switch {
+	case errors.Is(err, proof.ErrProofNotFound):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch proof of collectible "+
+			"%x: %w", assetID[:], err)
+	}
+
+	var proofFile proof.File
+	if err := proofFile.Decode(bytes.NewReader(proofBlob)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	provenance := make([]*tarorpc.CollectibleOwner, proofFile.NumProofs())
+	for idx := range provenance {
+		p, err := proofFile.ProofAt(uint32(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		anchorOutpoint := wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		}
+		blockHash := p.BlockHeader.BlockHash()
+		provenance[idx] = &tarorpc.CollectibleOwner{
+			AnchorOutpoint:  anchorOutpoint.String(),
+			AnchorBlockHash: blockHash[:],
+			ScriptKey: p.Asset.ScriptKey.PubKey.
+				SerializeCompressed(),
+		}
+	}
+
+	return provenance, nil
 }
 
 func (r *rpcServer) listBalancesByAsset(ctx context.Context,
@@ -511,7 +724,20 @@ func (r *rpcServer) ListTransfers(ctx context.Context,
 	in *tarorpc.ListTransfersRequest) (*tarorpc.ListTransfersResponse,
 	error) {
 
-	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
+	err := validateMetadataFilter(
+		in.ExternalMetadataKey, in.ExternalMetadataValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcels, err := r.cfg.AssetStore.QueryParcels(
+		ctx, tarofreighter.ParcelQueryParams{
+			Label:                 in.Label,
+			ExternalMetadataKey:   in.ExternalMetadataKey,
+			ExternalMetadataValue: in.ExternalMetadataValue,
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query parcels: %w", err)
 	}
@@ -554,6 +780,8 @@ func (r *rpcServer) ListTransfers(ctx context.Context,
 				TaroRoot:          parcel.TaroRoot,
 				AnchorTxHash:      anchorTxHash[:],
 				AssetSpendDeltas:  deltas,
+				Label:             parcel.Label,
+				ExternalMetadata:  parcel.ExternalMetadata,
 			},
 		)
 	}
@@ -565,9 +793,19 @@ func (r *rpcServer) ListTransfers(ctx context.Context,
 func (r *rpcServer) QueryAddrs(ctx context.Context,
 	in *tarorpc.QueryAddrRequest) (*tarorpc.QueryAddrResponse, error) {
 
+	err := validateMetadataFilter(
+		in.ExternalMetadataKey, in.ExternalMetadataValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	query := address.QueryParams{
-		Limit:  in.Limit,
-		Offset: in.Offset,
+		Limit:                 in.Limit,
+		Offset:                in.Offset,
+		Label:                 in.Label,
+		ExternalMetadataKey:   in.ExternalMetadataKey,
+		ExternalMetadataValue: in.ExternalMetadataValue,
 	}
 
 	// The unix time of 0 (1970-01-01) is not the same as an empty Time
@@ -594,7 +832,7 @@ func (r *rpcServer) QueryAddrs(ctx context.Context,
 	addrs := make([]*tarorpc.Addr, len(dbAddrs))
 	for i, dbAddr := range dbAddrs {
 		dbAddr.ChainParams = &taroParams
-		addrs[i], err = marshalAddr(dbAddr.Taro)
+		addrs[i], err = marshalAddrWithKeyInfo(&dbAddrs[i])
 		if err != nil {
 			return nil, fmt.Errorf("unable to marshal addr: %w",
 				err)
@@ -627,29 +865,96 @@ func (r *rpcServer) NewAddr(ctx context.Context,
 		}
 	}
 
-	genReader := bytes.NewReader(in.GenesisBootstrapInfo)
-	genesis, err := asset.DecodeGenesis(genReader)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode genesis bootstrap "+
-			"info: %w", err)
+	// A family address accepts any asset ID within the family, so there is
+	// no genesis to decode in that case.
+	var genesis asset.Genesis
+	switch {
+	case in.FamilyOnly:
+		if famKey == nil {
+			return nil, fmt.Errorf("family address requires a " +
+				"fam key")
+		}
+
+		genesis = address.FamilyGenesis(asset.Type(in.AssetType))
+		rpcsLog.Infof("[NewAddr]: making new family addr: "+
+			"fam_key=%x, amt=%v, type=%v",
+			famKey.SerializeCompressed(), in.Amt, genesis.Type)
+
+	default:
+		genReader := bytes.NewReader(in.GenesisBootstrapInfo)
+		genesis, err = asset.DecodeGenesis(genReader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode genesis "+
+				"bootstrap info: %w", err)
+		}
+
+		assetID := genesis.ID()
+		rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, "+
+			"amt=%v, type=%v", assetID[:], in.Amt, genesis.Type)
 	}
 
-	assetID := genesis.ID()
-	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v, "+
-		"type=%v", assetID[:], in.Amt, asset.Type(genesis.Type))
+	tapLeaves := make([]txscript.TapLeaf, 0, len(in.TapscriptLeaves))
+	for _, rpcLeaf := range in.TapscriptLeaves {
+		tapLeaf, err := unmarshalTapLeaf(rpcLeaf)
+		if err != nil {
+			return nil, err
+		}
+		tapLeaves = append(tapLeaves, *tapLeaf)
+	}
+
+	var addrOpts []address.NewAddrOption
+	if in.ExpiryTimeUnix != 0 {
+		addrOpts = append(addrOpts, address.WithExpiry(
+			time.Unix(in.ExpiryTimeUnix, 0),
+		))
+	}
+	if in.SingleUse {
+		addrOpts = append(addrOpts, address.WithSingleUse())
+	}
+	if in.Label != "" {
+		addrOpts = append(addrOpts, address.WithLabel(in.Label))
+	}
+	if len(in.ExternalMetadata) > 0 {
+		addrOpts = append(addrOpts, address.WithExternalMetadata(
+			in.ExternalMetadata,
+		))
+	}
+	if in.AmountPolicy != tarorpc.AmountPolicy_AMOUNT_POLICY_EXACT {
+		addrOpts = append(addrOpts, address.WithAmountPolicy(
+			address.AmountPolicy(in.AmountPolicy),
+		))
+	}
+	if in.Memo != "" {
+		addrOpts = append(addrOpts, address.WithMemo(in.Memo))
+	}
+	if in.ScriptVersion < 0 || in.ScriptVersion > math.MaxUint16 {
+		return nil, address.ErrUnknownScriptVersion
+	}
+	addrOpts = append(addrOpts, address.WithScriptVersion(
+		asset.ScriptVersion(in.ScriptVersion),
+	))
 
 	// Now that we have all the params, we'll try to add a new address to
 	// the addr book.
-	addr, err := r.cfg.AddrBook.NewAddress(
-		ctx, genesis, famKey, uint64(in.Amt),
-	)
+	var addr *address.AddrWithKeyInfo
+	if in.FamilyOnly {
+		addr, err = r.cfg.AddrBook.NewFamilyAddress(
+			ctx, famKey, genesis.Type, uint64(in.Amt), tapLeaves,
+			addrOpts...,
+		)
+	} else {
+		addr, err = r.cfg.AddrBook.NewAddress(
+			ctx, genesis, famKey, uint64(in.Amt), tapLeaves,
+			addrOpts...,
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to make new addr: %w", err)
 	}
 
 	// With our addr obtained, we'll marshal it as an RPC message then send
 	// off the response.
-	rpcAddr, err := marshalAddr(addr.Taro)
+	rpcAddr, err := marshalAddrWithKeyInfo(addr)
 	if err != nil {
 		return nil, fmt.Errorf("unable to marshal addr: %w", err)
 	}
@@ -690,13 +995,14 @@ func (r *rpcServer) VerifyProof(ctx context.Context,
 		return nil, fmt.Errorf("proof file must be specified")
 	}
 
-	var proofFile proof.File
-	err := proofFile.Decode(bytes.NewReader(in.RawProof))
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	verifier := &proof.BaseVerifier{
+		Checkpoints: r.cfg.AssetStore,
+		HeaderVerifier: tarogarden.GenHeaderVerifier(
+			r.cfg.ChainBridge,
+		),
+		VMOptions: r.cfg.VMOptions,
 	}
-
-	_, err = proofFile.Verify(ctx)
+	snapshot, err := verifier.Verify(ctx, bytes.NewReader(in.RawProof))
 	valid := err == nil
 
 	// TODO(roasbeef): also show additional final resting anchor
@@ -704,10 +1010,59 @@ func (r *rpcServer) VerifyProof(ctx context.Context,
 
 	// TODO(roasbeef): show the final resting place of the asset?
 	return &tarorpc.ProofVerifyResponse{
-		Valid: valid,
+		Valid:           valid,
+		IsBurn:          valid && snapshot.Asset.IsBurn(),
+		ValidationError: marshalValidationError(err),
 	}, nil
 }
 
+// DebugStateTransition verifies all but the last proof of the given proof
+// file, then executes the asset state transition of the last proof with
+// tracing enabled and returns the resulting trace.
+func (r *rpcServer) DebugStateTransition(ctx context.Context,
+	in *tarorpc.ProofFile) (*tarorpc.DebugStateTransitionResponse, error) {
+
+	if len(in.RawProof) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
+	}
+
+	var proofFile proof.File
+	if err := proofFile.Decode(bytes.NewReader(in.RawProof)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	// Without a trace, the state transition couldn't even be executed, so
+	// the error refers to the proof file itself.
+	trace, err := proofFile.TraceLastTransition(
+		ctx, tarogarden.GenHeaderVerifier(r.cfg.ChainBridge),
+		r.cfg.VMOptions...,
+	)
+	if trace == nil {
+		return nil, fmt.Errorf("unable to trace state transition: %w",
+			err)
+	}
+
+	resp := &tarorpc.DebugStateTransitionResponse{
+		Valid:           err == nil,
+		Steps:           make([]*tarorpc.VMTraceStep, len(trace.Steps)),
+		ValidationError: marshalValidationError(err),
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	for i, step := range trace.Steps {
+		resp.Steps[i] = &tarorpc.VMTraceStep{
+			Name:    step.Name,
+			Details: step.Details,
+		}
+		if step.Err != nil {
+			resp.Steps[i].Error = step.Err.Error()
+		}
+	}
+
+	return resp, nil
+}
+
 // ExportProof exports the latest raw proof file anchored at the specified
 // script_key.
 func (r *rpcServer) ExportProof(ctx context.Context,
@@ -737,8 +1092,41 @@ func (r *rpcServer) ExportProof(ctx context.Context,
 		return nil, err
 	}
 
+	// If the caller already has a part of the proof file, we only send
+	// them the proofs they're missing.
+	if len(in.SinceHash) == 0 {
+		return &tarorpc.ProofFile{
+			RawProof: proofBlob,
+		}, nil
+	}
+
+	if len(in.SinceHash) != sha256.Size {
+		return nil, fmt.Errorf("since hash must be %d bytes",
+			sha256.Size)
+	}
+
+	var sinceHash [sha256.Size]byte
+	copy(sinceHash[:], in.SinceHash)
+
+	var proofFile proof.File
+	if err := proofFile.Decode(bytes.NewReader(proofBlob)); err != nil {
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	suffix, err := proofFile.Suffix(sinceHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute proof file suffix: %w",
+			err)
+	}
+
+	var buf bytes.Buffer
+	if err := suffix.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("unable to encode proof file suffix: %w",
+			err)
+	}
+
 	return &tarorpc.ProofFile{
-		RawProof: proofBlob,
+		RawProof: buf.Bytes(),
 	}, nil
 }
 
@@ -765,63 +1153,670 @@ func (r *rpcServer) ImportProof(ctx context.Context,
 	return &tarorpc.ImportProofResponse{}, nil
 }
 
-// AddrReceives lists all receives for incoming asset transfers for addresses
-// that were created previously.
-func (r *rpcServer) AddrReceives(ctx context.Context,
-	in *tarorpc.AddrReceivesRequest) (*tarorpc.AddrReceivesResponse,
-	error) {
+// CompactProof rewrites the archived proof file of an asset to only contain its
+// most recent state transitions. The pruned history is replaced by a
+// checkpoint whose root is marked as trusted.
+func (r *rpcServer) CompactProof(ctx context.Context,
+	in *tarorpc.CompactProofRequest) (*tarorpc.CompactProofResponse, error) {
 
-	var sqlQuery address.EventQueryParams
+	if len(in.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
+	}
 
-	if len(in.FilterAddr) > 0 {
-		taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	scriptKey, err := btcec.ParsePubKey(in.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
 
-		addr, err := address.DecodeAddress(in.FilterAddr, &taroParams)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode addr: %w", err)
-		}
+	if len(in.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
 
-		taprootOutputKey, err := addr.TaprootOutputKey(nil)
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot key: %w",
-				err)
-		}
+	var assetID asset.ID
+	copy(assetID[:], in.AssetId)
 
-		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
-			taprootOutputKey,
-		)
+	numKeep := in.NumKeep
+	if numKeep == 0 {
+		numKeep = 1
 	}
 
-	if in.FilterStatus != tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
-		status, err := unmarshalAddrEventStatus(in.FilterStatus)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing status: %w", err)
-		}
+	loc := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	}
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
 
-		sqlQuery.StatusFrom = &status
-		sqlQuery.StatusTo = &status
+	// Before we mark a new checkpoint as trusted, we need to make sure the
+	// history we're about to prune is actually valid.
+	verifier := &proof.BaseVerifier{
+		Checkpoints: r.cfg.AssetStore,
+		HeaderVerifier: tarogarden.GenHeaderVerifier(
+			r.cfg.ChainBridge,
+		),
+		VMOptions: r.cfg.VMOptions,
+	}
+	_, err = verifier.Verify(ctx, bytes.NewReader(proofBlob))
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify proof file: %w", err)
 	}
 
-	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	var proofFile proof.File
+	err = proofFile.Decode(bytes.NewReader(proofBlob))
 	if err != nil {
-		return nil, fmt.Errorf("error querying events: %w", err)
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
 	}
 
-	resp := &tarorpc.AddrReceivesResponse{
-		Events: make([]*tarorpc.AddrEvent, len(events)),
+	checkpoint, err := proofFile.Compact(numKeep)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compact proof file: %w", err)
 	}
 
-	for idx, event := range events {
-		resp.Events[idx], err = marshalAddrEvent(event)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling event: %w",
+	rpcsLog.Infof("[CompactProof]: pruning %d state transitions from "+
+		"proof file of asset_id=%x, script_key=%x", checkpoint.NumPruned,
+		assetID[:], in.ScriptKey)
+
+	// The new checkpoint needs to be trusted before we rewrite the proof
+	// file, otherwise we wouldn't be able to verify it anymore.
+	root := checkpoint.Root()
+	err = r.cfg.AssetStore.AddTrustedCheckpoint(
+		ctx, loc, root, checkpoint.NumPruned,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store checkpoint: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := proofFile.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("unable to encode proof file: %w", err)
+	}
+
+	rewriter, ok := r.cfg.ProofArchive.(proof.Rewriter)
+	if !ok {
+		return nil, fmt.Errorf("proof archive doesn't support " +
+			"replacing proofs")
+	}
+
+	err = rewriter.ReplaceProofs(ctx, &proof.AnnotatedProof{
+		Locator: loc,
+		Blob:    buf.Bytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to replace proof file: %w", err)
+	}
+
+	return &tarorpc.CompactProofResponse{
+		CheckpointRoot: root[:],
+		NumPruned:      checkpoint.NumPruned,
+		NumProofs:      uint32(proofFile.NumProofs()),
+	}, nil
+}
+
+// TrustCheckpoint marks a proof file checkpoint root created by another party
+// as trusted, so compacted proof files starting at it can be verified.
+func (r *rpcServer) TrustCheckpoint(ctx context.Context,
+	in *tarorpc.TrustCheckpointRequest) (*tarorpc.TrustCheckpointResponse,
+	error) {
+
+	if len(in.CheckpointRoot) != sha256.Size {
+		return nil, fmt.Errorf("checkpoint root must be 32 bytes")
+	}
+
+	if len(in.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	if len(in.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
+	}
+
+	scriptKey, err := btcec.ParsePubKey(in.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+
+	var (
+		assetID asset.ID
+		root    [sha256.Size]byte
+	)
+	copy(assetID[:], in.AssetId)
+	copy(root[:], in.CheckpointRoot)
+
+	rpcsLog.Infof("[TrustCheckpoint]: trusting checkpoint root=%x of "+
+		"asset_id=%x, script_key=%x", root[:], assetID[:], in.ScriptKey)
+
+	err = r.cfg.AssetStore.AddTrustedCheckpoint(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	}, root, in.NumPruned)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store checkpoint: %w", err)
+	}
+
+	return &tarorpc.TrustCheckpointResponse{}, nil
+}
+
+// AuditProofs checks the integrity of the proof archive. Every stored proof
+// file is decoded, verified and checked against the locator it is stored
+// under. Every asset owned by the daemon must have such a valid proof file
+// whose final state matches the asset's state in the database.
+func (r *rpcServer) AuditProofs(ctx context.Context,
+	_ *tarorpc.AuditProofsRequest) (*tarorpc.AuditProofsResponse, error) {
+
+	auditor, ok := r.cfg.ProofArchive.(proof.Auditor)
+	if !ok {
+		return nil, fmt.Errorf("proof archive doesn't support audits")
+	}
+
+	results, err := auditor.AuditProofs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to audit proofs: %w", err)
+	}
+
+	assets, err := r.cfg.AssetStore.FetchAllAssets(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch assets: %w", err)
+	}
+
+	resp := &tarorpc.AuditProofsResponse{
+		NumAssets: uint32(len(assets)),
+		NumProofs: uint32(len(results)),
+	}
+	addIssue := func(loc proof.Locator, issue error) {
+		audit := &tarorpc.AssetProofAudit{
+			ScriptKey: loc.ScriptKey.SerializeCompressed(),
+			Error:     issue.Error(),
+		}
+		if loc.AssetID != nil {
+			audit.AssetId = loc.AssetID[:]
+		}
+
+		rpcsLog.Warnf("[AuditProofs]: proof of asset_id=%x, "+
+			"script_key=%x: %v", audit.AssetId, audit.ScriptKey,
+			issue)
+
+		resp.Audits = append(resp.Audits, audit)
+	}
+
+	// We first report all stored proof files that are invalid by
+	// themselves, and index all of them by the asset they belong to.
+	type proofKey struct {
+		assetID   asset.ID
+		scriptKey asset.SerializedKey
+	}
+	storedProofs := make(map[proofKey]*proof.AuditResult, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			addIssue(result.Locator, result.Err)
+		}
+
+		if result.Locator.AssetID == nil {
+			continue
+		}
+
+		key := proofKey{
+			assetID: *result.Locator.AssetID,
+			scriptKey: asset.ToSerialized(
+				&result.Locator.ScriptKey,
+			),
+		}
+		storedProofs[key] = result
+	}
+
+	// Then we make sure every owned asset has a valid proof file that
+	// matches its state in the database.
+	for _, chainAsset := range assets {
+		assetID := chainAsset.ID()
+		loc := proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: *chainAsset.ScriptKey.PubKey,
+		}
+
+		result, ok := storedProofs[proofKey{
+			assetID:   assetID,
+			scriptKey: asset.ToSerialized(&loc.ScriptKey),
+		}]
+		switch {
+		case !ok:
+			addIssue(loc, proof.ErrProofNotFound)
+			continue
+
+		// An invalid proof file was already reported above.
+		case result.Err != nil:
+			continue
+		}
+
+		err := proof.CheckSnapshot(
+			result.Snapshot, chainAsset.AnchorOutpoint,
+			chainAsset.Asset,
+		)
+		if err != nil {
+			addIssue(loc, err)
+		}
+	}
+
+	return resp, nil
+}
+
+// parseProofFilter parses the optional asset ID and family key a proof query
+// can be filtered by.
+func parseProofFilter(rawAssetID, rawFamilyKey []byte) (*asset.ID,
+	*btcec.PublicKey, error) {
+
+	var (
+		assetID   *asset.ID
+		familyKey *btcec.PublicKey
+	)
+	if len(rawAssetID) != 0 {
+		if len(rawAssetID) != 32 {
+			return nil, nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var id asset.ID
+		copy(id[:], rawAssetID)
+		assetID = &id
+	}
+
+	if len(rawFamilyKey) != 0 {
+		var err error
+		familyKey, err = btcec.ParsePubKey(rawFamilyKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid family key: %w",
 				err)
 		}
 	}
 
+	return assetID, familyKey, nil
+}
+
+// ListProofs lists the proof files in the archive, optionally filtered by
+// asset ID and/or family key.
+func (r *rpcServer) ListProofs(ctx context.Context,
+	in *tarorpc.ListProofsRequest) (*tarorpc.ListProofsResponse, error) {
+
+	assetID, familyKey, err := parseProofFilter(in.AssetId, in.FamilyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := r.cfg.ProofArchive.FetchProofs(ctx, assetID, familyKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proofs: %w", err)
+	}
+
+	resp := &tarorpc.ListProofsResponse{
+		Proofs: make([]*tarorpc.ProofLocator, len(proofs)),
+	}
+	for idx, p := range proofs {
+		loc := &tarorpc.ProofLocator{
+			ScriptKey: p.ScriptKey.SerializeCompressed(),
+		}
+		if p.AssetID != nil {
+			loc.AssetId = p.AssetID[:]
+		}
+		if p.FamilyKey != nil {
+			loc.FamilyKey = p.FamilyKey.SerializeCompressed()
+		}
+
+		resp.Proofs[idx] = loc
+	}
+
 	return resp, nil
 }
 
+// ExportProofs exports the proof files in the archive, optionally filtered by
+// asset ID and/or family key, as a single proof bundle.
+func (r *rpcServer) ExportProofs(ctx context.Context,
+	in *tarorpc.ExportProofsRequest) (*tarorpc.ExportProofsResponse, error) {
+
+	assetID, familyKey, err := parseProofFilter(in.AssetId, in.FamilyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := r.cfg.ProofArchive.FetchProofs(ctx, assetID, familyKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proofs: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := proof.WriteBundle(&buf, proofs); err != nil {
+		return nil, fmt.Errorf("unable to create proof bundle: %w", err)
+	}
+
+	return &tarorpc.ExportProofsResponse{
+		ProofBundle: buf.Bytes(),
+		NumProofs:   uint32(len(proofs)),
+	}, nil
+}
+
+// ImportProofs imports all proof files of a proof bundle. Proof files of
+// assets that are already known are skipped.
+func (r *rpcServer) ImportProofs(ctx context.Context,
+	in *tarorpc.ImportProofsRequest) (*tarorpc.ImportProofsResponse, error) {
+
+	if len(in.ProofBundle) == 0 {
+		return nil, fmt.Errorf("proof bundle must be specified")
+	}
+
+	proofs, err := proof.ReadBundle(bytes.NewReader(in.ProofBundle))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp tarorpc.ImportProofsResponse
+	for _, p := range proofs {
+		// The locator of the manifest isn't trusted, so we derive it
+		// from the proof itself. The archive verifies the proof before
+		// importing it, which makes sure the derived locator is valid.
+		loc, err := proof.LocatorFromBlob(p.Blob)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof of "+
+				"script_key=%x: %w",
+				p.ScriptKey.SerializeCompressed(), err)
+		}
+
+		_, err = r.cfg.ProofArchive.FetchProof(ctx, *loc)
+		switch {
+		case err == nil:
+			resp.NumSkipped++
+			continue
+
+		case !errors.Is(err, proof.ErrProofNotFound):
+			return nil, err
+		}
+
+		err = r.cfg.ProofArchive.ImportProofs(ctx, &proof.AnnotatedProof{
+			Locator: *loc,
+			Blob:    p.Blob,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to import proof of "+
+				"script_key=%x: %w",
+				loc.ScriptKey.SerializeCompressed(), err)
+		}
+
+		resp.NumImported++
+	}
+
+	return &resp, nil
+}
+
+// AddrReceives lists all receives for incoming asset transfers for addresses
+// that were created previously.
+func (r *rpcServer) AddrReceives(ctx context.Context,
+	in *tarorpc.AddrReceivesRequest) (*tarorpc.AddrReceivesResponse,
+	error) {
+
+	var (
+		sqlQuery address.EventQueryParams
+		err      error
+	)
+
+	sqlQuery.AddrTaprootOutputKey, err = r.addrFilterKey(in.FilterAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateMetadataFilter(
+		in.FilterExternalMetadataKey, in.FilterExternalMetadataValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlQuery.AddrLabel = in.FilterLabel
+	sqlQuery.AddrExternalMetadataKey = in.FilterExternalMetadataKey
+	sqlQuery.AddrExternalMetadataValue = in.FilterExternalMetadataValue
+
+	if in.FilterStatus != tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
+		status, err := unmarshalAddrEventStatus(in.FilterStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing status: %w", err)
+		}
+
+		sqlQuery.StatusFrom = &status
+		sqlQuery.StatusTo = &status
+	}
+
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events: %w", err)
+	}
+
+	// Wallet outputs found in recovery mode that await their proof don't
+	// belong to any address yet, so they're only listed if we don't filter
+	// by any address property.
+	if len(sqlQuery.AddrTaprootOutputKey) == 0 && in.FilterLabel == "" &&
+		in.FilterExternalMetadataKey == "" {
+
+		recoveryEvents, err := r.cfg.AddrBook.QueryRecoveryEvents(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error querying recovery "+
+				"events: %w", err)
+		}
+
+		for _, event := range recoveryEvents {
+			if sqlQuery.StatusFrom != nil &&
+				event.Status != *sqlQuery.StatusFrom {
+
+				continue
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	resp := &tarorpc.AddrReceivesResponse{
+		Events: make([]*tarorpc.AddrEvent, len(events)),
+	}
+
+	for idx, event := range events {
+		resp.Events[idx], err = marshalAddrEvent(event)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling event: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// SubscribeReceiveEvents subscribes to inbound asset transfers for addresses
+// that were created previously. An event is sent each time the status of an
+// inbound asset transfer changes.
+func (r *rpcServer) SubscribeReceiveEvents(
+	in *tarorpc.SubscribeReceiveEventsRequest,
+	stream tarorpc.Taro_SubscribeReceiveEventsServer) error {
+
+	filterKey, err := r.addrFilterKey(in.FilterAddr)
+	if err != nil {
+		return err
+	}
+
+	// If a start timestamp was given, we first deliver all existing events
+	// whose status changed since then, so clients can catch up on anything
+	// they missed while disconnected.
+	var (
+		deliverExisting = in.StartTimestamp > 0
+		deliverFrom     = address.EventQueryParams{
+			AddrTaprootOutputKey: filterKey,
+		}
+	)
+	if deliverExisting {
+		deliverFrom.UpdatedAfter = time.Unix(in.StartTimestamp, 0)
+	}
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err = r.cfg.AddrBook.RegisterEventSubscriber(
+		eventSub, deliverExisting, deliverFrom,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to events: %w", err)
+	}
+	defer func() {
+		err := r.cfg.AddrBook.RemoveEventSubscriber(eventSub)
+		if err != nil {
+			rpcsLog.Errorf("Unable to remove event subscriber: %v",
+				err)
+		}
+	}()
+
+	for {
+		select {
+		case event := <-eventSub.NewItemCreated.ChanOut():
+			// New events are delivered for all addresses, so we
+			// need to apply the address filter ourselves. Events
+			// of outputs found in recovery mode don't have an
+			// address yet, so they never match the filter.
+			if len(filterKey) > 0 {
+				if event.Addr == nil {
+					continue
+				}
+
+				eventKey := schnorr.SerializePubKey(
+					&event.Addr.TaprootOutputKey,
+				)
+				if !bytes.Equal(filterKey, eventKey) {
+					continue
+				}
+			}
+
+			rpcEvent, err := marshalAddrEvent(event)
+			if err != nil {
+				return fmt.Errorf("error marshaling event: %w",
+					err)
+			}
+
+			if err := stream.Send(rpcEvent); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// ExportAddrs exports the addresses of the address book, including the key
+// locators of their internal and script keys, as a single address backup.
+func (r *rpcServer) ExportAddrs(ctx context.Context,
+	in *tarorpc.ExportAddrsRequest) (*tarorpc.ExportAddrsResponse, error) {
+
+	err := validateMetadataFilter(
+		in.ExternalMetadataKey, in.ExternalMetadataValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	query := address.QueryParams{
+		Label:                 in.Label,
+		ExternalMetadataKey:   in.ExternalMetadataKey,
+		ExternalMetadataValue: in.ExternalMetadataValue,
+	}
+	if in.CreatedBefore > 0 {
+		query.CreatedBefore = time.Unix(in.CreatedBefore, 0)
+	}
+	if in.CreatedAfter > 0 {
+		query.CreatedAfter = time.Unix(in.CreatedAfter, 0)
+	}
+
+	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
+	}
+
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	for idx := range dbAddrs {
+		dbAddrs[idx].ChainParams = &taroParams
+	}
+
+	var buf bytes.Buffer
+	if err := address.WriteBackup(&buf, dbAddrs); err != nil {
+		return nil, fmt.Errorf("unable to write addr backup: %w", err)
+	}
+
+	rpcsLog.Debugf("[ExportAddrs]: exported %d addrs", len(dbAddrs))
+
+	return &tarorpc.ExportAddrsResponse{
+		AddrBackup: buf.Bytes(),
+		NumAddrs:   uint32(len(dbAddrs)),
+	}, nil
+}
+
+// ImportAddrs imports all addresses of an address backup. Addresses that are
+// already known are skipped.
+func (r *rpcServer) ImportAddrs(ctx context.Context,
+	in *tarorpc.ImportAddrsRequest) (*tarorpc.ImportAddrsResponse, error) {
+
+	if len(in.AddrBackup) == 0 {
+		return nil, fmt.Errorf("addr backup must be specified")
+	}
+
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	addrs, err := address.ReadBackup(
+		bytes.NewReader(in.AddrBackup), &taroParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	importedAddrs, err := r.cfg.AddrBook.ImportAddrs(ctx, addrs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import addrs: %w", err)
+	}
+
+	rpcsLog.Infof("[ImportAddrs]: imported %d of %d addrs",
+		len(importedAddrs), len(addrs))
+
+	resp := &tarorpc.ImportAddrsResponse{
+		ImportedAddrs: make([]*tarorpc.Addr, len(importedAddrs)),
+		NumSkipped:    uint32(len(addrs) - len(importedAddrs)),
+	}
+	for idx := range importedAddrs {
+		resp.ImportedAddrs[idx], err = marshalAddrWithKeyInfo(
+			&importedAddrs[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal addr: %w",
+				err)
+		}
+	}
+
+	return resp, nil
+}
+
+// addrFilterKey decodes the given Taro address and returns the serialized
+// Taproot output key to filter address events by. If no address is given, nil
+// is returned.
+func (r *rpcServer) addrFilterKey(filterAddr string) ([]byte, error) {
+	if len(filterAddr) == 0 {
+		return nil, nil
+	}
+
+	taroParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+	addr, err := address.DecodeAddress(filterAddr, &taroParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
+	}
+
+	taprootOutputKey, err := addr.TaprootOutputKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving Taproot key: %w", err)
+	}
+
+	return schnorr.SerializePubKey(taprootOutputKey), nil
+}
+
 // marshalAddr turns an address into its RPC counterpart.
 func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 	addrStr, err := addr.EncodeAddress()
@@ -835,15 +1830,24 @@ func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 			err)
 	}
 
-	id := addr.ID()
 	rpcAddr := &tarorpc.Addr{
 		Encoded:          addrStr,
-		AssetId:          id[:],
 		AssetType:        tarorpc.AssetType(addr.Type),
 		Amount:           int64(addr.Amount),
 		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
 		InternalKey:      addr.InternalKey.SerializeCompressed(),
 		TaprootOutputKey: schnorr.SerializePubKey(taprootOutputKey),
+		FamilyOnly:       addr.IsFamilyAddr(),
+		AmountPolicy:     tarorpc.AmountPolicy(addr.AmountPolicy),
+		Memo:             addr.Memo,
+		MemoSigned:       addr.MemoSig != nil,
+		ScriptVersion:    int32(addr.ScriptVersion),
+	}
+
+	// A family address doesn't commit to a specific asset ID.
+	if !rpcAddr.FamilyOnly {
+		id := addr.ID()
+		rpcAddr.AssetId = id[:]
 	}
 
 	if addr.FamilyKey != nil {
@@ -853,11 +1857,61 @@ func marshalAddr(addr *address.Taro) (*tarorpc.Addr, error) {
 	return rpcAddr, nil
 }
 
+// validateMetadataFilter makes sure an external metadata value is only used as
+// a filter in combination with the key it belongs to.
+func validateMetadataFilter(key, value string) error {
+	if value != "" && key == "" {
+		return fmt.Errorf("external metadata value filter requires a " +
+			"key")
+	}
+
+	return nil
+}
+
+// marshalAddrWithKeyInfo turns an address from our address book into its RPC
+// counterpart, including the properties only known to us.
+func marshalAddrWithKeyInfo(
+	addr *address.AddrWithKeyInfo) (*tarorpc.Addr, error) {
+
+	rpcAddr, err := marshalAddr(addr.Taro)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addr.ExpiryTime.IsZero() {
+		rpcAddr.ExpiryTimeUnix = addr.ExpiryTime.Unix()
+	}
+	rpcAddr.SingleUse = addr.SingleUse
+	rpcAddr.Label = addr.Label
+	rpcAddr.ExternalMetadata = addr.ExternalMetadata
+
+	return rpcAddr, nil
+}
+
+// unmarshalTapLeaf parses a tapscript leaf from its RPC counterpart.
+func unmarshalTapLeaf(rpcLeaf *tarorpc.TapLeaf) (*txscript.TapLeaf, error) {
+	if rpcLeaf == nil || len(rpcLeaf.Script) == 0 {
+		return nil, fmt.Errorf("tapscript leaf must have a script")
+	}
+
+	tapLeaf := txscript.NewBaseTapLeaf(rpcLeaf.Script)
+	return &tapLeaf, nil
+}
+
 // marshalAddrEvent turns an address event into its RPC counterpart.
 func marshalAddrEvent(event *address.Event) (*tarorpc.AddrEvent, error) {
-	rpcAddr, err := marshalAddr(event.Addr.Taro)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling addr: %w", err)
+	// Events of wallet outputs found in recovery mode don't have an
+	// address until their proof is imported.
+	var (
+		rpcAddr *tarorpc.Addr
+		err     error
+	)
+	if event.Addr != nil {
+		rpcAddr, err = marshalAddrWithKeyInfo(event.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling addr: %w",
+				err)
+		}
 	}
 
 	rpcStatus, err := marshalAddrEventStatus(event.Status)
@@ -874,6 +1928,7 @@ func marshalAddrEvent(event *address.Event) (*tarorpc.AddrEvent, error) {
 		TaprootSibling:          event.TapscriptSibling,
 		ConfirmationHeight:      event.ConfirmationHeight,
 		HasProof:                event.HasProof,
+		AssetAmount:             event.AssetAmount,
 	}, nil
 }
 
@@ -889,12 +1944,18 @@ func unmarshalAddrEventStatus(
 	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
 		return address.StatusTransactionConfirmed, nil
 
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FINAL:
+		return address.StatusTransactionFinal, nil
+
 	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
 		return address.StatusProofReceived, nil
 
 	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
 		return address.StatusCompleted, nil
 
+	case tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_LATE_PAYMENT:
+		return address.StatusLatePayment, nil
+
 	default:
 		return 0, fmt.Errorf("unknown address event status <%d>",
 			rpcStatus)
@@ -915,6 +1976,10 @@ func marshalAddrEventStatus(status address.Status) (tarorpc.AddrEventStatus,
 		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
 			nil
 
+	case address.StatusTransactionFinal:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FINAL,
+			nil
+
 	case address.StatusProofReceived:
 		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
 			nil
@@ -922,6 +1987,10 @@ func marshalAddrEventStatus(status address.Status) (tarorpc.AddrEventStatus,
 	case address.StatusCompleted:
 		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
 
+	case address.StatusLatePayment:
+		return tarorpc.AddrEventStatus_ADDR_EVENT_STATUS_LATE_PAYMENT,
+			nil
+
 	default:
 		return 0, fmt.Errorf("unknown address event status <%d>",
 			status)
@@ -944,13 +2013,65 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 		return nil, err
 	}
 
+	// The amount of an address that doesn't require an exact amount is
+	// chosen by us, so we bind the address to that amount before we
+	// construct the send.
+	switch {
+	case taroAddr.AmountPolicy == address.AmountExact:
+		if in.Amount != 0 && in.Amount != taroAddr.Amount {
+			return nil, fmt.Errorf("amount %d doesn't match the "+
+				"requested amount %d of the addr", in.Amount,
+				taroAddr.Amount)
+		}
+
+	default:
+		amt := in.Amount
+		if amt == 0 {
+			amt = taroAddr.Amount
+		}
+
+		boundAddr, err := taroAddr.BindAmount(amt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to send %d units to "+
+				"addr with amount policy %v: %w", amt,
+				taroAddr.AmountPolicy, err)
+		}
+		taroAddr = boundAddr
+	}
+
+	var tapscriptSpend *taroscript.TapscriptSpend
+	if in.TapscriptSpend != nil {
+		tapLeaf, err := unmarshalTapLeaf(in.TapscriptSpend.Leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		tapscriptSpend = &taroscript.TapscriptSpend{
+			Leaf:          *tapLeaf,
+			WitnessItems:  in.TapscriptSpend.WitnessItems,
+			SkipSignature: in.TapscriptSpend.SkipSignature,
+		}
+	}
+
 	resp, err := r.cfg.ChainPorter.RequestShipment(&tarofreighter.AssetParcel{
-		Dest: taroAddr,
+		Dest:             taroAddr,
+		TapscriptSpend:   tapscriptSpend,
+		NumConfs:         in.NumConfs,
+		Label:            in.Label,
+		ExternalMetadata: in.ExternalMetadata,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	return marshalSendAssetResponse(resp)
+}
+
+// marshalSendAssetResponse turns a pending parcel of a completed send into its
+// RPC response.
+func marshalSendAssetResponse(
+	resp *tarofreighter.PendingParcel) (*tarorpc.SendAssetResponse, error) {
+
 	transferTXID := resp.TransferTx.TxHash()
 
 	var txBuf bytes.Buffer
@@ -997,3 +2118,45 @@ func (r *rpcServer) SendAsset(ctx context.Context,
 		TotalFeeSats: int64(resp.TotalFees),
 	}, nil
 }
+
+// BurnAsset burns the given amount of an asset by sending it to a provably
+// unspendable script key that is derived from the spent input.
+func (r *rpcServer) BurnAsset(ctx context.Context,
+	in *tarorpc.BurnAssetRequest) (*tarorpc.BurnAssetResponse, error) {
+
+	if len(in.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+	if in.AmountToBurn <= 0 {
+		return nil, fmt.Errorf("amount to burn must be positive")
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], in.AssetId)
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(&tarofreighter.AssetParcel{
+		Burn: &tarofreighter.BurnRequest{
+			AssetID: assetID,
+			Amount:  uint64(in.AmountToBurn),
+		},
+		NumConfs: in.NumConfs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Burns) != 1 {
+		return nil, fmt.Errorf("expected one burn, got %d",
+			len(resp.Burns))
+	}
+
+	burnTransfer, err := marshalSendAssetResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tarorpc.BurnAssetResponse{
+		BurnTransfer: burnTransfer,
+		BurnKey:      resp.Burns[0].BurnKey.SerializeCompressed(),
+	}, nil
+}
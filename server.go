@@ -149,6 +149,13 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 
 	rpcServerOpts := interceptorChain.CreateServerOpts()
 	serverOpts = append(serverOpts, rpcServerOpts...)
+
+	// Validation errors are converted to gRPC status errors with
+	// machine-readable details by the innermost interceptor, after the
+	// main interceptor chain.
+	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(
+		validationErrorUnaryServerInterceptor(),
+	))
 	serverOpts = append(
 		serverOpts, grpc.MaxRecvMsgSize(lnrpc.MaxGrpcMsgSize),
 	)
@@ -190,7 +197,13 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 	// TODO(roasbeef): make macaroons service, needs the lnd APIs present
 	// an abstracted
 
-	// First, we'll start the main batched asset minter.
+	// First, we'll start the re-org watcher, as the other sub-systems
+	// hand confirmed transactions over to it.
+	if err := s.cfg.ReOrgWatcher.Start(); err != nil {
+		return mkErr("unable to start re-org watcher: %v", err)
+	}
+
+	// Next, we'll start the main batched asset minter.
 	if err := s.cfg.AssetMinter.Start(); err != nil {
 		return mkErr("unable to start asset minter: %v", err)
 	}
@@ -407,6 +420,10 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.ReOrgWatcher.Stop(); err != nil {
+		return err
+	}
+
 	close(s.quit)
 
 	s.wg.Wait()
@@ -57,6 +57,14 @@ const (
 	// batch.
 	defaultBatchMintingInterval = time.Minute * 10
 
+	// defaultNumConfs is the default number of confirmations a mint, send
+	// or receive transaction needs before it's considered final.
+	defaultNumConfs = 1
+
+	// defaultAddrExpiryInterval is the interval at which the custodian
+	// checks for addresses that expired and stops managing them.
+	defaultAddrExpiryInterval = time.Minute
+
 	// defaultHashMailAddr is the default address we'll use to deliver
 	// optionally deliver proofs for asynchronous sends.
 	defaultHashMailAddr = "mailbox.terminal.lightning.today:443"
@@ -199,8 +207,16 @@ type Config struct {
 
 	BatchMintingInterval time.Duration `long:"batch-minting-interval" description:"A duration (1m, 2h, etc) that governs how frequently pending assets are gather into a batch to be minted."`
 
+	MintNumConfs    uint32 `long:"mint-num-confs" description:"The number of confirmations a minting transaction needs before the minted assets are considered final. Can be overridden per mint request."`
+	SendNumConfs    uint32 `long:"send-num-confs" description:"The number of confirmations a transfer transaction needs before an outbound transfer is considered complete. Can be overridden per send request."`
+	ReceiveNumConfs uint32 `long:"receive-num-confs" description:"The number of confirmations the transaction of an inbound transfer needs before it is considered final and the received assets are taken into custody."`
+
+	RecoveryWindow uint32 `long:"recovery-window" description:"If set, starts in address recovery mode to restore addresses that were lost from the database. The keys of the Taro key family are re-derived up to this many keys beyond the last one found in use, and the wallet is rescanned for outputs that await the proof of an inbound transfer to a lost address. Until their proof is received through the proof courier or imported manually, these outputs are listed as receive events without an address. Only addresses with a BIP 86 script key and no tapscript leaves can be recovered, and they're restored as addresses that request exactly the received asset."`
+
 	HashMailAddr string `long:"hashmailaddr" description:"The full host:port that should be used to optionally deliver proofs files for asynchronous sends"`
 
+	ExperimentalScriptV1 bool `long:"experimental-scriptv1" description:"Accept asset inputs committing to Taro script version 1, which adds asset-aware opcodes to tapscript. Experimental, assets using it are rejected by nodes that don't enable it"`
+
 	ChainConf *ChainConfig
 	RpcConf   *RpcConfig
 
@@ -264,6 +280,9 @@ func DefaultConfig() Config {
 		},
 		LogWriter:            build.NewRotatingLogWriter(),
 		BatchMintingInterval: defaultBatchMintingInterval,
+		MintNumConfs:         defaultNumConfs,
+		SendNumConfs:         defaultNumConfs,
+		ReceiveNumConfs:      defaultNumConfs,
 		HashMailAddr:         defaultHashMailAddr,
 	}
 }
@@ -483,6 +502,15 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor) (*Config,
 			cfg.ChainConf.Network))
 	}
 
+	// A transaction needs to be confirmed at least once before it can be
+	// considered final.
+	if cfg.MintNumConfs == 0 || cfg.SendNumConfs == 0 ||
+		cfg.ReceiveNumConfs == 0 {
+
+		return nil, nil, mkErr("the number of confirmations for " +
+			"mints, sends and receives must be at least 1")
+	}
+
 	// Validate profile port or host:port.
 	if cfg.Profile != "" {
 		str := "%s: The profile port must be between 1024 and 65535"
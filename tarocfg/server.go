@@ -1,10 +1,13 @@
 package tarocfg
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"path/filepath"
 
 	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro"
 	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/proof"
@@ -12,7 +15,9 @@ import (
 	"github.com/lightninglabs/taro/tarodb/sqlc"
 	"github.com/lightninglabs/taro/tarofreighter"
 	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/lightninglabs/taro/vm"
 	"github.com/lightningnetwork/lnd"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -40,6 +45,14 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 			err)
 	}
 
+	// Script version 1 is still behind a feature flag, so we only accept
+	// inputs committing to it if the user opted in.
+	var vmOpts []vm.EngineOption
+	if cfg.ExperimentalScriptV1 {
+		cfgLogger.Warnf("Experimental script version 1 enabled")
+		vmOpts = append(vmOpts, vm.WithScriptV1())
+	}
+
 	// Now that we know where the database will live, we'll go ahead and
 	// open up the default implementation of it.
 	var db databaseBackend
@@ -101,7 +114,21 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	keyRing := taro.NewLndRpcKeyRing(lndServices)
 	walletAnchor := taro.NewLndRpcWalletAnchor(lndServices)
-	chainBridge := taro.NewLndRpcChainBridge(lndServices)
+
+	// The lndclient chain notifier doesn't deliver re-org events for
+	// confirmations, so the chain bridge uses a raw RPC client for those.
+	chainConn, err := lndclient.NewBasicConn(
+		cfg.Lnd.Host, cfg.Lnd.TLSPath,
+		filepath.Dir(cfg.Lnd.MacaroonPath), cfg.ChainConf.Network,
+		lndclient.MacFilename(filepath.Base(cfg.Lnd.MacaroonPath)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to lnd chain "+
+			"notifier: %v", err)
+	}
+	chainBridge := taro.NewLndRpcChainBridge(
+		lndServices, chainrpc.NewChainNotifierClient(chainConn),
+	)
 
 	cfgLogger.Infof("lnd connection initialized")
 
@@ -109,18 +136,41 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		Store:        tarodbAddrBook,
 		StoreTimeout: tarodb.DefaultStoreTimeout,
 		KeyRing:      keyRing,
+		MemoSigner:   keyRing,
 		Chain:        taroChainParams,
 	})
 
 	assetStore := tarodb.NewAssetStore(assetDB)
 
-	proofFileStore, err := proof.NewFileArchiver(cfg.networkDir)
+	// Proof files used to be stored as full blobs, we make sure all of
+	// them are moved into the deduplicated proof archive before using it.
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), tarodb.DefaultStoreTimeout,
+	)
+	err = assetStore.MigrateLegacyProofs(ctxt)
+	cancel()
 	if err != nil {
-		return nil, fmt.Errorf("unable to open disk archive: %v", err)
+		return nil, fmt.Errorf("unable to migrate legacy proofs: %v",
+			err)
 	}
+
+	headerVerifier := tarogarden.GenHeaderVerifier(chainBridge)
 	proofArchive := proof.NewMultiArchiver(
-		&proof.BaseVerifier{}, tarodb.DefaultStoreTimeout,
-		assetStore, proofFileStore,
+		&proof.BaseVerifier{
+			Checkpoints:    assetStore,
+			HeaderVerifier: headerVerifier,
+			VMOptions:      vmOpts,
+		}, tarodb.DefaultStoreTimeout, assetStore,
+	)
+
+	reOrgWatcher := tarogarden.NewReOrgWatcher(
+		&tarogarden.ReOrgWatcherConfig{
+			ChainBridge:  chainBridge,
+			AnchorStore:  assetStore,
+			ProofArchive: proofArchive,
+			SafeDepth:    tarogarden.DefaultReOrgSafeDepth,
+			ErrChan:      mainErrChan,
+		},
 	)
 
 	var hashMailCourier proof.Courier[address.Taro]
@@ -149,7 +199,10 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 				GenSigner: taro.NewLndRpcGenSigner(
 					lndServices,
 				),
-				ProofFiles: proofFileStore,
+				ProofFiles:     proofArchive,
+				HeaderVerifier: headerVerifier,
+				ReOrgWatcher:   reOrgWatcher,
+				NumConfs:       cfg.MintNumConfs,
 			},
 			BatchTicker: ticker.New(cfg.BatchMintingInterval),
 			ErrChan:     mainErrChan,
@@ -163,21 +216,35 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 				ProofArchive: proofArchive,
 				ErrChan:      mainErrChan,
 				ProofCourier: hashMailCourier,
+				ReOrgWatcher: reOrgWatcher,
+				NumConfs:     cfg.ReceiveNumConfs,
+				ExpiryTicker: ticker.New(
+					defaultAddrExpiryInterval,
+				),
+				KeyRing:        keyRing,
+				RecoveryWindow: cfg.RecoveryWindow,
 			},
 		),
+		ReOrgWatcher: reOrgWatcher,
+		ChainBridge:  chainBridge,
 		AddrBook:     addrBook,
 		ProofArchive: proofArchive,
+		VMOptions:    vmOpts,
 		ChainPorter: tarofreighter.NewChainPorter(&tarofreighter.ChainPorterConfig{
 			CoinSelector: assetStore,
 			Signer:       taro.NewLndRpcVirtualTxSigner(lndServices),
-			TxValidator:  &taro.ValidatorV0{},
+			TxValidator: &taro.ValidatorV0{
+				VMOptions: vmOpts,
+			},
 			ExportLog:    assetStore,
 			ChainBridge:  chainBridge,
 			Wallet:       walletAnchor,
 			KeyRing:      keyRing,
 			ChainParams:  &taroChainParams,
-			AssetProofs:  proofFileStore,
+			AssetProofs:  proofArchive,
 			ProofCourier: hashMailCourier,
+			ReOrgWatcher: reOrgWatcher,
+			NumConfs:     cfg.SendNumConfs,
 		}),
 		SignalInterceptor: shutdownInterceptor,
 		LogWriter:         cfg.LogWriter,
@@ -37,13 +37,29 @@ type (
 	// Taproot output key.
 	AddrByTaprootOutput = sqlc.FetchAddrByTaprootOutputKeyRow
 
+	// AddrByScriptKey is a type alias for returning an address by its
+	// script key.
+	AddrByScriptKey = sqlc.FetchAddrByScriptKeyRow
+
 	// AddrManaged is a type alias for setting an address as managed.
 	AddrManaged = sqlc.SetAddrManagedParams
 
+	// AddrMetadata is a type alias for inserting or updating an external
+	// metadata entry of an address.
+	AddrMetadata = sqlc.UpsertAddrMetadataParams
+
+	// AddrMetadataEntry is a type alias for fetching an external metadata
+	// entry of an address.
+	AddrMetadataEntry = sqlc.FetchAddrMetadataRow
+
 	// UpsertAddrEvent is a type alias for creating a new address event or
 	// updating an existing one.
 	UpsertAddrEvent = sqlc.UpsertAddrEventParams
 
+	// AddrEventStatus is a type alias for updating the status of an
+	// existing address event.
+	AddrEventStatus = sqlc.UpdateAddrEventStatusParams
+
 	// AddrEvent is a type alias for fetching an address event row.
 	AddrEvent = sqlc.FetchAddrEventRow
 
@@ -55,6 +71,26 @@ type (
 	// and its corresponding address.
 	AddrEventID = sqlc.QueryEventIDsRow
 
+	// RecoveryOutput is a type alias for storing a wallet output that
+	// might belong to a lost address.
+	RecoveryOutput = sqlc.UpsertRecoveryOutputParams
+
+	// RecoveryOutputRow is a type alias for fetching a wallet output that
+	// might belong to a lost address.
+	RecoveryOutputRow = sqlc.QueryRecoveryOutputsRow
+
+	// AssetByAnchorQuery is a type alias for a query of an asset by its
+	// script key and anchor outpoint.
+	AssetByAnchorQuery = sqlc.FetchAssetByScriptKeyAnchorParams
+
+	// AssetByAnchor is a type alias for the primary key and asset ID of an
+	// asset fetched by its script key and anchor outpoint.
+	AssetByAnchor = sqlc.FetchAssetByScriptKeyAnchorRow
+
+	// RecoveryOutputID is a type alias for deleting a wallet output that
+	// might belong to a lost address.
+	RecoveryOutputID = sqlc.DeleteRecoveryOutputParams
+
 	// Genesis is a type alias for fetching the genesis asset information.
 	Genesis = sqlc.FetchGenesisByIDRow
 )
@@ -82,9 +118,23 @@ type AddrBook interface {
 	FetchAddrByTaprootOutputKey(ctx context.Context,
 		arg []byte) (AddrByTaprootOutput, error)
 
+	// FetchAddrByScriptKey returns a single address based on its script
+	// key or a sql.ErrNoRows error if no such address exists.
+	FetchAddrByScriptKey(ctx context.Context,
+		tweakedScriptKey []byte) (AddrByScriptKey, error)
+
 	// InsertAddr inserts a new address into the database.
 	InsertAddr(ctx context.Context, arg NewAddr) (int32, error)
 
+	// UpsertAddrMetadata inserts a new or updates an existing external
+	// metadata entry of an address.
+	UpsertAddrMetadata(ctx context.Context, arg AddrMetadata) error
+
+	// FetchAddrMetadata returns all external metadata entries of the
+	// address with the given primary key.
+	FetchAddrMetadata(ctx context.Context,
+		addrID int32) ([]AddrMetadataEntry, error)
+
 	// UpsertInternalKey inserts a new or updates an existing internal key
 	// into the database and returns the primary key.
 	UpsertInternalKey(ctx context.Context, arg InternalKey) (int32, error)
@@ -109,6 +159,10 @@ type AddrBook interface {
 	// and returns the primary key.
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEvent) (int32, error)
 
+	// UpdateAddrEventStatus updates the status of an existing address
+	// event.
+	UpdateAddrEventStatus(ctx context.Context, arg AddrEventStatus) error
+
 	// FetchAddrEvent returns a single address event based on its primary
 	// key.
 	FetchAddrEvent(ctx context.Context, id int32) (AddrEvent, error)
@@ -118,10 +172,28 @@ type AddrBook interface {
 	QueryEventIDs(ctx context.Context, query AddrEventQuery) ([]AddrEventID,
 		error)
 
-	// FetchAssetProof fetches the asset proof for a given asset identified
-	// by its script key.
-	FetchAssetProof(ctx context.Context, scriptKey []byte) (AssetProofI,
+	// UpsertRecoveryOutput inserts a wallet output that might belong to a
+	// lost address, if it isn't known yet.
+	UpsertRecoveryOutput(ctx context.Context, arg RecoveryOutput) error
+
+	// QueryRecoveryOutputs returns all wallet outputs that might belong to
+	// a lost address.
+	QueryRecoveryOutputs(ctx context.Context) ([]RecoveryOutputRow, error)
+
+	// DeleteRecoveryOutput deletes a wallet output that might belong to a
+	// lost address.
+	DeleteRecoveryOutput(ctx context.Context, arg RecoveryOutputID) error
+
+	// FetchProofFile fetches the metadata of the proof file of the asset
+	// with the given asset ID and script key.
+	FetchProofFile(ctx context.Context, arg ProofFileID) (ProofFileRow,
 		error)
+
+	// FetchAssetByScriptKeyAnchor fetches the primary key and asset ID of
+	// the asset with the given script key that is anchored in the given
+	// outpoint, optionally filtered by asset ID.
+	FetchAssetByScriptKeyAnchor(ctx context.Context,
+		arg AssetByAnchorQuery) (AssetByAnchor, error)
 }
 
 // AddrBookTxOptions defines the set of db txn options the AddrBook
@@ -217,10 +289,18 @@ func (t *TaroAddressBook) InsertAddrs(ctx context.Context,
 				return fmt.Errorf("unable to insert internal "+
 					"script key: %w", err)
 			}
+			tapscriptTree, err := asset.EncodeTapLeaves(
+				addr.ScriptKeyTweak.TapLeaves,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to encode tapscript "+
+					"tree: %w", err)
+			}
 			scriptKeyID, err := db.UpsertScriptKey(ctx, NewScriptKey{
 				InternalKeyID:    rawScriptKeyID,
 				TweakedScriptKey: addr.ScriptKey.SerializeCompressed(),
 				Tweak:            addr.ScriptKeyTweak.Tweak,
+				TapscriptTree:    tapscriptTree,
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert script "+
@@ -239,7 +319,11 @@ func (t *TaroAddressBook) InsertAddrs(ctx context.Context,
 			if addr.FamilyKey != nil {
 				famKeyBytes = addr.FamilyKey.SerializeCompressed()
 			}
-			_, err = db.InsertAddr(ctx, NewAddr{
+			var memoSigBytes []byte
+			if addr.MemoSig != nil {
+				memoSigBytes = addr.MemoSig.Serialize()
+			}
+			addrID, err := db.InsertAddr(ctx, NewAddr{
 				Version:        int16(addr.Version),
 				GenesisAssetID: genAssetID,
 				FamKey:         famKeyBytes,
@@ -251,11 +335,41 @@ func (t *TaroAddressBook) InsertAddrs(ctx context.Context,
 				Amount:       int64(addr.Amount),
 				AssetType:    int16(addr.Type),
 				CreationTime: addr.CreationTime.UTC(),
+				ExpiryTime: sql.NullTime{
+					Time:  addr.ExpiryTime.UTC(),
+					Valid: !addr.ExpiryTime.IsZero(),
+				},
+				SingleUse:    addr.SingleUse,
+				Label:        addr.Label,
+				AmountPolicy: int16(addr.AmountPolicy),
+				Memo:         addr.Memo,
+				MemoSig:      memoSigBytes,
+				ScriptVersion: int32(
+					addr.ScriptVersion,
+				),
+				FamilyOnly: addr.FamilyOnly,
+				ManagedFrom: sql.NullTime{
+					Time:  addr.ManagedAfter.UTC(),
+					Valid: !addr.ManagedAfter.IsZero(),
+				},
+				WatchOnly: addr.WatchOnly,
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert addr: %w",
 					err)
 			}
+
+			for key, value := range addr.ExternalMetadata {
+				err := db.UpsertAddrMetadata(ctx, AddrMetadata{
+					AddrID:    addrID,
+					MetaKey:   key,
+					MetaValue: value,
+				})
+				if err != nil {
+					return fmt.Errorf("unable to insert "+
+						"addr metadata: %w", err)
+				}
+			}
 		}
 
 		return nil
@@ -295,6 +409,13 @@ func (t *TaroAddressBook) QueryAddrs(ctx context.Context,
 			NumOffset:     int32(params.Offset),
 			NumLimit:      limit,
 			UnmanagedOnly: params.UnmanagedOnly,
+			ExpiredBefore: sql.NullTime{
+				Time:  params.ExpiredBefore.UTC(),
+				Valid: !params.ExpiredBefore.IsZero(),
+			},
+			Label:     sqlOptStr(params.Label),
+			MetaKey:   sqlOptStr(params.ExternalMetadataKey),
+			MetaValue: sqlOptStr(params.ExternalMetadataValue),
 		})
 		if err != nil {
 			return err
@@ -357,6 +478,13 @@ func (t *TaroAddressBook) QueryAddrs(ctx context.Context,
 			if err != nil {
 				return err
 			}
+			tapLeaves, err := asset.DecodeTapLeaves(
+				addr.ScriptKeyTapscriptTree,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"tapscript tree: %w", err)
+			}
 
 			taprootOutputKey, err := schnorr.ParsePubKey(
 				addr.TaprootOutputKey,
@@ -366,24 +494,55 @@ func (t *TaroAddressBook) QueryAddrs(ctx context.Context,
 					"output key: %w", err)
 			}
 
+			var memoSig *schnorr.Signature
+			if len(addr.MemoSig) != 0 {
+				memoSig, err = schnorr.ParseSignature(
+					addr.MemoSig,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to decode "+
+						"memo sig: %w", err)
+				}
+			}
+
+			metadata, err := fetchAddrMetadata(ctx, db, addr.AddrID)
+			if err != nil {
+				return err
+			}
+
 			addrs = append(addrs, address.AddrWithKeyInfo{
 				Taro: &address.Taro{
 					Version:     asset.Version(addr.Version),
 					Genesis:     assetGenesis,
 					FamilyKey:   famKey,
+					FamilyOnly:  addr.FamilyOnly,
 					ScriptKey:   *scriptKey,
 					InternalKey: *internalKey,
 					Amount:      uint64(addr.Amount),
+					AmountPolicy: address.AmountPolicy(
+						addr.AmountPolicy,
+					),
+					Memo:    addr.Memo,
+					MemoSig: memoSig,
+					ScriptVersion: asset.ScriptVersion(
+						addr.ScriptVersion,
+					),
 					ChainParams: t.params,
 				},
 				ScriptKeyTweak: asset.TweakedScriptKey{
-					RawKey: rawScriptKeyDesc,
-					Tweak:  addr.ScriptKeyTweak,
+					RawKey:    rawScriptKeyDesc,
+					Tweak:     addr.ScriptKeyTweak,
+					TapLeaves: tapLeaves,
 				},
 				InternalKeyDesc:  internalKeyDesc,
 				TaprootOutputKey: *taprootOutputKey,
 				CreationTime:     addr.CreationTime.UTC(),
 				ManagedAfter:     addr.ManagedFrom.Time.UTC(),
+				ExpiryTime:       addr.ExpiryTime.Time.UTC(),
+				SingleUse:        addr.SingleUse,
+				WatchOnly:        addr.WatchOnly,
+				Label:            addr.Label,
+				ExternalMetadata: metadata,
 			})
 		}
 
@@ -417,6 +576,27 @@ func (t *TaroAddressBook) AddrByTaprootOutput(ctx context.Context,
 	return addr, nil
 }
 
+// AddrByScriptKey returns a single address based on its script key or an
+// address.ErrNoAddr error if no such address exists.
+func (t *TaroAddressBook) AddrByScriptKey(ctx context.Context,
+	key *btcec.PublicKey) (*address.AddrWithKeyInfo, error) {
+
+	var (
+		addr     *address.AddrWithKeyInfo
+		readOpts = NewAddrBookReadTx()
+	)
+	err := t.db.ExecTx(ctx, &readOpts, func(db AddrBook) error {
+		var err error
+		addr, err = fetchAddrByScriptKey(ctx, db, t.params, key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
 // fetchAddr fetches a single address identified by its taproot output key from
 // the database and populates all its fields.
 func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
@@ -433,6 +613,43 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		return nil, err
 	}
 
+	return parseAddr(ctx, db, params, dbAddr, taprootOutputKey)
+}
+
+// fetchAddrByScriptKey fetches a single address identified by its script key
+// from the database and populates all its fields.
+func fetchAddrByScriptKey(ctx context.Context, db AddrBook,
+	params *address.ChainParams,
+	scriptKey *btcec.PublicKey) (*address.AddrWithKeyInfo, error) {
+
+	dbAddr, err := db.FetchAddrByScriptKey(
+		ctx, scriptKey.SerializeCompressed(),
+	)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, address.ErrNoAddr
+
+	case err != nil:
+		return nil, err
+	}
+
+	taprootOutputKey, err := schnorr.ParsePubKey(dbAddr.TaprootOutputKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode taproot output key: "+
+			"%w", err)
+	}
+
+	return parseAddr(
+		ctx, db, params, AddrByTaprootOutput(dbAddr), taprootOutputKey,
+	)
+}
+
+// parseAddr populates all fields of the given address row, including the ones
+// stored in other tables.
+func parseAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
+	dbAddr AddrByTaprootOutput,
+	taprootOutputKey *btcec.PublicKey) (*address.AddrWithKeyInfo, error) {
+
 	genesis, err := fetchGenesis(ctx, db, dbAddr.GenesisAssetID)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching genesis: %w", err)
@@ -465,6 +682,11 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode script key: %w", err)
 	}
+	tapLeaves, err := asset.DecodeTapLeaves(dbAddr.ScriptKeyTapscriptTree)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode tapscript tree: %w",
+			err)
+	}
 
 	internalKey, err := btcec.ParsePubKey(dbAddr.RawTaprootKey)
 	if err != nil {
@@ -480,28 +702,78 @@ func fetchAddr(ctx context.Context, db AddrBook, params *address.ChainParams,
 		PubKey: internalKey,
 	}
 
+	var memoSig *schnorr.Signature
+	if len(dbAddr.MemoSig) != 0 {
+		memoSig, err = schnorr.ParseSignature(dbAddr.MemoSig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode memo sig: %w",
+				err)
+		}
+	}
+
+	metadata, err := fetchAddrMetadata(ctx, db, dbAddr.AddrID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &address.AddrWithKeyInfo{
 		Taro: &address.Taro{
-			Version:     asset.Version(dbAddr.Version),
-			Genesis:     genesis,
-			FamilyKey:   famKey,
-			ScriptKey:   *scriptKey,
-			InternalKey: *internalKey,
-			Amount:      uint64(dbAddr.Amount),
+			Version:      asset.Version(dbAddr.Version),
+			Genesis:      genesis,
+			FamilyKey:    famKey,
+			FamilyOnly:   dbAddr.FamilyOnly,
+			ScriptKey:    *scriptKey,
+			InternalKey:  *internalKey,
+			Amount:       uint64(dbAddr.Amount),
+			AmountPolicy: address.AmountPolicy(dbAddr.AmountPolicy),
+			Memo:         dbAddr.Memo,
+			MemoSig:      memoSig,
+			ScriptVersion: asset.ScriptVersion(
+				dbAddr.ScriptVersion,
+			),
 			ChainParams: params,
 		},
 		ScriptKeyTweak: asset.TweakedScriptKey{
-			RawKey: scriptKeyDesc,
-			Tweak:  dbAddr.ScriptKeyTweak,
+			RawKey:    scriptKeyDesc,
+			Tweak:     dbAddr.ScriptKeyTweak,
+			TapLeaves: tapLeaves,
 		},
 		InternalKeyDesc:  internalKeyDesc,
 		TaprootOutputKey: *taprootOutputKey,
 		CreationTime:     dbAddr.CreationTime.UTC(),
+		ManagedAfter:     dbAddr.ManagedFrom.Time.UTC(),
+		ExpiryTime:       dbAddr.ExpiryTime.Time.UTC(),
+		SingleUse:        dbAddr.SingleUse,
+		WatchOnly:        dbAddr.WatchOnly,
+		Label:            dbAddr.Label,
+		ExternalMetadata: metadata,
 	}, nil
 }
 
+// fetchAddrMetadata fetches the external metadata of the address with the
+// given primary key. If the address has no metadata, nil is returned.
+func fetchAddrMetadata(ctx context.Context, db AddrBook,
+	addrID int32) (map[string]string, error) {
+
+	entries, err := db.FetchAddrMetadata(ctx, addrID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch addr metadata: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	metadata := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		metadata[entry.MetaKey] = entry.MetaValue
+	}
+
+	return metadata, nil
+}
+
 // SetAddrManaged sets an address as being managed by the internal
-// wallet.
+// wallet. A zero managedFrom time marks the address as no longer being managed.
 func (t *TaroAddressBook) SetAddrManaged(ctx context.Context,
 	addr *address.AddrWithKeyInfo, managedFrom time.Time) error {
 
@@ -510,7 +782,7 @@ func (t *TaroAddressBook) SetAddrManaged(ctx context.Context,
 		return db.SetAddrManaged(ctx, AddrManaged{
 			ManagedFrom: sql.NullTime{
 				Time:  managedFrom.UTC(),
-				Valid: true,
+				Valid: !managedFrom.IsZero(),
 			},
 			TaprootOutputKey: schnorr.SerializePubKey(
 				&addr.TaprootOutputKey,
@@ -531,12 +803,9 @@ func (t *TaroAddressBook) GetOrCreateEvent(ctx context.Context,
 		writeTxOpts  AddrBookTxOptions
 		event        *address.Event
 		txHash       = walletTx.Tx.TxHash()
-		txBuf        bytes.Buffer
 		siblingBytes []byte
+		now          = time.Now().UTC()
 	)
-	if err := walletTx.Tx.Serialize(&txBuf); err != nil {
-		return nil, fmt.Errorf("error serializing tx: %w", err)
-	}
 	outpoint := wire.OutPoint{
 		Hash:  txHash,
 		Index: outputIdx,
@@ -554,29 +823,9 @@ func (t *TaroAddressBook) GetOrCreateEvent(ctx context.Context,
 	dbErr := t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
 		// The first step is to make sure we already track the on-chain
 		// transaction in our DB.
-		txUpsert := ChainTx{
-			Txid:  txHash[:],
-			RawTx: txBuf.Bytes(),
-		}
-		if walletTx.Confirmations > 0 {
-			txUpsert.BlockHeight.Valid = true
-			txUpsert.BlockHeight.Int32 = walletTx.BlockHeight
-
-			// We're missing the transaction index within the block,
-			// we need to update that from the proof. Fortunately we
-			// only update fields that aren't nil in the upsert.
-			blockHash, err := chainhash.NewHashFromStr(
-				walletTx.BlockHash,
-			)
-			if err != nil {
-				return fmt.Errorf("error parsing block hash: "+
-					"%w", err)
-			}
-			txUpsert.BlockHash = blockHash[:]
-		}
-		chainTxID, err := db.UpsertChainTx(ctx, txUpsert)
+		chainTxID, err := upsertWalletTx(ctx, db, walletTx)
 		if err != nil {
-			return fmt.Errorf("error upserting chain TX: %w", err)
+			return err
 		}
 
 		commitment, err := addr.TaroCommitment()
@@ -602,7 +851,8 @@ func (t *TaroAddressBook) GetOrCreateEvent(ctx context.Context,
 			TaprootOutputKey: schnorr.SerializePubKey(
 				&addr.TaprootOutputKey,
 			),
-			CreationTime:        time.Now().UTC(),
+			CreationTime:        now,
+			UpdateTime:          now,
 			Status:              int16(status),
 			Txid:                txHash[:],
 			ChainTxnOutputIndex: int32(outputIdx),
@@ -623,6 +873,45 @@ func (t *TaroAddressBook) GetOrCreateEvent(ctx context.Context,
 	return event, nil
 }
 
+// upsertWalletTx makes sure the given wallet transaction is tracked in the
+// database and returns its primary key.
+func upsertWalletTx(ctx context.Context, db AddrBook,
+	walletTx *lndclient.Transaction) (int32, error) {
+
+	var (
+		txHash = walletTx.Tx.TxHash()
+		txBuf  bytes.Buffer
+	)
+	if err := walletTx.Tx.Serialize(&txBuf); err != nil {
+		return 0, fmt.Errorf("error serializing tx: %w", err)
+	}
+
+	txUpsert := ChainTx{
+		Txid:  txHash[:],
+		RawTx: txBuf.Bytes(),
+	}
+	if walletTx.Confirmations > 0 {
+		txUpsert.BlockHeight.Valid = true
+		txUpsert.BlockHeight.Int32 = walletTx.BlockHeight
+
+		// We're missing the transaction index within the block, we need
+		// to update that from the proof. Fortunately we only update
+		// fields that aren't nil in the upsert.
+		blockHash, err := chainhash.NewHashFromStr(walletTx.BlockHash)
+		if err != nil {
+			return 0, fmt.Errorf("error parsing block hash: %w",
+				err)
+		}
+		txUpsert.BlockHash = blockHash[:]
+	}
+	chainTxID, err := db.UpsertChainTx(ctx, txUpsert)
+	if err != nil {
+		return 0, fmt.Errorf("error upserting chain TX: %w", err)
+	}
+
+	return chainTxID, nil
+}
+
 // QueryAddrEvents returns a list of event that match the given query
 // parameters.
 func (t *TaroAddressBook) QueryAddrEvents(
@@ -630,8 +919,13 @@ func (t *TaroAddressBook) QueryAddrEvents(
 	error) {
 
 	sqlQuery := AddrEventQuery{
-		StatusFrom: int16(address.StatusTransactionDetected),
-		StatusTo:   int16(address.StatusCompleted),
+		StatusFrom:   int16(address.StatusTransactionDetected),
+		StatusTo:     int16(address.StatusLatePayment),
+		CreatedAfter: params.CreatedAfter.UTC(),
+		UpdatedAfter: params.UpdatedAfter.UTC(),
+		Label:        sqlOptStr(params.AddrLabel),
+		MetaKey:      sqlOptStr(params.AddrExternalMetadataKey),
+		MetaValue:    sqlOptStr(params.AddrExternalMetadataValue),
 	}
 	if len(params.AddrTaprootOutputKey) > 0 {
 		sqlQuery.AddrTaprootKey = params.AddrTaprootOutputKey
@@ -716,6 +1010,7 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int32,
 	return &address.Event{
 		ID:                 eventID,
 		CreationTime:       dbEvent.CreationTime.UTC(),
+		UpdateTime:         dbEvent.UpdateTime.UTC(),
 		Addr:               addr,
 		Status:             address.Status(dbEvent.Status),
 		Outpoint:           op,
@@ -724,6 +1019,7 @@ func fetchEvent(ctx context.Context, db AddrBook, eventID int32,
 		TapscriptSibling:   dbEvent.TapscriptSibling,
 		ConfirmationHeight: uint32(dbEvent.ConfirmationHeight.Int32),
 		HasProof:           dbEvent.AssetProofID.Valid,
+		AssetAmount:        uint64(dbEvent.AssetAmount.Int64),
 	}, nil
 }
 
@@ -734,10 +1030,36 @@ func (t *TaroAddressBook) CompleteEvent(ctx context.Context,
 	anchorPoint wire.OutPoint) error {
 
 	scriptKeyBytes := event.Addr.ScriptKey.SerializeCompressed()
+	anchorPointBytes, err := encodeOutpoint(anchorPoint)
+	if err != nil {
+		return err
+	}
+
+	// A family address accepts any asset ID within the family, so we only
+	// know it from the imported asset.
+	var assetIDBytes []byte
+	if !event.Addr.IsFamilyAddr() {
+		assetID := event.Addr.ID()
+		assetIDBytes = assetID[:]
+	}
 
 	var writeTxOpts AddrBookTxOptions
 	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
-		proofData, err := db.FetchAssetProof(ctx, scriptKeyBytes)
+		dbAsset, err := db.FetchAssetByScriptKeyAnchor(
+			ctx, AssetByAnchorQuery{
+				ScriptKey:   scriptKeyBytes,
+				AnchorPoint: anchorPointBytes,
+				AssetID:     assetIDBytes,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error fetching asset: %w", err)
+		}
+
+		proofFile, err := db.FetchProofFile(ctx, ProofFileID{
+			AssetID:   dbAsset.AssetID,
+			ScriptKey: scriptKeyBytes,
+		})
 		if err != nil {
 			return fmt.Errorf("error fetching asset proof: %w", err)
 		}
@@ -746,16 +1068,125 @@ func (t *TaroAddressBook) CompleteEvent(ctx context.Context,
 			TaprootOutputKey: schnorr.SerializePubKey(
 				&event.Addr.TaprootOutputKey,
 			),
+			UpdateTime:          time.Now().UTC(),
 			Status:              int16(status),
 			Txid:                anchorPoint.Hash[:],
 			ChainTxnOutputIndex: int32(anchorPoint.Index),
-			AssetProofID:        sqlInt32(proofData.ProofID),
-			AssetID:             sqlInt32(proofData.AssetID),
+			AssetProofID:        sqlInt32(proofFile.FileID),
+			AssetID:             sqlInt32(dbAsset.AssetPrimaryKey),
 		})
 		return err
 	})
 }
 
+// SetEventStatus updates the status of an existing address event without
+// changing any of its other fields.
+func (t *TaroAddressBook) SetEventStatus(ctx context.Context,
+	event *address.Event, status address.Status) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		return db.UpdateAddrEventStatus(ctx, AddrEventStatus{
+			Status:     int16(status),
+			UpdateTime: time.Now().UTC(),
+			ID:         event.ID,
+		})
+	})
+}
+
+// InsertRecoveryOutput stores a wallet output that doesn't belong to any known
+// address, but might belong to one that was lost from the database. Storing an
+// output that is already known is a no-op.
+func (t *TaroAddressBook) InsertRecoveryOutput(ctx context.Context,
+	walletTx *lndclient.Transaction, outputIdx uint32) error {
+
+	var (
+		writeTxOpts AddrBookTxOptions
+		txHash      = walletTx.Tx.TxHash()
+		now         = time.Now().UTC()
+	)
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		if _, err := upsertWalletTx(ctx, db, walletTx); err != nil {
+			return err
+		}
+
+		return db.UpsertRecoveryOutput(ctx, RecoveryOutput{
+			CreationTime:        now,
+			ChainTxnOutputIndex: int32(outputIdx),
+			AmtSats:             walletTx.OutputDetails[outputIdx].Amount,
+			Txid:                txHash[:],
+		})
+	})
+}
+
+// QueryRecoveryOutputs returns all wallet outputs that might belong to a lost
+// address as events that await their proof. The events don't have an address,
+// as it's only known from the proof.
+func (t *TaroAddressBook) QueryRecoveryOutputs(
+	ctx context.Context) ([]*address.Event, error) {
+
+	var (
+		readTxOpts = NewAddrBookReadTx()
+		events     []*address.Event
+	)
+	err := t.db.ExecTx(ctx, &readTxOpts, func(db AddrBook) error {
+		dbOutputs, err := db.QueryRecoveryOutputs(ctx)
+		if err != nil {
+			return fmt.Errorf("error fetching recovery outputs: "+
+				"%w", err)
+		}
+
+		events = make([]*address.Event, len(dbOutputs))
+		for idx, dbOutput := range dbOutputs {
+			hash, err := chainhash.NewHash(dbOutput.Txid)
+			if err != nil {
+				return fmt.Errorf("error parsing txid: %w", err)
+			}
+
+			status := address.StatusTransactionDetected
+			if dbOutput.ConfirmationHeight.Valid {
+				status = address.StatusTransactionConfirmed
+			}
+
+			events[idx] = &address.Event{
+				ID:           dbOutput.ID,
+				CreationTime: dbOutput.CreationTime.UTC(),
+				UpdateTime:   dbOutput.CreationTime.UTC(),
+				Status:       status,
+				Outpoint: wire.OutPoint{
+					Hash:  *hash,
+					Index: uint32(dbOutput.OutputIndex),
+				},
+				Amt: btcutil.Amount(dbOutput.AmtSats),
+				ConfirmationHeight: uint32(
+					dbOutput.ConfirmationHeight.Int32,
+				),
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// DeleteRecoveryOutput deletes a wallet output that might belong to a lost
+// address, once it's clear whether it does.
+func (t *TaroAddressBook) DeleteRecoveryOutput(ctx context.Context,
+	op wire.OutPoint) error {
+
+	var writeTxOpts AddrBookTxOptions
+	return t.db.ExecTx(ctx, &writeTxOpts, func(db AddrBook) error {
+		return db.DeleteRecoveryOutput(ctx, RecoveryOutputID{
+			Txid:                op.Hash[:],
+			ChainTxnOutputIndex: int32(op.Index),
+		})
+	})
+}
+
 // A set of compile-time assertions to ensure that TaroAddressBook meets the
 // address.Storage and address.EventStorage interface.
 var _ address.Storage = (*TaroAddressBook)(nil)
@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro/address"
+	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/tarodb/sqlc"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -97,12 +98,18 @@ func assertEqualAddr(t *testing.T, expected, actual address.AddrWithKeyInfo) {
 	// compare the unix timestamps separately.
 	actualTime := actual.CreationTime
 	expectedTime := expected.CreationTime
+	actualExpiry := actual.ExpiryTime
+	expectedExpiry := expected.ExpiryTime
 
 	actual.CreationTime = time.Time{}
 	expected.CreationTime = time.Time{}
+	actual.ExpiryTime = time.Time{}
+	expected.ExpiryTime = time.Time{}
 
 	require.Equal(t, expected, actual)
 	require.Equal(t, expectedTime.Unix(), actualTime.Unix())
+	require.Equal(t, expectedExpiry.IsZero(), actualExpiry.IsZero())
+	require.Equal(t, expectedExpiry.Unix(), actualExpiry.Unix())
 }
 
 // assertEqualAddrEvents makes sure the given actual address events match the
@@ -131,6 +138,12 @@ func assertEqualAddrEvent(t *testing.T, expected, actual address.Event) {
 	actual.CreationTime = time.Time{}
 	expected.CreationTime = time.Time{}
 
+	// The update time changes with the status, so it can only ever move
+	// forward.
+	require.False(t, actual.UpdateTime.Before(expected.UpdateTime))
+	actual.UpdateTime = time.Time{}
+	expected.UpdateTime = time.Time{}
+
 	require.Equal(t, expected, actual)
 	require.Equal(t, expectedTime.Unix(), actualTime.Unix())
 }
@@ -206,6 +219,255 @@ func TestAddressInsertion(t *testing.T) {
 
 	require.Equal(t, managedFrom.Unix(), dbAddrs[0].ManagedAfter.Unix())
 	require.Equal(t, managedFrom.Unix(), dbAddrs[1].ManagedAfter.Unix())
+
+	// Setting a zero time should mark the first address as unmanaged
+	// again.
+	err = addrBook.SetAddrManaged(ctx, &dbAddrs[0], time.Time{})
+	require.NoError(t, err)
+
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		UnmanagedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, 4)
+	require.True(t, dbAddrs[0].ManagedAfter.IsZero())
+	assertEqualAddr(t, addrs[0], dbAddrs[0])
+}
+
+// TestAddressExpiryAndSingleUse tests that the expiry time and single-use flag
+// of an address are stored and respected by the query parameters.
+func TestAddressExpiryAndSingleUse(t *testing.T) {
+	t.Parallel()
+
+	// First, make a new addr book instance we'll use in the test below.
+	addrBook, _ := newAddrBook(t)
+
+	// We create an address that already expired, one that expires in the
+	// future and a single-use one without an expiry.
+	now := time.Now()
+	expiredAddr := address.RandAddr(t, chainParams)
+	expiredAddr.ExpiryTime = now.Add(-time.Hour)
+	futureAddr := address.RandAddr(t, chainParams)
+	futureAddr.ExpiryTime = now.Add(time.Hour)
+	singleUseAddr := address.RandAddr(t, chainParams)
+	singleUseAddr.SingleUse = true
+
+	addrs := []address.AddrWithKeyInfo{
+		*expiredAddr, *futureAddr, *singleUseAddr,
+	}
+	ctx := context.Background()
+	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
+
+	// All the properties should be returned as we inserted them.
+	dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs, dbAddrs)
+
+	dbAddr, err := addrBook.AddrByTaprootOutput(
+		ctx, &singleUseAddr.TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	assertEqualAddr(t, *singleUseAddr, *dbAddr)
+
+	// Only the first address expired by now, the second one only expires
+	// within the next two hours.
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		ExpiredBefore: now,
+	})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs[:1], dbAddrs)
+
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		ExpiredBefore: now.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs[:2], dbAddrs)
+
+	// Once the single-use address received a transfer, it should no longer
+	// be returned as an unmanaged address.
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		UnmanagedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, dbAddrs, 3)
+
+	txn := randWalletTx()
+	_, err = addrBook.GetOrCreateEvent(
+		ctx, address.StatusTransactionDetected, singleUseAddr, txn, 0,
+		nil,
+	)
+	require.NoError(t, err)
+
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		UnmanagedOnly: true,
+	})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs[:2], dbAddrs)
+}
+
+// TestAddressLabelsAndMetadata tests that the label and external metadata of
+// an address are persisted and can be used to filter addresses and events.
+func TestAddressLabelsAndMetadata(t *testing.T) {
+	t.Parallel()
+
+	// First, make a new addr book instance we'll use in the test below.
+	addrBook, _ := newAddrBook(t)
+
+	// We create one address with a label and metadata, one with only a
+	// label and one without either.
+	labeledAddr := address.RandAddr(t, chainParams)
+	labeledAddr.Label = "invoice"
+	labeledAddr.ExternalMetadata = map[string]string{
+		"order":    "1234",
+		"customer": "alice",
+	}
+	labelOnlyAddr := address.RandAddr(t, chainParams)
+	labelOnlyAddr.Label = "invoice"
+	plainAddr := address.RandAddr(t, chainParams)
+
+	addrs := []address.AddrWithKeyInfo{
+		*labeledAddr, *labelOnlyAddr, *plainAddr,
+	}
+	ctx := context.Background()
+	require.NoError(t, addrBook.InsertAddrs(ctx, addrs...))
+
+	// All the properties should be returned as we inserted them.
+	dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs, dbAddrs)
+
+	dbAddr, err := addrBook.AddrByTaprootOutput(
+		ctx, &labeledAddr.TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	assertEqualAddr(t, *labeledAddr, *dbAddr)
+
+	// Filtering by label should return the first two addresses.
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		Label: "invoice",
+	})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs[:2], dbAddrs)
+
+	// Filtering by a metadata key only or by a key and value should only
+	// return the first address.
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		ExternalMetadataKey: "order",
+	})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs[:1], dbAddrs)
+
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		ExternalMetadataKey:   "customer",
+		ExternalMetadataValue: "alice",
+	})
+	require.NoError(t, err)
+	assertEqualAddrs(t, addrs[:1], dbAddrs)
+
+	dbAddrs, err = addrBook.QueryAddrs(ctx, address.QueryParams{
+		ExternalMetadataKey:   "customer",
+		ExternalMetadataValue: "bob",
+	})
+	require.NoError(t, err)
+	require.Empty(t, dbAddrs)
+
+	// We now create an event for each address and make sure the same
+	// filters can be applied to the address events.
+	for idx := range addrs {
+		_, err := addrBook.GetOrCreateEvent(
+			ctx, address.StatusTransactionDetected, &addrs[idx],
+			randWalletTx(), 0, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	events, err := addrBook.QueryAddrEvents(ctx, address.EventQueryParams{
+		AddrLabel: "invoice",
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	events, err = addrBook.QueryAddrEvents(ctx, address.EventQueryParams{
+		AddrExternalMetadataKey:   "order",
+		AddrExternalMetadataValue: "1234",
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assertEqualAddr(t, *labeledAddr, *events[0].Addr)
+}
+
+// TestFamilyAddress tests that a family address, which doesn't commit to a
+// specific asset ID, can be stored and looked up by its script key.
+func TestFamilyAddress(t *testing.T) {
+	t.Parallel()
+
+	// First, make a new addr book instance we'll use in the test below.
+	addrBook, _ := newAddrBook(t)
+
+	famAddr := address.RandAddr(t, chainParams)
+	famAddr.FamilyKey = test.RandPubKey(t)
+	famAddr.Genesis = address.FamilyGenesis(famAddr.Genesis.Type)
+	famAddr.FamilyOnly = true
+	regularAddr := address.RandAddr(t, chainParams)
+
+	ctx := context.Background()
+	require.NoError(t, addrBook.InsertAddrs(ctx, *famAddr, *regularAddr))
+
+	// Both addresses should be found by their script key, and only the
+	// first one should be a family address.
+	dbAddr, err := addrBook.AddrByScriptKey(ctx, &famAddr.ScriptKey)
+	require.NoError(t, err)
+	assertEqualAddr(t, *famAddr, *dbAddr)
+	require.True(t, dbAddr.IsFamilyAddr())
+
+	dbAddr, err = addrBook.AddrByScriptKey(ctx, &regularAddr.ScriptKey)
+	require.NoError(t, err)
+	assertEqualAddr(t, *regularAddr, *dbAddr)
+	require.False(t, dbAddr.IsFamilyAddr())
+
+	// The family flag should also survive listing the addresses.
+	dbAddrs, err := addrBook.QueryAddrs(ctx, address.QueryParams{})
+	require.NoError(t, err)
+	assertEqualAddrs(
+		t, []address.AddrWithKeyInfo{*famAddr, *regularAddr}, dbAddrs,
+	)
+
+	// An unknown script key should result in the expected error.
+	_, err = addrBook.AddrByScriptKey(ctx, test.RandPubKey(t))
+	require.ErrorIs(t, err, address.ErrNoAddr)
+}
+
+// TestPaymentRequestAddress tests that the amount policy, the signed memo and
+// the script version of an address are stored and retrieved correctly.
+func TestPaymentRequestAddress(t *testing.T) {
+	t.Parallel()
+
+	// First, make a new addr book instance we'll use in the test below.
+	addrBook, _ := newAddrBook(t)
+
+	addr := address.RandAddr(t, chainParams)
+	addr.Amount = 0
+	addr.AmountPolicy = address.AmountOpen
+	addr.Memo = "donation"
+	memoDigest := address.MemoDigest(addr.Memo)
+	memoSig, err := schnorr.Sign(test.RandPrivKey(t), memoDigest[:])
+	require.NoError(t, err)
+	addr.MemoSig = memoSig
+	addr.ScriptVersion = asset.ScriptV1
+	regularAddr := address.RandAddr(t, chainParams)
+
+	ctx := context.Background()
+	require.NoError(t, addrBook.InsertAddrs(ctx, *addr, *regularAddr))
+
+	dbAddr, err := addrBook.AddrByScriptKey(ctx, &addr.ScriptKey)
+	require.NoError(t, err)
+	assertEqualAddr(t, *addr, *dbAddr)
+	require.True(t, dbAddr.HasDynamicOutput())
+
+	dbAddr, err = addrBook.AddrByScriptKey(ctx, &regularAddr.ScriptKey)
+	require.NoError(t, err)
+	assertEqualAddr(t, *regularAddr, *dbAddr)
+	require.Nil(t, dbAddr.MemoSig)
 }
 
 // TestAddressQuery tests that we're able to properly retrieve rows based on
@@ -333,7 +595,7 @@ func TestAddrEventStatusDBEnum(t *testing.T) {
 	outputIndex := rand.Intn(len(txn.Tx.TxOut))
 
 	_, err = addrBook.GetOrCreateEvent(
-		ctx, address.Status(4), addr, txn, uint32(outputIndex), nil,
+		ctx, address.Status(6), addr, txn, uint32(outputIndex), nil,
 	)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "constraint")
@@ -419,6 +681,25 @@ func TestAddrEventCreation(t *testing.T) {
 
 		assertEqualAddrEvent(t, *events[idx], *actual)
 	}
+
+	// Once the transactions are final, only the status of the events
+	// should change.
+	final := address.StatusTransactionFinal
+	for idx := range events {
+		err := addrBook.SetEventStatus(ctx, events[idx], final)
+		require.NoError(t, err)
+
+		events[idx].Status = final
+	}
+
+	finalEvents, err := addrBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{
+			StatusFrom: &final,
+			StatusTo:   &final,
+		},
+	)
+	require.NoError(t, err)
+	assertEqualAddrEvents(t, events, finalEvents)
 }
 
 // TestAddressEventQuery tests that we're able to properly retrieve rows based
@@ -452,6 +733,24 @@ func TestAddressEventQuery(t *testing.T) {
 		addrs[i] = *addr
 	}
 
+	// We now change the status of the first event, which should be
+	// reflected in its update time.
+	updateTime := time.Now()
+	firstEvents, err := addrBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{
+			AddrTaprootOutputKey: schnorr.SerializePubKey(
+				&addrs[0].TaprootOutputKey,
+			),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, firstEvents, 1)
+	require.True(t, firstEvents[0].UpdateTime.Before(updateTime))
+	err = addrBook.SetEventStatus(
+		ctx, firstEvents[0], address.StatusTransactionFinal,
+	)
+	require.NoError(t, err)
+
 	var (
 		confirmed = address.StatusTransactionConfirmed
 		invalid   = address.Status(123)
@@ -463,6 +762,8 @@ func TestAddressEventQuery(t *testing.T) {
 		addrTaprootKey []byte
 		stateFrom      *address.Status
 		stateTo        *address.Status
+		createdAfter   time.Time
+		updatedAfter   time.Time
 
 		numAddrs int
 		firstID  int
@@ -518,6 +819,32 @@ func TestAddressEventQuery(t *testing.T) {
 			numAddrs: 1,
 			firstID:  5,
 		},
+
+		// Created after a time in the past.
+		{
+			name: "created after past time",
+
+			createdAfter: time.Now().Add(-time.Hour),
+			numAddrs:     5,
+		},
+
+		// Created after a time in the future.
+		{
+			name: "created after future time",
+
+			createdAfter: time.Now().Add(time.Hour),
+			numAddrs:     0,
+		},
+
+		// Only the event whose status changed was updated after the
+		// status change.
+		{
+			name: "updated after status change",
+
+			updatedAfter: updateTime,
+			numAddrs:     1,
+			firstID:      1,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -527,6 +854,8 @@ func TestAddressEventQuery(t *testing.T) {
 					AddrTaprootOutputKey: test.addrTaprootKey,
 					StatusFrom:           test.stateFrom,
 					StatusTo:             test.stateTo,
+					CreatedAfter:         test.createdAfter,
+					UpdatedAfter:         test.updatedAfter,
 				},
 			)
 			require.NoError(t, err)
@@ -540,3 +869,72 @@ func TestAddressEventQuery(t *testing.T) {
 		})
 	}
 }
+
+// TestRecoveryOutputs tests that wallet outputs found in recovery mode are
+// stored once and listed as events without an address until they're deleted.
+func TestRecoveryOutputs(t *testing.T) {
+	t.Parallel()
+
+	addrBook, _ := newAddrBook(t)
+	ctx := context.Background()
+
+	txn := randWalletTx()
+	require.NoError(t, addrBook.InsertRecoveryOutput(ctx, txn, 0))
+
+	// Storing the same output again doesn't result in a new event, but a
+	// confirmation of its transaction is picked up.
+	confirmTx(txn)
+	require.NoError(t, addrBook.InsertRecoveryOutput(ctx, txn, 0))
+
+	events, err := addrBook.QueryRecoveryOutputs(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	op := wire.OutPoint{Hash: txn.Tx.TxHash(), Index: 0}
+	event := events[0]
+	require.Nil(t, event.Addr)
+	require.Equal(t, op, event.Outpoint)
+	require.Equal(t, address.StatusTransactionConfirmed, event.Status)
+	require.EqualValues(t, txn.BlockHeight, event.ConfirmationHeight)
+	require.EqualValues(t, txn.OutputDetails[0].Amount, event.Amt)
+
+	require.NoError(t, addrBook.DeleteRecoveryOutput(ctx, op))
+	events, err = addrBook.QueryRecoveryOutputs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+// TestScriptKeyInfoUpdate tests that the key information of a script key that
+// was only known by its tweaked key is filled in once an address with that
+// script key is inserted.
+func TestScriptKeyInfoUpdate(t *testing.T) {
+	t.Parallel()
+
+	addrBook, db := newAddrBook(t)
+	ctx := context.Background()
+
+	// Importing the proof of an asset we don't know the keys of stores
+	// its tweaked script key as its own raw key, without a key locator.
+	addr := address.RandAddr(t, chainParams)
+	tweakedKey := addr.ScriptKey.SerializeCompressed()
+	rawKeyID, err := db.UpsertInternalKey(ctx, InternalKey{
+		RawKey: tweakedKey,
+	})
+	require.NoError(t, err)
+	_, err = db.UpsertScriptKey(ctx, NewScriptKey{
+		InternalKeyID:    rawKeyID,
+		TweakedScriptKey: tweakedKey,
+	})
+	require.NoError(t, err)
+	_, err = db.UpsertInternalKey(ctx, InternalKey{
+		RawKey: addr.InternalKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	// Once we insert the address, we learn about the actual keys.
+	require.NoError(t, addrBook.InsertAddrs(ctx, *addr))
+
+	dbAddr, err := addrBook.AddrByScriptKey(ctx, &addr.ScriptKey)
+	require.NoError(t, err)
+	assertEqualAddr(t, *addr, *dbAddr)
+}
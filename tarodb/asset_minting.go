@@ -22,6 +22,10 @@ type (
 	// BatchStateUpdate holds the arguments to update the state of a batch.
 	BatchStateUpdate = sqlc.UpdateMintingBatchStateParams
 
+	// BatchNumConfsUpdate holds the arguments to raise the number of
+	// confirmations required for a batch.
+	BatchNumConfsUpdate = sqlc.RaiseMintingBatchNumConfsParams
+
 	// InternalKey holds the arguments to update an internal key.
 	InternalKey = sqlc.UpsertInternalKeyParams
 
@@ -44,6 +48,13 @@ type (
 	// AssetSeedling is an asset seedling.
 	AssetSeedling = sqlc.AssetSeedling
 
+	// SeedlingMetadata is used to insert an external metadata entry of a
+	// seedling.
+	SeedlingMetadata = sqlc.InsertSeedlingMetadataParams
+
+	// SeedlingMetadataEntry is an external metadata entry of a seedling.
+	SeedlingMetadataEntry = sqlc.FetchSeedlingMetadataRow
+
 	// MintingBatchTuple is used to update a batch state based on the raw
 	// key.
 	MintingBatchTuple = sqlc.UpdateMintingBatchStateParams
@@ -91,9 +102,6 @@ type (
 	// MintingBatchInit is used to create a new minting batch.
 	MintingBatchInit = sqlc.NewMintingBatchParams
 
-	// ProofUpdate is used to update a proof file on disk.
-	ProofUpdate = sqlc.UpsertAssetProofParams
-
 	// NewScriptKey wraps the params needed to insert a new script key on
 	// disk.
 	NewScriptKey = sqlc.UpsertScriptKeyParams
@@ -115,14 +123,31 @@ type PendingAssetStore interface {
 	UpdateMintingBatchState(ctx context.Context,
 		arg BatchStateUpdate) error
 
+	// RaiseMintingBatchNumConfs raises the number of confirmations
+	// required for an existing minting batch, if the new value is higher
+	// than the current one.
+	RaiseMintingBatchNumConfs(ctx context.Context,
+		arg BatchNumConfsUpdate) error
+
 	// InsertAssetSeedling inserts a new asset seedling (base description)
-	// into the database.
-	InsertAssetSeedling(ctx context.Context, arg AssetSeedlingShell) error
+	// into the database and returns its primary key.
+	InsertAssetSeedling(ctx context.Context,
+		arg AssetSeedlingShell) (int32, error)
 
 	// InsertAssetSeedlingIntoBatch inserts a new asset seedling into a
-	// batch based on the batch key its included in.
+	// batch based on the batch key its included in and returns its primary
+	// key.
 	InsertAssetSeedlingIntoBatch(ctx context.Context,
-		arg AssetSeedlingItem) error
+		arg AssetSeedlingItem) (int32, error)
+
+	// InsertSeedlingMetadata inserts an external metadata entry of a
+	// seedling.
+	InsertSeedlingMetadata(ctx context.Context, arg SeedlingMetadata) error
+
+	// FetchSeedlingMetadata fetches all external metadata entries of the
+	// seedling with the given primary key.
+	FetchSeedlingMetadata(ctx context.Context,
+		seedlingID int32) ([]SeedlingMetadataEntry, error)
 
 	// FetchMintingBatchesByState is used to fetch minting batches with a
 	// particular state.
@@ -172,12 +197,9 @@ type PendingAssetStore interface {
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]AssetSprout,
 		error)
 
-	// UpsertAssetProof inserts a new or updates an existing asset proof on
-	// disk.
-	//
-	// TODO(roasbeef): move somewhere else??
-	UpsertAssetProof(ctx context.Context,
-		arg sqlc.UpsertAssetProofParams) error
+	// ProofArchiveStore houses the methods related to storing the proof
+	// files of assets.
+	ProofArchiveStore
 }
 
 // AssetStoreTxOptions defines the set of db txn options the PendingAssetStore
@@ -253,6 +275,7 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 		if err := q.NewMintingBatch(ctx, MintingBatchInit{
 			BatchID:          batchID,
 			CreationTimeUnix: newBatch.CreationTime.UTC(),
+			NumConfs:         int32(newBatch.NumConfs),
 		}); err != nil {
 			return fmt.Errorf("unable to insert minting "+
 				"batch: %w", err)
@@ -262,14 +285,31 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 		// internal key inserted above, we can create the set of new
 		// seedlings.
 		for _, seedling := range newBatch.Seedlings {
-			err := q.InsertAssetSeedling(ctx, AssetSeedlingShell{
-				BatchID:         batchID,
-				AssetName:       seedling.AssetName,
-				AssetType:       int16(seedling.AssetType),
-				AssetSupply:     int64(seedling.Amount),
-				AssetMeta:       seedling.Metadata,
-				EmissionEnabled: seedling.EnableEmission,
-			})
+			seedlingID, err := q.InsertAssetSeedling(
+				ctx, AssetSeedlingShell{
+					BatchID:         batchID,
+					AssetName:       seedling.AssetName,
+					AssetType:       int16(seedling.AssetType),
+					AssetSupply:     int64(seedling.Amount),
+					AssetMeta:       seedling.Metadata,
+					EmissionEnabled: seedling.EnableEmission,
+					LockTime:        sqlInt32(seedling.LockTime),
+					RelativeLockTime: sqlInt32(
+						seedling.RelativeLockTime,
+					),
+					Label: seedling.Label,
+					ScriptVersion: int32(
+						seedling.ScriptVersion,
+					),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			err = insertSeedlingMetadata(
+				ctx, q, seedlingID, seedling.ExternalMetadata,
+			)
 			if err != nil {
 				return err
 			}
@@ -300,18 +340,71 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMeta:       seedling.Metadata,
 				EmissionEnabled: seedling.EnableEmission,
+				LockTime:        sqlInt32(seedling.LockTime),
+				RelativeLockTime: sqlInt32(
+					seedling.RelativeLockTime,
+				),
+				Label: seedling.Label,
+				ScriptVersion: int32(
+					seedling.ScriptVersion,
+				),
 			}
-			err := q.InsertAssetSeedlingIntoBatch(ctx, dbSeedling)
+			seedlingID, err := q.InsertAssetSeedlingIntoBatch(
+				ctx, dbSeedling,
+			)
 			if err != nil {
 				return fmt.Errorf("unable to insert "+
 					"seedling into db: %v", err)
 			}
+
+			err = insertSeedlingMetadata(
+				ctx, q, seedlingID, seedling.ExternalMetadata,
+			)
+			if err != nil {
+				return err
+			}
+
+			// The batch needs as many confirmations as the most
+			// demanding of its seedlings.
+			if seedling.NumConfs == 0 {
+				continue
+			}
+			err = q.RaiseMintingBatchNumConfs(
+				ctx, BatchNumConfsUpdate{
+					RawKey:   rawKey,
+					NumConfs: int32(seedling.NumConfs),
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update batch "+
+					"num confs: %w", err)
+			}
 		}
 
 		return nil
 	})
 }
 
+// insertSeedlingMetadata inserts the external metadata entries of the seedling
+// with the given primary key.
+func insertSeedlingMetadata(ctx context.Context, q PendingAssetStore,
+	seedlingID int32, metadata map[string]string) error {
+
+	for key, value := range metadata {
+		err := q.InsertSeedlingMetadata(ctx, SeedlingMetadata{
+			SeedlingID: seedlingID,
+			MetaKey:    key,
+			MetaValue:  value,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert seedling "+
+				"metadata: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // fetchAssetSeedlings attempts to fetch a set of asset seedlings for a given
 // batch. This is performed within the context of a greater DB transaction.
 func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
@@ -328,6 +421,22 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 
 	seedlings := make(map[string]*tarogarden.Seedling)
 	for _, seedling := range dbSeedlings {
+		metaEntries, err := q.FetchSeedlingMetadata(
+			ctx, seedling.SeedlingID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch seedling "+
+				"metadata: %w", err)
+		}
+
+		var metadata map[string]string
+		if len(metaEntries) > 0 {
+			metadata = make(map[string]string, len(metaEntries))
+		}
+		for _, entry := range metaEntries {
+			metadata[entry.MetaKey] = entry.MetaValue
+		}
+
 		seedling := &tarogarden.Seedling{
 			AssetType: asset.Type(
 				seedling.AssetType,
@@ -338,6 +447,17 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 				seedling.AssetSupply,
 			),
 			EnableEmission: seedling.EmissionEnabled,
+			LockTime: extractSqlInt32[uint64](
+				seedling.LockTime,
+			),
+			RelativeLockTime: extractSqlInt32[uint64](
+				seedling.RelativeLockTime,
+			),
+			ScriptVersion: asset.ScriptVersion(
+				seedling.ScriptVersion,
+			),
+			Label:            seedling.Label,
+			ExternalMetadata: metadata,
 		}
 
 		seedlings[seedling.AssetName] = seedling
@@ -454,6 +574,7 @@ func fetchAssetSprouts(ctx context.Context, q PendingAssetStore,
 
 		assetSprout, err := asset.New(
 			assetGenesis, amount, lockTime, relativeLocktime,
+			asset.ScriptVersion(sprout.ScriptVersion),
 			asset.NewScriptKeyBIP0086(scriptKey), familyKey,
 		)
 		if err != nil {
@@ -524,6 +645,7 @@ func (a *AssetMintingStore) FetchNonFinalBatches(
 					PubKey: batchKey,
 				},
 				CreationTime: batch.CreationTimeUnix.UTC(),
+				NumConfs:     uint32(batch.NumConfs),
 			}
 
 			if batch.MintingTxPsbt != nil {
@@ -801,11 +923,35 @@ func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
 
 		// As a final act, we'll now insert the proof files for each of
 		// the assets that were fully confirmed with this block.
-		for scriptKey, proofBlob := range mintingProofs {
-			err := q.UpsertAssetProof(ctx, ProofUpdate{
-				TweakedScriptKey: scriptKey.CopyBytes(),
-				ProofFile:        proofBlob,
-			})
+		dbAssets, err := q.FetchAssetsForBatch(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch batch assets: %w",
+				err)
+		}
+		for _, dbAsset := range dbAssets {
+			scriptKey, err := btcec.ParsePubKey(
+				dbAsset.TweakedScriptKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			proofBlob, ok := mintingProofs[asset.ToSerialized(
+				scriptKey,
+			)]
+			if !ok {
+				return fmt.Errorf("no minting proof for asset "+
+					"with script key %x",
+					dbAsset.TweakedScriptKey)
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], dbAsset.AssetID)
+
+			err = insertProofFile(ctx, q, proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *scriptKey,
+			}, proofBlob)
 			if err != nil {
 				return fmt.Errorf("unable to insert proof "+
 					"file: %w", err)
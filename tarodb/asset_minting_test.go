@@ -64,6 +64,7 @@ func assertBatchEqual(t *testing.T, a, b *tarogarden.MintingBatch) {
 	require.Equal(t, a.Seedlings, b.Seedlings)
 	require.Equal(t, a.GenesisPacket, b.GenesisPacket)
 	require.Equal(t, a.RootAssetCommitment, b.RootAssetCommitment)
+	require.Equal(t, a.NumConfs, b.NumConfs)
 }
 
 func assertSeedlingBatchLen(t *testing.T, batches []*tarogarden.MintingBatch,
@@ -90,6 +91,7 @@ func TestCommitMintingBatchSeedlings(t *testing.T) {
 	// First, we'll write a new minting batch to disk, including an
 	// internal key and a set of seedlings.
 	mintingBatch := tarogarden.RandSeedlingMintingBatch(t, numSeedlings)
+	mintingBatch.NumConfs = 3
 	err := assetStore.CommitMintingBatch(ctx, mintingBatch)
 	require.NoError(t, err, "unable to write batch: %v", err)
 
@@ -105,15 +107,23 @@ func TestCommitMintingBatchSeedlings(t *testing.T) {
 	// The batch should also still be in the pending state.
 	assertBatchState(t, mintingBatches[0], tarogarden.BatchStatePending)
 
-	// Now we'll add an additional set of seedlings.
+	// Now we'll add an additional set of seedlings. One of them requires
+	// more confirmations than the batch so far, which raises the number
+	// of confirmations of the whole batch.
 	seedlings := tarogarden.RandSeedlings(t, numSeedlings)
+	newSeedlings := maps.Values(seedlings)
+	newSeedlings[0].NumConfs = 6
 	mintingBatch.Seedlings = mergeMap(mintingBatch.Seedlings, seedlings)
 	require.NoError(t,
 		assetStore.AddSeedlingsToBatch(
-			ctx, batchKey, maps.Values(seedlings)...,
+			ctx, batchKey, newSeedlings...,
 		), "unable to write seedlings: %v", err,
 	)
 
+	// The number of confirmations is only stored for the batch.
+	newSeedlings[0].NumConfs = 0
+	mintingBatch.NumConfs = 6
+
 	// If we read the batch from disk again, then we should have 10 total
 	// seedlings, and the batch still matches what we wrote to disk.
 	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
@@ -198,7 +208,7 @@ func seedlingsToAssetRoot(t *testing.T, genesisPoint wire.OutPoint,
 		}
 
 		newAsset, err := asset.New(
-			assetGen, amount, 0, 0,
+			assetGen, amount, 0, 0, seedling.ScriptVersion,
 			asset.NewScriptKeyBIP0086(scriptKey), familyKey,
 		)
 		require.NoError(t, err)
@@ -431,10 +441,13 @@ func TestCommitBatchChainActions(t *testing.T) {
 	// For each asset created above, we'll make a fake proof file for it.
 	assetProofs := make(proof.AssetBlobs)
 	for _, a := range assetRoot.CommittedAssets() {
-		blob := make([]byte, 100)
-		_, err := rand.Read(blob[:])
+		rawProof := make([]byte, 100)
+		_, err := rand.Read(rawProof[:])
 		require.NoError(t, err)
 
+		blob := encodeRawFile(t, proof.NewFileFromRawProofs(
+			proof.V0, nil, rawProof,
+		))
 		assetProofs[asset.ToSerialized(a.ScriptKey.PubKey)] = blob
 	}
 
@@ -250,10 +250,16 @@ func upsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey,
 			return 0, fmt.Errorf("unable to insert internal key: "+
 				"%w", err)
 		}
+		tapscriptTree, err := asset.EncodeTapLeaves(scriptKey.TapLeaves)
+		if err != nil {
+			return 0, fmt.Errorf("unable to encode tapscript "+
+				"tree: %w", err)
+		}
 		scriptKeyID, err := q.UpsertScriptKey(ctx, NewScriptKey{
 			InternalKeyID:    rawScriptKeyID,
 			TweakedScriptKey: scriptKey.PubKey.SerializeCompressed(),
 			Tweak:            scriptKey.Tweak,
+			TapscriptTree:    tapscriptTree,
 		})
 		if err != nil {
 			return 0, fmt.Errorf("unable to insert script key: "+
@@ -3,9 +3,11 @@ package tarodb
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -18,6 +20,8 @@ import (
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarodb/sqlc"
 	"github.com/lightninglabs/taro/tarofreighter"
+	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/keychain"
 	"golang.org/x/exp/maps"
 )
@@ -34,13 +38,9 @@ type (
 	// asset family or all asset families tracked by this daemon.
 	RawAssetFamilyBalance = sqlc.QueryAssetBalancesByFamilyRow
 
-	// AssetProof is the asset proof for a given asset, identified by its
-	// script key.
-	AssetProof = sqlc.FetchAssetProofsRow
-
-	// AssetProofI is identical to AssetProof but is used for the case
-	// where the proofs for a specific asset are fetched.
-	AssetProofI = sqlc.FetchAssetProofRow
+	// LegacyAssetProof is a proof file that is still stored in the legacy
+	// asset_proofs table, along with the script key of its asset.
+	LegacyAssetProof = sqlc.FetchLegacyAssetProofsRow
 
 	// PrevInput stores the full input information including the prev out,
 	// and also the witness information itself.
@@ -74,6 +74,10 @@ type (
 	// AnchorTxConf identifies an unconfirmed anchor tx to confirm.
 	AnchorTxConf = sqlc.ConfirmChainAnchorTxParams
 
+	// ChainTxConfUpdate is used to overwrite the confirmation details of a
+	// chain transaction after a re-org.
+	ChainTxConfUpdate = sqlc.UpdateChainTxConfParams
+
 	// AssetDelta tracks the changes to an asset within the confines of a
 	// transfer.
 	AssetDelta = sqlc.FetchAssetDeltasRow
@@ -96,9 +100,31 @@ type (
 	// based on set information.
 	TransferQuery = sqlc.QueryAssetTransfersParams
 
+	// TransferMetadata is used to insert or update an external metadata
+	// entry of an asset transfer.
+	TransferMetadata = sqlc.UpsertTransferMetadataParams
+
+	// TransferMetadataEntry is an external metadata entry of an asset
+	// transfer.
+	TransferMetadataEntry = sqlc.FetchTransferMetadataRow
+
 	// NewSpendProof is used to insert new spend proofs for the
 	// sender+receiver.
 	NewSpendProof = sqlc.InsertSpendProofsParams
+
+	// NewProofCheckpoint wraps the params needed to mark a proof file
+	// checkpoint as trusted.
+	NewProofCheckpoint = sqlc.InsertProofCheckpointParams
+
+	// NewAssetBurn wraps the params needed to record a new asset burn.
+	NewAssetBurn = sqlc.InsertAssetBurnParams
+
+	// AssetBurn is a burn record along with the transfer that created it.
+	AssetBurn = sqlc.QueryAssetBurnsRow
+
+	// BurnQuery allows callers to filter the set of burns by asset ID or
+	// the transfer that created them.
+	BurnQuery = sqlc.QueryAssetBurnsParams
 )
 
 // ActiveAssetsStore is a sub-set of the main sqlc.Querier interface that
@@ -124,14 +150,17 @@ type ActiveAssetsStore interface {
 	QueryAssetBalancesByFamily(context.Context,
 		[]byte) ([]RawAssetFamilyBalance, error)
 
-	// FetchAssetProofs fetches all the asset proofs we have stored on
-	// disk.
-	FetchAssetProofs(ctx context.Context) ([]AssetProof, error)
+	// ProofArchiveStore houses the methods related to storing the proof
+	// files of assets.
+	ProofArchiveStore
 
-	// FetchAssetProof fetches the asset proof for a given asset identified
-	// by its script key.
-	FetchAssetProof(ctx context.Context,
-		scriptKey []byte) (AssetProofI, error)
+	// FetchLegacyAssetProofs fetches all the proof files that are still
+	// stored in the legacy asset_proofs table.
+	FetchLegacyAssetProofs(ctx context.Context) ([]LegacyAssetProof, error)
+
+	// DeleteLegacyAssetProofs removes all proof files from the legacy
+	// asset_proofs table.
+	DeleteLegacyAssetProofs(ctx context.Context) error
 
 	// UpsertChainTx inserts a new or updates an existing chain tx into the
 	// DB.
@@ -142,11 +171,6 @@ type ActiveAssetsStore interface {
 	UpsertManagedUTXO(ctx context.Context, arg RawManagedUTXO) (int32,
 		error)
 
-	// UpsertAssetProof inserts a new or updates an existing asset proof on
-	// disk.
-	UpsertAssetProof(ctx context.Context,
-		arg sqlc.UpsertAssetProofParams) error
-
 	// InsertAssetWitness inserts a new prev input for an asset into the
 	// database.
 	InsertAssetWitness(context.Context, PrevInput) error
@@ -197,6 +221,15 @@ type ActiveAssetsStore interface {
 	QueryAssetTransfers(ctx context.Context,
 		tranferQuery TransferQuery) ([]AssetTransfer, error)
 
+	// UpsertTransferMetadata inserts a new or updates an existing external
+	// metadata entry of an asset transfer.
+	UpsertTransferMetadata(ctx context.Context, arg TransferMetadata) error
+
+	// FetchTransferMetadata fetches all external metadata entries of the
+	// asset transfer with the given primary key.
+	FetchTransferMetadata(ctx context.Context,
+		transferID int32) ([]TransferMetadataEntry, error)
+
 	// DeleteAssetWitnesses deletes the witnesses on disk associated with a
 	// given asset ID.
 	DeleteAssetWitnesses(ctx context.Context, assetID int32) error
@@ -213,6 +246,32 @@ type ActiveAssetsStore interface {
 	// ID.
 	FetchSpendProofs(ctx context.Context,
 		transferID int32) (sqlc.FetchSpendProofsRow, error)
+
+	// InsertProofCheckpoint marks a proof file checkpoint as trusted.
+	InsertProofCheckpoint(ctx context.Context,
+		arg NewProofCheckpoint) error
+
+	// FetchProofCheckpoint fetches a trusted proof file checkpoint by its
+	// root.
+	FetchProofCheckpoint(ctx context.Context,
+		checkpointRoot []byte) (sqlc.ProofCheckpoint, error)
+
+	// InsertAssetBurn records a new asset burn of a transfer.
+	InsertAssetBurn(ctx context.Context, arg NewAssetBurn) error
+
+	// QueryAssetBurns queries the asset burns, optionally filtered by the
+	// ID of the burned asset or the transfer that created them.
+	QueryAssetBurns(ctx context.Context,
+		burnQuery BurnQuery) ([]AssetBurn, error)
+
+	// FetchRecentChainTxns fetches all chain transactions that were
+	// confirmed at or above the given block height.
+	FetchRecentChainTxns(ctx context.Context,
+		minHeight sql.NullInt32) ([]sqlc.ChainTxn, error)
+
+	// UpdateChainTxConf overwrites the confirmation details of a chain
+	// transaction, identified by its txid.
+	UpdateChainTxConf(ctx context.Context, arg ChainTxConfUpdate) error
 }
 
 // AssetBalance holds a balance query result for a particular asset or all
@@ -271,6 +330,10 @@ type ChainAsset struct {
 	// AnchorBlockHash is the blockhash that mined the anchor tx.
 	AnchorBlockHash chainhash.Hash
 
+	// AnchorBlockHeight is the height of the block that mined the anchor
+	// tx. This is zero if the anchor tx hasn't confirmed yet.
+	AnchorBlockHeight uint32
+
 	// AnchorOutpoint is the outpoint that commits to the asset.
 	AnchorOutpoint wire.OutPoint
 
@@ -470,17 +533,26 @@ func dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 		if err != nil {
 			return nil, err
 		}
+		tapLeaves, err := asset.DecodeTapLeaves(
+			sprout.ScriptKeyTapscriptTree,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode tapscript "+
+				"tree: %w", err)
+		}
 		scriptKey := asset.ScriptKey{
 			PubKey: scriptKeyPub,
 			TweakedScriptKey: &asset.TweakedScriptKey{
-				RawKey: rawScriptKeyDesc,
-				Tweak:  sprout.ScriptKeyTweak,
+				RawKey:    rawScriptKeyDesc,
+				Tweak:     sprout.ScriptKeyTweak,
+				TapLeaves: tapLeaves,
 			},
 		}
 
 		assetSprout, err := asset.New(
 			assetGenesis, amount, lockTime, relativeLocktime,
-			scriptKey, familyKey,
+			asset.ScriptVersion(sprout.ScriptVersion), scriptKey,
+			familyKey,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create new sprout: "+
@@ -557,10 +629,13 @@ func dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 		}
 
 		chainAssets[i] = &ChainAsset{
-			Asset:             assetSprout,
-			AnchorTx:          anchorTx,
-			AnchorTxid:        anchorTx.TxHash(),
-			AnchorBlockHash:   anchorBlockHash,
+			Asset:           assetSprout,
+			AnchorTx:        anchorTx,
+			AnchorTxid:      anchorTx.TxHash(),
+			AnchorBlockHash: anchorBlockHash,
+			AnchorBlockHeight: extractSqlInt32[uint32](
+				sprout.AnchorBlockHeight,
+			),
 			AnchorOutpoint:    anchorOutpoint,
 			AnchorInternalKey: anchorInternalKey,
 		}
@@ -574,6 +649,8 @@ func dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 func constraintsToDbFilter(query *AssetQueryFilters) QueryAssetFilters {
 	var assetFilter QueryAssetFilters
 	if query != nil {
+		assetFilter.SpendableOnly = query.SpendableOnly
+
 		if query.MinAmt != 0 {
 			assetFilter.MinAmt = sql.NullInt64{
 				Int64: int64(query.MinAmt),
@@ -627,6 +704,10 @@ func fetchAssetsWithWitness(ctx context.Context, q ActiveAssetsStore,
 // which lets us filter the results of the set of assets returned.
 type AssetQueryFilters struct {
 	tarofreighter.CommitmentConstraints
+
+	// SpendableOnly if set, only assets we can spend are returned. Assets
+	// received with a watch-only address are skipped in that case.
+	SpendableOnly bool
 }
 
 // QueryBalancesByAsset queries the balances for assets or alternatively
@@ -781,44 +862,58 @@ func (a *AssetStore) FetchAssetProofs(ctx context.Context,
 	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
 		// No target asset so we can just read them all from disk.
 		if len(targetAssets) == 0 {
-			assetProofs, err := q.FetchAssetProofs(ctx)
+			fileRows, err := q.FetchProofFiles(
+				ctx, ProofFileFilter{},
+			)
 			if err != nil {
 				return fmt.Errorf("unable to fetch asset "+
 					"proofs: %w", err)
 			}
 
-			for _, p := range assetProofs {
-				scriptKey, err := btcec.ParsePubKey(p.ScriptKey)
+			for _, fileRow := range fileRows {
+				scriptKey, err := btcec.ParsePubKey(
+					fileRow.ScriptKey,
+				)
+				if err != nil {
+					return err
+				}
+
+				blob, err := fetchProofFileBlob(ctx, q, fileRow)
 				if err != nil {
 					return err
 				}
 
-				serializedKey := asset.ToSerialized(scriptKey)
-				proofs[serializedKey] = p.ProofFile
+				proofs[asset.ToSerialized(scriptKey)] = blob
 			}
 
 			return nil
 		}
 
 		// Otherwise, we'll need to issue a series of queries to fetch
-		// each of the relevant proof files.
-		//
-		// TODO(roasbeef): can modify the query to use IN somewhere
-		// instead? then would take input params and insert into
-		// virtual rows to use
+		// each of the relevant proof files. If assets with different
+		// IDs share the script key, the most recently stored proof
+		// file is returned.
 		for _, scriptKey := range targetAssets {
-			scriptKey := scriptKey
-			serializedKey := asset.ToSerialized(scriptKey)
+			fileRows, err := q.FetchProofFiles(ctx, ProofFileFilter{
+				ScriptKey: scriptKey.SerializeCompressed(),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to fetch asset "+
+					"proofs: %w", err)
+			}
+			if len(fileRows) == 0 {
+				return fmt.Errorf("unable to fetch asset "+
+					"proof: %w", proof.ErrProofNotFound)
+			}
 
-			assetProof, err := q.FetchAssetProof(
-				ctx, serializedKey[:],
+			blob, err := fetchProofFileBlob(
+				ctx, q, fileRows[len(fileRows)-1],
 			)
 			if err != nil {
-				return fmt.Errorf("unable to fetch asset "+
-					"proof: %w", err)
+				return err
 			}
 
-			proofs[serializedKey] = assetProof.ProofFile
+			proofs[asset.ToSerialized(scriptKey)] = blob
 		}
 		return nil
 	})
@@ -836,34 +931,92 @@ func (a *AssetStore) FetchAssetProofs(ctx context.Context,
 func (a *AssetStore) FetchProof(ctx context.Context,
 	locator proof.Locator) (proof.Blob, error) {
 
-	// We don't need anything else but the script key since we have an
-	// on-disk index for all proofs we store.
-	scriptKey := locator.ScriptKey
-
 	var diskProof proof.Blob
 
 	readOpts := NewAssetStoreReadTx()
 	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
-		assetProof, err := q.FetchAssetProof(
-			ctx, scriptKey.SerializeCompressed(),
-		)
+		var err error
+		_, diskProof, err = fetchProofFile(ctx, q, locator)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return diskProof, nil
+}
+
+// FetchProofs fetches all proofs of assets with the given asset ID and/or
+// family key. If neither is specified, all proofs of the archive are returned.
+//
+// NOTE: This implements the proof.Archiver interface.
+func (a *AssetStore) FetchProofs(ctx context.Context, assetID *asset.ID,
+	familyKey *btcec.PublicKey) ([]*proof.AnnotatedProof, error) {
+
+	assetFilter := constraintsToDbFilter(&AssetQueryFilters{
+		CommitmentConstraints: tarofreighter.CommitmentConstraints{
+			AssetID:   assetID,
+			FamilyKey: familyKey,
+		},
+	})
+
+	var proofs []*proof.AnnotatedProof
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbAssets, err := q.QueryAssets(ctx, assetFilter)
 		if err != nil {
-			return fmt.Errorf("unable to fetch asset "+
-				"proof: %w", err)
+			return fmt.Errorf("unable to read db assets: %w", err)
 		}
 
-		diskProof = assetProof.ProofFile
+		for _, dbAsset := range dbAssets {
+			scriptKey, err := btcec.ParsePubKey(
+				dbAsset.TweakedScriptKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			var id asset.ID
+			copy(id[:], dbAsset.AssetID)
+
+			loc := proof.Locator{
+				AssetID:   &id,
+				ScriptKey: *scriptKey,
+			}
+			if len(dbAsset.TweakedFamKey) != 0 {
+				loc.FamilyKey, err = btcec.ParsePubKey(
+					dbAsset.TweakedFamKey,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			_, blob, err := fetchProofFile(ctx, q, loc)
+			switch {
+			// Not every asset necessarily has a proof yet.
+			case errors.Is(err, proof.ErrProofNotFound):
+				continue
+
+			case err != nil:
+				return fmt.Errorf("unable to fetch asset "+
+					"proof: %w", err)
+			}
+
+			proofs = append(proofs, &proof.AnnotatedProof{
+				Locator: loc,
+				Blob:    blob,
+			})
+		}
 
 		return nil
 	})
-	switch {
-	case errors.Is(dbErr, sql.ErrNoRows):
-		return nil, proof.ErrProofNotFound
-	case dbErr != nil:
+	if dbErr != nil {
 		return nil, dbErr
 	}
 
-	return diskProof, nil
+	return proofs, nil
 }
 
 // insertAssetWitnesses attempts to insert the set of asset witnesses in to the
@@ -1010,12 +1163,13 @@ func (a *AssetStore) importAssetFromProof(ctx context.Context,
 	}
 
 	// As a final step, we'll insert the proof file we used to generate all
-	// the above information.
-	scriptKeyBytes := newAsset.ScriptKey.PubKey.SerializeCompressed()
-	return db.UpsertAssetProof(ctx, ProofUpdate{
-		TweakedScriptKey: scriptKeyBytes,
-		ProofFile:        proof.Blob,
-	})
+	// the above information. The proof file is stored under the ID of the
+	// asset it proves, which the locator might not specify.
+	loc := proof.Locator
+	assetID := newAsset.ID()
+	loc.AssetID = &assetID
+
+	return insertProofFile(ctx, db, loc, proof.Blob)
 }
 
 // ImportProofs attempts to store fully populated proofs on disk. The previous
@@ -1040,6 +1194,140 @@ func (a *AssetStore) ImportProofs(ctx context.Context,
 	})
 }
 
+// ReplaceProofs replaces the proof files of assets keyed by the asset ID and
+// script key of their locator. Other than ImportProofs, this only stores the
+// proof files and doesn't import the assets they describe.
+//
+// NOTE: This implements the proof.Rewriter interface.
+func (a *AssetStore) ReplaceProofs(ctx context.Context,
+	proofs ...*proof.AnnotatedProof) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		for _, p := range proofs {
+			err := insertProofFile(ctx, q, p.Locator, p.Blob)
+			if err != nil {
+				return fmt.Errorf("unable to replace proof: %w",
+					err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// MigrateLegacyProofs moves the proof files that are still stored as full
+// blobs in the legacy asset_proofs table into the deduplicated proof archive.
+// Proof files that are already in the archive take precedence, as they were
+// written more recently. The empty proof files the database migration created
+// for the legacy proofs, so address events can reference them, are filled in.
+func (a *AssetStore) MigrateLegacyProofs(ctx context.Context) error {
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		legacyProofs, err := q.FetchLegacyAssetProofs(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch legacy proofs: %w",
+				err)
+		}
+
+		for _, legacyProof := range legacyProofs {
+			fileRow, err := q.FetchProofFile(ctx, ProofFileID{
+				AssetID:   legacyProof.AssetID,
+				ScriptKey: legacyProof.ScriptKey,
+			})
+			switch {
+			case err == nil:
+				rawProofs, err := q.FetchProofFileTransitions(
+					ctx, fileRow.FileID,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to fetch "+
+						"proof file transitions: %w",
+						err)
+				}
+				if len(rawProofs) > 0 {
+					continue
+				}
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return fmt.Errorf("unable to fetch proof "+
+					"file: %w", err)
+			}
+
+			scriptKey, err := btcec.ParsePubKey(
+				legacyProof.ScriptKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], legacyProof.AssetID)
+
+			err = insertProofFile(ctx, q, proof.Locator{
+				AssetID:   &assetID,
+				ScriptKey: *scriptKey,
+			}, legacyProof.ProofFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(legacyProofs) > 0 {
+			log.Infof("Migrated %d legacy proof files",
+				len(legacyProofs))
+		}
+
+		return q.DeleteLegacyAssetProofs(ctx)
+	})
+}
+
+// IsTrustedCheckpoint returns true if the given checkpoint root was marked as
+// trusted before.
+//
+// NOTE: This implements the proof.CheckpointArchive interface.
+func (a *AssetStore) IsTrustedCheckpoint(ctx context.Context,
+	root [sha256.Size]byte) (bool, error) {
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		_, err := q.FetchProofCheckpoint(ctx, root[:])
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return false, nil
+	case dbErr != nil:
+		return false, dbErr
+	}
+
+	return true, nil
+}
+
+// AddTrustedCheckpoint marks the given checkpoint root of the asset
+// identified by the locator as trusted.
+//
+// NOTE: This implements the proof.CheckpointArchive interface.
+func (a *AssetStore) AddTrustedCheckpoint(ctx context.Context,
+	loc proof.Locator, root [sha256.Size]byte, numPruned uint64) error {
+
+	var assetID []byte
+	if loc.AssetID != nil {
+		assetID = loc.AssetID[:]
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.InsertProofCheckpoint(ctx, NewProofCheckpoint{
+			CheckpointRoot: root[:],
+			AssetID:        assetID,
+			ScriptKey:      loc.ScriptKey.SerializeCompressed(),
+			NumPruned:      int64(numPruned),
+			CreationTime:   time.Now().UTC(),
+		})
+	})
+}
+
 // queryChainAssets queries the database for assets matching the passed filter.
 // The returned assets have all anchor and witness information populated.
 func queryChainAssets(ctx context.Context, q ActiveAssetsStore,
@@ -1078,7 +1366,8 @@ func (a *AssetStore) SelectCommitment(
 	// First, we'll map the commitment constraints to our database query
 	// filters.
 	assetFilter := constraintsToDbFilter(&AssetQueryFilters{
-		constraints,
+		CommitmentConstraints: constraints,
+		SpendableOnly:         true,
 	})
 
 	readOpts := NewAssetStoreReadTx()
@@ -1215,9 +1504,10 @@ func (a *AssetStore) SelectCommitment(
 					),
 				},
 			},
-			TapscriptSibling: anchorUTXO.TapscriptSibling,
-			Asset:            matchingAsset.Asset,
-			Commitment:       anchorPointToCommitment[anchorPoint],
+			TapscriptSibling:  anchorUTXO.TapscriptSibling,
+			AnchorBlockHeight: matchingAsset.AnchorBlockHeight,
+			Asset:             matchingAsset.Asset,
+			Commitment:        anchorPointToCommitment[anchorPoint],
 		}
 	}
 
@@ -1305,12 +1595,26 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			NewInternalKey:   internalKeyID,
 			NewAnchorUtxo:    newUtxoID,
 			TransferTimeUnix: spend.TransferTime,
+			Label:            spend.Label,
+			NumConfs:         int32(spend.NumConfs),
 		})
 		if err != nil {
 			return fmt.Errorf("unable to insert asset "+
 				"transfer: %w", err)
 		}
 
+		for key, value := range spend.ExternalMetadata {
+			err := q.UpsertTransferMetadata(ctx, TransferMetadata{
+				TransferID: transferID,
+				MetaKey:    key,
+				MetaValue:  value,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert transfer "+
+					"metadata: %w", err)
+			}
+		}
+
 		// Now that the transfer itself has been inserted, we can
 		// insert the deltas associated w/ each transfer.
 		for _, assetDelta := range spend.AssetSpendDeltas {
@@ -1356,10 +1660,18 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 				return fmt.Errorf("unable to insert internal "+
 					"key: %w", err)
 			}
+			tapscriptTree, err := asset.EncodeTapLeaves(
+				assetDelta.NewScriptKey.TapLeaves,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to encode "+
+					"tapscript tree: %w", err)
+			}
 			scriptKeyID, err := q.UpsertScriptKey(ctx, NewScriptKey{
 				InternalKeyID:    rawScriptKeyID,
 				TweakedScriptKey: assetDelta.NewScriptKey.PubKey.SerializeCompressed(),
 				Tweak:            assetDelta.NewScriptKey.Tweak,
+				TapscriptTree:    tapscriptTree,
 			})
 			if err != nil {
 				return fmt.Errorf("unable to insert script "+
@@ -1384,10 +1696,132 @@ func (a *AssetStore) LogPendingParcel(ctx context.Context,
 			}
 		}
 
+		// The anchor output of a burn uses one of our internal keys,
+		// so we track it as a managed UTXO to not lose the BTC it
+		// carries. No assets are inserted for it, as burned assets
+		// can't be spent.
+		if spend.BurnAnchor != nil {
+			err := insertBurnAnchor(
+				ctx, q, spend.BurnAnchor, spend.AnchorTx, txnID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Finally, we'll record any assets that were burned by this
+		// transfer.
+		for _, burn := range spend.Burns {
+			err := q.InsertAssetBurn(ctx, NewAssetBurn{
+				TransferID: transferID,
+				AssetID:    burn.AssetID[:],
+				BurnKey:    burn.BurnKey.SerializeCompressed(),
+				Amount:     int64(burn.Amount),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to insert asset "+
+					"burn: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
 
+// insertBurnAnchor inserts the anchor output of a burn as a managed UTXO.
+func insertBurnAnchor(ctx context.Context, q ActiveAssetsStore,
+	burnAnchor *tarofreighter.AnchorOutput, anchorTx *wire.MsgTx,
+	txnID int32) error {
+
+	anchorPointBytes, err := encodeOutpoint(burnAnchor.AnchorPoint)
+	if err != nil {
+		return err
+	}
+
+	internalKeyBytes := burnAnchor.InternalKey.PubKey.SerializeCompressed()
+	_, err = q.UpsertInternalKey(ctx, InternalKey{
+		RawKey:    internalKeyBytes,
+		KeyFamily: int32(burnAnchor.InternalKey.Family),
+		KeyIndex:  int32(burnAnchor.InternalKey.Index),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert burn internal key: %w", err)
+	}
+
+	anchorIndex := burnAnchor.AnchorPoint.Index
+	_, err = q.UpsertManagedUTXO(ctx, RawManagedUTXO{
+		RawKey:   internalKeyBytes,
+		Outpoint: anchorPointBytes,
+		AmtSats:  anchorTx.TxOut[anchorIndex].Value,
+		TaroRoot: burnAnchor.TaroRoot,
+		TxnID:    txnID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert burn anchor utxo: %w", err)
+	}
+
+	return nil
+}
+
+// dbBurnsToAssetBurns maps a set of burn records to asset burns.
+func dbBurnsToAssetBurns(
+	dbBurns []AssetBurn) ([]tarofreighter.AssetBurn, error) {
+
+	var burns []tarofreighter.AssetBurn
+	for _, dbBurn := range dbBurns {
+		burnKey, err := btcec.ParsePubKey(dbBurn.BurnKey)
+		if err != nil {
+			return nil, err
+		}
+
+		anchorTxid, err := chainhash.NewHash(dbBurn.AnchorTxid)
+		if err != nil {
+			return nil, err
+		}
+
+		burn := tarofreighter.AssetBurn{
+			BurnKey:    *burnKey,
+			Amount:     uint64(dbBurn.Amount),
+			AnchorTxid: *anchorTxid,
+			BurnTime:   dbBurn.TransferTimeUnix,
+		}
+		copy(burn.AssetID[:], dbBurn.AssetID)
+
+		burns = append(burns, burn)
+	}
+
+	return burns, nil
+}
+
+// QueryBurns returns the set of assets burned by our transfers, optionally
+// filtered by the ID of the burned asset.
+func (a *AssetStore) QueryBurns(ctx context.Context,
+	assetID *asset.ID) ([]tarofreighter.AssetBurn, error) {
+
+	var burnQuery BurnQuery
+	if assetID != nil {
+		burnQuery.AssetID = assetID[:]
+	}
+
+	var burns []tarofreighter.AssetBurn
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbBurns, err := q.QueryAssetBurns(ctx, burnQuery)
+		if err != nil {
+			return err
+		}
+
+		burns, err = dbBurnsToAssetBurns(dbBurns)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return burns, nil
+}
+
 // ConfirmParcelDelivery marks a spend event on disk as confirmed. This updates
 // the on-chain reference information on disk to point to this new spend.
 func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
@@ -1477,10 +1911,22 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 
 			// Now we can update the asset proof for the sender for
 			// this given delta.
-			err = q.UpsertAssetProof(ctx, ProofUpdate{
-				TweakedScriptKey: assetDelta.NewScriptKeyBytes,
-				ProofFile:        conf.FinalSenderProof,
-			})
+			err = insertProofFile(
+				ctx, q, conf.FinalSenderProof.Locator,
+				conf.FinalSenderProof.Blob,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// We also keep a copy of the receiver's final proof, so we
+		// can deliver it again if needed.
+		if conf.FinalReceiverProof != nil {
+			err = insertProofFile(
+				ctx, q, conf.FinalReceiverProof.Locator,
+				conf.FinalReceiverProof.Blob,
+			)
 			if err != nil {
 				return err
 			}
@@ -1516,12 +1962,15 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 func (a *AssetStore) PendingParcels(
 	ctx context.Context) ([]*tarofreighter.OutboundParcelDelta, error) {
 
-	return a.QueryParcels(ctx, true)
+	return a.QueryParcels(ctx, tarofreighter.ParcelQueryParams{
+		PendingOnly: true,
+	})
 }
 
-// QueryParcels returns the set of confirmed or unconfirmed parcels.
+// QueryParcels returns the set of parcels that match the given query params.
 func (a *AssetStore) QueryParcels(ctx context.Context,
-	pending bool) ([]*tarofreighter.OutboundParcelDelta, error) {
+	params tarofreighter.ParcelQueryParams) (
+	[]*tarofreighter.OutboundParcelDelta, error) {
 
 	var deltas []*tarofreighter.OutboundParcelDelta
 
@@ -1530,7 +1979,10 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 		// If we want every unconfirmed transfer, then we only pass in
 		// the UnconfOnly field.
 		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
-			UnconfOnly: pending,
+			UnconfOnly: params.PendingOnly,
+			Label:      sqlOptStr(params.Label),
+			MetaKey:    sqlOptStr(params.ExternalMetadataKey),
+			MetaValue:  sqlOptStr(params.ExternalMetadataValue),
 		})
 		if err != nil {
 			return err
@@ -1613,6 +2065,14 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 						"witness: %v", err)
 				}
 
+				tapLeaves, err := asset.DecodeTapLeaves(
+					delta.ScriptKeyTapscriptTree,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to decode "+
+						"tapscript tree: %w", err)
+				}
+
 				tweakedScriptKey := &asset.TweakedScriptKey{
 					RawKey: keychain.KeyDescriptor{
 						PubKey: rawScriptKey,
@@ -1625,7 +2085,8 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 							),
 						},
 					},
-					Tweak: delta.ScriptKeyTweak,
+					Tweak:     delta.ScriptKeyTweak,
+					TapLeaves: tapLeaves,
 				}
 				spendDeltas[i] = tarofreighter.AssetSpendDelta{
 					OldScriptKey: *oldScriptKey,
@@ -1644,6 +2105,34 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 				}
 			}
 
+			dbBurns, err := q.QueryAssetBurns(ctx, BurnQuery{
+				TransferID: sqlInt32(xfer.TransferID),
+			})
+			if err != nil {
+				return err
+			}
+			burns, err := dbBurnsToAssetBurns(dbBurns)
+			if err != nil {
+				return err
+			}
+
+			metaEntries, err := q.FetchTransferMetadata(
+				ctx, xfer.TransferID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch transfer "+
+					"metadata: %w", err)
+			}
+			var metadata map[string]string
+			if len(metaEntries) > 0 {
+				metadata = make(
+					map[string]string, len(metaEntries),
+				)
+			}
+			for _, entry := range metaEntries {
+				metadata[entry.MetaKey] = entry.MetaValue
+			}
+
 			deltas = append(deltas, &tarofreighter.OutboundParcelDelta{
 				OldAnchorPoint: oldAnchorPoint,
 				NewAnchorPoint: newAnchorPoint,
@@ -1660,6 +2149,10 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 				AssetSpendDeltas: spendDeltas,
 				TransferTime:     xfer.TransferTimeUnix,
 				ChainFees:        xfer.ChainFees,
+				Burns:            burns,
+				Label:            xfer.Label,
+				ExternalMetadata: metadata,
+				NumConfs:         uint32(xfer.NumConfs),
 			})
 		}
 
@@ -1672,10 +2165,127 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 	return deltas, nil
 }
 
+// FetchRecentAnchorTxns returns the confirmation details of all anchor
+// transactions that were confirmed at or above the given block height.
+//
+// NOTE: This implements the tarogarden.AnchorTxStore interface.
+func (a *AssetStore) FetchRecentAnchorTxns(ctx context.Context,
+	minHeight uint32) ([]*chainntnfs.TxConfirmation, error) {
+
+	var confs []*chainntnfs.TxConfirmation
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		dbTxns, err := q.FetchRecentChainTxns(ctx, sqlInt32(minHeight))
+		if err != nil {
+			return fmt.Errorf("unable to fetch chain txns: %w", err)
+		}
+
+		for _, dbTx := range dbTxns {
+			var tx wire.MsgTx
+			err := tx.Deserialize(bytes.NewReader(dbTx.RawTx))
+			if err != nil {
+				return err
+			}
+
+			blockHash, err := chainhash.NewHash(dbTx.BlockHash)
+			if err != nil {
+				return err
+			}
+
+			confs = append(confs, &chainntnfs.TxConfirmation{
+				BlockHash: blockHash,
+				BlockHeight: extractSqlInt32[uint32](
+					dbTx.BlockHeight,
+				),
+				TxIndex: extractSqlInt32[uint32](dbTx.TxIndex),
+				Tx:      &tx,
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return confs, nil
+}
+
+// FetchAnchoredProofs returns all proof files that contain a state transition
+// proof anchored in the transaction with the given txid. Besides the proofs of
+// the assets currently anchored in the transaction, these are the proofs of
+// assets that were sent to others in the transaction and of all assets that
+// descend from them.
+//
+// NOTE: This implements the tarogarden.AnchorTxStore interface.
+func (a *AssetStore) FetchAnchoredProofs(ctx context.Context,
+	txid chainhash.Hash) (proof.AssetBlobs, error) {
+
+	proofs := make(proof.AssetBlobs)
+
+	// Looking up the proofs might fill in the anchor txid of old state
+	// transition proofs, so we need a write transaction.
+	var writeTxOpts AssetStoreTxOptions
+	dbErr := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		fileRows, blobs, err := fetchProofFilesByAnchor(ctx, q, txid)
+		if err != nil {
+			return err
+		}
+
+		for idx, fileRow := range fileRows {
+			scriptKey, err := btcec.ParsePubKey(fileRow.ScriptKey)
+			if err != nil {
+				return err
+			}
+
+			proofs[asset.ToSerialized(scriptKey)] = blobs[idx]
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return proofs, nil
+}
+
+// UpdateAnchorTxConf updates the confirmation details of the anchor
+// transaction with the given txid. A nil confirmation marks the transaction as
+// unconfirmed again.
+//
+// NOTE: This implements the tarogarden.AnchorTxStore interface.
+func (a *AssetStore) UpdateAnchorTxConf(ctx context.Context,
+	txid chainhash.Hash, conf *chainntnfs.TxConfirmation) error {
+
+	params := ChainTxConfUpdate{
+		Txid: txid[:],
+	}
+	if conf != nil {
+		params.BlockHash = conf.BlockHash[:]
+		params.BlockHeight = sqlInt32(conf.BlockHeight)
+		params.TxIndex = sqlInt32(conf.TxIndex)
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		return q.UpdateChainTxConf(ctx, params)
+	})
+}
+
 // A compile-time constraint to ensure that AssetStore meets the proof.Archiver
 // interface.
 var _ proof.Archiver = (*AssetStore)(nil)
 
+// A compile-time constraint to ensure that AssetStore meets the proof.Rewriter
+// interface.
+var _ proof.Rewriter = (*AssetStore)(nil)
+
+// A compile-time constraint to ensure that AssetStore meets the
+// proof.CheckpointArchive interface.
+var _ proof.CheckpointArchive = (*AssetStore)(nil)
+
 // A compile-time constraint to ensure that AssetStore meets the
 // tarofreighter.CommitmentSelector interface.
 var _ tarofreighter.CommitmentSelector = (*AssetStore)(nil)
@@ -1683,3 +2293,7 @@ var _ tarofreighter.CommitmentSelector = (*AssetStore)(nil)
 // A compile-time constraint to ensure that AssetStore meets the
 // tarofreighter.ExportLog interface.
 var _ tarofreighter.ExportLog = (*AssetStore)(nil)
+
+// A compile-time constraint to ensure that AssetStore meets the
+// tarogarden.AnchorTxStore interface.
+var _ tarogarden.AnchorTxStore = (*AssetStore)(nil)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"database/sql"
 	"math/rand"
 	"testing"
 
@@ -11,12 +12,14 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/mssmt"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarofreighter"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/stretchr/testify/require"
 )
@@ -241,7 +244,9 @@ func TestImportAssetProof(t *testing.T) {
 			AssetID:   &assetID,
 			ScriptKey: *testAsset.ScriptKey.PubKey,
 		},
-		Blob: bytes.Repeat([]byte{0x0}, 100),
+		Blob: encodeRawFile(t, proof.NewFileFromRawProofs(
+			proof.V0, nil, test.RandBytes(100),
+		)),
 		AssetSnapshot: &proof.AssetSnapshot{
 			Asset:             testAsset,
 			OutPoint:          anchorPoint,
@@ -320,8 +325,9 @@ func TestImportAssetProof(t *testing.T) {
 	require.Equal(t, testProof.AnchorTx.TxHash(), dbAsset.AnchorTx.TxHash())
 
 	// We should also be able to fetch the proof we just inserted using the
-	// script key of the new asset.
+	// asset ID and script key of the new asset.
 	_, err = assetStore.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
 		ScriptKey: *testAsset.ScriptKey.PubKey,
 	})
 	require.NoError(t, err)
@@ -341,6 +347,92 @@ func TestImportAssetProof(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, selectedAssets, 1)
 	assertAssetEqual(t, testAsset, selectedAssets[0].Asset)
+
+	balances, err := assetStore.QueryBalancesByAsset(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+
+	// If the asset was received with a watch-only address, it's no longer
+	// part of our balance and can't be selected as an input anymore. But
+	// it's still listed.
+	sqlDB := db.(*SqliteStore)
+	addrBook := NewTaroAddressBook(
+		NewTransactionExecutor[AddrBook](
+			sqlDB, func(tx *sql.Tx) AddrBook {
+				return sqlDB.WithTx(tx)
+			},
+		), chainParams,
+	)
+	watchOnlyAddr := address.RandAddr(t, chainParams)
+	watchOnlyAddr.ScriptKey = *testAsset.ScriptKey.PubKey
+	watchOnlyAddr.ScriptKeyTweak.RawKey = testAsset.ScriptKey.RawKey
+	watchOnlyAddr.WatchOnly = true
+	require.NoError(t, addrBook.InsertAddrs(ctx, *watchOnlyAddr))
+
+	_, err = assetStore.SelectCommitment(ctx, assetConstraints)
+	require.ErrorIs(t, err, tarofreighter.ErrNoPossibleAssetInputs)
+
+	balances, err = assetStore.QueryBalancesByAsset(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, balances)
+
+	assets, err = assetStore.FetchAllAssets(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+
+	// Proof files that are still stored in the legacy asset_proofs table
+	// are moved into the proof archive on startup. A proof file that is
+	// already in the archive takes precedence over the legacy one.
+	insertLegacyProof := func(blob proof.Blob) {
+		_, err := sqlDB.Exec(
+			"INSERT INTO asset_proofs (asset_id, proof_file) "+
+				"SELECT asset_id, $1 FROM assets", blob,
+		)
+		require.NoError(t, err)
+	}
+	assertProof := func(blob proof.Blob) {
+		diskProof, err := assetStore.FetchProof(ctx, proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: *testAsset.ScriptKey.PubKey,
+		})
+		require.NoError(t, err)
+		require.Equal(t, blob, diskProof)
+
+		var numLegacy int
+		err = sqlDB.QueryRow(
+			"SELECT COUNT(*) FROM asset_proofs",
+		).Scan(&numLegacy)
+		require.NoError(t, err)
+		require.Zero(t, numLegacy)
+	}
+
+	legacyBlob := encodeRawFile(t, proof.NewFileFromRawProofs(
+		proof.V0, nil, test.RandBytes(100),
+	))
+	insertLegacyProof(legacyBlob)
+	require.NoError(t, assetStore.MigrateLegacyProofs(ctx))
+	assertProof(testProof.Blob)
+
+	// If the archive doesn't know the proof file yet, the legacy one is
+	// moved over.
+	_, err = sqlDB.Exec("DELETE FROM proof_file_transitions")
+	require.NoError(t, err)
+	_, err = sqlDB.Exec("DELETE FROM proof_files")
+	require.NoError(t, err)
+
+	insertLegacyProof(legacyBlob)
+	require.NoError(t, assetStore.MigrateLegacyProofs(ctx))
+	assertProof(legacyBlob)
+
+	// The database migration creates empty proof files for the legacy
+	// ones, so address events can reference them. Those are filled in with
+	// the legacy proof file.
+	_, err = sqlDB.Exec("DELETE FROM proof_file_transitions")
+	require.NoError(t, err)
+
+	insertLegacyProof(testProof.Blob)
+	require.NoError(t, assetStore.MigrateLegacyProofs(ctx))
+	assertProof(testProof.Blob)
 }
 
 // TestInternalKeyUpsert tests that if we insert an internal key that's a
@@ -374,6 +466,42 @@ func TestInternalKeyUpsert(t *testing.T) {
 	require.Equal(t, k1, k2)
 }
 
+// TestTrustedCheckpoints tests that we can mark proof file checkpoints as
+// trusted and look them up again by their root.
+func TestTrustedCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	_, assetStore, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	assetID := asset.RandID(t)
+	loc := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	checkpoint := &proof.Checkpoint{
+		NumPruned: 3,
+	}
+	root := checkpoint.Root()
+
+	// Before we add the checkpoint, it shouldn't be trusted.
+	trusted, err := assetStore.IsTrustedCheckpoint(ctx, root)
+	require.NoError(t, err)
+	require.False(t, trusted)
+
+	// Adding the same checkpoint twice should be a no-op the second time.
+	require.NoError(t, assetStore.AddTrustedCheckpoint(
+		ctx, loc, root, checkpoint.NumPruned,
+	))
+	require.NoError(t, assetStore.AddTrustedCheckpoint(
+		ctx, loc, root, checkpoint.NumPruned,
+	))
+
+	trusted, err = assetStore.IsTrustedCheckpoint(ctx, root)
+	require.NoError(t, err)
+	require.True(t, trusted)
+}
+
 type assetDesc struct {
 	assetGen    asset.Genesis
 	anchorPoint wire.OutPoint
@@ -485,15 +613,22 @@ func (a *assetGenerator) genAssets(t *testing.T, assetStore *AssetStore,
 
 		anchorPoint := a.anchorPointsToTx[desc.anchorPoint]
 
+		assetID := asset.ID()
 		err = assetStore.importAssetFromProof(
 			ctx, assetStore.db, &proof.AnnotatedProof{
+				Locator: proof.Locator{
+					AssetID:   &assetID,
+					ScriptKey: *asset.ScriptKey.PubKey,
+				},
 				AssetSnapshot: &proof.AssetSnapshot{
 					AnchorTx:    anchorPoint,
 					InternalKey: test.RandPubKey(t),
 					Asset:       asset,
 					ScriptRoot:  taroCommitment,
 				},
-				Blob: bytes.Repeat([]byte{1}, 100),
+				Blob: encodeRawFile(t, proof.NewFileFromRawProofs(
+					proof.V0, nil, bytes.Repeat([]byte{1}, 100),
+				)),
 			},
 		)
 		require.NoError(t, err)
@@ -720,6 +855,10 @@ func TestAssetExportLog(t *testing.T) {
 		PkScript: bytes.Repeat([]byte{0x01}, 34),
 		Value:    1000,
 	})
+	newAnchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x02}, 34),
+		Value:    1000,
+	})
 
 	newScriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
 		PubKey: test.RandPubKey(t),
@@ -744,6 +883,22 @@ func TestAssetExportLog(t *testing.T) {
 
 	chainFees := int64(100)
 
+	// The rest of the asset we modify is burned.
+	var burnedAssetID asset.ID
+	chainAssets, err := assetsStore.FetchAllAssets(ctx, nil)
+	require.NoError(t, err)
+	for _, chainAsset := range chainAssets {
+		if chainAsset.ScriptKey.PubKey.IsEqual(targetScriptKey.PubKey) {
+			burnedAssetID = chainAsset.ID()
+		}
+	}
+	burnKey := asset.DeriveBurnKey(asset.PrevID{
+		OutPoint:  assetGen.anchorPoints[0],
+		ID:        burnedAssetID,
+		ScriptKey: asset.ToSerialized(targetScriptKey.PubKey),
+	})
+	burnAmt := uint64(7)
+
 	// With the assets inserted, we'll now construct the struct we'll used
 	// to commit a new spend on disk.
 	anchorTxHash := newAnchorTx.TxHash()
@@ -783,9 +938,55 @@ func TestAssetExportLog(t *testing.T) {
 			},
 		},
 		ChainFees: int64(chainFees),
+		Burns: []tarofreighter.AssetBurn{{
+			AssetID:    burnedAssetID,
+			BurnKey:    *burnKey,
+			Amount:     burnAmt,
+			AnchorTxid: anchorTxHash,
+		}},
+		BurnAnchor: &tarofreighter.AnchorOutput{
+			AnchorPoint: wire.OutPoint{
+				Hash:  anchorTxHash,
+				Index: 1,
+			},
+			InternalKey: keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+			},
+			TaroRoot: bytes.Repeat([]byte{0x02}, 32),
+		},
+		Label: "payout",
+		ExternalMetadata: map[string]string{
+			"batch": "7",
+		},
+		NumConfs: 3,
 	}
 	require.NoError(t, assetsStore.LogPendingParcel(ctx, spendDelta))
 
+	// The burn should be recorded, and be found when filtering for the
+	// burned asset only.
+	burns, err := assetsStore.QueryBurns(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, spendDelta.Burns, burns)
+	burns, err = assetsStore.QueryBurns(ctx, &burnedAssetID)
+	require.NoError(t, err)
+	require.Equal(t, spendDelta.Burns, burns)
+	burns, err = assetsStore.QueryBurns(ctx, &asset.ID{})
+	require.NoError(t, err)
+	require.Empty(t, burns)
+
+	// The anchor output of the burn should be tracked as a managed UTXO.
+	burnAnchorBytes, err := encodeOutpoint(spendDelta.BurnAnchor.AnchorPoint)
+	require.NoError(t, err)
+	burnUTXO, err := db.FetchManagedUTXO(ctx, UtxoQuery{
+		Outpoint: burnAnchorBytes,
+	})
+	require.NoError(t, err)
+	require.Equal(t, spendDelta.BurnAnchor.TaroRoot, burnUTXO.TaroRoot)
+	require.Equal(
+		t, spendDelta.BurnAnchor.InternalKey.PubKey.SerializeCompressed(),
+		burnUTXO.RawKey,
+	)
+
 	// At this point, we should be able to query for the log parcel, by
 	// looking for all unconfirmed transfers.
 	assetTransfers, err := db.QueryAssetTransfers(ctx, TransferQuery{})
@@ -810,30 +1011,71 @@ func TestAssetExportLog(t *testing.T) {
 	require.Equal(t, 1, len(assetTransfers))
 
 	// This should also show up in the set of pending parcels. It should
-	// also match exactly the inbound parcel we used to make the delta.
+	// also match exactly the inbound parcel we used to make the delta,
+	// except for the burn anchor, which is only tracked as a managed UTXO.
 	parcels, err := assetsStore.PendingParcels(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(parcels))
-	require.Equal(t, spendDelta, parcels[0])
+	expectedParcel := *spendDelta
+	expectedParcel.BurnAnchor = nil
+	require.Equal(t, &expectedParcel, parcels[0])
+
+	// The parcel can also be found by its label and external metadata,
+	// but not if any of the filters doesn't match.
+	parcels, err = assetsStore.QueryParcels(
+		ctx, tarofreighter.ParcelQueryParams{
+			Label:                 "payout",
+			ExternalMetadataKey:   "batch",
+			ExternalMetadataValue: "7",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parcels))
+	require.Equal(t, &expectedParcel, parcels[0])
+
+	parcels, err = assetsStore.QueryParcels(
+		ctx, tarofreighter.ParcelQueryParams{
+			Label: "refund",
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	parcels, err = assetsStore.QueryParcels(
+		ctx, tarofreighter.ParcelQueryParams{
+			ExternalMetadataKey:   "batch",
+			ExternalMetadataValue: "8",
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
 
 	// With the asset delta committed and verified, we'll now mark the
 	// delta as being confirmed on chain.
 	fakeBlockHash := chainhash.Hash(sha256.Sum256([]byte("fake")))
 	blockHeight := int32(100)
 	txIndex := int32(10)
-	finalSenderBlob := bytes.Repeat([]byte{0x03}, 100)
+	finalSenderBlob := encodeRawFile(t, proof.NewFileFromRawProofs(
+		proof.V0, nil, anchoredRawProof(t, newAnchorTx),
+	))
 	err = assetsStore.ConfirmParcelDelivery(ctx, &tarofreighter.AssetConfirmEvent{
-		AnchorPoint:      spendDelta.NewAnchorPoint,
-		TxIndex:          txIndex,
-		BlockHeight:      blockHeight,
-		BlockHash:        fakeBlockHash,
-		FinalSenderProof: finalSenderBlob,
+		AnchorPoint: spendDelta.NewAnchorPoint,
+		TxIndex:     txIndex,
+		BlockHeight: blockHeight,
+		BlockHash:   fakeBlockHash,
+		FinalSenderProof: &proof.AnnotatedProof{
+			Locator: proof.Locator{
+				AssetID:   &burnedAssetID,
+				ScriptKey: *newScriptKey.PubKey,
+			},
+			Blob: finalSenderBlob,
+		},
 	})
 	require.NoError(t, err)
 
 	// We'll now fetch all the assets to verify that they were updated
 	// properly on disk.
-	chainAssets, err := assetsStore.FetchAllAssets(ctx, nil)
+	chainAssets, err = assetsStore.FetchAllAssets(ctx, nil)
 	require.NoError(t, err)
 	require.Equal(t, numAssets, len(chainAssets))
 
@@ -858,13 +1100,19 @@ func TestAssetExportLog(t *testing.T) {
 	}
 	require.True(t, mutationFound)
 
+	// The burned amount should no longer be part of our balance.
+	balances, err := assetsStore.QueryBalancesByAsset(ctx, &burnedAssetID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(newAmt), balances[burnedAssetID].Balance)
+
 	// As a final check for the asset, we'll fetch its blob to ensure it's
 	// been updated on disk.
-	diskSenderBlob, err := db.FetchAssetProof(
-		ctx, newScriptKey.PubKey.SerializeCompressed(),
-	)
+	diskSenderBlob, err := assetsStore.FetchProof(ctx, proof.Locator{
+		AssetID:   &burnedAssetID,
+		ScriptKey: *newScriptKey.PubKey,
+	})
 	require.NoError(t, err)
-	require.Equal(t, finalSenderBlob, diskSenderBlob.ProofFile)
+	require.Equal(t, finalSenderBlob, diskSenderBlob)
 
 	// If we fetch the chain transaction again, then it should have the
 	// conf information populated.
@@ -881,6 +1129,64 @@ func TestAssetExportLog(t *testing.T) {
 	parcels, err = assetsStore.PendingParcels(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(parcels))
+
+	// The anchor transaction should now show up as a recently confirmed
+	// anchor transaction, and the sender proof should be anchored in it.
+	assertRecentAnchor := func(minHeight uint32,
+		blockHash *chainhash.Hash, height, index uint32) {
+
+		recentTxns, err := assetsStore.FetchRecentAnchorTxns(
+			ctx, minHeight,
+		)
+		require.NoError(t, err)
+
+		var found bool
+		for _, conf := range recentTxns {
+			if conf.Tx.TxHash() != anchorTxHash {
+				continue
+			}
+
+			require.Equal(t, *blockHash, *conf.BlockHash)
+			require.Equal(t, height, conf.BlockHeight)
+			require.Equal(t, index, conf.TxIndex)
+			found = true
+		}
+		require.Equal(t, blockHash != nil, found)
+	}
+	assertRecentAnchor(
+		uint32(blockHeight), &fakeBlockHash, uint32(blockHeight),
+		uint32(txIndex),
+	)
+	assertRecentAnchor(uint32(blockHeight)+1, nil, 0, 0)
+
+	anchoredProofs, err := assetsStore.FetchAnchoredProofs(
+		ctx, anchorTxHash,
+	)
+	require.NoError(t, err)
+	newScriptKeySerialized := asset.ToSerialized(newScriptKey.PubKey)
+	require.Contains(t, anchoredProofs, newScriptKeySerialized)
+	require.Equal(
+		t, finalSenderBlob, anchoredProofs[newScriptKeySerialized],
+	)
+
+	// After a re-org, the transaction is confirmed in a different block.
+	reOrgBlockHash := chainhash.Hash(sha256.Sum256([]byte("re-org")))
+	err = assetsStore.UpdateAnchorTxConf(
+		ctx, anchorTxHash, &chainntnfs.TxConfirmation{
+			BlockHash:   &reOrgBlockHash,
+			BlockHeight: uint32(blockHeight) + 1,
+			TxIndex:     3,
+		},
+	)
+	require.NoError(t, err)
+	assertRecentAnchor(
+		uint32(blockHeight), &reOrgBlockHash, uint32(blockHeight)+1, 3,
+	)
+
+	// Marking it as unconfirmed removes it from the recent anchors.
+	err = assetsStore.UpdateAnchorTxConf(ctx, anchorTxHash, nil)
+	require.NoError(t, err)
+	assertRecentAnchor(0, nil, 0, 0)
 }
 
 // TestAssetFamilySigUpsert tests that if you try to insert another asset
@@ -0,0 +1,191 @@
+package tarodb
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	sqlite_migrate "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/httpfs"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMigrator creates a new, empty sqlite database and returns it together
+// with a migrate instance that can move its schema to any version.
+func newTestMigrator(t *testing.T) (*migrate.Migrate, *sql.DB) {
+	t.Helper()
+
+	dbFileName := filepath.Join(t.TempDir(), "migrations.db")
+	db, err := sql.Open("sqlite", dbFileName)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	driver, err := sqlite_migrate.WithInstance(
+		db, &sqlite_migrate.Config{},
+	)
+	require.NoError(t, err)
+
+	migrateFileServer, err := httpfs.New(
+		http.FS(sqlSchemas), "sqlc/migrations",
+	)
+	require.NoError(t, err)
+
+	sqlMigrate, err := migrate.NewWithInstance(
+		"migrations", migrateFileServer, "sqlc", driver,
+	)
+	require.NoError(t, err)
+
+	return sqlMigrate, db
+}
+
+// TestMigrationAddrEventFinalStatus tests that the migration that introduced
+// the final address event status shifts the status of existing events.
+func TestMigrationAddrEventFinalStatus(t *testing.T) {
+	t.Parallel()
+
+	sqlMigrate, db := newTestMigrator(t)
+	require.NoError(t, sqlMigrate.Migrate(11))
+
+	// We insert one event for each of the old status values, using the
+	// address ID to identify them later. Foreign keys aren't enforced on
+	// this connection, so we don't need to create the referenced rows.
+	oldStatuses := []int{0, 1, 2, 3}
+	for i, status := range oldStatuses {
+		_, err := db.Exec(`
+			INSERT INTO addr_events (
+				creation_time, addr_id, status, chain_txn_id,
+				chain_txn_output_index, managed_utxo_id
+			) VALUES (?, ?, ?, 1, 0, 1)`,
+			time.Now(), i+1, status,
+		)
+		require.NoError(t, err)
+	}
+
+	assertStatuses := func(expected map[int]int) {
+		rows, err := db.Query(
+			"SELECT id, addr_id, status FROM addr_events " +
+				"ORDER BY id",
+		)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		actual := make(map[int]int)
+		for rows.Next() {
+			var id, addrID, status int
+			require.NoError(t, rows.Scan(&id, &addrID, &status))
+
+			// The event IDs are re-assigned in their original
+			// order.
+			require.Equal(t, addrID, id)
+			actual[addrID] = status
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, expected, actual)
+	}
+
+	// Detected and confirmed events keep their status, while the proof
+	// received and completed events are shifted past the new final
+	// status.
+	require.NoError(t, sqlMigrate.Migrate(12))
+	assertStatuses(map[int]int{1: 0, 2: 1, 3: 3, 4: 4})
+}
+
+// TestMigrationSingleProofStore tests that the migration that made the proof
+// archive the single proof store links existing address events to the proof
+// files of their assets, even if assets with different IDs share the same
+// script key.
+func TestMigrationSingleProofStore(t *testing.T) {
+	t.Parallel()
+
+	sqlMigrate, db := newTestMigrator(t)
+	require.NoError(t, sqlMigrate.Migrate(15))
+
+	// We create two assets with different IDs that share the same script
+	// key, each with a legacy proof file. Foreign keys aren't enforced on
+	// this connection, so we only insert the rows the migration joins.
+	scriptKey := test.RandPubKey(t).SerializeCompressed()
+	_, err := db.Exec(`
+		INSERT INTO script_keys (
+			script_key_id, internal_key_id, tweaked_script_key
+		) VALUES (1, 1, ?)`, scriptKey,
+	)
+	require.NoError(t, err)
+
+	assetIDs := [][]byte{test.RandBytes(32), test.RandBytes(32)}
+	for i, assetID := range assetIDs {
+		id := i + 1
+		_, err := db.Exec(`
+			INSERT INTO genesis_assets (
+				gen_asset_id, asset_id, asset_tag, output_index,
+				asset_type, genesis_point_id
+			) VALUES (?, ?, ?, 0, 0, 1)`,
+			id, assetID, fmt.Sprintf("asset-%d", id),
+		)
+		require.NoError(t, err)
+
+		_, err = db.Exec(`
+			INSERT INTO assets (
+				asset_id, genesis_id, version, script_key_id,
+				script_version, amount
+			) VALUES (?, ?, 0, 1, 0, 1)`, id, id,
+		)
+		require.NoError(t, err)
+
+		_, err = db.Exec(`
+			INSERT INTO asset_proofs (
+				proof_id, asset_id, proof_file
+			) VALUES (?, ?, ?)`, id, id, test.RandBytes(100),
+		)
+		require.NoError(t, err)
+
+		// The event ID matches the ID of the proof it references.
+		_, err = db.Exec(`
+			INSERT INTO addr_events (
+				creation_time, addr_id, status, chain_txn_id,
+				chain_txn_output_index, managed_utxo_id,
+				asset_proof_id
+			) VALUES (?, ?, 4, 1, ?, 1, ?)`,
+			time.Now(), id, i, id,
+		)
+		require.NoError(t, err)
+	}
+
+	// The proof file of the first asset is already in the archive.
+	_, err = db.Exec(`
+		INSERT INTO proof_files (
+			file_id, script_key, asset_id, version
+		) VALUES (7, ?, ?, 0)`, scriptKey, assetIDs[0],
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, sqlMigrate.Migrate(16))
+
+	// Each event now references the proof file of its own asset, which is
+	// only stored once.
+	for i, assetID := range assetIDs {
+		var fileAssetID, fileScriptKey []byte
+		err := db.QueryRow(`
+			SELECT proof_files.asset_id, proof_files.script_key
+			FROM addr_events
+			JOIN proof_files
+				ON addr_events.asset_proof_id =
+					proof_files.file_id
+			WHERE addr_events.id = ?`, i+1,
+		).Scan(&fileAssetID, &fileScriptKey)
+		require.NoError(t, err)
+		require.Equal(t, assetID, fileAssetID)
+		require.Equal(t, scriptKey, fileScriptKey)
+	}
+
+	var numFiles int
+	err = db.QueryRow("SELECT COUNT(*) FROM proof_files").Scan(&numFiles)
+	require.NoError(t, err)
+	require.Equal(t, 2, numFiles)
+}
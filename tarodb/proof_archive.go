@@ -0,0 +1,322 @@
+package tarodb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/taro/proof"
+	"github.com/lightninglabs/taro/tarodb/sqlc"
+)
+
+type (
+	// NewProofTransition is a type alias for the params to insert a new
+	// state transition proof.
+	NewProofTransition = sqlc.UpsertProofTransitionParams
+
+	// NewProofFile is a type alias for the params to insert or update the
+	// metadata of a proof file.
+	NewProofFile = sqlc.UpsertProofFileParams
+
+	// NewProofFileTransition is a type alias for the params to add a state
+	// transition proof reference to a proof file.
+	NewProofFileTransition = sqlc.InsertProofFileTransitionParams
+
+	// ProofFileRow is a type alias for the metadata of a stored proof file.
+	ProofFileRow = sqlc.ProofFile
+
+	// ProofFileID is a type alias for the asset ID and script key that
+	// identify a stored proof file.
+	ProofFileID = sqlc.FetchProofFileParams
+
+	// ProofFileFilter is a type alias for the optional asset ID and script
+	// key to filter stored proof files by.
+	ProofFileFilter = sqlc.FetchProofFilesParams
+
+	// UnanchoredProofTransition is a type alias for a stored state
+	// transition proof whose anchor txid isn't known yet.
+	UnanchoredProofTransition = sqlc.FetchUnanchoredProofTransitionsRow
+
+	// ProofTransitionAnchor is a type alias for the params to set the
+	// anchor txid of a state transition proof.
+	ProofTransitionAnchor = sqlc.SetProofTransitionAnchorParams
+)
+
+// ProofArchiveStore is a sub-set of the main sqlc.Querier interface that
+// contains only the methods needed to store deduplicated proof files. Every
+// state transition proof is only stored once, keyed by its hash. A proof file
+// is stored as an ordered list of references to its state transition proofs,
+// so the shared ancestry of assets (for example the outputs of a split)
+// doesn't take up additional space. The full proof file is re-assembled when
+// it's fetched.
+type ProofArchiveStore interface {
+	// UpsertProofTransition inserts a new state transition proof or
+	// returns the primary key of the existing one with the same hash.
+	UpsertProofTransition(ctx context.Context,
+		arg NewProofTransition) (int32, error)
+
+	// UpsertProofFile inserts or updates the metadata of a proof file and
+	// returns its primary key.
+	UpsertProofFile(ctx context.Context, arg NewProofFile) (int32, error)
+
+	// InsertProofFileTransition adds a reference to a state transition
+	// proof to a proof file.
+	InsertProofFileTransition(ctx context.Context,
+		arg NewProofFileTransition) error
+
+	// DeleteProofFileTransitions removes all state transition proof
+	// references of a proof file and returns the IDs of the referenced
+	// state transitions.
+	DeleteProofFileTransitions(ctx context.Context,
+		fileID int32) ([]int32, error)
+
+	// DeleteOrphanedProofTransition removes the state transition proof
+	// with the given ID if it's no longer referenced by any proof file.
+	DeleteOrphanedProofTransition(ctx context.Context,
+		transitionID int32) error
+
+	// FetchProofFile fetches the metadata of the proof file of the asset
+	// with the given asset ID and script key.
+	FetchProofFile(ctx context.Context, arg ProofFileID) (ProofFileRow,
+		error)
+
+	// FetchProofFiles fetches the metadata of all proof files, optionally
+	// filtered by asset ID and script key.
+	FetchProofFiles(ctx context.Context,
+		arg ProofFileFilter) ([]ProofFileRow, error)
+
+	// FetchProofFileTransitions fetches the ordered list of encoded state
+	// transition proofs of a proof file.
+	FetchProofFileTransitions(ctx context.Context,
+		fileID int32) ([][]byte, error)
+
+	// FetchProofFilesByTransitionAnchor fetches the metadata of all proof
+	// files that contain a state transition proof anchored in the
+	// transaction with the given txid.
+	FetchProofFilesByTransitionAnchor(ctx context.Context,
+		txid []byte) ([]ProofFileRow, error)
+
+	// FetchUnanchoredProofTransitions fetches all state transition proofs
+	// whose anchor txid isn't known yet.
+	FetchUnanchoredProofTransitions(ctx context.Context) (
+		[]UnanchoredProofTransition, error)
+
+	// SetProofTransitionAnchor sets the anchor txid of a state transition
+	// proof.
+	SetProofTransitionAnchor(ctx context.Context,
+		arg ProofTransitionAnchor) error
+}
+
+// fetchProofFile fetches and re-assembles the proof file of the asset with the
+// asset ID and script key of the given locator.
+func fetchProofFile(ctx context.Context, q ProofArchiveStore,
+	loc proof.Locator) (ProofFileRow, proof.Blob, error) {
+
+	if loc.AssetID == nil {
+		return ProofFileRow{}, nil, proof.ErrInvalidLocatorID
+	}
+
+	fileRow, err := q.FetchProofFile(ctx, ProofFileID{
+		AssetID:   loc.AssetID[:],
+		ScriptKey: loc.ScriptKey.SerializeCompressed(),
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return fileRow, nil, proof.ErrProofNotFound
+	case err != nil:
+		return fileRow, nil, fmt.Errorf("unable to fetch proof file: %w",
+			err)
+	}
+
+	blob, err := fetchProofFileBlob(ctx, q, fileRow)
+	if err != nil {
+		return fileRow, nil, err
+	}
+
+	return fileRow, blob, nil
+}
+
+// fetchProofFileBlob re-assembles the proof file described by the given
+// metadata row from its state transition proofs.
+func fetchProofFileBlob(ctx context.Context, q ProofArchiveStore,
+	fileRow ProofFileRow) (proof.Blob, error) {
+
+	rawProofs, err := q.FetchProofFileTransitions(ctx, fileRow.FileID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch proof file "+
+			"transitions: %w", err)
+	}
+
+	return assembleProofFile(fileRow, rawProofs)
+}
+
+// assembleProofFile re-assembles and encodes a proof file from its stored
+// metadata and the ordered list of its state transition proofs.
+func assembleProofFile(fileRow ProofFileRow,
+	rawProofs [][]byte) (proof.Blob, error) {
+
+	var checkpoint *proof.Checkpoint
+	if len(fileRow.Checkpoint) != 0 {
+		checkpoint = &proof.Checkpoint{}
+		err := checkpoint.Decode(bytes.NewReader(fileRow.Checkpoint))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode checkpoint: %w",
+				err)
+		}
+	}
+
+	proofFile := proof.NewFileFromRawProofs(
+		proof.Version(fileRow.Version), checkpoint, rawProofs...,
+	)
+
+	var b bytes.Buffer
+	if err := proofFile.Encode(&b); err != nil {
+		return nil, fmt.Errorf("unable to encode proof file: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+// insertProofFile splits the given proof file of the asset identified by the
+// locator into its individual state transition proofs and stores them,
+// together with the ordered list of references that make up the file. Any
+// existing proof file of the asset is replaced.
+func insertProofFile(ctx context.Context, q ProofArchiveStore,
+	loc proof.Locator, blob proof.Blob) error {
+
+	if loc.AssetID == nil {
+		return proof.ErrInvalidLocatorID
+	}
+
+	var proofFile proof.File
+	if err := proofFile.Decode(bytes.NewReader(blob)); err != nil {
+		return fmt.Errorf("unable to decode proof file: %w", err)
+	}
+
+	var checkpointBytes []byte
+	if checkpoint := proofFile.Checkpoint(); checkpoint != nil {
+		var b bytes.Buffer
+		if err := checkpoint.Encode(&b); err != nil {
+			return fmt.Errorf("unable to encode checkpoint: %w", err)
+		}
+		checkpointBytes = b.Bytes()
+	}
+
+	fileID, err := q.UpsertProofFile(ctx, NewProofFile{
+		ScriptKey:  loc.ScriptKey.SerializeCompressed(),
+		AssetID:    loc.AssetID[:],
+		Version:    int32(proofFile.Version),
+		Checkpoint: checkpointBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert proof file: %w", err)
+	}
+
+	// If we already had a proof file for this asset, we replace its list
+	// of state transitions entirely.
+	oldTransitions, err := q.DeleteProofFileTransitions(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("unable to delete proof file "+
+			"transitions: %w", err)
+	}
+
+	for idx, rawProof := range proofFile.RawProofs() {
+		anchorTxid := proofAnchorTxid(rawProof)
+
+		proofHash := sha256.Sum256(rawProof)
+		transitionID, err := q.UpsertProofTransition(
+			ctx, NewProofTransition{
+				ProofHash:  proofHash[:],
+				ProofBytes: rawProof,
+				AnchorTxid: anchorTxid,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert proof "+
+				"transition: %w", err)
+		}
+
+		err = q.InsertProofFileTransition(ctx, NewProofFileTransition{
+			FileID:       fileID,
+			SeqNum:       int32(idx),
+			TransitionID: transitionID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert proof file "+
+				"transition: %w", err)
+		}
+	}
+
+	// Any of the state transitions of the replaced file that aren't part
+	// of the new file or of any other file are no longer needed.
+	for _, transitionID := range oldTransitions {
+		err := q.DeleteOrphanedProofTransition(ctx, transitionID)
+		if err != nil {
+			return fmt.Errorf("unable to delete orphaned proof "+
+				"transition: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// proofAnchorTxid returns the ID of the transaction the given encoded state
+// transition proof is anchored in. A proof that can't be decoded can't be
+// re-anchored either, so an empty, non-nil txid is returned for it to mark it
+// as looked at.
+func proofAnchorTxid(rawProof []byte) []byte {
+	var p proof.Proof
+	if err := p.Decode(bytes.NewReader(rawProof)); err != nil {
+		log.Warnf("Unable to decode state transition proof: %v", err)
+		return []byte{}
+	}
+
+	txid := p.AnchorTx.TxHash()
+	return txid[:]
+}
+
+// fetchProofFilesByAnchor fetches and re-assembles all proof files that
+// contain a state transition proof anchored in the transaction with the given
+// txid. The anchor txid of state transition proofs stored before it was
+// tracked is filled in first.
+func fetchProofFilesByAnchor(ctx context.Context, q ProofArchiveStore,
+	txid chainhash.Hash) ([]ProofFileRow, []proof.Blob, error) {
+
+	unanchored, err := q.FetchUnanchoredProofTransitions(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch unanchored proof "+
+			"transitions: %w", err)
+	}
+	for _, transition := range unanchored {
+		err = q.SetProofTransitionAnchor(ctx, ProofTransitionAnchor{
+			AnchorTxid:   proofAnchorTxid(transition.ProofBytes),
+			TransitionID: transition.TransitionID,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to set proof "+
+				"transition anchor: %w", err)
+		}
+	}
+
+	fileRows, err := q.FetchProofFilesByTransitionAnchor(ctx, txid[:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch anchored proof "+
+			"files: %w", err)
+	}
+
+	blobs := make([]proof.Blob, 0, len(fileRows))
+	for _, fileRow := range fileRows {
+		blob, err := fetchProofFileBlob(ctx, q, fileRow)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		blobs = append(blobs, blob)
+	}
+
+	return fileRows, blobs, nil
+}
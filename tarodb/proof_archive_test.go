@@ -0,0 +1,281 @@
+package tarodb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// newProofArchive makes a new instance of the AssetStore, which archives the
+// proof files of assets, backed by a fresh test database.
+func newProofArchive(t *testing.T) (*AssetStore, *SqliteStore) {
+	db := NewTestDB(t)
+
+	txCreator := func(tx *sql.Tx) ActiveAssetsStore {
+		return db.WithTx(tx)
+	}
+
+	assetsDB := NewTransactionExecutor[ActiveAssetsStore](db, txCreator)
+	return NewAssetStore(assetsDB), db
+}
+
+// encodeRawFile encodes the given proof file.
+func encodeRawFile(t *testing.T, f *proof.File) proof.Blob {
+	var b bytes.Buffer
+	require.NoError(t, f.Encode(&b))
+
+	return b.Bytes()
+}
+
+// numProofTransitions returns the number of distinct state transition proofs
+// stored in the database.
+func numProofTransitions(t *testing.T, db *SqliteStore) int {
+	var count int
+	err := db.QueryRow(
+		"SELECT COUNT(*) FROM proof_transitions",
+	).Scan(&count)
+	require.NoError(t, err)
+
+	return count
+}
+
+// TestProofArchiveDeduplication tests that proof files that share a common
+// ancestry only store the shared state transition proofs once, and that the
+// full files can be re-assembled again.
+func TestProofArchiveDeduplication(t *testing.T) {
+	t.Parallel()
+
+	archive, db := newProofArchive(t)
+	ctx := context.Background()
+
+	// We create two proof files of split siblings, both share the genesis
+	// and the first transfer proof.
+	genesisProof := test.RandBytes(100)
+	transferProof := test.RandBytes(100)
+	split1Proof := test.RandBytes(100)
+	split2Proof := test.RandBytes(100)
+
+	file1 := proof.NewFileFromRawProofs(
+		proof.V0, nil, genesisProof, transferProof, split1Proof,
+	)
+	file2 := proof.NewFileFromRawProofs(
+		proof.V0, nil, genesisProof, transferProof, split2Proof,
+	)
+
+	assetID := asset.RandID(t)
+	loc1 := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	loc2 := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+
+	// Fetching a proof we don't know should return the proper error.
+	_, err := archive.FetchProof(ctx, loc1)
+	require.ErrorIs(t, err, proof.ErrProofNotFound)
+
+	blob1 := encodeRawFile(t, file1)
+	blob2 := encodeRawFile(t, file2)
+	err = archive.ReplaceProofs(ctx, &proof.AnnotatedProof{
+		Locator: loc1,
+		Blob:    blob1,
+	}, &proof.AnnotatedProof{
+		Locator: loc2,
+		Blob:    blob2,
+	})
+	require.NoError(t, err)
+
+	// The shared ancestry should only be stored once.
+	require.Equal(t, 4, numProofTransitions(t, db))
+
+	// Both files should be re-assembled exactly.
+	dbBlob1, err := archive.FetchProof(ctx, loc1)
+	require.NoError(t, err)
+	require.Equal(t, blob1, dbBlob1)
+
+	dbBlob2, err := archive.FetchProof(ctx, loc2)
+	require.NoError(t, err)
+	require.Equal(t, blob2, dbBlob2)
+
+	// A locator with a different asset ID shouldn't match.
+	otherID := asset.RandID(t)
+	otherLoc := proof.Locator{
+		AssetID:   &otherID,
+		ScriptKey: loc1.ScriptKey,
+	}
+	_, err = archive.FetchProof(ctx, otherLoc)
+	require.ErrorIs(t, err, proof.ErrProofNotFound)
+
+	// We now compact the first file, which replaces its history with a
+	// checkpoint. The second file still references the shared proofs, so
+	// only the first file's representation changes.
+	_, err = file1.Compact(1)
+	require.NoError(t, err)
+	compactBlob1 := encodeRawFile(t, file1)
+	err = archive.ReplaceProofs(ctx, &proof.AnnotatedProof{
+		Locator: loc1,
+		Blob:    compactBlob1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, numProofTransitions(t, db))
+
+	dbBlob1, err = archive.FetchProof(ctx, loc1)
+	require.NoError(t, err)
+	require.Equal(t, compactBlob1, dbBlob1)
+
+	// Once the second file is compacted as well, the shared proofs aren't
+	// referenced anymore and are removed.
+	_, err = file2.Compact(1)
+	require.NoError(t, err)
+	compactBlob2 := encodeRawFile(t, file2)
+	err = archive.ReplaceProofs(ctx, &proof.AnnotatedProof{
+		Locator: loc2,
+		Blob:    compactBlob2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numProofTransitions(t, db))
+
+	dbBlob2, err = archive.FetchProof(ctx, loc2)
+	require.NoError(t, err)
+	require.Equal(t, compactBlob2, dbBlob2)
+
+	// An asset with a different ID can share the script key, its proof
+	// file is stored separately and doesn't replace the first one.
+	otherBlob := encodeRawFile(t, proof.NewFileFromRawProofs(
+		proof.V0, nil, test.RandBytes(100),
+	))
+	err = archive.ReplaceProofs(ctx, &proof.AnnotatedProof{
+		Locator: otherLoc,
+		Blob:    otherBlob,
+	})
+	require.NoError(t, err)
+
+	dbOtherBlob, err := archive.FetchProof(ctx, otherLoc)
+	require.NoError(t, err)
+	require.Equal(t, otherBlob, dbOtherBlob)
+
+	dbBlob1, err = archive.FetchProof(ctx, loc1)
+	require.NoError(t, err)
+	require.Equal(t, compactBlob1, dbBlob1)
+
+	// A locator without an asset ID doesn't identify a proof file.
+	_, err = archive.FetchProof(ctx, proof.Locator{
+		ScriptKey: loc1.ScriptKey,
+	})
+	require.ErrorIs(t, err, proof.ErrInvalidLocatorID)
+}
+
+// anchoredRawProof returns an encoded state transition proof of a random asset
+// that is anchored in the given transaction.
+func anchoredRawProof(t *testing.T, anchorTx *wire.MsgTx) []byte {
+	newAsset, err := asset.New(
+		asset.RandGenesis(t, asset.Normal), 1, 0, 0, asset.ScriptV0,
+		asset.NewScriptKey(test.RandPubKey(t)), nil,
+	)
+	require.NoError(t, err)
+
+	p := proof.Proof{
+		AnchorTx: *anchorTx,
+		Asset:    *newAsset,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, p.Encode(&b))
+
+	return b.Bytes()
+}
+
+// TestProofArchiveAnchorLookup tests that all proof files that contain a state
+// transition proof anchored in a given transaction can be found, including
+// files stored before the anchor was tracked.
+func TestProofArchiveAnchorLookup(t *testing.T) {
+	t.Parallel()
+
+	archive, db := newProofArchive(t)
+	ctx := context.Background()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+	anchorTx.AddTxOut(&wire.TxOut{PkScript: test.RandBytes(34)})
+	otherTx := wire.NewMsgTx(2)
+	otherTx.AddTxIn(&wire.TxIn{PreviousOutPoint: test.RandOp(t)})
+
+	// The transfer is anchored in our transaction. The receiver's file
+	// ends with it, while a later spend of the received asset is anchored
+	// in a different transaction.
+	genesisProof := anchoredRawProof(t, otherTx)
+	transferProof := anchoredRawProof(t, anchorTx)
+	spendProof := anchoredRawProof(t, otherTx)
+
+	assetID := asset.RandID(t)
+	receiverLoc := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	spendLoc := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	unrelatedLoc := proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *test.RandPubKey(t),
+	}
+	err := archive.ReplaceProofs(ctx, &proof.AnnotatedProof{
+		Locator: receiverLoc,
+		Blob: encodeRawFile(t, proof.NewFileFromRawProofs(
+			proof.V0, nil, genesisProof, transferProof,
+		)),
+	}, &proof.AnnotatedProof{
+		Locator: spendLoc,
+		Blob: encodeRawFile(t, proof.NewFileFromRawProofs(
+			proof.V0, nil, genesisProof, transferProof, spendProof,
+		)),
+	}, &proof.AnnotatedProof{
+		Locator: unrelatedLoc,
+		Blob: encodeRawFile(t, proof.NewFileFromRawProofs(
+			proof.V0, nil, genesisProof,
+		)),
+	})
+	require.NoError(t, err)
+
+	assertAnchoredFiles := func() {
+		blobs, err := archive.FetchAnchoredProofs(
+			ctx, anchorTx.TxHash(),
+		)
+		require.NoError(t, err)
+		require.Len(t, blobs, 2)
+		require.Contains(
+			t, blobs, asset.ToSerialized(&receiverLoc.ScriptKey),
+		)
+		require.Contains(
+			t, blobs, asset.ToSerialized(&spendLoc.ScriptKey),
+		)
+	}
+	assertAnchoredFiles()
+
+	// Proofs stored before the anchor was tracked are looked at once we
+	// look up the anchored files.
+	_, err = db.Exec("UPDATE proof_transitions SET anchor_txid = NULL")
+	require.NoError(t, err)
+	assertAnchoredFiles()
+
+	var numUnanchored int
+	err = db.QueryRow(
+		"SELECT COUNT(*) FROM proof_transitions WHERE anchor_txid " +
+			"IS NULL",
+	).Scan(&numUnanchored)
+	require.NoError(t, err)
+	require.Zero(t, numUnanchored)
+}
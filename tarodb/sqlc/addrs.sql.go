@@ -11,12 +11,124 @@ import (
 	"time"
 )
 
+const deleteRecoveryOutput = `-- name: DeleteRecoveryOutput :exec
+DELETE FROM recovery_outputs
+WHERE chain_txn_id IN (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE chain_txns.txid = $1
+) AND chain_txn_output_index = $2
+`
+
+type DeleteRecoveryOutputParams struct {
+	Txid                []byte
+	ChainTxnOutputIndex int32
+}
+
+func (q *Queries) DeleteRecoveryOutput(ctx context.Context, arg DeleteRecoveryOutputParams) error {
+	_, err := q.db.ExecContext(ctx, deleteRecoveryOutput, arg.Txid, arg.ChainTxnOutputIndex)
+	return err
+}
+
+const fetchAddrByScriptKey = `-- name: FetchAddrByScriptKey :one
+SELECT
+    version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
+    creation_time, managed_from, expiry_time, single_use, addrs.id AS addr_id,
+    label, amount_policy, memo, memo_sig, addrs.script_version,
+    addrs.family_only, addrs.watch_only,
+    script_keys.tweaked_script_key,
+    script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
+    raw_script_keys.raw_key as raw_script_key,
+    raw_script_keys.key_family AS script_key_family,
+    raw_script_keys.key_index AS script_key_index,
+    taproot_keys.raw_key AS raw_taproot_key,
+    taproot_keys.key_family AS taproot_key_family,
+    taproot_keys.key_index AS taproot_key_index
+FROM addrs
+JOIN script_keys
+  ON addrs.script_key_id = script_keys.script_key_id
+JOIN internal_keys raw_script_keys
+  ON script_keys.internal_key_id = raw_script_keys.key_id
+JOIN internal_keys taproot_keys
+  ON addrs.taproot_key_id = taproot_keys.key_id
+WHERE script_keys.tweaked_script_key = $1
+`
+
+type FetchAddrByScriptKeyRow struct {
+	Version                int16
+	GenesisAssetID         int32
+	FamKey                 []byte
+	TaprootOutputKey       []byte
+	Amount                 int64
+	AssetType              int16
+	CreationTime           time.Time
+	ManagedFrom            sql.NullTime
+	ExpiryTime             sql.NullTime
+	SingleUse              bool
+	AddrID                 int32
+	Label                  string
+	AmountPolicy           int16
+	Memo                   string
+	MemoSig                []byte
+	ScriptVersion          int32
+	FamilyOnly             bool
+	WatchOnly              bool
+	TweakedScriptKey       []byte
+	ScriptKeyTweak         []byte
+	ScriptKeyTapscriptTree []byte
+	RawScriptKey           []byte
+	ScriptKeyFamily        int32
+	ScriptKeyIndex         int32
+	RawTaprootKey          []byte
+	TaprootKeyFamily       int32
+	TaprootKeyIndex        int32
+}
+
+func (q *Queries) FetchAddrByScriptKey(ctx context.Context, tweakedScriptKey []byte) (FetchAddrByScriptKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchAddrByScriptKey, tweakedScriptKey)
+	var i FetchAddrByScriptKeyRow
+	err := row.Scan(
+		&i.Version,
+		&i.GenesisAssetID,
+		&i.FamKey,
+		&i.TaprootOutputKey,
+		&i.Amount,
+		&i.AssetType,
+		&i.CreationTime,
+		&i.ManagedFrom,
+		&i.ExpiryTime,
+		&i.SingleUse,
+		&i.AddrID,
+		&i.Label,
+		&i.AmountPolicy,
+		&i.Memo,
+		&i.MemoSig,
+		&i.ScriptVersion,
+		&i.FamilyOnly,
+		&i.WatchOnly,
+		&i.TweakedScriptKey,
+		&i.ScriptKeyTweak,
+		&i.ScriptKeyTapscriptTree,
+		&i.RawScriptKey,
+		&i.ScriptKeyFamily,
+		&i.ScriptKeyIndex,
+		&i.RawTaprootKey,
+		&i.TaprootKeyFamily,
+		&i.TaprootKeyIndex,
+	)
+	return i, err
+}
+
 const fetchAddrByTaprootOutputKey = `-- name: FetchAddrByTaprootOutputKey :one
 SELECT
     version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    creation_time, managed_from, expiry_time, single_use, addrs.id AS addr_id,
+    label, amount_policy, memo, memo_sig, addrs.script_version,
+    addrs.family_only, addrs.watch_only,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     raw_script_keys.raw_key as raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -34,22 +146,33 @@ WHERE taproot_output_key = $1
 `
 
 type FetchAddrByTaprootOutputKeyRow struct {
-	Version          int16
-	GenesisAssetID   int32
-	FamKey           []byte
-	TaprootOutputKey []byte
-	Amount           int64
-	AssetType        int16
-	CreationTime     time.Time
-	ManagedFrom      sql.NullTime
-	TweakedScriptKey []byte
-	ScriptKeyTweak   []byte
-	RawScriptKey     []byte
-	ScriptKeyFamily  int32
-	ScriptKeyIndex   int32
-	RawTaprootKey    []byte
-	TaprootKeyFamily int32
-	TaprootKeyIndex  int32
+	Version                int16
+	GenesisAssetID         int32
+	FamKey                 []byte
+	TaprootOutputKey       []byte
+	Amount                 int64
+	AssetType              int16
+	CreationTime           time.Time
+	ManagedFrom            sql.NullTime
+	ExpiryTime             sql.NullTime
+	SingleUse              bool
+	AddrID                 int32
+	Label                  string
+	AmountPolicy           int16
+	Memo                   string
+	MemoSig                []byte
+	ScriptVersion          int32
+	FamilyOnly             bool
+	WatchOnly              bool
+	TweakedScriptKey       []byte
+	ScriptKeyTweak         []byte
+	ScriptKeyTapscriptTree []byte
+	RawScriptKey           []byte
+	ScriptKeyFamily        int32
+	ScriptKeyIndex         int32
+	RawTaprootKey          []byte
+	TaprootKeyFamily       int32
+	TaprootKeyIndex        int32
 }
 
 func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error) {
@@ -64,8 +187,19 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 		&i.AssetType,
 		&i.CreationTime,
 		&i.ManagedFrom,
+		&i.ExpiryTime,
+		&i.SingleUse,
+		&i.AddrID,
+		&i.Label,
+		&i.AmountPolicy,
+		&i.Memo,
+		&i.MemoSig,
+		&i.ScriptVersion,
+		&i.FamilyOnly,
+		&i.WatchOnly,
 		&i.TweakedScriptKey,
 		&i.ScriptKeyTweak,
+		&i.ScriptKeyTapscriptTree,
 		&i.RawScriptKey,
 		&i.ScriptKeyFamily,
 		&i.ScriptKeyIndex,
@@ -78,13 +212,15 @@ func (q *Queries) FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutput
 
 const fetchAddrEvent = `-- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    addr_events.creation_time, addr_events.update_time, status,
+    asset_proof_id, addr_events.asset_id,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
     managed_utxos.amt_sats as amt_sats,
     managed_utxos.tapscript_sibling as tapscript_sibling,
-    internal_keys.raw_key as internal_key
+    internal_keys.raw_key as internal_key,
+    assets.amount as asset_amount
 FROM addr_events
 LEFT JOIN chain_txns
        ON addr_events.chain_txn_id = chain_txns.txn_id
@@ -92,11 +228,14 @@ LEFT JOIN managed_utxos
        ON addr_events.managed_utxo_id = managed_utxos.utxo_id
 LEFT JOIN internal_keys
        ON managed_utxos.internal_key_id = internal_keys.key_id
+LEFT JOIN assets
+       ON addr_events.asset_id = assets.asset_id
 WHERE id = $1
 `
 
 type FetchAddrEventRow struct {
 	CreationTime       time.Time
+	UpdateTime         time.Time
 	Status             int16
 	AssetProofID       sql.NullInt32
 	AssetID            sql.NullInt32
@@ -106,6 +245,7 @@ type FetchAddrEventRow struct {
 	AmtSats            sql.NullInt64
 	TapscriptSibling   []byte
 	InternalKey        []byte
+	AssetAmount        sql.NullInt64
 }
 
 func (q *Queries) FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventRow, error) {
@@ -113,6 +253,7 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventR
 	var i FetchAddrEventRow
 	err := row.Scan(
 		&i.CreationTime,
+		&i.UpdateTime,
 		&i.Status,
 		&i.AssetProofID,
 		&i.AssetID,
@@ -122,16 +263,55 @@ func (q *Queries) FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventR
 		&i.AmtSats,
 		&i.TapscriptSibling,
 		&i.InternalKey,
+		&i.AssetAmount,
 	)
 	return i, err
 }
 
+const fetchAddrMetadata = `-- name: FetchAddrMetadata :many
+SELECT meta_key, meta_value
+FROM addr_metadata
+WHERE addr_id = $1
+ORDER BY meta_key
+`
+
+type FetchAddrMetadataRow struct {
+	MetaKey   string
+	MetaValue string
+}
+
+func (q *Queries) FetchAddrMetadata(ctx context.Context, addrID int32) ([]FetchAddrMetadataRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchAddrMetadata, addrID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchAddrMetadataRow
+	for rows.Next() {
+		var i FetchAddrMetadataRow
+		if err := rows.Scan(&i.MetaKey, &i.MetaValue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchAddrs = `-- name: FetchAddrs :many
 SELECT 
     version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    creation_time, managed_from, expiry_time, single_use, addrs.id AS addr_id,
+    label, amount_policy, memo, memo_sig, addrs.script_version,
+    addrs.family_only, addrs.watch_only,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     raw_script_keys.raw_key AS raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -145,39 +325,70 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
-WHERE creation_time >= $1
-    AND creation_time <= $2
+WHERE addrs.creation_time >= $1
+    AND addrs.creation_time <= $2
     AND ($3 = false OR
          (CASE WHEN managed_from IS NULL THEN true ELSE false END) = $3)
+    AND ($3 = false OR single_use = false OR NOT EXISTS (
+        SELECT 1 FROM addr_events events WHERE events.addr_id = addrs.id
+    ))
+    -- An unmanaged watch-only address was retired by the address book it was
+    -- imported from, so it shouldn't be managed again.
+    AND ($3 = false OR watch_only = false)
+    AND (expiry_time <= $4 OR
+         $4 IS NULL)
+    AND (label = $5 OR $5 IS NULL)
+    AND (EXISTS (
+        SELECT 1 FROM addr_metadata meta
+        WHERE meta.addr_id = addrs.id
+          AND meta.meta_key = $6
+          AND (meta.meta_value = $7 OR
+               $7 IS NULL)
+    ) OR $6 IS NULL)
 ORDER BY addrs.creation_time
-LIMIT $5 OFFSET $4
+LIMIT $9 OFFSET $8
 `
 
 type FetchAddrsParams struct {
 	CreatedAfter  time.Time
 	CreatedBefore time.Time
 	UnmanagedOnly interface{}
+	ExpiredBefore sql.NullTime
+	Label         sql.NullString
+	MetaKey       sql.NullString
+	MetaValue     sql.NullString
 	NumOffset     int32
 	NumLimit      int32
 }
 
 type FetchAddrsRow struct {
-	Version          int16
-	GenesisAssetID   int32
-	FamKey           []byte
-	TaprootOutputKey []byte
-	Amount           int64
-	AssetType        int16
-	CreationTime     time.Time
-	ManagedFrom      sql.NullTime
-	TweakedScriptKey []byte
-	ScriptKeyTweak   []byte
-	RawScriptKey     []byte
-	ScriptKeyFamily  int32
-	ScriptKeyIndex   int32
-	RawTaprootKey    []byte
-	TaprootKeyFamily int32
-	TaprootKeyIndex  int32
+	Version                int16
+	GenesisAssetID         int32
+	FamKey                 []byte
+	TaprootOutputKey       []byte
+	Amount                 int64
+	AssetType              int16
+	CreationTime           time.Time
+	ManagedFrom            sql.NullTime
+	ExpiryTime             sql.NullTime
+	SingleUse              bool
+	AddrID                 int32
+	Label                  string
+	AmountPolicy           int16
+	Memo                   string
+	MemoSig                []byte
+	ScriptVersion          int32
+	FamilyOnly             bool
+	WatchOnly              bool
+	TweakedScriptKey       []byte
+	ScriptKeyTweak         []byte
+	ScriptKeyTapscriptTree []byte
+	RawScriptKey           []byte
+	ScriptKeyFamily        int32
+	ScriptKeyIndex         int32
+	RawTaprootKey          []byte
+	TaprootKeyFamily       int32
+	TaprootKeyIndex        int32
 }
 
 func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error) {
@@ -185,6 +396,10 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 		arg.CreatedAfter,
 		arg.CreatedBefore,
 		arg.UnmanagedOnly,
+		arg.ExpiredBefore,
+		arg.Label,
+		arg.MetaKey,
+		arg.MetaValue,
 		arg.NumOffset,
 		arg.NumLimit,
 	)
@@ -204,8 +419,19 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 			&i.AssetType,
 			&i.CreationTime,
 			&i.ManagedFrom,
+			&i.ExpiryTime,
+			&i.SingleUse,
+			&i.AddrID,
+			&i.Label,
+			&i.AmountPolicy,
+			&i.Memo,
+			&i.MemoSig,
+			&i.ScriptVersion,
+			&i.FamilyOnly,
+			&i.WatchOnly,
 			&i.TweakedScriptKey,
 			&i.ScriptKeyTweak,
+			&i.ScriptKeyTapscriptTree,
 			&i.RawScriptKey,
 			&i.ScriptKeyFamily,
 			&i.ScriptKeyIndex,
@@ -229,8 +455,13 @@ func (q *Queries) FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]Fetch
 const insertAddr = `-- name: InsertAddr :one
 INSERT INTO addrs (
     version, genesis_asset_id, fam_key, script_key_id, taproot_key_id,
-    taproot_output_key, amount, asset_type, creation_time
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id
+    taproot_output_key, amount, asset_type, creation_time, expiry_time,
+    single_use, label, amount_policy, memo, memo_sig, script_version,
+    family_only, managed_from, watch_only
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+    $17, $18, $19
+) RETURNING id
 `
 
 type InsertAddrParams struct {
@@ -243,6 +474,16 @@ type InsertAddrParams struct {
 	Amount           int64
 	AssetType        int16
 	CreationTime     time.Time
+	ExpiryTime       sql.NullTime
+	SingleUse        bool
+	Label            string
+	AmountPolicy     int16
+	Memo             string
+	MemoSig          []byte
+	ScriptVersion    int32
+	FamilyOnly       bool
+	ManagedFrom      sql.NullTime
+	WatchOnly        bool
 }
 
 func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int32, error) {
@@ -256,6 +497,16 @@ func (q *Queries) InsertAddr(ctx context.Context, arg InsertAddrParams) (int32,
 		arg.Amount,
 		arg.AssetType,
 		arg.CreationTime,
+		arg.ExpiryTime,
+		arg.SingleUse,
+		arg.Label,
+		arg.AmountPolicy,
+		arg.Memo,
+		arg.MemoSig,
+		arg.ScriptVersion,
+		arg.FamilyOnly,
+		arg.ManagedFrom,
+		arg.WatchOnly,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -271,6 +522,16 @@ JOIN addrs
 WHERE addr_events.status >= $1 
   AND addr_events.status <= $2
   AND COALESCE($3, addrs.taproot_output_key) = addrs.taproot_output_key
+  AND addr_events.creation_time >= $4
+  AND addr_events.update_time >= $5
+  AND (addrs.label = $6 OR $6 IS NULL)
+  AND (EXISTS (
+      SELECT 1 FROM addr_metadata meta
+      WHERE meta.addr_id = addrs.id
+        AND meta.meta_key = $7
+        AND (meta.meta_value = $8 OR
+             $8 IS NULL)
+  ) OR $7 IS NULL)
 ORDER by addr_events.creation_time
 `
 
@@ -278,6 +539,11 @@ type QueryEventIDsParams struct {
 	StatusFrom     int16
 	StatusTo       int16
 	AddrTaprootKey []byte
+	CreatedAfter   time.Time
+	UpdatedAfter   time.Time
+	Label          sql.NullString
+	MetaKey        sql.NullString
+	MetaValue      sql.NullString
 }
 
 type QueryEventIDsRow struct {
@@ -286,7 +552,16 @@ type QueryEventIDsRow struct {
 }
 
 func (q *Queries) QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error) {
-	rows, err := q.db.QueryContext(ctx, queryEventIDs, arg.StatusFrom, arg.StatusTo, arg.AddrTaprootKey)
+	rows, err := q.db.QueryContext(ctx, queryEventIDs,
+		arg.StatusFrom,
+		arg.StatusTo,
+		arg.AddrTaprootKey,
+		arg.CreatedAfter,
+		arg.UpdatedAfter,
+		arg.Label,
+		arg.MetaKey,
+		arg.MetaValue,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -308,6 +583,57 @@ func (q *Queries) QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([
 	return items, nil
 }
 
+const queryRecoveryOutputs = `-- name: QueryRecoveryOutputs :many
+SELECT
+    recovery_outputs.id, recovery_outputs.creation_time,
+    chain_txns.txid as txid,
+    chain_txns.block_height as confirmation_height,
+    chain_txn_output_index as output_index, amt_sats
+FROM recovery_outputs
+JOIN chain_txns
+  ON recovery_outputs.chain_txn_id = chain_txns.txn_id
+ORDER BY recovery_outputs.creation_time
+`
+
+type QueryRecoveryOutputsRow struct {
+	ID                 int32
+	CreationTime       time.Time
+	Txid               []byte
+	ConfirmationHeight sql.NullInt32
+	OutputIndex        int32
+	AmtSats            int64
+}
+
+func (q *Queries) QueryRecoveryOutputs(ctx context.Context) ([]QueryRecoveryOutputsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryRecoveryOutputs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryRecoveryOutputsRow
+	for rows.Next() {
+		var i QueryRecoveryOutputsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreationTime,
+			&i.Txid,
+			&i.ConfirmationHeight,
+			&i.OutputIndex,
+			&i.AmtSats,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setAddrManaged = `-- name: SetAddrManaged :exec
 WITH target_addr(addr_id) AS (
     SELECT id
@@ -329,6 +655,23 @@ func (q *Queries) SetAddrManaged(ctx context.Context, arg SetAddrManagedParams)
 	return err
 }
 
+const updateAddrEventStatus = `-- name: UpdateAddrEventStatus :exec
+UPDATE addr_events
+SET status = $1, update_time = $2
+WHERE id = $3
+`
+
+type UpdateAddrEventStatusParams struct {
+	Status     int16
+	UpdateTime time.Time
+	ID         int32
+}
+
+func (q *Queries) UpdateAddrEventStatus(ctx context.Context, arg UpdateAddrEventStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateAddrEventStatus, arg.Status, arg.UpdateTime, arg.ID)
+	return err
+}
+
 const upsertAddrEvent = `-- name: UpsertAddrEvent :one
 WITH target_addr(addr_id) AS (
     SELECT id
@@ -341,13 +684,18 @@ WITH target_addr(addr_id) AS (
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, update_time
 ) VALUES (
     $3, (SELECT addr_id FROM target_addr), $4,
-    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8
+    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8, $9
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
     DO UPDATE SET status = EXCLUDED.status,
+                  update_time = CASE
+                      WHEN addr_events.status = EXCLUDED.status
+                      THEN addr_events.update_time
+                      ELSE EXCLUDED.update_time
+                  END,
                   asset_proof_id = COALESCE(EXCLUDED.asset_proof_id, addr_events.asset_proof_id),
                   asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id)
 RETURNING id
@@ -362,6 +710,7 @@ type UpsertAddrEventParams struct {
 	ManagedUtxoID       int32
 	AssetProofID        sql.NullInt32
 	AssetID             sql.NullInt32
+	UpdateTime          time.Time
 }
 
 func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int32, error) {
@@ -374,8 +723,63 @@ func (q *Queries) UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams
 		arg.ManagedUtxoID,
 		arg.AssetProofID,
 		arg.AssetID,
+		arg.UpdateTime,
 	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
+
+const upsertAddrMetadata = `-- name: UpsertAddrMetadata :exec
+INSERT INTO addr_metadata (
+    addr_id, meta_key, meta_value
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (addr_id, meta_key)
+    DO UPDATE SET meta_value = EXCLUDED.meta_value
+`
+
+type UpsertAddrMetadataParams struct {
+	AddrID    int32
+	MetaKey   string
+	MetaValue string
+}
+
+func (q *Queries) UpsertAddrMetadata(ctx context.Context, arg UpsertAddrMetadataParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAddrMetadata, arg.AddrID, arg.MetaKey, arg.MetaValue)
+	return err
+}
+
+const upsertRecoveryOutput = `-- name: UpsertRecoveryOutput :exec
+WITH target_chain_txn(txn_id) AS (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE chain_txns.txid = $4
+)
+INSERT INTO recovery_outputs (
+    creation_time, chain_txn_id, chain_txn_output_index, amt_sats
+) VALUES (
+    $1, (SELECT txn_id FROM target_chain_txn),
+    $2, $3
+)
+ON CONFLICT (chain_txn_id, chain_txn_output_index)
+    -- This is a NOP, we only need to know about the output once.
+    DO NOTHING
+`
+
+type UpsertRecoveryOutputParams struct {
+	CreationTime        time.Time
+	ChainTxnOutputIndex int32
+	AmtSats             int64
+	Txid                []byte
+}
+
+func (q *Queries) UpsertRecoveryOutput(ctx context.Context, arg UpsertRecoveryOutputParams) error {
+	_, err := q.db.ExecContext(ctx, upsertRecoveryOutput,
+		arg.CreationTime,
+		arg.ChainTxnOutputIndex,
+		arg.AmtSats,
+		arg.Txid,
+	)
+	return err
+}
@@ -86,7 +86,7 @@ func (q *Queries) AllInternalKeys(ctx context.Context) ([]InternalKey, error) {
 }
 
 const allMintingBatches = `-- name: AllMintingBatches :many
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index 
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, num_confs, key_id, raw_key, key_family, key_index 
 FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id
@@ -99,6 +99,7 @@ type AllMintingBatchesRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	NumConfs           int32
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -121,6 +122,7 @@ func (q *Queries) AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow
 			&i.MintingOutputIndex,
 			&i.GenesisID,
 			&i.CreationTimeUnix,
+			&i.NumConfs,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -413,6 +415,15 @@ func (q *Queries) ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams)
 	return err
 }
 
+const deleteLegacyAssetProofs = `-- name: DeleteLegacyAssetProofs :exec
+DELETE FROM asset_proofs
+`
+
+func (q *Queries) DeleteLegacyAssetProofs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteLegacyAssetProofs)
+	return err
+}
+
 const deleteManagedUTXO = `-- name: DeleteManagedUTXO :exec
 DELETE FROM managed_utxos
 WHERE outpoint = $1
@@ -423,79 +434,38 @@ func (q *Queries) DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	return err
 }
 
-const fetchAssetProof = `-- name: FetchAssetProof :one
-WITH asset_info AS (
-    SELECT assets.asset_id, script_keys.tweaked_script_key
-    FROM assets
-    JOIN script_keys
-        ON assets.script_key_id = script_keys.script_key_id
-    WHERE script_keys.tweaked_script_key = $1
-)
-SELECT asset_info.tweaked_script_key AS script_key, asset_proofs.proof_file,
-       asset_info.asset_id as asset_id, asset_proofs.proof_id as proof_id
-FROM asset_proofs
-JOIN asset_info
-    ON asset_info.asset_id = asset_proofs.asset_id
+const fetchAssetByScriptKeyAnchor = `-- name: FetchAssetByScriptKeyAnchor :one
+SELECT assets.asset_id AS asset_primary_key,
+       genesis_assets.asset_id AS asset_id
+FROM assets
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+JOIN managed_utxos utxos
+    ON assets.anchor_utxo_id = utxos.utxo_id
+WHERE script_keys.tweaked_script_key = $1 AND
+    utxos.outpoint = $2 AND
+    (genesis_assets.asset_id = $3 OR
+        $3 IS NULL)
 `
 
-type FetchAssetProofRow struct {
-	ScriptKey []byte
-	ProofFile []byte
-	AssetID   int32
-	ProofID   int32
+type FetchAssetByScriptKeyAnchorParams struct {
+	ScriptKey   []byte
+	AnchorPoint []byte
+	AssetID     []byte
 }
 
-func (q *Queries) FetchAssetProof(ctx context.Context, tweakedScriptKey []byte) (FetchAssetProofRow, error) {
-	row := q.db.QueryRowContext(ctx, fetchAssetProof, tweakedScriptKey)
-	var i FetchAssetProofRow
-	err := row.Scan(
-		&i.ScriptKey,
-		&i.ProofFile,
-		&i.AssetID,
-		&i.ProofID,
-	)
-	return i, err
+type FetchAssetByScriptKeyAnchorRow struct {
+	AssetPrimaryKey int32
+	AssetID         []byte
 }
 
-const fetchAssetProofs = `-- name: FetchAssetProofs :many
-WITH asset_info AS (
-    SELECT assets.asset_id, script_keys.tweaked_script_key
-    FROM assets
-    JOIN script_keys
-        ON assets.script_key_id = script_keys.script_key_id
-)
-SELECT asset_info.tweaked_script_key AS script_key, asset_proofs.proof_file
-FROM asset_proofs
-JOIN asset_info
-    ON asset_info.asset_id = asset_proofs.asset_id
-`
-
-type FetchAssetProofsRow struct {
-	ScriptKey []byte
-	ProofFile []byte
-}
-
-func (q *Queries) FetchAssetProofs(ctx context.Context) ([]FetchAssetProofsRow, error) {
-	rows, err := q.db.QueryContext(ctx, fetchAssetProofs)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []FetchAssetProofsRow
-	for rows.Next() {
-		var i FetchAssetProofsRow
-		if err := rows.Scan(&i.ScriptKey, &i.ProofFile); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) FetchAssetByScriptKeyAnchor(ctx context.Context, arg FetchAssetByScriptKeyAnchorParams) (FetchAssetByScriptKeyAnchorRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchAssetByScriptKeyAnchor, arg.ScriptKey, arg.AnchorPoint, arg.AssetID)
+	var i FetchAssetByScriptKeyAnchorRow
+	err := row.Scan(&i.AssetPrimaryKey, &i.AssetID)
+	return i, err
 }
 
 const fetchAssetWitnesses = `-- name: FetchAssetWitnesses :many
@@ -786,6 +756,47 @@ func (q *Queries) FetchGenesisPointByAnchorTx(ctx context.Context, anchorTxID sq
 	return i, err
 }
 
+const fetchLegacyAssetProofs = `-- name: FetchLegacyAssetProofs :many
+SELECT script_keys.tweaked_script_key AS script_key,
+       genesis_assets.asset_id AS asset_id, asset_proofs.proof_file
+FROM asset_proofs
+JOIN assets
+    ON assets.asset_id = asset_proofs.asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+`
+
+type FetchLegacyAssetProofsRow struct {
+	ScriptKey []byte
+	AssetID   []byte
+	ProofFile []byte
+}
+
+func (q *Queries) FetchLegacyAssetProofs(ctx context.Context) ([]FetchLegacyAssetProofsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchLegacyAssetProofs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchLegacyAssetProofsRow
+	for rows.Next() {
+		var i FetchLegacyAssetProofsRow
+		if err := rows.Scan(&i.ScriptKey, &i.AssetID, &i.ProofFile); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchManagedUTXO = `-- name: FetchManagedUTXO :one
 SELECT utxo_id, outpoint, amt_sats, internal_key_id, tapscript_sibling, taro_root, txn_id, key_id, raw_key, key_family, key_index
 FROM managed_utxos utxos
@@ -836,7 +847,7 @@ func (q *Queries) FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOPara
 }
 
 const fetchMintingBatch = `-- name: FetchMintingBatch :one
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, num_confs, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -850,6 +861,7 @@ type FetchMintingBatchRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	NumConfs           int32
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -866,6 +878,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 		&i.MintingOutputIndex,
 		&i.GenesisID,
 		&i.CreationTimeUnix,
+		&i.NumConfs,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -875,7 +888,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 }
 
 const fetchMintingBatchesByInverseState = `-- name: FetchMintingBatchesByInverseState :many
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, num_confs, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -889,6 +902,7 @@ type FetchMintingBatchesByInverseStateRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	NumConfs           int32
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -911,6 +925,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 			&i.MintingOutputIndex,
 			&i.GenesisID,
 			&i.CreationTimeUnix,
+			&i.NumConfs,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -930,7 +945,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 }
 
 const fetchMintingBatchesByState = `-- name: FetchMintingBatchesByState :many
-SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, minting_output_index, genesis_id, creation_time_unix, num_confs, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -944,6 +959,7 @@ type FetchMintingBatchesByStateRow struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	NumConfs           int32
 	KeyID              int32
 	RawKey             []byte
 	KeyFamily          int32
@@ -966,6 +982,7 @@ func (q *Queries) FetchMintingBatchesByState(ctx context.Context, batchState int
 			&i.MintingOutputIndex,
 			&i.GenesisID,
 			&i.CreationTimeUnix,
+			&i.NumConfs,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -984,6 +1001,44 @@ func (q *Queries) FetchMintingBatchesByState(ctx context.Context, batchState int
 	return items, nil
 }
 
+const fetchRecentChainTxns = `-- name: FetchRecentChainTxns :many
+SELECT txn_id, txid, chain_fees, raw_tx, block_height, block_hash, tx_index
+FROM chain_txns
+WHERE block_height >= $1
+ORDER BY block_height
+`
+
+func (q *Queries) FetchRecentChainTxns(ctx context.Context, minHeight sql.NullInt32) ([]ChainTxn, error) {
+	rows, err := q.db.QueryContext(ctx, fetchRecentChainTxns, minHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChainTxn
+	for rows.Next() {
+		var i ChainTxn
+		if err := rows.Scan(
+			&i.TxnID,
+			&i.Txid,
+			&i.ChainFees,
+			&i.RawTx,
+			&i.BlockHeight,
+			&i.BlockHash,
+			&i.TxIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchScriptKeyIDByTweakedKey = `-- name: FetchScriptKeyIDByTweakedKey :one
 SELECT script_key_id
 FROM script_keys
@@ -997,6 +1052,41 @@ func (q *Queries) FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScrip
 	return script_key_id, err
 }
 
+const fetchSeedlingMetadata = `-- name: FetchSeedlingMetadata :many
+SELECT meta_key, meta_value
+FROM seedling_metadata
+WHERE seedling_id = $1
+ORDER BY meta_key
+`
+
+type FetchSeedlingMetadataRow struct {
+	MetaKey   string
+	MetaValue string
+}
+
+func (q *Queries) FetchSeedlingMetadata(ctx context.Context, seedlingID int32) ([]FetchSeedlingMetadataRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchSeedlingMetadata, seedlingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchSeedlingMetadataRow
+	for rows.Next() {
+		var i FetchSeedlingMetadataRow
+		if err := rows.Scan(&i.MetaKey, &i.MetaValue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchSeedlingsForBatch = `-- name: FetchSeedlingsForBatch :many
 WITH target_batch(batch_id) AS (
     SELECT batch_id
@@ -1006,7 +1096,8 @@ WITH target_batch(batch_id) AS (
     WHERE keys.raw_key = $1
 )
 SELECT seedling_id, asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, genesis_id, batch_id
+    emission_enabled, genesis_id, batch_id, lock_time, relative_lock_time,
+    label, script_version
 FROM asset_seedlings 
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch)
 `
@@ -1029,6 +1120,10 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 			&i.EmissionEnabled,
 			&i.GenesisID,
 			&i.BatchID,
+			&i.LockTime,
+			&i.RelativeLockTime,
+			&i.Label,
+			&i.ScriptVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -1107,37 +1202,48 @@ func (q *Queries) GenesisPoints(ctx context.Context) ([]GenesisPoint, error) {
 	return items, nil
 }
 
-const insertAssetSeedling = `-- name: InsertAssetSeedling :exec
+const insertAssetSeedling = `-- name: InsertAssetSeedling :one
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, lock_time, relative_lock_time, label,
+    script_version
 ) VALUES (
-   $1, $2, $3, $4, $5, $6
-)
+   $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING seedling_id
 `
 
 type InsertAssetSeedlingParams struct {
-	AssetName       string
-	AssetType       int16
-	AssetSupply     int64
-	AssetMeta       []byte
-	EmissionEnabled bool
-	BatchID         int32
+	AssetName        string
+	AssetType        int16
+	AssetSupply      int64
+	AssetMeta        []byte
+	EmissionEnabled  bool
+	BatchID          int32
+	LockTime         sql.NullInt32
+	RelativeLockTime sql.NullInt32
+	Label            string
+	ScriptVersion    int32
 }
 
-func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error {
-	_, err := q.db.ExecContext(ctx, insertAssetSeedling,
+func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, insertAssetSeedling,
 		arg.AssetName,
 		arg.AssetType,
 		arg.AssetSupply,
 		arg.AssetMeta,
 		arg.EmissionEnabled,
 		arg.BatchID,
+		arg.LockTime,
+		arg.RelativeLockTime,
+		arg.Label,
+		arg.ScriptVersion,
 	)
-	return err
+	var seedling_id int32
+	err := row.Scan(&seedling_id)
+	return seedling_id, err
 }
 
-const insertAssetSeedlingIntoBatch = `-- name: InsertAssetSeedlingIntoBatch :exec
+const insertAssetSeedlingIntoBatch = `-- name: InsertAssetSeedlingIntoBatch :one
 WITH target_key_id AS (
     -- We use this CTE to fetch the key_id of the internal key that's
     -- associated with a given batch. This can only return one value in
@@ -1150,31 +1256,42 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, lock_time, relative_lock_time, label,
+    script_version
 ) VALUES (
-    $2, $3, $4, $5, $6, (SELECT key_id FROM target_key_id)
-)
+    $2, $3, $4, $5, $6, (SELECT key_id FROM target_key_id), $7, $8, $9, $10
+) RETURNING seedling_id
 `
 
 type InsertAssetSeedlingIntoBatchParams struct {
-	RawKey          []byte
-	AssetName       string
-	AssetType       int16
-	AssetSupply     int64
-	AssetMeta       []byte
-	EmissionEnabled bool
+	RawKey           []byte
+	AssetName        string
+	AssetType        int16
+	AssetSupply      int64
+	AssetMeta        []byte
+	EmissionEnabled  bool
+	LockTime         sql.NullInt32
+	RelativeLockTime sql.NullInt32
+	Label            string
+	ScriptVersion    int32
 }
 
-func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error {
-	_, err := q.db.ExecContext(ctx, insertAssetSeedlingIntoBatch,
+func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, insertAssetSeedlingIntoBatch,
 		arg.RawKey,
 		arg.AssetName,
 		arg.AssetType,
 		arg.AssetSupply,
 		arg.AssetMeta,
 		arg.EmissionEnabled,
+		arg.LockTime,
+		arg.RelativeLockTime,
+		arg.Label,
+		arg.ScriptVersion,
 	)
-	return err
+	var seedling_id int32
+	err := row.Scan(&seedling_id)
+	return seedling_id, err
 }
 
 const insertAssetWitness = `-- name: InsertAssetWitness :exec
@@ -1245,19 +1362,39 @@ func (q *Queries) InsertNewAsset(ctx context.Context, arg InsertNewAssetParams)
 	return asset_id, err
 }
 
+const insertSeedlingMetadata = `-- name: InsertSeedlingMetadata :exec
+INSERT INTO seedling_metadata (
+    seedling_id, meta_key, meta_value
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type InsertSeedlingMetadataParams struct {
+	SeedlingID int32
+	MetaKey    string
+	MetaValue  string
+}
+
+func (q *Queries) InsertSeedlingMetadata(ctx context.Context, arg InsertSeedlingMetadataParams) error {
+	_, err := q.db.ExecContext(ctx, insertSeedlingMetadata, arg.SeedlingID, arg.MetaKey, arg.MetaValue)
+	return err
+}
+
 const newMintingBatch = `-- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, creation_time_unix
-) VALUES (0, $1, $2)
+    batch_state, batch_id, creation_time_unix, num_confs
+) VALUES (0, $1, $2, $3)
 `
 
 type NewMintingBatchParams struct {
 	BatchID          int32
 	CreationTimeUnix time.Time
+	NumConfs         int32
 }
 
 func (q *Queries) NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error {
-	_, err := q.db.ExecContext(ctx, newMintingBatch, arg.BatchID, arg.CreationTimeUnix)
+	_, err := q.db.ExecContext(ctx, newMintingBatch, arg.BatchID, arg.CreationTimeUnix, arg.NumConfs)
 	return err
 }
 
@@ -1274,6 +1411,10 @@ JOIN genesis_info_view
         $1 IS NULL)
 LEFT JOIN key_fam_info_view
     ON assets.genesis_id = key_fam_info_view.gen_asset_id
+WHERE NOT EXISTS (
+    SELECT 1 FROM addrs
+    WHERE addrs.script_key_id = assets.script_key_id AND addrs.watch_only
+)
 GROUP BY assets.genesis_id, genesis_info_view.asset_id,
          version, genesis_info_view.asset_tag, genesis_info_view.meta_data,
          genesis_info_view.asset_type, genesis_info_view.output_index,
@@ -1295,6 +1436,7 @@ type QueryAssetBalancesByAssetRow struct {
 // generate rows that have NULL values for the family key fields if an asset
 // doesn't have a family key. See the comment in fetchAssetSprouts for a work
 // around that needs to be used with this query until a sqlc bug is fixed.
+// Assets received with a watch-only address can't be spent by us.
 func (q *Queries) QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter []byte) ([]QueryAssetBalancesByAssetRow, error) {
 	rows, err := q.db.QueryContext(ctx, queryAssetBalancesByAsset, assetIDFilter)
 	if err != nil {
@@ -1335,6 +1477,10 @@ JOIN key_fam_info_view
     ON assets.genesis_id = key_fam_info_view.gen_asset_id AND
       (key_fam_info_view.tweaked_fam_key = $1 OR
         $1 IS NULL)
+WHERE NOT EXISTS (
+    SELECT 1 FROM addrs
+    WHERE addrs.script_key_id = assets.script_key_id AND addrs.watch_only
+)
 GROUP BY key_fam_info_view.tweaked_fam_key
 `
 
@@ -1370,6 +1516,7 @@ const queryAssets = `-- name: QueryAssets :many
 SELECT
     assets.asset_id AS asset_primary_key, assets.genesis_id, version,
     script_keys.tweak AS script_key_tweak, 
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     script_keys.tweaked_script_key, 
     internal_keys.raw_key AS script_key_raw,
     internal_keys.key_family AS script_key_fam,
@@ -1387,6 +1534,7 @@ SELECT
     genesis_info_view.asset_type,
     genesis_info_view.prev_out AS genesis_prev_out,
     txns.raw_tx AS anchor_tx, txns.txid AS anchor_txid, txns.block_hash AS anchor_block_hash,
+    txns.block_height AS anchor_block_height,
     utxos.outpoint AS anchor_outpoint,
     utxo_internal_keys.raw_key AS anchor_internal_key,
     split_commitment_root_hash, split_commitment_root_value
@@ -1412,7 +1560,14 @@ JOIN chain_txns txns
 WHERE (
     assets.amount >= COALESCE($3, assets.amount) AND
     (key_fam_info_view.tweaked_fam_key = $4 OR
-      $4 IS NULL)
+      $4 IS NULL) AND
+    -- Assets received with a watch-only address can't be spent by us, so
+    -- they're skipped when selecting inputs.
+    (COALESCE($5, false) = false OR NOT EXISTS (
+        SELECT 1 FROM addrs
+        WHERE addrs.script_key_id = assets.script_key_id AND
+          addrs.watch_only
+    ))
 )
 `
 
@@ -1421,6 +1576,7 @@ type QueryAssetsParams struct {
 	AnchorPoint   []byte
 	MinAmt        sql.NullInt64
 	KeyFamFilter  []byte
+	SpendableOnly interface{}
 }
 
 type QueryAssetsRow struct {
@@ -1428,6 +1584,7 @@ type QueryAssetsRow struct {
 	GenesisID                int32
 	Version                  int32
 	ScriptKeyTweak           []byte
+	ScriptKeyTapscriptTree   []byte
 	TweakedScriptKey         []byte
 	ScriptKeyRaw             []byte
 	ScriptKeyFam             int32
@@ -1450,6 +1607,7 @@ type QueryAssetsRow struct {
 	AnchorTx                 []byte
 	AnchorTxid               []byte
 	AnchorBlockHash          []byte
+	AnchorBlockHeight        sql.NullInt32
 	AnchorOutpoint           []byte
 	AnchorInternalKey        []byte
 	SplitCommitmentRootHash  []byte
@@ -1470,6 +1628,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 		arg.AnchorPoint,
 		arg.MinAmt,
 		arg.KeyFamFilter,
+		arg.SpendableOnly,
 	)
 	if err != nil {
 		return nil, err
@@ -1483,6 +1642,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 			&i.GenesisID,
 			&i.Version,
 			&i.ScriptKeyTweak,
+			&i.ScriptKeyTapscriptTree,
 			&i.TweakedScriptKey,
 			&i.ScriptKeyRaw,
 			&i.ScriptKeyFam,
@@ -1505,6 +1665,7 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 			&i.AnchorTx,
 			&i.AnchorTxid,
 			&i.AnchorBlockHash,
+			&i.AnchorBlockHeight,
 			&i.AnchorOutpoint,
 			&i.AnchorInternalKey,
 			&i.SplitCommitmentRootHash,
@@ -1523,6 +1684,30 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 	return items, nil
 }
 
+const raiseMintingBatchNumConfs = `-- name: RaiseMintingBatchNumConfs :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET num_confs = $2
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+    AND num_confs < $2
+`
+
+type RaiseMintingBatchNumConfsParams struct {
+	RawKey   []byte
+	NumConfs int32
+}
+
+func (q *Queries) RaiseMintingBatchNumConfs(ctx context.Context, arg RaiseMintingBatchNumConfsParams) error {
+	_, err := q.db.ExecContext(ctx, raiseMintingBatchNumConfs, arg.RawKey, arg.NumConfs)
+	return err
+}
+
 const updateBatchGenesisTx = `-- name: UpdateBatchGenesisTx :exec
 WITH target_batch AS (
     SELECT batch_id
@@ -1546,6 +1731,31 @@ func (q *Queries) UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenes
 	return err
 }
 
+const updateChainTxConf = `-- name: UpdateChainTxConf :exec
+UPDATE chain_txns
+SET block_height = $1,
+    block_hash = $2,
+    tx_index = $3
+WHERE txid = $4
+`
+
+type UpdateChainTxConfParams struct {
+	BlockHeight sql.NullInt32
+	BlockHash   []byte
+	TxIndex     sql.NullInt32
+	Txid        []byte
+}
+
+func (q *Queries) UpdateChainTxConf(ctx context.Context, arg UpdateChainTxConfParams) error {
+	_, err := q.db.ExecContext(ctx, updateChainTxConf,
+		arg.BlockHeight,
+		arg.BlockHash,
+		arg.TxIndex,
+		arg.Txid,
+	)
+	return err
+}
+
 const updateMintingBatchState = `-- name: UpdateMintingBatchState :exec
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -1621,36 +1831,6 @@ func (q *Queries) UpsertAssetFamilySig(ctx context.Context, arg UpsertAssetFamil
 	return sig_id, err
 }
 
-const upsertAssetProof = `-- name: UpsertAssetProof :exec
-WITH target_asset(asset_id) AS (
-    SELECT asset_id
-    FROM assets
-    JOIN script_keys 
-        ON assets.script_key_id = script_keys.script_key_id
-    WHERE script_keys.tweaked_script_key = $1
-    -- TODO(guggero): Fix this by disallowing multiple assets with the same
-    -- script key!
-    LIMIT 1
-)
-INSERT INTO asset_proofs (
-    asset_id, proof_file
-) VALUES (
-    (SELECT asset_id FROM target_asset), $2
-) ON CONFLICT (asset_id)
-    -- This is not a NOP, update the proof file in case it wasn't set before.
-    DO UPDATE SET proof_file = EXCLUDED.proof_file
-`
-
-type UpsertAssetProofParams struct {
-	TweakedScriptKey []byte
-	ProofFile        []byte
-}
-
-func (q *Queries) UpsertAssetProof(ctx context.Context, arg UpsertAssetProofParams) error {
-	_, err := q.db.ExecContext(ctx, upsertAssetProof, arg.TweakedScriptKey, arg.ProofFile)
-	return err
-}
-
 const upsertChainTx = `-- name: UpsertChainTx :one
 INSERT INTO chain_txns (
     txid, raw_tx, chain_fees, block_height, block_hash, tx_index
@@ -1747,8 +1927,20 @@ INSERT INTO internal_keys (
 ) VALUES (
     $1, $2, $3
 ) ON CONFLICT (raw_key)
-    -- This is a NOP, raw_key is the unique field that caused the conflict.
-    DO UPDATE SET raw_key = EXCLUDED.raw_key
+    -- A key imported with the proof of an asset is stored without its key
+    -- locator. Once we learn it, for example when recovering an address, we
+    -- fill it in. Otherwise this is a NOP.
+    DO UPDATE SET
+        key_family = CASE
+            WHEN internal_keys.key_family = 0 AND internal_keys.key_index = 0
+            THEN EXCLUDED.key_family
+            ELSE internal_keys.key_family
+        END,
+        key_index = CASE
+            WHEN internal_keys.key_family = 0 AND internal_keys.key_index = 0
+            THEN EXCLUDED.key_index
+            ELSE internal_keys.key_index
+        END
 RETURNING key_id
 `
 
@@ -1807,13 +1999,42 @@ func (q *Queries) UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOPa
 
 const upsertScriptKey = `-- name: UpsertScriptKey :one
 INSERT INTO script_keys (
-    internal_key_id, tweaked_script_key, tweak
+    internal_key_id, tweaked_script_key, tweak, tapscript_tree
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 )  ON CONFLICT (tweaked_script_key)
-    -- As a NOP, we just set the script key to the one that triggered the
-    -- conflict.
-    DO UPDATE SET tweaked_script_key = EXCLUDED.tweaked_script_key
+    -- A script key imported with the proof of an asset is only known by its
+    -- tweaked key, which is stored as its own raw key. Once we learn the
+    -- actual raw key and tweak, for example when recovering an address, we
+    -- fill them in. Otherwise this is a NOP.
+    DO UPDATE SET
+        internal_key_id = CASE
+            WHEN script_keys.internal_key_id IN (
+                SELECT key_id
+                FROM internal_keys
+                WHERE raw_key = script_keys.tweaked_script_key
+            )
+            THEN EXCLUDED.internal_key_id
+            ELSE script_keys.internal_key_id
+        END,
+        tweak = CASE
+            WHEN script_keys.internal_key_id IN (
+                SELECT key_id
+                FROM internal_keys
+                WHERE raw_key = script_keys.tweaked_script_key
+            )
+            THEN EXCLUDED.tweak
+            ELSE script_keys.tweak
+        END,
+        tapscript_tree = CASE
+            WHEN script_keys.internal_key_id IN (
+                SELECT key_id
+                FROM internal_keys
+                WHERE raw_key = script_keys.tweaked_script_key
+            )
+            THEN EXCLUDED.tapscript_tree
+            ELSE script_keys.tapscript_tree
+        END
 RETURNING script_key_id
 `
 
@@ -1821,10 +2042,16 @@ type UpsertScriptKeyParams struct {
 	InternalKeyID    int32
 	TweakedScriptKey []byte
 	Tweak            []byte
+	TapscriptTree    []byte
 }
 
 func (q *Queries) UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int32, error) {
-	row := q.db.QueryRowContext(ctx, upsertScriptKey, arg.InternalKeyID, arg.TweakedScriptKey, arg.Tweak)
+	row := q.db.QueryRowContext(ctx, upsertScriptKey,
+		arg.InternalKeyID,
+		arg.TweakedScriptKey,
+		arg.Tweak,
+		arg.TapscriptTree,
+	)
 	var script_key_id int32
 	err := row.Scan(&script_key_id)
 	return script_key_id, err
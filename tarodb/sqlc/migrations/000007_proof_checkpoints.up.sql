@@ -0,0 +1,24 @@
+-- proof_checkpoints stores the roots of proof file checkpoints we trust. A
+-- proof file that was compacted starts at a checkpoint instead of the asset's
+-- genesis and can only be verified if its checkpoint root is known here.
+CREATE TABLE IF NOT EXISTS proof_checkpoints (
+    checkpoint_id INTEGER PRIMARY KEY,
+
+    -- checkpoint_root is the chained checksum of the last pruned proof of
+    -- the compacted proof file.
+    checkpoint_root BLOB UNIQUE NOT NULL CHECK(length(checkpoint_root) = 32),
+
+    -- asset_id is the asset ID of the asset the checkpoint was created for.
+    asset_id BLOB,
+
+    -- script_key is the script key of the asset the checkpoint was created
+    -- for.
+    script_key BLOB NOT NULL,
+
+    -- num_pruned is the total number of state transitions the checkpoint
+    -- replaces.
+    num_pruned BIGINT NOT NULL,
+
+    -- creation_time is the time the checkpoint was first marked as trusted.
+    creation_time TIMESTAMP NOT NULL
+);
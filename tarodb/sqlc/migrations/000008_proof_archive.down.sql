@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS proof_file_transitions_transition_idx;
+DROP TABLE IF EXISTS proof_file_transitions;
+DROP TABLE IF EXISTS proof_files;
+DROP TABLE IF EXISTS proof_transitions;
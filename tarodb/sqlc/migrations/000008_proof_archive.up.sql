@@ -0,0 +1,52 @@
+-- proof_transitions stores every individual state transition proof exactly
+-- once, keyed by the hash of its encoding. Proof files that share the same
+-- ancestry (for example the outputs of an asset split) reference the same
+-- rows.
+CREATE TABLE IF NOT EXISTS proof_transitions (
+    transition_id INTEGER PRIMARY KEY,
+
+    -- proof_hash is the SHA256 hash of the encoded proof.
+    proof_hash BLOB UNIQUE NOT NULL CHECK(length(proof_hash) = 32),
+
+    -- proof_bytes is the encoded state transition proof.
+    proof_bytes BLOB NOT NULL
+);
+
+-- proof_files stores the metadata of a proof file, the proofs it consists of
+-- are stored as references in proof_file_transitions.
+CREATE TABLE IF NOT EXISTS proof_files (
+    file_id INTEGER PRIMARY KEY,
+
+    -- script_key is the script key of the asset the proof file is for.
+    script_key BLOB NOT NULL,
+
+    -- asset_id is the asset ID of the asset the proof file is for. Assets
+    -- with different IDs can share the same script key, so a proof file is
+    -- only identified by both.
+    asset_id BLOB NOT NULL,
+
+    -- version is the version of the proof file.
+    version INTEGER NOT NULL,
+
+    -- checkpoint is the encoded checkpoint the proof file starts at, if the
+    -- file was compacted.
+    checkpoint BLOB,
+
+    UNIQUE(asset_id, script_key)
+);
+
+-- proof_file_transitions is the ordered list of state transition proofs a
+-- proof file consists of.
+CREATE TABLE IF NOT EXISTS proof_file_transitions (
+    file_id INTEGER NOT NULL REFERENCES proof_files(file_id),
+
+    -- seq_num is the position of the proof within the proof file.
+    seq_num INTEGER NOT NULL,
+
+    transition_id INTEGER NOT NULL REFERENCES proof_transitions(transition_id),
+
+    UNIQUE(file_id, seq_num)
+);
+
+CREATE INDEX IF NOT EXISTS proof_file_transitions_transition_idx
+    ON proof_file_transitions(transition_id);
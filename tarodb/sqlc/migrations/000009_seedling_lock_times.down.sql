@@ -0,0 +1,2 @@
+ALTER TABLE asset_seedlings DROP COLUMN relative_lock_time;
+ALTER TABLE asset_seedlings DROP COLUMN lock_time;
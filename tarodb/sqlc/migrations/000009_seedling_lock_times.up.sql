@@ -0,0 +1,8 @@
+-- lock_time is the optional absolute lock time (block height) the seedling's
+-- asset will be created with. The asset can't be moved before that height.
+ALTER TABLE asset_seedlings ADD COLUMN lock_time INTEGER;
+
+-- relative_lock_time is the optional relative lock time (number of blocks) the
+-- seedling's asset will be created with. The asset can't be moved until that
+-- number of blocks has passed since its last transfer was confirmed.
+ALTER TABLE asset_seedlings ADD COLUMN relative_lock_time INTEGER;
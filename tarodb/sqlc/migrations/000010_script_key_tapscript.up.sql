@@ -0,0 +1,4 @@
+-- tapscript_tree is the optional serialized set of tapscript leaves the script
+-- key commits to. If present, then the tweak is the root hash of the tree
+-- assembled from those leaves.
+ALTER TABLE script_keys ADD COLUMN tapscript_tree BLOB;
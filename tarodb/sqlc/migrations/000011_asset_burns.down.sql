@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS asset_burns_asset_id;
+DROP TABLE IF EXISTS asset_burns;
@@ -0,0 +1,16 @@
+-- asset_burns records the assets that were provably burned by one of our
+-- transfers. The burned assets themselves aren't tracked in the assets table,
+-- as they can never be spent again.
+CREATE TABLE IF NOT EXISTS asset_burns (
+    burn_id INTEGER PRIMARY KEY,
+
+    transfer_id INTEGER NOT NULL REFERENCES asset_transfers(id),
+
+    asset_id BLOB NOT NULL,
+
+    -- burn_key is the unspendable script key the asset was burned to.
+    burn_key BLOB NOT NULL,
+
+    amount BIGINT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS asset_burns_asset_id on asset_burns (asset_id);
@@ -0,0 +1,67 @@
+-- The address event status gained a new value for transactions that reached
+-- the number of confirmations required to be considered final, which sits
+-- between the confirmed (1) and the proof received (2) status. As the allowed
+-- values are enforced by a CHECK constraint, we need to re-create the table
+-- and shift the existing status values of 2 and above by one. The event IDs
+-- are re-assigned in their original order, as copying them over explicitly
+-- wouldn't advance the ID sequence on postgres.
+CREATE TABLE IF NOT EXISTS addr_events_new (
+    id INTEGER PRIMARY KEY,
+
+    -- creation_time is the creation time of this event.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- addr_id is the reference to the address this event was emitted for.
+    addr_id INTEGER NOT NULL REFERENCES addrs(id),
+
+    -- status is the status of the inbound asset.
+    status SMALLINT NOT NULL CHECK (status IN (0, 1, 2, 3, 4)),
+
+    -- chain_txn_id is a reference to the chain transaction that has the Taproot
+    -- output for this event.
+    chain_txn_id INTEGER NOT NULL REFERENCES chain_txns(txn_id),
+
+    -- chain_txn_output_index is the index of the on-chain output (of the
+    -- transaction referenced by chain_txn_id) that houses the Taro commitment.
+    chain_txn_output_index INTEGER NOT NULL,
+
+    -- managed_utxo_id is a reference to the managed UTXO the internal wallet
+    -- tracks with on-chain funds that belong to us.
+    managed_utxo_id INTEGER NOT NULL REFERENCES managed_utxos(utxo_id),
+
+    -- asset_proof_id is a reference to the proof associated with this asset
+    -- event.
+    asset_proof_id INTEGER REFERENCES asset_proofs(proof_id),
+
+    -- asset_id is a reference to the asset once we have taken custody of it.
+    -- This will only be set once the proofs were imported successfully and the
+    -- event is in the status complete.
+    asset_id INTEGER REFERENCES assets(asset_id),
+
+    UNIQUE(addr_id, chain_txn_id, chain_txn_output_index)
+);
+
+INSERT INTO addr_events_new (
+    creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
+    managed_utxo_id, asset_proof_id, asset_id
+)
+SELECT
+    creation_time, addr_id,
+    CASE WHEN status >= 2 THEN status + 1 ELSE status END,
+    chain_txn_id, chain_txn_output_index, managed_utxo_id, asset_proof_id,
+    asset_id
+FROM addr_events
+ORDER BY id;
+
+DROP INDEX IF EXISTS creation_time_idx;
+DROP INDEX IF EXISTS status_idx;
+DROP INDEX IF EXISTS asset_proof_id_idx;
+DROP INDEX IF EXISTS asset_id_idx;
+DROP TABLE addr_events;
+
+ALTER TABLE addr_events_new RENAME TO addr_events;
+
+CREATE INDEX IF NOT EXISTS creation_time_idx ON addr_events(creation_time);
+CREATE INDEX IF NOT EXISTS status_idx ON addr_events(status);
+CREATE INDEX IF NOT EXISTS asset_proof_id_idx ON addr_events(asset_proof_id);
+CREATE INDEX IF NOT EXISTS asset_id_idx ON addr_events(asset_id);
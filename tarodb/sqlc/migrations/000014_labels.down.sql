@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS seedling_metadata;
+DROP INDEX IF EXISTS transfer_metadata_lookup;
+DROP TABLE IF EXISTS transfer_metadata;
+DROP INDEX IF EXISTS addr_metadata_lookup;
+DROP TABLE IF EXISTS addr_metadata;
+
+DROP INDEX IF EXISTS transfer_label_idx;
+DROP INDEX IF EXISTS addr_label_idx;
+
+ALTER TABLE asset_seedlings DROP COLUMN label;
+ALTER TABLE asset_transfers DROP COLUMN label;
+ALTER TABLE addrs DROP COLUMN label;
@@ -0,0 +1,56 @@
+-- Addresses, transfers and minting seedlings can carry a free-form label that
+-- can be used to map them to entities of external systems.
+ALTER TABLE addrs ADD COLUMN label TEXT NOT NULL DEFAULT '';
+ALTER TABLE asset_transfers ADD COLUMN label TEXT NOT NULL DEFAULT '';
+ALTER TABLE asset_seedlings ADD COLUMN label TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS addr_label_idx ON addrs(label);
+CREATE INDEX IF NOT EXISTS transfer_label_idx ON asset_transfers(label);
+
+-- addr_metadata stores arbitrary key/value metadata of an address.
+CREATE TABLE IF NOT EXISTS addr_metadata (
+    id INTEGER PRIMARY KEY,
+
+    -- addr_id is the reference to the address the metadata belongs to.
+    addr_id INTEGER NOT NULL REFERENCES addrs(id),
+
+    meta_key TEXT NOT NULL,
+
+    meta_value TEXT NOT NULL,
+
+    UNIQUE(addr_id, meta_key)
+);
+CREATE INDEX IF NOT EXISTS addr_metadata_lookup
+    ON addr_metadata(meta_key, meta_value);
+
+-- transfer_metadata stores arbitrary key/value metadata of an outbound asset
+-- transfer.
+CREATE TABLE IF NOT EXISTS transfer_metadata (
+    id INTEGER PRIMARY KEY,
+
+    -- transfer_id is the reference to the transfer the metadata belongs to.
+    transfer_id INTEGER NOT NULL REFERENCES asset_transfers(id),
+
+    meta_key TEXT NOT NULL,
+
+    meta_value TEXT NOT NULL,
+
+    UNIQUE(transfer_id, meta_key)
+);
+CREATE INDEX IF NOT EXISTS transfer_metadata_lookup
+    ON transfer_metadata(meta_key, meta_value);
+
+-- seedling_metadata stores arbitrary key/value metadata of an asset seedling
+-- that is about to be minted.
+CREATE TABLE IF NOT EXISTS seedling_metadata (
+    id INTEGER PRIMARY KEY,
+
+    -- seedling_id is the reference to the seedling the metadata belongs to.
+    seedling_id INTEGER NOT NULL REFERENCES asset_seedlings(seedling_id),
+
+    meta_key TEXT NOT NULL,
+
+    meta_value TEXT NOT NULL,
+
+    UNIQUE(seedling_id, meta_key)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE addrs DROP COLUMN memo_sig;
+ALTER TABLE addrs DROP COLUMN memo;
+ALTER TABLE addrs DROP COLUMN amount_policy;
@@ -0,0 +1,9 @@
+-- Addresses can accept amounts other than the exact amount they request,
+-- depending on their amount policy (0 = exact, 1 = at least, 2 = open).
+ALTER TABLE addrs ADD COLUMN amount_policy SMALLINT NOT NULL DEFAULT 0
+    CHECK (amount_policy IN (0, 1, 2));
+
+-- An address can optionally carry a memo that describes the payment it
+-- requests, signed with the internal key of the address.
+ALTER TABLE addrs ADD COLUMN memo TEXT NOT NULL DEFAULT '';
+ALTER TABLE addrs ADD COLUMN memo_sig BLOB;
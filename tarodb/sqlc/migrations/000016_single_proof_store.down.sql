@@ -0,0 +1,61 @@
+-- Re-create the address event table with the reference to the asset_proofs
+-- table. The proof files were moved out of that table, so the references can't
+-- be restored.
+CREATE TABLE IF NOT EXISTS addr_events_new (
+    id INTEGER PRIMARY KEY,
+
+    -- creation_time is the creation time of this event.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- addr_id is the reference to the address this event was emitted for.
+    addr_id INTEGER NOT NULL REFERENCES addrs(id),
+
+    -- status is the status of the inbound asset.
+    status SMALLINT NOT NULL CHECK (status IN (0, 1, 2, 3, 4, 5)),
+
+    -- chain_txn_id is a reference to the chain transaction that has the Taproot
+    -- output for this event.
+    chain_txn_id INTEGER NOT NULL REFERENCES chain_txns(txn_id),
+
+    -- chain_txn_output_index is the index of the on-chain output (of the
+    -- transaction referenced by chain_txn_id) that houses the Taro commitment.
+    chain_txn_output_index INTEGER NOT NULL,
+
+    -- managed_utxo_id is a reference to the managed UTXO the internal wallet
+    -- tracks with on-chain funds that belong to us.
+    managed_utxo_id INTEGER NOT NULL REFERENCES managed_utxos(utxo_id),
+
+    -- asset_proof_id is a reference to the proof associated with this asset
+    -- event.
+    asset_proof_id INTEGER REFERENCES asset_proofs(proof_id),
+
+    -- asset_id is a reference to the asset once we have taken custody of it.
+    -- This will only be set once the proofs were imported successfully and the
+    -- event is in the status complete.
+    asset_id INTEGER REFERENCES assets(asset_id),
+
+    UNIQUE(addr_id, chain_txn_id, chain_txn_output_index)
+);
+
+INSERT INTO addr_events_new (
+    creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
+    managed_utxo_id, asset_id
+)
+SELECT
+    creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
+    managed_utxo_id, asset_id
+FROM addr_events
+ORDER BY id;
+
+DROP INDEX IF EXISTS creation_time_idx;
+DROP INDEX IF EXISTS status_idx;
+DROP INDEX IF EXISTS asset_proof_id_idx;
+DROP INDEX IF EXISTS asset_id_idx;
+DROP TABLE addr_events;
+
+ALTER TABLE addr_events_new RENAME TO addr_events;
+
+CREATE INDEX IF NOT EXISTS creation_time_idx ON addr_events(creation_time);
+CREATE INDEX IF NOT EXISTS status_idx ON addr_events(status);
+CREATE INDEX IF NOT EXISTS asset_proof_id_idx ON addr_events(asset_proof_id);
+CREATE INDEX IF NOT EXISTS asset_id_idx ON addr_events(asset_id);
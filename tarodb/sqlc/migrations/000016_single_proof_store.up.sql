@@ -0,0 +1,95 @@
+-- Proof files are now only stored in the deduplicated proof archive
+-- (proof_files and proof_transitions). Address events therefore need to
+-- reference a proof file instead of a row of the asset_proofs table. As
+-- foreign keys can't be altered, we need to re-create the table. The event IDs
+-- are re-assigned in their original order, as copying them over explicitly
+-- wouldn't advance the ID sequence on postgres.
+--
+-- The blobs that are left in asset_proofs can't be split into their state
+-- transitions in SQL, they're moved into the proof archive by the daemon on
+-- startup. So the events can reference them already, we insert an empty proof
+-- file for each of them that is filled in by the daemon.
+INSERT INTO proof_files (asset_id, script_key, version)
+SELECT DISTINCT genesis_assets.asset_id, script_keys.tweaked_script_key, 0
+FROM asset_proofs
+JOIN assets
+    ON assets.asset_id = asset_proofs.asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+WHERE genesis_assets.asset_id IS NOT NULL
+ON CONFLICT (asset_id, script_key) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS addr_events_new (
+    id INTEGER PRIMARY KEY,
+
+    -- creation_time is the creation time of this event.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- addr_id is the reference to the address this event was emitted for.
+    addr_id INTEGER NOT NULL REFERENCES addrs(id),
+
+    -- status is the status of the inbound asset.
+    status SMALLINT NOT NULL CHECK (status IN (0, 1, 2, 3, 4, 5)),
+
+    -- chain_txn_id is a reference to the chain transaction that has the Taproot
+    -- output for this event.
+    chain_txn_id INTEGER NOT NULL REFERENCES chain_txns(txn_id),
+
+    -- chain_txn_output_index is the index of the on-chain output (of the
+    -- transaction referenced by chain_txn_id) that houses the Taro commitment.
+    chain_txn_output_index INTEGER NOT NULL,
+
+    -- managed_utxo_id is a reference to the managed UTXO the internal wallet
+    -- tracks with on-chain funds that belong to us.
+    managed_utxo_id INTEGER NOT NULL REFERENCES managed_utxos(utxo_id),
+
+    -- asset_proof_id is a reference to the proof file associated with this
+    -- asset event.
+    asset_proof_id INTEGER REFERENCES proof_files(file_id),
+
+    -- asset_id is a reference to the asset once we have taken custody of it.
+    -- This will only be set once the proofs were imported successfully and the
+    -- event is in the status complete.
+    asset_id INTEGER REFERENCES assets(asset_id),
+
+    UNIQUE(addr_id, chain_txn_id, chain_txn_output_index)
+);
+
+INSERT INTO addr_events_new (
+    creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
+    managed_utxo_id, asset_proof_id, asset_id
+)
+SELECT
+    addr_events.creation_time, addr_events.addr_id, addr_events.status,
+    addr_events.chain_txn_id, addr_events.chain_txn_output_index,
+    addr_events.managed_utxo_id, (
+        SELECT proof_files.file_id
+        FROM asset_proofs
+        JOIN assets
+            ON assets.asset_id = asset_proofs.asset_id
+        JOIN script_keys
+            ON assets.script_key_id = script_keys.script_key_id
+        JOIN genesis_assets
+            ON assets.genesis_id = genesis_assets.gen_asset_id
+        JOIN proof_files
+            ON proof_files.asset_id = genesis_assets.asset_id AND
+               proof_files.script_key = script_keys.tweaked_script_key
+        WHERE asset_proofs.proof_id = addr_events.asset_proof_id
+    ), addr_events.asset_id
+FROM addr_events
+ORDER BY addr_events.id;
+
+DROP INDEX IF EXISTS creation_time_idx;
+DROP INDEX IF EXISTS status_idx;
+DROP INDEX IF EXISTS asset_proof_id_idx;
+DROP INDEX IF EXISTS asset_id_idx;
+DROP TABLE addr_events;
+
+ALTER TABLE addr_events_new RENAME TO addr_events;
+
+CREATE INDEX IF NOT EXISTS creation_time_idx ON addr_events(creation_time);
+CREATE INDEX IF NOT EXISTS status_idx ON addr_events(status);
+CREATE INDEX IF NOT EXISTS asset_proof_id_idx ON addr_events(asset_proof_id);
+CREATE INDEX IF NOT EXISTS asset_id_idx ON addr_events(asset_id);
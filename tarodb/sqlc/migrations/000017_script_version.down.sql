@@ -0,0 +1,2 @@
+ALTER TABLE addrs DROP COLUMN script_version;
+ALTER TABLE asset_seedlings DROP COLUMN script_version;
@@ -0,0 +1,10 @@
+-- The script version a seedling's asset will be minted with, which determines
+-- how the script key of the asset is validated (0 = ScriptV0, 1 = ScriptV1).
+ALTER TABLE asset_seedlings ADD COLUMN script_version INTEGER NOT NULL
+    DEFAULT 0 CHECK (script_version IN (0, 1));
+
+-- The script version of the asset an address expects to receive. It is part
+-- of the asset leaf the address commits to, so it must match the version of
+-- the sent asset.
+ALTER TABLE addrs ADD COLUMN script_version INTEGER NOT NULL DEFAULT 0
+    CHECK (script_version IN (0, 1));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS update_time_idx;
+ALTER TABLE addr_events DROP COLUMN update_time;
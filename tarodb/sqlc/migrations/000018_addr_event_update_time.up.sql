@@ -0,0 +1,9 @@
+-- update_time is the time the status of an address event last changed. As we
+-- don't know when existing events were last updated, we use their creation
+-- time.
+ALTER TABLE addr_events ADD COLUMN update_time TIMESTAMP NOT NULL
+    DEFAULT '1970-01-01 00:00:00';
+
+UPDATE addr_events SET update_time = creation_time;
+
+CREATE INDEX IF NOT EXISTS update_time_idx ON addr_events(update_time);
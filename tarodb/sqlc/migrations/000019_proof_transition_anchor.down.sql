@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS proof_transitions_anchor_txid_idx;
+ALTER TABLE proof_transitions DROP COLUMN anchor_txid;
@@ -0,0 +1,8 @@
+-- anchor_txid is the ID of the transaction the state transition proof is
+-- anchored in. This allows us to find all proof files that need to be
+-- re-anchored after a chain re-org. It's NULL for proofs stored before the
+-- column was added, those are filled in the first time they're looked up.
+ALTER TABLE proof_transitions ADD COLUMN anchor_txid BLOB;
+
+CREATE INDEX IF NOT EXISTS proof_transitions_anchor_txid_idx
+    ON proof_transitions(anchor_txid);
@@ -0,0 +1,2 @@
+ALTER TABLE asset_transfers DROP COLUMN num_confs;
+ALTER TABLE asset_minting_batches DROP COLUMN num_confs;
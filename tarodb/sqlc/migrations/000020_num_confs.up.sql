@@ -0,0 +1,5 @@
+-- Minting batches and outbound transfers can require a custom number of
+-- confirmations before they're considered final. A value of zero means the
+-- default of the daemon is used.
+ALTER TABLE asset_minting_batches ADD COLUMN num_confs INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE asset_transfers ADD COLUMN num_confs INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE addrs DROP COLUMN family_only;
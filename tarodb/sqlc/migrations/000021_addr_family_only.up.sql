@@ -0,0 +1,3 @@
+-- Family addresses accept any asset ID within the family of their family key.
+-- Their genesis only specifies the asset type, so they're marked explicitly.
+ALTER TABLE addrs ADD COLUMN family_only BOOLEAN NOT NULL DEFAULT FALSE;
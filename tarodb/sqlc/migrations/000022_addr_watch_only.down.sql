@@ -0,0 +1 @@
+ALTER TABLE addrs DROP COLUMN watch_only;
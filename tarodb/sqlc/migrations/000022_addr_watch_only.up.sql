@@ -0,0 +1,4 @@
+-- Addresses imported from another address book are watch-only, as their keys
+-- might not be held by our key ring. Assets received with them are tracked,
+-- but aren't part of our balance and can't be selected as inputs.
+ALTER TABLE addrs ADD COLUMN watch_only BOOLEAN NOT NULL DEFAULT FALSE;
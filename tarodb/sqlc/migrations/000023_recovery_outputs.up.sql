@@ -0,0 +1,23 @@
+-- recovery_outputs stores the Taproot outputs of the wallet that don't belong
+-- to any address we know of, as found in address recovery mode. Each of them
+-- might be an inbound transfer to an address that was lost from the database
+-- and awaits the proof of the transfer to tell.
+CREATE TABLE IF NOT EXISTS recovery_outputs (
+    id INTEGER PRIMARY KEY,
+
+    -- creation_time is the time the output was first detected.
+    creation_time TIMESTAMP NOT NULL,
+
+    -- chain_txn_id is a reference to the chain transaction that has the
+    -- Taproot output.
+    chain_txn_id INTEGER NOT NULL REFERENCES chain_txns(txn_id),
+
+    -- chain_txn_output_index is the index of the on-chain output (of the
+    -- transaction referenced by chain_txn_id).
+    chain_txn_output_index INTEGER NOT NULL,
+
+    -- amt_sats is the amount of satoshis the output carries.
+    amt_sats BIGINT NOT NULL,
+
+    UNIQUE(chain_txn_id, chain_txn_output_index)
+);
@@ -21,6 +21,15 @@ type Addr struct {
 	AssetType        int16
 	CreationTime     time.Time
 	ManagedFrom      sql.NullTime
+	ExpiryTime       sql.NullTime
+	SingleUse        bool
+	Label            string
+	AmountPolicy     int16
+	Memo             string
+	MemoSig          []byte
+	ScriptVersion    int32
+	FamilyOnly       bool
+	WatchOnly        bool
 }
 
 type AddrEvent struct {
@@ -33,6 +42,14 @@ type AddrEvent struct {
 	ManagedUtxoID       int32
 	AssetProofID        sql.NullInt32
 	AssetID             sql.NullInt32
+	UpdateTime          time.Time
+}
+
+type AddrMetadatum struct {
+	ID        int32
+	AddrID    int32
+	MetaKey   string
+	MetaValue string
 }
 
 type Asset struct {
@@ -50,6 +67,14 @@ type Asset struct {
 	AnchorUtxoID             sql.NullInt32
 }
 
+type AssetBurn struct {
+	BurnID     int32
+	TransferID int32
+	AssetID    []byte
+	BurnKey    []byte
+	Amount     int64
+}
+
 type AssetDelta struct {
 	ID                       int32
 	OldScriptKey             []byte
@@ -83,6 +108,7 @@ type AssetMintingBatch struct {
 	MintingOutputIndex sql.NullInt16
 	GenesisID          sql.NullInt32
 	CreationTimeUnix   time.Time
+	NumConfs           int32
 }
 
 type AssetProof struct {
@@ -92,14 +118,18 @@ type AssetProof struct {
 }
 
 type AssetSeedling struct {
-	SeedlingID      int32
-	AssetName       string
-	AssetType       int16
-	AssetSupply     int64
-	AssetMeta       []byte
-	EmissionEnabled bool
-	GenesisID       sql.NullInt32
-	BatchID         int32
+	SeedlingID       int32
+	AssetName        string
+	AssetType        int16
+	AssetSupply      int64
+	AssetMeta        []byte
+	EmissionEnabled  bool
+	GenesisID        sql.NullInt32
+	BatchID          int32
+	LockTime         sql.NullInt32
+	RelativeLockTime sql.NullInt32
+	Label            string
+	ScriptVersion    int32
 }
 
 type AssetTransfer struct {
@@ -108,6 +138,8 @@ type AssetTransfer struct {
 	NewInternalKey   int32
 	NewAnchorUtxo    int32
 	TransferTimeUnix time.Time
+	Label            string
+	NumConfs         int32
 }
 
 type AssetWitness struct {
@@ -203,11 +235,64 @@ type MssmtRoot struct {
 	RootHash  []byte
 }
 
+type ProofCheckpoint struct {
+	CheckpointID   int32
+	CheckpointRoot []byte
+	AssetID        []byte
+	ScriptKey      []byte
+	NumPruned      int64
+	CreationTime   time.Time
+}
+
+type ProofFile struct {
+	FileID     int32
+	ScriptKey  []byte
+	AssetID    []byte
+	Version    int32
+	Checkpoint []byte
+}
+
+type ProofFileTransition struct {
+	FileID       int32
+	SeqNum       int32
+	TransitionID int32
+}
+
+type ProofTransition struct {
+	TransitionID int32
+	ProofHash    []byte
+	ProofBytes   []byte
+	AnchorTxid   []byte
+}
+
+type RecoveryOutput struct {
+	ID                  int32
+	CreationTime        time.Time
+	ChainTxnID          int32
+	ChainTxnOutputIndex int32
+	AmtSats             int64
+}
+
 type ScriptKey struct {
 	ScriptKeyID      int32
 	InternalKeyID    int32
 	TweakedScriptKey []byte
 	Tweak            []byte
+	TapscriptTree    []byte
+}
+
+type SeedlingMetadatum struct {
+	ID         int32
+	SeedlingID int32
+	MetaKey    string
+	MetaValue  string
+}
+
+type TransferMetadatum struct {
+	ID         int32
+	TransferID int32
+	MetaKey    string
+	MetaValue  string
 }
 
 type TransferProof struct {
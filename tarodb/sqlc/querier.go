@@ -22,16 +22,21 @@ type Querier interface {
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int32) error
+	DeleteLegacyAssetProofs(ctx context.Context) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeleteOrphanedProofTransition(ctx context.Context, transitionID int32) error
+	DeleteProofFileTransitions(ctx context.Context, fileID int32) ([]int32, error)
+	DeleteRecoveryOutput(ctx context.Context, arg DeleteRecoveryOutputParams) error
 	DeleteSpendProofs(ctx context.Context, transferID int32) error
+	FetchAddrByScriptKey(ctx context.Context, tweakedScriptKey []byte) (FetchAddrByScriptKeyRow, error)
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
 	FetchAddrEvent(ctx context.Context, id int32) (FetchAddrEventRow, error)
+	FetchAddrMetadata(ctx context.Context, addrID int32) ([]FetchAddrMetadataRow, error)
 	FetchAddrs(ctx context.Context, arg FetchAddrsParams) ([]FetchAddrsRow, error)
+	FetchAssetByScriptKeyAnchor(ctx context.Context, arg FetchAssetByScriptKeyAnchorParams) (FetchAssetByScriptKeyAnchorRow, error)
 	FetchAssetDeltas(ctx context.Context, transferID int32) ([]FetchAssetDeltasRow, error)
 	FetchAssetDeltasWithProofs(ctx context.Context, transferID int32) ([]FetchAssetDeltasWithProofsRow, error)
-	FetchAssetProof(ctx context.Context, tweakedScriptKey []byte) (FetchAssetProofRow, error)
-	FetchAssetProofs(ctx context.Context) ([]FetchAssetProofsRow, error)
 	FetchAssetWitnesses(ctx context.Context, assetID sql.NullInt32) ([]FetchAssetWitnessesRow, error)
 	FetchAssetsByAnchorTx(ctx context.Context, anchorUtxoID sql.NullInt32) ([]Asset, error)
 	// We use a LEFT JOIN here as not every asset has a family key, so this'll
@@ -44,36 +49,52 @@ type Querier interface {
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
 	FetchGenesisByID(ctx context.Context, genAssetID int32) (FetchGenesisByIDRow, error)
 	FetchGenesisPointByAnchorTx(ctx context.Context, anchorTxID sql.NullInt32) (GenesisPoint, error)
+	FetchLegacyAssetProofs(ctx context.Context) ([]FetchLegacyAssetProofsRow, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
 	FetchMintingBatchesByState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByStateRow, error)
+	FetchProofCheckpoint(ctx context.Context, checkpointRoot []byte) (ProofCheckpoint, error)
+	FetchProofFile(ctx context.Context, arg FetchProofFileParams) (ProofFile, error)
+	FetchProofFileTransitions(ctx context.Context, fileID int32) ([][]byte, error)
+	FetchProofFiles(ctx context.Context, arg FetchProofFilesParams) ([]ProofFile, error)
+	FetchProofFilesByTransitionAnchor(ctx context.Context, txid []byte) ([]ProofFile, error)
+	FetchRecentChainTxns(ctx context.Context, minHeight sql.NullInt32) ([]ChainTxn, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int32, error)
+	FetchSeedlingMetadata(ctx context.Context, seedlingID int32) ([]FetchSeedlingMetadataRow, error)
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]AssetSeedling, error)
 	FetchSpendProofs(ctx context.Context, transferID int32) (FetchSpendProofsRow, error)
+	FetchTransferMetadata(ctx context.Context, transferID int32) ([]FetchTransferMetadataRow, error)
+	FetchUnanchoredProofTransitions(ctx context.Context) ([]FetchUnanchoredProofTransitionsRow, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
 	InsertAddr(ctx context.Context, arg InsertAddrParams) (int32, error)
+	InsertAssetBurn(ctx context.Context, arg InsertAssetBurnParams) error
 	InsertAssetDelta(ctx context.Context, arg InsertAssetDeltaParams) error
-	InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error
-	InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error
+	InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) (int32, error)
+	InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) (int32, error)
 	InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int32, error)
 	InsertAssetWitness(ctx context.Context, arg InsertAssetWitnessParams) error
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
 	InsertNewAsset(ctx context.Context, arg InsertNewAssetParams) (int32, error)
+	InsertProofCheckpoint(ctx context.Context, arg InsertProofCheckpointParams) error
+	InsertProofFileTransition(ctx context.Context, arg InsertProofFileTransitionParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertSeedlingMetadata(ctx context.Context, arg InsertSeedlingMetadataParams) error
 	InsertSpendProofs(ctx context.Context, arg InsertSpendProofsParams) (int32, error)
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
 	// We use a LEFT JOIN here as not every asset has a family key, so this'll
 	// generate rows that have NULL values for the family key fields if an asset
 	// doesn't have a family key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
+	// Assets received with a watch-only address can't be spent by us.
 	QueryAssetBalancesByAsset(ctx context.Context, assetIDFilter []byte) ([]QueryAssetBalancesByAssetRow, error)
 	QueryAssetBalancesByFamily(ctx context.Context, keyFamFilter []byte) ([]QueryAssetBalancesByFamilyRow, error)
+	QueryAssetBurns(ctx context.Context, arg QueryAssetBurnsParams) ([]QueryAssetBurnsRow, error)
 	QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error)
 	// We use a LEFT JOIN here as not every asset has a family key, so this'll
 	// generate rows that have NULL values for the family key fields if an asset
@@ -85,21 +106,30 @@ type Querier interface {
 	// specified.
 	QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]QueryAssetsRow, error)
 	QueryEventIDs(ctx context.Context, arg QueryEventIDsParams) ([]QueryEventIDsRow, error)
+	QueryRecoveryOutputs(ctx context.Context) ([]QueryRecoveryOutputsRow, error)
+	RaiseMintingBatchNumConfs(ctx context.Context, arg RaiseMintingBatchNumConfsParams) error
 	ReanchorAssets(ctx context.Context, arg ReanchorAssetsParams) error
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
+	SetProofTransitionAnchor(ctx context.Context, arg SetProofTransitionAnchorParams) error
+	UpdateAddrEventStatus(ctx context.Context, arg UpdateAddrEventStatusParams) error
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
+	UpdateChainTxConf(ctx context.Context, arg UpdateChainTxConfParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int32, error)
+	UpsertAddrMetadata(ctx context.Context, arg UpsertAddrMetadataParams) error
 	UpsertAssetFamilyKey(ctx context.Context, arg UpsertAssetFamilyKeyParams) (int32, error)
 	UpsertAssetFamilySig(ctx context.Context, arg UpsertAssetFamilySigParams) (int32, error)
-	UpsertAssetProof(ctx context.Context, arg UpsertAssetProofParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int32, error)
 	UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAssetParams) (int32, error)
 	UpsertGenesisPoint(ctx context.Context, prevOut []byte) (int32, error)
 	UpsertInternalKey(ctx context.Context, arg UpsertInternalKeyParams) (int32, error)
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int32, error)
+	UpsertProofFile(ctx context.Context, arg UpsertProofFileParams) (int32, error)
+	UpsertProofTransition(ctx context.Context, arg UpsertProofTransitionParams) (int32, error)
+	UpsertRecoveryOutput(ctx context.Context, arg UpsertRecoveryOutputParams) error
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int32, error)
+	UpsertTransferMetadata(ctx context.Context, arg UpsertTransferMetadataParams) error
 }
 
 var _ Querier = (*Queries)(nil)
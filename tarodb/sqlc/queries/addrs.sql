@@ -1,15 +1,37 @@
 -- name: InsertAddr :one
 INSERT INTO addrs (
     version, genesis_asset_id, fam_key, script_key_id, taproot_key_id,
-    taproot_output_key, amount, asset_type, creation_time
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id;
+    taproot_output_key, amount, asset_type, creation_time, expiry_time,
+    single_use, label, amount_policy, memo, memo_sig, script_version,
+    family_only, managed_from, watch_only
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+    $17, $18, $19
+) RETURNING id;
+
+-- name: UpsertAddrMetadata :exec
+INSERT INTO addr_metadata (
+    addr_id, meta_key, meta_value
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (addr_id, meta_key)
+    DO UPDATE SET meta_value = EXCLUDED.meta_value;
+
+-- name: FetchAddrMetadata :many
+SELECT meta_key, meta_value
+FROM addr_metadata
+WHERE addr_id = $1
+ORDER BY meta_key;
 
 -- name: FetchAddrs :many
 SELECT 
     version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    creation_time, managed_from, expiry_time, single_use, addrs.id AS addr_id,
+    label, amount_policy, memo, memo_sig, addrs.script_version,
+    addrs.family_only, addrs.watch_only,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     raw_script_keys.raw_key AS raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -23,19 +45,38 @@ JOIN internal_keys raw_script_keys
     ON script_keys.internal_key_id = raw_script_keys.key_id
 JOIN internal_keys taproot_keys
     ON addrs.taproot_key_id = taproot_keys.key_id
-WHERE creation_time >= @created_after
-    AND creation_time <= @created_before
+WHERE addrs.creation_time >= @created_after
+    AND addrs.creation_time <= @created_before
     AND (@unmanaged_only = false OR
          (CASE WHEN managed_from IS NULL THEN true ELSE false END) = @unmanaged_only)
+    AND (@unmanaged_only = false OR single_use = false OR NOT EXISTS (
+        SELECT 1 FROM addr_events events WHERE events.addr_id = addrs.id
+    ))
+    -- An unmanaged watch-only address was retired by the address book it was
+    -- imported from, so it shouldn't be managed again.
+    AND (@unmanaged_only = false OR watch_only = false)
+    AND (expiry_time <= sqlc.narg('expired_before') OR
+         sqlc.narg('expired_before') IS NULL)
+    AND (label = sqlc.narg('label') OR sqlc.narg('label') IS NULL)
+    AND (EXISTS (
+        SELECT 1 FROM addr_metadata meta
+        WHERE meta.addr_id = addrs.id
+          AND meta.meta_key = sqlc.narg('meta_key')
+          AND (meta.meta_value = sqlc.narg('meta_value') OR
+               sqlc.narg('meta_value') IS NULL)
+    ) OR sqlc.narg('meta_key') IS NULL)
 ORDER BY addrs.creation_time
 LIMIT @num_limit OFFSET @num_offset;
 
 -- name: FetchAddrByTaprootOutputKey :one
 SELECT
     version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
-    creation_time, managed_from,
+    creation_time, managed_from, expiry_time, single_use, addrs.id AS addr_id,
+    label, amount_policy, memo, memo_sig, addrs.script_version,
+    addrs.family_only, addrs.watch_only,
     script_keys.tweaked_script_key,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     raw_script_keys.raw_key as raw_script_key,
     raw_script_keys.key_family AS script_key_family,
     raw_script_keys.key_index AS script_key_index,
@@ -51,6 +92,30 @@ JOIN internal_keys taproot_keys
   ON addrs.taproot_key_id = taproot_keys.key_id
 WHERE taproot_output_key = $1;
 
+-- name: FetchAddrByScriptKey :one
+SELECT
+    version, genesis_asset_id, fam_key, taproot_output_key, amount, asset_type,
+    creation_time, managed_from, expiry_time, single_use, addrs.id AS addr_id,
+    label, amount_policy, memo, memo_sig, addrs.script_version,
+    addrs.family_only, addrs.watch_only,
+    script_keys.tweaked_script_key,
+    script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
+    raw_script_keys.raw_key as raw_script_key,
+    raw_script_keys.key_family AS script_key_family,
+    raw_script_keys.key_index AS script_key_index,
+    taproot_keys.raw_key AS raw_taproot_key,
+    taproot_keys.key_family AS taproot_key_family,
+    taproot_keys.key_index AS taproot_key_index
+FROM addrs
+JOIN script_keys
+  ON addrs.script_key_id = script_keys.script_key_id
+JOIN internal_keys raw_script_keys
+  ON script_keys.internal_key_id = raw_script_keys.key_id
+JOIN internal_keys taproot_keys
+  ON addrs.taproot_key_id = taproot_keys.key_id
+WHERE script_keys.tweaked_script_key = $1;
+
 -- name: SetAddrManaged :exec
 WITH target_addr(addr_id) AS (
     SELECT id
@@ -73,26 +138,33 @@ WITH target_addr(addr_id) AS (
 )
 INSERT INTO addr_events (
     creation_time, addr_id, status, chain_txn_id, chain_txn_output_index,
-    managed_utxo_id, asset_proof_id, asset_id
+    managed_utxo_id, asset_proof_id, asset_id, update_time
 ) VALUES (
     $3, (SELECT addr_id FROM target_addr), $4,
-    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8
+    (SELECT txn_id FROM target_chain_txn), $5, $6, $7, $8, $9
 )
 ON CONFLICT (addr_id, chain_txn_id, chain_txn_output_index)
     DO UPDATE SET status = EXCLUDED.status,
+                  update_time = CASE
+                      WHEN addr_events.status = EXCLUDED.status
+                      THEN addr_events.update_time
+                      ELSE EXCLUDED.update_time
+                  END,
                   asset_proof_id = COALESCE(EXCLUDED.asset_proof_id, addr_events.asset_proof_id),
                   asset_id = COALESCE(EXCLUDED.asset_id, addr_events.asset_id)
 RETURNING id;
 
 -- name: FetchAddrEvent :one
 SELECT
-    creation_time, status, asset_proof_id, asset_id,
+    addr_events.creation_time, addr_events.update_time, status,
+    asset_proof_id, addr_events.asset_id,
     chain_txns.txid as txid,
     chain_txns.block_height as confirmation_height,
     chain_txn_output_index as output_index,
     managed_utxos.amt_sats as amt_sats,
     managed_utxos.tapscript_sibling as tapscript_sibling,
-    internal_keys.raw_key as internal_key
+    internal_keys.raw_key as internal_key,
+    assets.amount as asset_amount
 FROM addr_events
 LEFT JOIN chain_txns
        ON addr_events.chain_txn_id = chain_txns.txn_id
@@ -100,6 +172,8 @@ LEFT JOIN managed_utxos
        ON addr_events.managed_utxo_id = managed_utxos.utxo_id
 LEFT JOIN internal_keys
        ON managed_utxos.internal_key_id = internal_keys.key_id
+LEFT JOIN assets
+       ON addr_events.asset_id = assets.asset_id
 WHERE id = $1;
 
 -- name: QueryEventIDs :many
@@ -111,4 +185,54 @@ JOIN addrs
 WHERE addr_events.status >= @status_from 
   AND addr_events.status <= @status_to
   AND COALESCE(@addr_taproot_key, addrs.taproot_output_key) = addrs.taproot_output_key
+  AND addr_events.creation_time >= @created_after
+  AND addr_events.update_time >= @updated_after
+  AND (addrs.label = sqlc.narg('label') OR sqlc.narg('label') IS NULL)
+  AND (EXISTS (
+      SELECT 1 FROM addr_metadata meta
+      WHERE meta.addr_id = addrs.id
+        AND meta.meta_key = sqlc.narg('meta_key')
+        AND (meta.meta_value = sqlc.narg('meta_value') OR
+             sqlc.narg('meta_value') IS NULL)
+  ) OR sqlc.narg('meta_key') IS NULL)
 ORDER by addr_events.creation_time;
+
+-- name: UpdateAddrEventStatus :exec
+UPDATE addr_events
+SET status = @status, update_time = @update_time
+WHERE id = @id;
+
+-- name: UpsertRecoveryOutput :exec
+WITH target_chain_txn(txn_id) AS (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE chain_txns.txid = @txid
+)
+INSERT INTO recovery_outputs (
+    creation_time, chain_txn_id, chain_txn_output_index, amt_sats
+) VALUES (
+    @creation_time, (SELECT txn_id FROM target_chain_txn),
+    @chain_txn_output_index, @amt_sats
+)
+ON CONFLICT (chain_txn_id, chain_txn_output_index)
+    -- This is a NOP, we only need to know about the output once.
+    DO NOTHING;
+
+-- name: QueryRecoveryOutputs :many
+SELECT
+    recovery_outputs.id, recovery_outputs.creation_time,
+    chain_txns.txid as txid,
+    chain_txns.block_height as confirmation_height,
+    chain_txn_output_index as output_index, amt_sats
+FROM recovery_outputs
+JOIN chain_txns
+  ON recovery_outputs.chain_txn_id = chain_txns.txn_id
+ORDER BY recovery_outputs.creation_time;
+
+-- name: DeleteRecoveryOutput :exec
+DELETE FROM recovery_outputs
+WHERE chain_txn_id IN (
+    SELECT txn_id
+    FROM chain_txns
+    WHERE chain_txns.txid = @txid
+) AND chain_txn_output_index = @chain_txn_output_index;
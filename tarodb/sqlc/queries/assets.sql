@@ -4,14 +4,26 @@ INSERT INTO internal_keys (
 ) VALUES (
     $1, $2, $3
 ) ON CONFLICT (raw_key)
-    -- This is a NOP, raw_key is the unique field that caused the conflict.
-    DO UPDATE SET raw_key = EXCLUDED.raw_key
+    -- A key imported with the proof of an asset is stored without its key
+    -- locator. Once we learn it, for example when recovering an address, we
+    -- fill it in. Otherwise this is a NOP.
+    DO UPDATE SET
+        key_family = CASE
+            WHEN internal_keys.key_family = 0 AND internal_keys.key_index = 0
+            THEN EXCLUDED.key_family
+            ELSE internal_keys.key_family
+        END,
+        key_index = CASE
+            WHEN internal_keys.key_family = 0 AND internal_keys.key_index = 0
+            THEN EXCLUDED.key_index
+            ELSE internal_keys.key_index
+        END
 RETURNING key_id;
 
 -- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, creation_time_unix
-) VALUES (0, $1, $2);
+    batch_state, batch_id, creation_time_unix, num_confs
+) VALUES (0, $1, $2, $3);
 
 -- name: FetchMintingBatch :one
 SELECT *
@@ -50,14 +62,41 @@ UPDATE asset_minting_batches
 SET batch_state = $2
 WHERE batch_id in (SELECT batch_id FROM target_batch);
 
--- name: InsertAssetSeedling :exec
+-- name: RaiseMintingBatchNumConfs :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET num_confs = @num_confs
+WHERE batch_id in (SELECT batch_id FROM target_batch)
+    AND num_confs < @num_confs;
+
+-- name: InsertAssetSeedling :one
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, lock_time, relative_lock_time, label,
+    script_version
 ) VALUES (
-   $1, $2, $3, $4, $5, $6
+   $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING seedling_id;
+
+-- name: InsertSeedlingMetadata :exec
+INSERT INTO seedling_metadata (
+    seedling_id, meta_key, meta_value
+) VALUES (
+    $1, $2, $3
 );
 
+-- name: FetchSeedlingMetadata :many
+SELECT meta_key, meta_value
+FROM seedling_metadata
+WHERE seedling_id = $1
+ORDER BY meta_key;
+
 -- name: AllInternalKeys :many
 SELECT * 
 FROM internal_keys;
@@ -68,7 +107,7 @@ FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id;
 
--- name: InsertAssetSeedlingIntoBatch :exec
+-- name: InsertAssetSeedlingIntoBatch :one
 WITH target_key_id AS (
     -- We use this CTE to fetch the key_id of the internal key that's
     -- associated with a given batch. This can only return one value in
@@ -81,10 +120,11 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, batch_id
+    emission_enabled, batch_id, lock_time, relative_lock_time, label,
+    script_version
 ) VALUES (
-    $2, $3, $4, $5, $6, (SELECT key_id FROM target_key_id)
-);
+    $2, $3, $4, $5, $6, (SELECT key_id FROM target_key_id), $7, $8, $9, $10
+) RETURNING seedling_id;
 
 -- name: FetchSeedlingsForBatch :many
 WITH target_batch(batch_id) AS (
@@ -95,7 +135,8 @@ WITH target_batch(batch_id) AS (
     WHERE keys.raw_key = $1
 )
 SELECT seedling_id, asset_name, asset_type, asset_supply, asset_meta,
-    emission_enabled, genesis_id, batch_id
+    emission_enabled, genesis_id, batch_id, lock_time, relative_lock_time,
+    label, script_version
 FROM asset_seedlings 
 WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch);
 
@@ -222,6 +263,11 @@ JOIN genesis_info_view
 -- around that needs to be used with this query until a sqlc bug is fixed.
 LEFT JOIN key_fam_info_view
     ON assets.genesis_id = key_fam_info_view.gen_asset_id
+-- Assets received with a watch-only address can't be spent by us.
+WHERE NOT EXISTS (
+    SELECT 1 FROM addrs
+    WHERE addrs.script_key_id = assets.script_key_id AND addrs.watch_only
+)
 GROUP BY assets.genesis_id, genesis_info_view.asset_id,
          version, genesis_info_view.asset_tag, genesis_info_view.meta_data,
          genesis_info_view.asset_type, genesis_info_view.output_index,
@@ -235,12 +281,17 @@ JOIN key_fam_info_view
     ON assets.genesis_id = key_fam_info_view.gen_asset_id AND
       (key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter') OR
         sqlc.narg('key_fam_filter') IS NULL)
+WHERE NOT EXISTS (
+    SELECT 1 FROM addrs
+    WHERE addrs.script_key_id = assets.script_key_id AND addrs.watch_only
+)
 GROUP BY key_fam_info_view.tweaked_fam_key;
 
 -- name: QueryAssets :many
 SELECT
     assets.asset_id AS asset_primary_key, assets.genesis_id, version,
     script_keys.tweak AS script_key_tweak, 
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     script_keys.tweaked_script_key, 
     internal_keys.raw_key AS script_key_raw,
     internal_keys.key_family AS script_key_fam,
@@ -258,6 +309,7 @@ SELECT
     genesis_info_view.asset_type,
     genesis_info_view.prev_out AS genesis_prev_out,
     txns.raw_tx AS anchor_tx, txns.txid AS anchor_txid, txns.block_hash AS anchor_block_hash,
+    txns.block_height AS anchor_block_height,
     utxos.outpoint AS anchor_outpoint,
     utxo_internal_keys.raw_key AS anchor_internal_key,
     split_commitment_root_hash, split_commitment_root_value
@@ -291,7 +343,14 @@ JOIN chain_txns txns
 WHERE (
     assets.amount >= COALESCE(sqlc.narg('min_amt'), assets.amount) AND
     (key_fam_info_view.tweaked_fam_key = sqlc.narg('key_fam_filter') OR
-      sqlc.narg('key_fam_filter') IS NULL)
+      sqlc.narg('key_fam_filter') IS NULL) AND
+    -- Assets received with a watch-only address can't be spent by us, so
+    -- they're skipped when selecting inputs.
+    (COALESCE(@spendable_only, false) = false OR NOT EXISTS (
+        SELECT 1 FROM addrs
+        WHERE addrs.script_key_id = assets.script_key_id AND
+          addrs.watch_only
+    ))
 );
 
 -- name: AllAssets :many
@@ -454,50 +513,34 @@ UPDATE chain_txns
 SET block_height = $2, block_hash = $3, tx_index = $4
 WHERE txn_id in (SELECT txn_id FROM target_txn);
 
--- name: UpsertAssetProof :exec
-WITH target_asset(asset_id) AS (
-    SELECT asset_id
-    FROM assets
-    JOIN script_keys 
-        ON assets.script_key_id = script_keys.script_key_id
-    WHERE script_keys.tweaked_script_key = $1
-    -- TODO(guggero): Fix this by disallowing multiple assets with the same
-    -- script key!
-    LIMIT 1
-)
-INSERT INTO asset_proofs (
-    asset_id, proof_file
-) VALUES (
-    (SELECT asset_id FROM target_asset), $2
-) ON CONFLICT (asset_id)
-    -- This is not a NOP, update the proof file in case it wasn't set before.
-    DO UPDATE SET proof_file = EXCLUDED.proof_file;
-
--- name: FetchAssetProofs :many
-WITH asset_info AS (
-    SELECT assets.asset_id, script_keys.tweaked_script_key
-    FROM assets
-    JOIN script_keys
-        ON assets.script_key_id = script_keys.script_key_id
-)
-SELECT asset_info.tweaked_script_key AS script_key, asset_proofs.proof_file
-FROM asset_proofs
-JOIN asset_info
-    ON asset_info.asset_id = asset_proofs.asset_id;
-
--- name: FetchAssetProof :one
-WITH asset_info AS (
-    SELECT assets.asset_id, script_keys.tweaked_script_key
-    FROM assets
-    JOIN script_keys
-        ON assets.script_key_id = script_keys.script_key_id
-    WHERE script_keys.tweaked_script_key = $1
-)
-SELECT asset_info.tweaked_script_key AS script_key, asset_proofs.proof_file,
-       asset_info.asset_id as asset_id, asset_proofs.proof_id as proof_id
+-- name: FetchAssetByScriptKeyAnchor :one
+SELECT assets.asset_id AS asset_primary_key,
+       genesis_assets.asset_id AS asset_id
+FROM assets
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id
+JOIN managed_utxos utxos
+    ON assets.anchor_utxo_id = utxos.utxo_id
+WHERE script_keys.tweaked_script_key = @script_key AND
+    utxos.outpoint = @anchor_point AND
+    (genesis_assets.asset_id = sqlc.narg('asset_id') OR
+        sqlc.narg('asset_id') IS NULL);
+
+-- name: FetchLegacyAssetProofs :many
+SELECT script_keys.tweaked_script_key AS script_key,
+       genesis_assets.asset_id AS asset_id, asset_proofs.proof_file
 FROM asset_proofs
-JOIN asset_info
-    ON asset_info.asset_id = asset_proofs.asset_id;
+JOIN assets
+    ON assets.asset_id = asset_proofs.asset_id
+JOIN script_keys
+    ON assets.script_key_id = script_keys.script_key_id
+JOIN genesis_assets
+    ON assets.genesis_id = genesis_assets.gen_asset_id;
+
+-- name: DeleteLegacyAssetProofs :exec
+DELETE FROM asset_proofs;
 
 -- name: InsertAssetWitness :exec
 INSERT INTO asset_witnesses (
@@ -534,15 +577,57 @@ UPDATE chain_txns
 SET block_height = $2, block_hash = $3, tx_index = $4
 WHERE txn_id in (SELECT txn_id FROM target_txn);
 
+-- name: FetchRecentChainTxns :many
+SELECT *
+FROM chain_txns
+WHERE block_height >= @min_height
+ORDER BY block_height;
+
+-- name: UpdateChainTxConf :exec
+UPDATE chain_txns
+SET block_height = sqlc.narg('block_height'),
+    block_hash = sqlc.narg('block_hash'),
+    tx_index = sqlc.narg('tx_index')
+WHERE txid = @txid;
+
 -- name: UpsertScriptKey :one
 INSERT INTO script_keys (
-    internal_key_id, tweaked_script_key, tweak
+    internal_key_id, tweaked_script_key, tweak, tapscript_tree
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 )  ON CONFLICT (tweaked_script_key)
-    -- As a NOP, we just set the script key to the one that triggered the
-    -- conflict.
-    DO UPDATE SET tweaked_script_key = EXCLUDED.tweaked_script_key
+    -- A script key imported with the proof of an asset is only known by its
+    -- tweaked key, which is stored as its own raw key. Once we learn the
+    -- actual raw key and tweak, for example when recovering an address, we
+    -- fill them in. Otherwise this is a NOP.
+    DO UPDATE SET
+        internal_key_id = CASE
+            WHEN script_keys.internal_key_id IN (
+                SELECT key_id
+                FROM internal_keys
+                WHERE raw_key = script_keys.tweaked_script_key
+            )
+            THEN EXCLUDED.internal_key_id
+            ELSE script_keys.internal_key_id
+        END,
+        tweak = CASE
+            WHEN script_keys.internal_key_id IN (
+                SELECT key_id
+                FROM internal_keys
+                WHERE raw_key = script_keys.tweaked_script_key
+            )
+            THEN EXCLUDED.tweak
+            ELSE script_keys.tweak
+        END,
+        tapscript_tree = CASE
+            WHEN script_keys.internal_key_id IN (
+                SELECT key_id
+                FROM internal_keys
+                WHERE raw_key = script_keys.tweaked_script_key
+            )
+            THEN EXCLUDED.tapscript_tree
+            ELSE script_keys.tapscript_tree
+        END
 RETURNING script_key_id;
 
 -- name: FetchScriptKeyIDByTweakedKey :one
@@ -0,0 +1,97 @@
+-- name: InsertProofCheckpoint :exec
+INSERT INTO proof_checkpoints (
+    checkpoint_root, asset_id, script_key, num_pruned, creation_time
+) VALUES (
+    $1, $2, $3, $4, $5
+) ON CONFLICT (checkpoint_root)
+    -- A checkpoint root commits to the full pruned history, so there's
+    -- nothing to update if we already know it.
+    DO NOTHING;
+
+-- name: FetchProofCheckpoint :one
+SELECT *
+FROM proof_checkpoints
+WHERE checkpoint_root = $1;
+
+-- name: UpsertProofTransition :one
+INSERT INTO proof_transitions (
+    proof_hash, proof_bytes, anchor_txid
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (proof_hash)
+    -- The proof itself can't change as proof_hash is the unique field that
+    -- caused the conflict, we only fill in the anchor txid of old rows.
+    DO UPDATE SET anchor_txid = COALESCE(
+        proof_transitions.anchor_txid, EXCLUDED.anchor_txid
+    )
+RETURNING transition_id;
+
+-- name: UpsertProofFile :one
+INSERT INTO proof_files (
+    script_key, asset_id, version, checkpoint
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (asset_id, script_key)
+    DO UPDATE SET
+        version = EXCLUDED.version, checkpoint = EXCLUDED.checkpoint
+RETURNING file_id;
+
+-- name: InsertProofFileTransition :exec
+INSERT INTO proof_file_transitions (
+    file_id, seq_num, transition_id
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: DeleteProofFileTransitions :many
+DELETE FROM proof_file_transitions
+WHERE file_id = $1
+RETURNING transition_id;
+
+-- name: DeleteOrphanedProofTransition :exec
+DELETE FROM proof_transitions
+WHERE proof_transitions.transition_id = @transition_id AND NOT EXISTS (
+    SELECT 1
+    FROM proof_file_transitions
+    WHERE proof_file_transitions.transition_id = @transition_id
+);
+
+-- name: FetchProofFile :one
+SELECT *
+FROM proof_files
+WHERE asset_id = $1 AND script_key = $2;
+
+-- name: FetchProofFileTransitions :many
+SELECT transitions.proof_bytes
+FROM proof_file_transitions file_transitions
+JOIN proof_transitions transitions
+    ON file_transitions.transition_id = transitions.transition_id
+WHERE file_transitions.file_id = $1
+ORDER BY file_transitions.seq_num;
+
+-- name: FetchProofFiles :many
+SELECT *
+FROM proof_files
+WHERE (asset_id = sqlc.narg('asset_id') OR sqlc.narg('asset_id') IS NULL) AND
+    (script_key = sqlc.narg('script_key') OR
+        sqlc.narg('script_key') IS NULL)
+ORDER BY file_id;
+
+-- name: FetchProofFilesByTransitionAnchor :many
+SELECT DISTINCT proof_files.*
+FROM proof_files
+JOIN proof_file_transitions file_transitions
+    ON proof_files.file_id = file_transitions.file_id
+JOIN proof_transitions transitions
+    ON file_transitions.transition_id = transitions.transition_id
+WHERE transitions.anchor_txid = @txid;
+
+-- name: FetchUnanchoredProofTransitions :many
+SELECT transition_id, proof_bytes
+FROM proof_transitions
+WHERE anchor_txid IS NULL;
+
+-- name: SetProofTransitionAnchor :exec
+UPDATE proof_transitions
+SET anchor_txid = @anchor_txid
+WHERE transition_id = @transition_id;
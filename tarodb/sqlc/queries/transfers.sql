@@ -1,10 +1,25 @@
 -- name: InsertAssetTransfer :one
 INSERT INTO asset_transfers (
-    old_anchor_point, new_internal_key, new_anchor_utxo, transfer_time_unix
+    old_anchor_point, new_internal_key, new_anchor_utxo, transfer_time_unix,
+    label, num_confs
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6
 ) RETURNING id;
 
+-- name: UpsertTransferMetadata :exec
+INSERT INTO transfer_metadata (
+    transfer_id, meta_key, meta_value
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (transfer_id, meta_key)
+    DO UPDATE SET meta_value = EXCLUDED.meta_value;
+
+-- name: FetchTransferMetadata :many
+SELECT meta_key, meta_value
+FROM transfer_metadata
+WHERE transfer_id = $1
+ORDER BY meta_key;
+
 -- name: InsertAssetDelta :exec
 INSERT INTO asset_deltas (
     old_script_key, new_amt, new_script_key, serialized_witnesses, transfer_id,
@@ -28,7 +43,7 @@ SELECT
     txns.txid AS anchor_txid, txns.txn_id AS anchor_tx_primary_key, 
     txns.chain_fees, transfer_time_unix, keys.raw_key AS internal_key_bytes,
     keys.key_family AS internal_key_fam, keys.key_index AS internal_key_index,
-    id AS transfer_id, transfer_time_unix
+    id AS transfer_id, transfer_time_unix, label, num_confs
 FROM asset_transfers
 JOIN internal_keys keys
     ON asset_transfers.new_internal_key = keys.key_id
@@ -49,6 +64,23 @@ WHERE (
     -- based on the new_anchor_point, but only if it's specified.
     (utxos.outpoint = sqlc.narg('new_anchor_point') OR
        sqlc.narg('new_anchor_point') IS NULL)
+
+    AND
+
+    -- Transfers can also optionally be filtered by their label and their
+    -- metadata.
+    (asset_transfers.label = sqlc.narg('label') OR
+       sqlc.narg('label') IS NULL)
+
+    AND
+
+    (EXISTS (
+        SELECT 1 FROM transfer_metadata meta
+        WHERE meta.transfer_id = asset_transfers.id
+          AND meta.meta_key = sqlc.narg('meta_key')
+          AND (meta.meta_value = sqlc.narg('meta_value') OR
+               sqlc.narg('meta_value') IS NULL)
+    ) OR sqlc.narg('meta_key') IS NULL)
 );
 
 -- name: FetchAssetDeltas :many
@@ -56,6 +88,7 @@ SELECT
     deltas.old_script_key, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     deltas.new_script_key AS new_script_key_id, 
     internal_keys.raw_key AS new_raw_script_key_bytes,
     internal_keys.key_family AS new_script_key_family, 
@@ -74,6 +107,7 @@ SELECT
     deltas.old_script_key, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     deltas.new_script_key AS new_script_key_id, 
     internal_keys.raw_key AS new_raw_script_key_bytes,
     internal_keys.key_family AS new_script_key_family, 
@@ -127,3 +161,27 @@ WHERE asset_id = $1;
 -- name: DeleteSpendProofs :exec
 DELETE FROM transfer_proofs
 WHERE transfer_id = $1;
+
+-- name: InsertAssetBurn :exec
+INSERT INTO asset_burns (
+    transfer_id, asset_id, burn_key, amount
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: QueryAssetBurns :many
+SELECT
+    burns.asset_id, burns.burn_key, burns.amount, txns.txid AS anchor_txid,
+    transfers.transfer_time_unix
+FROM asset_burns burns
+JOIN asset_transfers transfers
+    ON burns.transfer_id = transfers.id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+WHERE (burns.asset_id = sqlc.narg('asset_id') OR
+    sqlc.narg('asset_id') IS NULL)
+    AND (burns.transfer_id = sqlc.narg('transfer_id') OR
+    sqlc.narg('transfer_id') IS NULL)
+ORDER BY burns.burn_id;
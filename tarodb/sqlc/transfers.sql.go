@@ -71,6 +71,7 @@ SELECT
     deltas.old_script_key, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     deltas.new_script_key AS new_script_key_id, 
     internal_keys.raw_key AS new_raw_script_key_bytes,
     internal_keys.key_family AS new_script_key_family, 
@@ -90,6 +91,7 @@ type FetchAssetDeltasRow struct {
 	NewAmt                   int64
 	NewScriptKeyBytes        []byte
 	ScriptKeyTweak           []byte
+	ScriptKeyTapscriptTree   []byte
 	NewScriptKeyID           int32
 	NewRawScriptKeyBytes     []byte
 	NewScriptKeyFamily       int32
@@ -113,6 +115,7 @@ func (q *Queries) FetchAssetDeltas(ctx context.Context, transferID int32) ([]Fet
 			&i.NewAmt,
 			&i.NewScriptKeyBytes,
 			&i.ScriptKeyTweak,
+			&i.ScriptKeyTapscriptTree,
 			&i.NewScriptKeyID,
 			&i.NewRawScriptKeyBytes,
 			&i.NewScriptKeyFamily,
@@ -139,6 +142,7 @@ SELECT
     deltas.old_script_key, deltas.new_amt, 
     script_keys.tweaked_script_key AS new_script_key_bytes,
     script_keys.tweak AS script_key_tweak,
+    script_keys.tapscript_tree AS script_key_tapscript_tree,
     deltas.new_script_key AS new_script_key_id, 
     internal_keys.raw_key AS new_raw_script_key_bytes,
     internal_keys.key_family AS new_script_key_family, 
@@ -161,6 +165,7 @@ type FetchAssetDeltasWithProofsRow struct {
 	NewAmt                   int64
 	NewScriptKeyBytes        []byte
 	ScriptKeyTweak           []byte
+	ScriptKeyTapscriptTree   []byte
 	NewScriptKeyID           int32
 	NewRawScriptKeyBytes     []byte
 	NewScriptKeyFamily       int32
@@ -186,6 +191,7 @@ func (q *Queries) FetchAssetDeltasWithProofs(ctx context.Context, transferID int
 			&i.NewAmt,
 			&i.NewScriptKeyBytes,
 			&i.ScriptKeyTweak,
+			&i.ScriptKeyTapscriptTree,
 			&i.NewScriptKeyID,
 			&i.NewRawScriptKeyBytes,
 			&i.NewScriptKeyFamily,
@@ -227,6 +233,66 @@ func (q *Queries) FetchSpendProofs(ctx context.Context, transferID int32) (Fetch
 	return i, err
 }
 
+const fetchTransferMetadata = `-- name: FetchTransferMetadata :many
+SELECT meta_key, meta_value
+FROM transfer_metadata
+WHERE transfer_id = $1
+ORDER BY meta_key
+`
+
+type FetchTransferMetadataRow struct {
+	MetaKey   string
+	MetaValue string
+}
+
+func (q *Queries) FetchTransferMetadata(ctx context.Context, transferID int32) ([]FetchTransferMetadataRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchTransferMetadata, transferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchTransferMetadataRow
+	for rows.Next() {
+		var i FetchTransferMetadataRow
+		if err := rows.Scan(&i.MetaKey, &i.MetaValue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertAssetBurn = `-- name: InsertAssetBurn :exec
+INSERT INTO asset_burns (
+    transfer_id, asset_id, burn_key, amount
+) VALUES (
+    $1, $2, $3, $4
+)
+`
+
+type InsertAssetBurnParams struct {
+	TransferID int32
+	AssetID    []byte
+	BurnKey    []byte
+	Amount     int64
+}
+
+func (q *Queries) InsertAssetBurn(ctx context.Context, arg InsertAssetBurnParams) error {
+	_, err := q.db.ExecContext(ctx, insertAssetBurn,
+		arg.TransferID,
+		arg.AssetID,
+		arg.BurnKey,
+		arg.Amount,
+	)
+	return err
+}
+
 const insertAssetDelta = `-- name: InsertAssetDelta :exec
 INSERT INTO asset_deltas (
     old_script_key, new_amt, new_script_key, serialized_witnesses, transfer_id,
@@ -263,9 +329,10 @@ func (q *Queries) InsertAssetDelta(ctx context.Context, arg InsertAssetDeltaPara
 
 const insertAssetTransfer = `-- name: InsertAssetTransfer :one
 INSERT INTO asset_transfers (
-    old_anchor_point, new_internal_key, new_anchor_utxo, transfer_time_unix
+    old_anchor_point, new_internal_key, new_anchor_utxo, transfer_time_unix,
+    label, num_confs
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6
 ) RETURNING id
 `
 
@@ -274,6 +341,8 @@ type InsertAssetTransferParams struct {
 	NewInternalKey   int32
 	NewAnchorUtxo    int32
 	TransferTimeUnix time.Time
+	Label            string
+	NumConfs         int32
 }
 
 func (q *Queries) InsertAssetTransfer(ctx context.Context, arg InsertAssetTransferParams) (int32, error) {
@@ -282,6 +351,8 @@ func (q *Queries) InsertAssetTransfer(ctx context.Context, arg InsertAssetTransf
 		arg.NewInternalKey,
 		arg.NewAnchorUtxo,
 		arg.TransferTimeUnix,
+		arg.Label,
+		arg.NumConfs,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -309,6 +380,66 @@ func (q *Queries) InsertSpendProofs(ctx context.Context, arg InsertSpendProofsPa
 	return proof_id, err
 }
 
+const queryAssetBurns = `-- name: QueryAssetBurns :many
+SELECT
+    burns.asset_id, burns.burn_key, burns.amount, txns.txid AS anchor_txid,
+    transfers.transfer_time_unix
+FROM asset_burns burns
+JOIN asset_transfers transfers
+    ON burns.transfer_id = transfers.id
+JOIN managed_utxos utxos
+    ON transfers.new_anchor_utxo = utxos.utxo_id
+JOIN chain_txns txns
+    ON utxos.txn_id = txns.txn_id
+WHERE (burns.asset_id = $1 OR
+    $1 IS NULL)
+    AND (burns.transfer_id = $2 OR
+    $2 IS NULL)
+ORDER BY burns.burn_id
+`
+
+type QueryAssetBurnsParams struct {
+	AssetID    []byte
+	TransferID sql.NullInt32
+}
+
+type QueryAssetBurnsRow struct {
+	AssetID          []byte
+	BurnKey          []byte
+	Amount           int64
+	AnchorTxid       []byte
+	TransferTimeUnix time.Time
+}
+
+func (q *Queries) QueryAssetBurns(ctx context.Context, arg QueryAssetBurnsParams) ([]QueryAssetBurnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryAssetBurns, arg.AssetID, arg.TransferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryAssetBurnsRow
+	for rows.Next() {
+		var i QueryAssetBurnsRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.BurnKey,
+			&i.Amount,
+			&i.AnchorTxid,
+			&i.TransferTimeUnix,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryAssetTransfers = `-- name: QueryAssetTransfers :many
 SELECT 
     asset_transfers.old_anchor_point, utxos.outpoint AS new_anchor_point,
@@ -317,7 +448,7 @@ SELECT
     txns.txid AS anchor_txid, txns.txn_id AS anchor_tx_primary_key, 
     txns.chain_fees, transfer_time_unix, keys.raw_key AS internal_key_bytes,
     keys.key_family AS internal_key_fam, keys.key_index AS internal_key_index,
-    id AS transfer_id, transfer_time_unix
+    id AS transfer_id, transfer_time_unix, label, num_confs
 FROM asset_transfers
 JOIN internal_keys keys
     ON asset_transfers.new_internal_key = keys.key_id
@@ -338,12 +469,32 @@ WHERE (
     -- based on the new_anchor_point, but only if it's specified.
     (utxos.outpoint = $2 OR
        $2 IS NULL)
+
+    AND
+
+    -- Transfers can also optionally be filtered by their label and their
+    -- metadata.
+    (asset_transfers.label = $3 OR
+       $3 IS NULL)
+
+    AND
+
+    (EXISTS (
+        SELECT 1 FROM transfer_metadata meta
+        WHERE meta.transfer_id = asset_transfers.id
+          AND meta.meta_key = $4
+          AND (meta.meta_value = $5 OR
+               $5 IS NULL)
+    ) OR $4 IS NULL)
 )
 `
 
 type QueryAssetTransfersParams struct {
 	UnconfOnly     interface{}
 	NewAnchorPoint []byte
+	Label          sql.NullString
+	MetaKey        sql.NullString
+	MetaValue      sql.NullString
 }
 
 type QueryAssetTransfersRow struct {
@@ -362,10 +513,18 @@ type QueryAssetTransfersRow struct {
 	InternalKeyIndex   int32
 	TransferID         int32
 	TransferTimeUnix_2 time.Time
+	Label              string
+	NumConfs           int32
 }
 
 func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfersParams) ([]QueryAssetTransfersRow, error) {
-	rows, err := q.db.QueryContext(ctx, queryAssetTransfers, arg.UnconfOnly, arg.NewAnchorPoint)
+	rows, err := q.db.QueryContext(ctx, queryAssetTransfers,
+		arg.UnconfOnly,
+		arg.NewAnchorPoint,
+		arg.Label,
+		arg.MetaKey,
+		arg.MetaValue,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -389,6 +548,8 @@ func (q *Queries) QueryAssetTransfers(ctx context.Context, arg QueryAssetTransfe
 			&i.InternalKeyIndex,
 			&i.TransferID,
 			&i.TransferTimeUnix_2,
+			&i.Label,
+			&i.NumConfs,
 		); err != nil {
 			return nil, err
 		}
@@ -425,3 +586,23 @@ func (q *Queries) ReanchorAssets(ctx context.Context, arg ReanchorAssetsParams)
 	_, err := q.db.ExecContext(ctx, reanchorAssets, arg.NewOutpointUtxoID, arg.OldOutpoint)
 	return err
 }
+
+const upsertTransferMetadata = `-- name: UpsertTransferMetadata :exec
+INSERT INTO transfer_metadata (
+    transfer_id, meta_key, meta_value
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (transfer_id, meta_key)
+    DO UPDATE SET meta_value = EXCLUDED.meta_value
+`
+
+type UpsertTransferMetadataParams struct {
+	TransferID int32
+	MetaKey    string
+	MetaValue  string
+}
+
+func (q *Queries) UpsertTransferMetadata(ctx context.Context, arg UpsertTransferMetadataParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTransferMetadata, arg.TransferID, arg.MetaKey, arg.MetaValue)
+	return err
+}
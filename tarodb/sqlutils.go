@@ -59,6 +59,15 @@ func extractSqlInt16[T constraints.Integer](num sql.NullInt16) T {
 	return T(num.Int16)
 }
 
+// sqlOptStr turns an optional string into the NullString that sql/sqlc uses
+// for optional query parameters. An empty string is mapped to NULL.
+func sqlOptStr(str string) sql.NullString {
+	return sql.NullString{
+		String: str,
+		Valid:  str != "",
+	}
+}
+
 // readOutPoint reads the next sequence of bytes from r as an OutPoint.
 //
 // NOTE: This function is intended to be used along with the wire.WriteOutPoint
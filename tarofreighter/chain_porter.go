@@ -2,11 +2,13 @@ package tarofreighter
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -21,6 +23,7 @@ import (
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
 )
 
 // ChainPorterConfig is the main config for the chain porter.
@@ -53,16 +56,24 @@ type ChainPorterConfig struct {
 	// ChainParams is the chain params of the chain we operate on.
 	ChainParams *address.ChainParams
 
-	// AssetProofs is used to write the proof files on disk for the
-	// receiver during a transfer.
-	//
-	// TODO(roasbeef): replace with proof.Courier in the future/
+	// AssetProofs is the proof archive used to fetch the proof file of
+	// the asset being sent. The updated proof files of a transfer are
+	// stored through the ExportLog once the transfer confirmed.
 	AssetProofs proof.Archiver
 
 	// ProofCourier is used to optionally deliver the final proof to the
 	// user using an asynchronous transport mechanism.
 	ProofCourier proof.Courier[address.Taro]
 
+	// ReOrgWatcher is used to watch the transfer transaction for chain
+	// re-organizations after it confirmed. This is optional.
+	ReOrgWatcher *tarogarden.ReOrgWatcher
+
+	// NumConfs is the default number of confirmations a transfer
+	// transaction needs before the transfer is considered complete. A
+	// value of zero is treated as a single confirmation.
+	NumConfs uint32
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -211,13 +222,24 @@ func (p *ChainPorter) taroPorter() {
 	for {
 		select {
 		case req := <-p.exportReqs:
-			log.Infof("Received to send request to: %x:%x",
-				req.Dest.ID(),
-				req.Dest.ScriptKey.SerializeCompressed())
+			if req.Burn != nil {
+				log.Infof("Received burn request of %d units "+
+					"of %x", req.Burn.Amount,
+					req.Burn.AssetID[:])
+			} else {
+				log.Infof("Received to send request to: %x:%x",
+					req.Dest.ID(),
+					req.Dest.ScriptKey.SerializeCompressed())
+			}
 
 			// Initialize a package with the destination address.
 			sendPkg := sendPackage{
-				ReceiverAddr: req.Dest,
+				ReceiverAddr:     req.Dest,
+				Burn:             req.Burn,
+				TapscriptSpend:   req.TapscriptSpend,
+				NumConfs:         req.NumConfs,
+				Label:            req.Label,
+				ExternalMetadata: req.ExternalMetadata,
 			}
 
 			// Advance the state machine for this package until we
@@ -249,8 +271,10 @@ func (p *ChainPorter) taroPorter() {
 }
 
 // waitForPkgConfirmation waits for the confirmation of the final transaction
-// within the delta. Once confirmed, the parcel will be marked as delivered on
-// chain, with the goroutine cleaning up its state.
+// within the delta. Once it reached the number of confirmations requested for
+// the parcel (or the configured default if none was requested), the parcel
+// will be marked as delivered on chain, with the goroutine cleaning up its
+// state.
 func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 	defer p.Wg.Done()
 
@@ -261,9 +285,18 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		return fmt.Errorf(format, args...)
 	}
 
+	numConfs := pkg.NumConfs
+	if numConfs == 0 {
+		numConfs = p.cfg.NumConfs
+	}
+	if numConfs == 0 {
+		numConfs = 1
+	}
+
 	txHash := pkg.AnchorTx.TxHash()
 
-	log.Infof("Waiting for confirmation of transfer_txid=%v", txHash)
+	log.Infof("Waiting for %d confirmation(s) of transfer_txid=%v",
+		numConfs, txHash)
 
 	// Before we can broadcast, we want to find out the current height to
 	// pass as a height hint.
@@ -277,7 +310,7 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 
 	confCtx, confCancel := p.WithCtxQuitNoTimeout()
 	confNtfn, errChan, err := p.cfg.ChainBridge.RegisterConfirmationsNtfn(
-		confCtx, &txHash, pkg.AnchorTx.TxOut[0].PkScript, 1,
+		confCtx, &txHash, pkg.AnchorTx.TxOut[0].PkScript, numConfs,
 		currentHeight, true,
 	)
 	if err != nil {
@@ -343,9 +376,10 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		return
 	}
 	err = senderProofSuffix.UpdateTransitionProof(&proof.BaseProofParams{
-		Block:   confEvent.Block,
-		Tx:      confEvent.Tx,
-		TxIndex: int(confEvent.TxIndex),
+		Block:       confEvent.Block,
+		BlockHeight: confEvent.BlockHeight,
+		Tx:          confEvent.Tx,
+		TxIndex:     int(confEvent.TxIndex),
 	})
 	if err != nil {
 		p.cfg.ErrChan <- mkErr("error updating sender transition "+
@@ -382,9 +416,10 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		return
 	}
 	err = receiverProofSuffix.UpdateTransitionProof(&proof.BaseProofParams{
-		Block:   confEvent.Block,
-		Tx:      confEvent.Tx,
-		TxIndex: int(confEvent.TxIndex),
+		Block:       confEvent.Block,
+		BlockHeight: confEvent.BlockHeight,
+		Tx:          confEvent.Tx,
+		TxIndex:     int(confEvent.TxIndex),
 	})
 	if err != nil {
 		p.cfg.ErrChan <- mkErr("error updating receiver transition "+
@@ -392,10 +427,9 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		return
 	}
 
-	log.Infof("Importing receiver proof into local Proof Archive")
-
-	// Now we'll write out the final receiver proof to the on disk proof
-	// archive.
+	// Now we'll create the final receiver proof, a copy of which is
+	// stored in the local proof archive once we mark the parcel as
+	// confirmed.
 	var updatedReceiverProof bytes.Buffer
 	if err := senderProof.ReplaceLastProof(receiverProofSuffix); err != nil {
 		p.cfg.ErrChan <- mkErr("error replacing receiver proof: %v", err)
@@ -412,22 +446,13 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 		},
 		Blob: updatedReceiverProof.Bytes(),
 	}
-	err = p.cfg.AssetProofs.ImportProofs(
-		ctx, receiverProof, newSenderProof,
-	)
-	if err != nil {
-		p.cfg.ErrChan <- mkErr("error importing proof: %v", err)
-		return
-	}
-
-	log.Debugf("Updated proofs for sender and receiver (new_len=%d)",
-		senderProof.NumProofs())
 
 	// If we have a proof courier instance active, then we'll launch a new
-	// goroutine to deliver the proof to the receiver.
+	// goroutine to deliver the proof to the receiver. There is no one to
+	// deliver the proof of a burn to, so we keep it to ourselves.
 	//
 	// TODO(roasbeef): move earlier?
-	if p.cfg.ProofCourier != nil {
+	if p.cfg.ProofCourier != nil && !receiverProofSuffix.Asset.IsBurn() {
 		p.Wg.Add(1)
 		go func() {
 			defer p.Wg.Done()
@@ -455,20 +480,105 @@ func (p *ChainPorter) waitForPkgConfirmation(pkg *OutboundParcelDelta) {
 	// At this point we have the confirmation signal, so we can mark the
 	// parcel delivery as completed in the database.
 	err = p.cfg.ExportLog.ConfirmParcelDelivery(ctx, &AssetConfirmEvent{
-		AnchorPoint:      pkg.NewAnchorPoint,
-		BlockHash:        *confEvent.BlockHash,
-		BlockHeight:      int32(confEvent.BlockHeight),
-		TxIndex:          int32(confEvent.TxIndex),
-		FinalSenderProof: updatedSenderProof.Bytes(),
+		AnchorPoint:        pkg.NewAnchorPoint,
+		BlockHash:          *confEvent.BlockHash,
+		BlockHeight:        int32(confEvent.BlockHeight),
+		TxIndex:            int32(confEvent.TxIndex),
+		FinalSenderProof:   newSenderProof,
+		FinalReceiverProof: receiverProof,
 	})
 	if err != nil {
 		p.cfg.ErrChan <- mkErr("unable to log tx conf: %w", err)
 		return
 	}
 
+	log.Debugf("Updated proofs for sender and receiver (new_len=%d)",
+		senderProof.NumProofs())
+
+	// The transfer is confirmed, but the block it was confirmed in might
+	// still be re-organized out of the chain. The watcher then re-anchors
+	// all proof files containing the transfer, which includes our copy of
+	// the receiver's proof.
+	if p.cfg.ReOrgWatcher != nil {
+		p.cfg.ReOrgWatcher.WatchTx(confEvent)
+	}
+
 	return
 }
 
+// burnAddr creates the address that the given amount of the input asset is
+// burned to. The script key of the address is the burn key derived from the
+// input, while the anchor output uses a fresh internal key of ours, which is
+// returned as well.
+func (p *ChainPorter) burnAddr(ctx context.Context, inputPrevID asset.PrevID,
+	inputAsset *asset.Asset, amount uint64) (*address.Taro,
+	keychain.KeyDescriptor, error) {
+
+	internalKey, err := p.cfg.KeyRing.DeriveNextKey(
+		ctx, tarogarden.TaroKeyFamily,
+	)
+	if err != nil {
+		return nil, internalKey, fmt.Errorf("unable to derive burn "+
+			"internal key: %w", err)
+	}
+
+	var famKey *btcec.PublicKey
+	if inputAsset.FamilyKey != nil {
+		famKey = &inputAsset.FamilyKey.FamKey
+	}
+
+	burnKey := asset.DeriveBurnKey(inputPrevID)
+
+	addr, err := address.New(
+		inputAsset.Genesis, famKey, *burnKey, *internalKey.PubKey,
+		amount, p.cfg.ChainParams,
+	)
+
+	return addr, internalKey, err
+}
+
+// importBurnAnchor imports the anchor output of a burn into the wallet, so it
+// takes account of the BTC carried by the output. The returned anchor output
+// is recorded as a managed UTXO together with the parcel.
+func (p *ChainPorter) importBurnAnchor(
+	currentPkg *sendPackage) (*AnchorOutput, error) {
+
+	burnCommitKey := currentPkg.ReceiverAddr.AssetCommitmentKey()
+	burnCommitment := currentPkg.NewOutputCommitments[burnCommitKey]
+	burnIndex := currentPkg.SendDelta.Locators[burnCommitKey].OutputIndex
+	burnRoot := burnCommitment.TapscriptRoot(nil)
+
+	burnOutputKey := txscript.ComputeTaprootOutputKey(
+		currentPkg.BurnInternalKey.PubKey, burnRoot[:],
+	)
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	_, err := p.cfg.Wallet.ImportTaprootOutput(ctx, burnOutputKey)
+	switch {
+	case err == nil:
+		break
+
+	// The output might already be known to the wallet if we crashed
+	// before logging the parcel.
+	case strings.Contains(err.Error(), "already exists"):
+		break
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to import burn anchor: %w", err)
+	}
+
+	return &AnchorOutput{
+		AnchorPoint: wire.OutPoint{
+			Hash:  currentPkg.TransferTx.TxHash(),
+			Index: burnIndex,
+		},
+		InternalKey: currentPkg.BurnInternalKey,
+		TaroRoot:    burnRoot[:],
+	}, nil
+}
+
 // advanceStateUntil will advance the state machine until the next state is the
 // target state.
 func (p *ChainPorter) advanceStateUntil(currentPkg *sendPackage,
@@ -542,6 +652,32 @@ func adjustFundedPsbt(pkt *psbt.Packet, changeIndex uint32,
 	pkt.UnsignedTx.TxOut[maxOutputIndex].Value += anchorInputValue
 }
 
+// selectTapscriptInput returns the first of the eligible commitments whose
+// asset script key commits to a tapscript tree that contains the given leaf.
+func selectTapscriptInput(eligibleCommitments []*AnchoredCommitment,
+	leaf txscript.TapLeaf) (*AnchoredCommitment, error) {
+
+	leafHash := leaf.TapHash()
+	for _, anchoredCommitment := range eligibleCommitments {
+		scriptKey := anchoredCommitment.Asset.ScriptKey
+		if scriptKey.TweakedScriptKey == nil {
+			continue
+		}
+
+		tapTree := scriptKey.TapscriptTree()
+		if tapTree == nil {
+			continue
+		}
+
+		if _, ok := tapTree.LeafProofIndex[leafHash]; ok {
+			return anchoredCommitment, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no eligible input commits to tap leaf %x",
+		leafHash[:])
+}
+
 // stateStep attempts to step through the state machine to complete a Taro
 // transfer.
 func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
@@ -557,6 +693,9 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 
 		currentPkg.SendDelta = &taroscript.SpendDelta{
 			InputAssets: make(commitment.InputSet),
+			TapscriptSpends: make(
+				map[asset.PrevID]*taroscript.TapscriptSpend,
+			),
 		}
 
 		currentPkg.SendState = SendStateCommitmentSelect
@@ -578,11 +717,25 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		// TODO(roasbeef): send logic assumes just one input (no
 		// merges) so we pass in the amount here to ensure we have
 		// enough to send
-		assetID := currentPkg.ReceiverAddr.ID()
-		constraints := CommitmentConstraints{
-			FamilyKey: currentPkg.ReceiverAddr.FamilyKey,
-			AssetID:   &assetID,
-			MinAmt:    currentPkg.ReceiverAddr.Amount,
+		var constraints CommitmentConstraints
+		if currentPkg.Burn != nil {
+			constraints = CommitmentConstraints{
+				AssetID: &currentPkg.Burn.AssetID,
+				MinAmt:  currentPkg.Burn.Amount,
+			}
+		} else {
+			constraints = CommitmentConstraints{
+				FamilyKey: currentPkg.ReceiverAddr.FamilyKey,
+				MinAmt:    currentPkg.ReceiverAddr.Amount,
+			}
+
+			// A family address accepts any asset ID within the
+			// family, so we only constrain the asset ID for all
+			// other addresses.
+			if !currentPkg.ReceiverAddr.IsFamilyAddr() {
+				assetID := currentPkg.ReceiverAddr.ID()
+				constraints.AssetID = &assetID
+			}
 		}
 		elgigibleCommitments, err := p.cfg.CoinSelector.SelectCommitment(
 			ctx, constraints,
@@ -592,13 +745,30 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 				"selection: %w", err)
 		}
 
-		log.Infof("Selected %v possible asset inputs for send to %x",
-			len(elgigibleCommitments),
-			currentPkg.ReceiverAddr.ScriptKey.SerializeCompressed())
+		if constraints.AssetID != nil {
+			log.Infof("Selected %v possible asset inputs for send "+
+				"of %x", len(elgigibleCommitments),
+				constraints.AssetID[:])
+		} else {
+			log.Infof("Selected %v possible asset inputs for send "+
+				"of family %x", len(elgigibleCommitments),
+				constraints.FamilyKey.SerializeCompressed())
+		}
 
 		// We'll take just the first commitment here as we need enough
-		// to complete the send w/o merging inputs.
+		// to complete the send w/o merging inputs. For a script path
+		// spend, it needs to be the first one whose script key commits
+		// to the leaf being spent.
 		assetInput := elgigibleCommitments[0]
+		if currentPkg.TapscriptSpend != nil {
+			assetInput, err = selectTapscriptInput(
+				elgigibleCommitments,
+				currentPkg.TapscriptSpend.Leaf,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		// If the key found for the input UTXO is not from the Taro
 		// keyfamily, something has gone wrong with the DB.
@@ -624,6 +794,36 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 		currentPkg.InputAsset = assetInput
 
+		// Now that we know the asset ID of the input, we can bind a
+		// family address to it, so the send can be constructed like
+		// for any other address.
+		if currentPkg.Burn == nil {
+			currentPkg.ReceiverAddr, err = taroscript.BindFamilyAddr(
+				*currentPkg.ReceiverAddr, assetInput.Asset,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// For a burn, the receiver is the provably unspendable burn
+		// key that can only be derived now that we know the input.
+		if currentPkg.Burn != nil {
+			burnAddr, burnKey, err := p.burnAddr(
+				ctx, currentPkg.InputAssetPrevID,
+				assetInput.Asset, currentPkg.Burn.Amount,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			currentPkg.ReceiverAddr = burnAddr
+			currentPkg.BurnInternalKey = burnKey
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		currentPkg.SendState = SendStateValidatedInput
 
 		return &currentPkg, nil
@@ -647,6 +847,11 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		}
 
 		currentPkg.SendDelta.InputAssets[currentPkg.InputAssetPrevID] = inputAsset
+		if currentPkg.TapscriptSpend != nil {
+			prevID := currentPkg.InputAssetPrevID
+			currentPkg.SendDelta.TapscriptSpends[prevID] =
+				currentPkg.TapscriptSpend
+		}
 
 		// Before we can prepare output assets for our send, we need to
 		// generate a new internal key and script key. The script key
@@ -711,13 +916,31 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		log.Infof("Generating Taro witnesses for send to: %x",
 			currentPkg.ReceiverAddr.ScriptKey.SerializeCompressed())
 
+		// The transfer can be confirmed in the next block at the
+		// earliest, so that's the height the lock times of our input
+		// need to have expired at.
+		ctx, cancel := p.WithCtxQuit()
+		defer cancel()
+		currentHeight, err := p.cfg.ChainBridge.CurrentHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get current "+
+				"height: %w", err)
+		}
+		inputHeight := currentPkg.InputAsset.AnchorBlockHeight
+		chainCtx := &taroscript.ChainContext{
+			BlockHeight: currentHeight + 1,
+			InputHeights: map[asset.PrevID]uint32{
+				currentPkg.InputAssetPrevID: inputHeight,
+			},
+		}
+
 		// Now we'll use the signer to sign all the inputs for the new
 		// taro leaves. The witness data for each input will be
 		// assigned for us.
 		completedSpend, err := taroscript.CompleteAssetSpend(
 			*currentPkg.InputAsset.Asset.ScriptKey.RawKey.PubKey,
 			currentPkg.InputAssetPrevID, *currentPkg.SendDelta,
-			p.cfg.Signer, p.cfg.TxValidator,
+			p.cfg.Signer, p.cfg.TxValidator, chainCtx,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to generate taro "+
@@ -956,8 +1179,26 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			},
 			TapscriptSibling: currentPkg.InputAsset.TapscriptSibling,
 			// TODO(bhandras): use clock.Clock instead.
-			TransferTime: time.Now(),
-			ChainFees:    chainFees,
+			TransferTime:     time.Now(),
+			ChainFees:        chainFees,
+			Label:            currentPkg.Label,
+			ExternalMetadata: currentPkg.ExternalMetadata,
+			NumConfs:         currentPkg.NumConfs,
+		}
+		if currentPkg.Burn != nil {
+			currentPkg.OutboundPkg.Burns = []AssetBurn{{
+				AssetID:    currentPkg.Burn.AssetID,
+				BurnKey:    currentPkg.ReceiverAddr.ScriptKey,
+				Amount:     currentPkg.Burn.Amount,
+				AnchorTxid: currentPkg.TransferTx.TxHash(),
+				BurnTime:   currentPkg.OutboundPkg.TransferTime,
+			}}
+
+			burnAnchor, err := p.importBurnAnchor(&currentPkg)
+			if err != nil {
+				return nil, err
+			}
+			currentPkg.OutboundPkg.BurnAnchor = burnAnchor
 		}
 
 		// Don't allow shutdown while we're attempting to store proofs.
@@ -13,6 +13,7 @@ import (
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/mssmt"
+	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -57,6 +58,10 @@ type AnchoredCommitment struct {
 	// usually be blank.
 	TapscriptSibling []byte
 
+	// AnchorBlockHeight is the height of the block the anchor transaction
+	// of the commitment was confirmed in.
+	AnchorBlockHeight uint32
+
 	// Commitment is the full Taro commitment anchored at the above
 	// outpoint. This includes both the asset to be used as an input, along
 	// with any other assets that might be collocated in this commitment.
@@ -120,6 +125,39 @@ type AssetSpendDelta struct {
 	ReceiverAssetProof []byte
 }
 
+// AssetBurn describes an amount of an asset that was provably burned by an
+// outbound parcel.
+type AssetBurn struct {
+	// AssetID is the ID of the burned asset.
+	AssetID asset.ID
+
+	// BurnKey is the unspendable script key the asset was burned to.
+	BurnKey btcec.PublicKey
+
+	// Amount is the burned amount.
+	Amount uint64
+
+	// AnchorTxid is the txid of the transaction that anchors the burn.
+	AnchorTxid chainhash.Hash
+
+	// BurnTime is the time the burn was created.
+	BurnTime time.Time
+}
+
+// AnchorOutput describes an on-chain output of ours that anchors assets we
+// can't spend, such as the output the assets of a burn are committed to. The
+// output still carries BTC, so we keep track of it.
+type AnchorOutput struct {
+	// AnchorPoint is the outpoint of the anchor output.
+	AnchorPoint wire.OutPoint
+
+	// InternalKey is our internal key of the anchor output.
+	InternalKey keychain.KeyDescriptor
+
+	// TaroRoot is the Taro commitment root of the anchor output.
+	TaroRoot []byte
+}
+
 // OutboundParcelDelta represents the database level delta of an outbound taro
 // parcel (outbound spend). A spend will destroy a series of assets at the old
 // anchor point, and re-create them at the new anchor point. Along the way some
@@ -160,6 +198,46 @@ type OutboundParcelDelta struct {
 	// ChainFees is the amount in sats paid in on-chain fees for the
 	// anchor transaction.
 	ChainFees int64
+
+	// Burns is the set of assets that were burned by this parcel.
+	Burns []AssetBurn
+
+	// BurnAnchor is the anchor output the burned assets are committed to.
+	// It is only set when logging a parcel that burns assets, and is not
+	// populated for parcels read back from disk.
+	BurnAnchor *AnchorOutput
+
+	// Label is an optional free-form label of the transfer that can be
+	// used to map it to an entity of an external system.
+	Label string
+
+	// ExternalMetadata is an optional set of key/value pairs that describe
+	// the transfer in the context of an external system.
+	ExternalMetadata map[string]string
+
+	// NumConfs is the number of confirmations the anchor transaction needs
+	// before the transfer is considered complete. If zero, the default of
+	// the porter is used.
+	NumConfs uint32
+}
+
+// ParcelQueryParams holds the set of query params for outbound parcels.
+type ParcelQueryParams struct {
+	// PendingOnly if set, only parcels with an unconfirmed anchor
+	// transaction will be returned.
+	PendingOnly bool
+
+	// Label if set, only parcels with exactly this label will be returned.
+	Label string
+
+	// ExternalMetadataKey if set, only parcels that have an external
+	// metadata entry with this key will be returned.
+	ExternalMetadataKey string
+
+	// ExternalMetadataValue if set, only parcels whose external metadata
+	// entry with the above key has this value will be returned. It is
+	// ignored if no ExternalMetadataKey is set.
+	ExternalMetadataValue string
 }
 
 // AssetConfirmEvent is used to mark a batched spend as confirmed on disk.
@@ -179,7 +257,12 @@ type AssetConfirmEvent struct {
 
 	// FinalSenderProof is the final proof for the sender that includes the
 	// chain information of the final confirmation point.
-	FinalSenderProof []byte
+	FinalSenderProof *proof.AnnotatedProof
+
+	// FinalReceiverProof is the final proof for the receiver that includes
+	// the chain information of the final confirmation point. We keep a
+	// copy of it so we can re-deliver it if needed.
+	FinalReceiverProof *proof.AnnotatedProof
 }
 
 // ExportLog is used to track the state of outbound taro parcels (batched
@@ -125,12 +125,47 @@ func (s SendState) String() string {
 	}
 }
 
+// BurnRequest describes an amount of an asset that should be provably burned
+// instead of being sent to an address.
+type BurnRequest struct {
+	// AssetID is the ID of the asset to burn.
+	AssetID asset.ID
+
+	// Amount is the amount of the asset to burn.
+	Amount uint64
+}
+
 // AssetParcel is the main request to issue an asset transfer. This packages a
 // destination address, and also response context.
 type AssetParcel struct {
 	// Dest is the address that should be used to satisfy the transfer.
+	// This is unset for burns, as the burn address is only known once the
+	// input has been selected.
 	Dest *address.Taro
 
+	// Burn is set if the parcel burns assets instead of sending them to
+	// an address.
+	Burn *BurnRequest
+
+	// TapscriptSpend is an optional script path spend of the input asset.
+	// If set, then only an input whose script key commits to the leaf of
+	// the spend is selected, and it's spent through that leaf instead of
+	// the key path.
+	TapscriptSpend *taroscript.TapscriptSpend
+
+	// NumConfs, if non-zero, overrides the number of confirmations the
+	// transfer transaction needs before the transfer is considered
+	// complete.
+	NumConfs uint32
+
+	// Label is an optional free-form label that is stored with the
+	// resulting transfer.
+	Label string
+
+	// ExternalMetadata is an optional set of key/value pairs that is stored
+	// with the resulting transfer.
+	ExternalMetadata map[string]string
+
 	// respChan is the channel a response will be sent over.
 	respChan chan *PendingParcel
 
@@ -187,6 +222,9 @@ type PendingParcel struct {
 	// TotalFees is the amount of on chain fees that the transfer
 	// transaction required.
 	TotalFees btcutil.Amount
+
+	// Burns is the set of assets burned by the transfer.
+	Burns []AssetBurn
 }
 
 // sendPackage houses the information we need to complete a package transfer.
@@ -217,6 +255,30 @@ type sendPackage struct {
 	// transfer.
 	ReceiverAddr *address.Taro
 
+	// Burn is set if this package burns assets. The receiver address is
+	// then derived from the selected input.
+	Burn *BurnRequest
+
+	// BurnInternalKey is our internal key of the anchor output the burned
+	// assets are committed to. It is only set if the package burns assets.
+	BurnInternalKey keychain.KeyDescriptor
+
+	// TapscriptSpend is the optional script path spend of the input asset
+	// requested by the sender.
+	TapscriptSpend *taroscript.TapscriptSpend
+
+	// NumConfs is the number of confirmations the transfer transaction
+	// needs before the transfer is considered complete. If zero, the
+	// default of the porter is used.
+	NumConfs uint32
+
+	// Label is the optional free-form label of the transfer.
+	Label string
+
+	// ExternalMetadata is the optional set of key/value pairs that
+	// describe the transfer.
+	ExternalMetadata map[string]string
+
 	// SendDelta contains the information needed to craft a final transfer
 	// transaction.
 	SendDelta *taroscript.SpendDelta
@@ -632,5 +694,6 @@ func (s *sendPackage) deliverResponse(respChan chan<- *PendingParcel) {
 			},
 		},
 		TotalFees: btcutil.Amount(s.OutboundPkg.ChainFees),
+		Burns:     s.OutboundPkg.Burns,
 	}
 }
@@ -47,6 +47,12 @@ type MintingBatch struct {
 	// BatchStateCommitted.
 	RootAssetCommitment *commitment.TaroCommitment
 
+	// NumConfs is the number of confirmations the minting transaction
+	// needs before the batch is considered final. This is the highest
+	// override requested by any of the seedlings, or zero if the default
+	// of the planter should be used.
+	NumConfs uint32
+
 	// mintingPubKey is the top-level Taproot output key that will be
 	// used to commit to the Taro commitment above.
 	mintingPubKey *btcec.PublicKey
@@ -66,6 +72,11 @@ func (m *MintingBatch) addSeedling(s *Seedling) error {
 	}
 
 	m.Seedlings[s.AssetName] = s
+
+	if s.NumConfs > m.NumConfs {
+		m.NumConfs = s.NumConfs
+	}
+
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package tarogarden
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -178,6 +179,14 @@ func (b *BatchCaretaker) taroCultivator() {
 	if b.cfg.Batch.BatchState == BatchStateConfirmed {
 		log.Infof("MintingBatch(%x): already confirmed!", b.batchKey[:])
 
+		// We might have shut down before the minting transaction
+		// reached the number of confirmations required for the batch
+		// to be final, so we'll make sure it did before moving on.
+		if err := b.resumeFinality(); err != nil {
+			log.Errorf("unable to await batch finality: %v", err)
+			return
+		}
+
 		_, err := b.advanceStateUntil(
 			BatchStateFinalized, BatchStateFinalized,
 		)
@@ -244,6 +253,112 @@ func (b *BatchCaretaker) taroCultivator() {
 	}
 }
 
+// numConfs returns the number of confirmations the minting transaction of the
+// batch needs before the batch is considered final.
+func (b *BatchCaretaker) numConfs() uint32 {
+	numConfs := b.cfg.NumConfs
+	if b.cfg.Batch.NumConfs != 0 {
+		numConfs = b.cfg.Batch.NumConfs
+	}
+
+	if numConfs == 0 {
+		return 1
+	}
+
+	return numConfs
+}
+
+// waitForFinality blocks until the given minting transaction that confirmed at
+// the given height has reached the number of confirmations required for the
+// batch to be final.
+func (b *BatchCaretaker) waitForFinality(tx *wire.MsgTx,
+	confHeight uint32) error {
+
+	numConfs := b.numConfs()
+	if numConfs <= 1 {
+		return nil
+	}
+
+	log.Infof("MintingBatch(%x): waiting for %d confirmations",
+		b.batchKey[:], numConfs)
+
+	txHash := tx.TxHash()
+	confCtx, confCancel := b.WithCtxQuitNoTimeout()
+	defer confCancel()
+	confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &txHash, tx.TxOut[0].PkScript, numConfs, confHeight,
+		false,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register for minting tx "+
+			"finality: %w", err)
+	}
+
+	select {
+	case <-confNtfn.Confirmed:
+		log.Infof("MintingBatch(%x): reached %d confirmations",
+			b.batchKey[:], numConfs)
+
+		return nil
+
+	case err := <-errChan:
+		return fmt.Errorf("error waiting for minting tx finality: %w",
+			err)
+
+	case <-b.Quit:
+		return fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+}
+
+// resumeFinality waits for the minting transaction of an already confirmed
+// batch to reach the number of confirmations required for the batch to be
+// final. As the confirmation height of the batch isn't stored with the batch
+// itself, we look it up in the minting proof of one of its assets.
+func (b *BatchCaretaker) resumeFinality() error {
+	if b.numConfs() <= 1 {
+		return nil
+	}
+
+	if b.cfg.Batch.GenesisPacket == nil ||
+		b.cfg.Batch.RootAssetCommitment == nil {
+
+		return fmt.Errorf("confirmed batch is missing genesis packet " +
+			"or assets")
+	}
+
+	assets := b.cfg.Batch.RootAssetCommitment.CommittedAssets()
+	if len(assets) == 0 {
+		return fmt.Errorf("confirmed batch has no assets")
+	}
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	assetID := assets[0].ID()
+	blob, err := b.cfg.ProofFiles.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *assets[0].ScriptKey.PubKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fetch minting proof: %w", err)
+	}
+
+	proofFile := proof.NewEmptyFile(proof.V0)
+	if err := proofFile.Decode(bytes.NewReader(blob)); err != nil {
+		return fmt.Errorf("unable to decode minting proof: %w", err)
+	}
+	mintingProof, err := proofFile.LastProof()
+	if err != nil {
+		return err
+	}
+
+	return b.waitForFinality(
+		b.cfg.Batch.GenesisPacket.Pkt.UnsignedTx,
+		mintingProof.BlockHeight,
+	)
+}
+
 // fundGenesisPsbt generates a PSBT packet we'll use to create an asset.  In
 // order to be able to create an asset, we need an initial genesis outpoint. To
 // obtain this we'll ask the wallet to fund a PSBT template for GenesisAmtSats
@@ -254,8 +369,11 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 	log.Infof("BatchCaretaker(%x): attempting to fund GenesisPacket",
 		b.batchKey[:])
 
+	// We add a copy of the dummy output, as its script is replaced with
+	// the actual minting script later on.
+	dummyTxOut := DummyGenesisTxOut
 	txTemplate := wire.NewMsgTx(2)
-	txTemplate.AddTxOut(&DummyGenesisTxOut)
+	txTemplate.AddTxOut(&dummyTxOut)
 	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
@@ -353,7 +471,8 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		}
 
 		newAsset, err := asset.New(
-			assetGen, amount, 0, 0,
+			assetGen, amount, seedling.LockTime,
+			seedling.RelativeLockTime, seedling.ScriptVersion,
 			asset.NewScriptKeyBIP0086(scriptKey), familyKey,
 		)
 		if err != nil {
@@ -682,6 +801,7 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		mintingProofs, err := proof.NewMintingBlobs(&proof.MintParams{
 			BaseProofParams: proof.BaseProofParams{
 				Block:       confInfo.Block,
+				BlockHeight: confInfo.BlockHeight,
 				Tx:          confInfo.Tx,
 				TxIndex:     int(confInfo.TxIndex),
 				OutputIndex: int(b.anchorOutputIndex),
@@ -691,31 +811,12 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			GenesisPoint: extractGenesisOutpoint(
 				b.cfg.Batch.GenesisPacket.Pkt.UnsignedTx,
 			),
-		})
+		}, b.cfg.HeaderVerifier)
 		if err != nil {
 			return 0, fmt.Errorf("unable to construct minting "+
 				"proofs: %v", err)
 		}
 
-		// Before we confirm the batch, we'll also update the on disk
-		// file system as well.
-		//
-		// TODO(roasbeef): rely on the upsert here instead
-		for _, newAsset := range b.cfg.Batch.RootAssetCommitment.CommittedAssets() {
-			assetID := newAsset.ID()
-			scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
-			err := b.cfg.ProofFiles.ImportProofs(ctx, &proof.AnnotatedProof{
-				Locator: proof.Locator{
-					AssetID:   &assetID,
-					ScriptKey: *newAsset.ScriptKey.PubKey,
-				},
-				Blob: mintingProofs[scriptKey],
-			})
-			if err != nil {
-				return 0, fmt.Errorf("unable to insert proofs: %v", err)
-			}
-		}
-
 		err = b.cfg.Log.MarkBatchConfirmed(
 			ctx, b.cfg.Batch.BatchKey.PubKey, confInfo.BlockHash,
 			confInfo.BlockHeight, confInfo.TxIndex, mintingProofs,
@@ -724,6 +825,22 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			return 0, fmt.Errorf("unable to confirm batch: %w", err)
 		}
 
+		// The batch is confirmed, but the block it was confirmed in
+		// might still be re-organized out of the chain.
+		if b.cfg.ReOrgWatcher != nil {
+			b.cfg.ReOrgWatcher.WatchTx(confInfo)
+		}
+
+		// Depending on the number of confirmations required, the
+		// minting transaction might need to be buried deeper in the
+		// chain before we consider the batch final.
+		err = b.waitForFinality(
+			confInfo.Tx, confInfo.BlockHeight,
+		)
+		if err != nil {
+			return 0, err
+		}
+
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateConfirmed, BatchStateFinalized)
 
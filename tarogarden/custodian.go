@@ -2,12 +2,17 @@ package tarogarden
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/lightninglabs/lndclient"
@@ -15,7 +20,10 @@ import (
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/chanutils"
 	"github.com/lightninglabs/taro/proof"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/ticker"
 )
 
 // CustodianConfig houses all the items that the Custodian needs to carry out
@@ -42,6 +50,34 @@ type CustodianConfig struct {
 	// user using an asynchronous transport mechanism.
 	ProofCourier proof.Courier[address.Taro]
 
+	// ReOrgWatcher is used to watch the transactions of inbound assets for
+	// chain re-organizations after they confirmed. This is optional.
+	ReOrgWatcher *ReOrgWatcher
+
+	// NumConfs is the number of confirmations the transaction of an
+	// inbound asset transfer needs before it is considered final and the
+	// custodian takes custody of the assets. A value of zero is treated as
+	// a single confirmation.
+	NumConfs uint32
+
+	// ExpiryTicker is used to periodically check for addresses that
+	// expired, so the custodian can stop managing them. This is optional,
+	// expired addresses are only retired on startup otherwise.
+	ExpiryTicker ticker.Ticker
+
+	// KeyRing is used to re-derive the keys of our addresses in recovery
+	// mode. This is only required if RecoveryWindow is set.
+	KeyRing KeyRing
+
+	// RecoveryWindow is the number of keys of the Taro key family that are
+	// derived beyond the last one found in use when recovering addresses
+	// that were lost from the database. A value of zero disables recovery
+	// mode.
+	//
+	// NOTE: Only addresses with a BIP 86 script key can be recovered, as
+	// the tapscript leaves a script key might commit to aren't known.
+	RecoveryWindow uint32
+
 	// ErrChan is the main error channel the custodian will report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -66,13 +102,44 @@ type Custodian struct {
 	// events about new proofs being imported.
 	proofSubscription *chanutils.EventReceiver[proof.Blob]
 
+	// reOrgSubscription is the subscription queue through which we receive
+	// events about inbound transactions being affected by a chain re-org.
+	reOrgSubscription *chanutils.EventReceiver[*ReOrgEvent]
+
 	// events is a map of all transaction outpoints and their ongoing
 	// address events of inbound assets.
 	events map[wire.OutPoint]*address.Event
 
+	// recoveryKeys maps the x-only serialization of all raw keys derived
+	// in recovery mode to their key descriptors.
+	recoveryKeys map[[32]byte]keychain.KeyDescriptor
+
+	// recoveryScriptKeys maps the x-only serialization of the BIP 86
+	// script keys of all keys derived in recovery mode to the script keys.
+	recoveryScriptKeys map[[32]byte]asset.ScriptKey
+
+	// nextRecoveryIndex is the index of the next key to derive in recovery
+	// mode.
+	nextRecoveryIndex uint32
+
+	// recoveredOutputs is a map of Taproot outputs of our wallet that
+	// don't belong to any address we know of. In recovery mode, these are
+	// the outputs that might belong to a lost address and await the proof
+	// of their inbound transfer.
+	recoveredOutputs map[wire.OutPoint]*lndclient.Transaction
+
+	// recoveryCouriers is the set of x-only serialized script keys derived
+	// in recovery mode that we already receive proofs for.
+	recoveryCouriers map[[32]byte]struct{}
+
 	// newProof is used to deliver a new proof to the custodian.
 	newProof chan *proof.Proof
 
+	// proofConfs is used to deliver inbound transfers that were only
+	// discovered through their proof to the main event loop, once the
+	// chain backend confirmed their anchor transaction.
+	proofConfs chan *proofReceive
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*chanutils.ContextGuard
@@ -86,11 +153,24 @@ func NewCustodian(cfg *CustodianConfig) *Custodian {
 	proofSub := chanutils.NewEventReceiver[proof.Blob](
 		chanutils.DefaultQueueSize,
 	)
+	reOrgSub := chanutils.NewEventReceiver[*ReOrgEvent](
+		chanutils.DefaultQueueSize,
+	)
 	return &Custodian{
 		cfg:               cfg,
 		addrSubscription:  addrSub,
 		proofSubscription: proofSub,
+		reOrgSubscription: reOrgSub,
 		events:            make(map[wire.OutPoint]*address.Event),
+		recoveryKeys: make(
+			map[[32]byte]keychain.KeyDescriptor,
+		),
+		recoveryScriptKeys: make(map[[32]byte]asset.ScriptKey),
+		recoveredOutputs: make(
+			map[wire.OutPoint]*lndclient.Transaction,
+		),
+		recoveryCouriers: make(map[[32]byte]struct{}),
+		proofConfs:       make(chan *proofReceive),
 		ContextGuard: &chanutils.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -130,6 +210,18 @@ func (c *Custodian) Start() error {
 			startErr = err
 			return
 		}
+
+		// If we watch for re-orgs, we want to know about any inbound
+		// transaction that is affected by one.
+		if c.cfg.ReOrgWatcher != nil {
+			err = c.cfg.ReOrgWatcher.RegisterSubscriber(
+				c.reOrgSubscription, false, struct{}{},
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
 	})
 	return startErr
 }
@@ -150,6 +242,15 @@ func (c *Custodian) Stop() error {
 		if err != nil {
 			stopErr = err
 		}
+
+		if c.cfg.ReOrgWatcher != nil {
+			err = c.cfg.ReOrgWatcher.RemoveSubscriber(
+				c.reOrgSubscription,
+			)
+			if err != nil {
+				stopErr = err
+			}
+		}
 	})
 
 	return stopErr
@@ -202,14 +303,61 @@ func (c *Custodian) watchInboundAssets() {
 		}
 
 		c.events[event.Outpoint] = event
+	}
+
+	// Some of the confirmed events might have reached the required number
+	// of confirmations while we were shut down.
+	ctxt, cancel = c.WithCtxQuit()
+	currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctxt)
+	cancel()
+	if err != nil {
+		reportErr(err)
+		return
+	}
+	if _, err := c.promoteFinalEvents(currentHeight); err != nil {
+		reportErr(err)
+		return
+	}
 
-		// Maybe a proof was delivered while we were shutting down or
-		// starting up, let's check now.
-		err = c.checkProofAvailable(event)
+	// Addresses might also have expired while we were shut down.
+	if err := c.retireExpiredAddrs(); err != nil {
+		reportErr(err)
+		return
+	}
+
+	// Transfers to the addresses we already manage that have a dynamic
+	// on-chain output are only discovered through their proofs, so we
+	// need to wait for them again. Addresses that aren't managed yet are
+	// delivered by our subscription.
+	if err := c.startDynamicAddrCouriers(); err != nil {
+		reportErr(err)
+		return
+	}
+
+	// In recovery mode, we need to know the keys our lost addresses might
+	// have used, so we can tell whether an imported proof belongs to one
+	// of them. We also need to look at the whole wallet history, as the
+	// database might not know about any of the events anymore.
+	if c.cfg.RecoveryWindow > 0 {
+		log.Infof("Starting address recovery with a window of %d keys",
+			c.cfg.RecoveryWindow)
+
+		err := c.deriveRecoveryKeys(c.cfg.RecoveryWindow)
 		if err != nil {
 			reportErr(err)
 			return
 		}
+
+		lastDetectHeight = 0
+	}
+
+	// Maybe a proof was delivered while we were shutting down or starting
+	// up, let's check now.
+	for _, event := range c.events {
+		if err := c.checkProofFinal(event); err != nil {
+			reportErr(err)
+			return
+		}
 	}
 
 	// Read all on-chain transactions and make sure they are mapped to an
@@ -238,6 +386,39 @@ func (c *Custodian) watchInboundAssets() {
 		}
 	}
 
+	// We only receive re-org events if we have a watcher, a nil channel
+	// blocks forever otherwise.
+	var reOrgChan <-chan *ReOrgEvent
+	if c.cfg.ReOrgWatcher != nil {
+		reOrgChan = c.reOrgSubscription.NewItemCreated.ChanOut()
+	}
+
+	// If a single confirmation isn't enough for a transaction to be final,
+	// we need to know about new blocks to find out when confirmed events
+	// reach the required depth.
+	var (
+		blockChan    chan int32
+		blockErrChan chan error
+	)
+	if c.numConfs() > 1 {
+		blockChan, blockErrChan, err = c.cfg.ChainBridge.RegisterBlockEpochNtfn(
+			ctxStream,
+		)
+		if err != nil {
+			reportErr(err)
+			return
+		}
+	}
+
+	// We only periodically retire expired addresses if we have a ticker.
+	var expiryTickChan <-chan time.Time
+	if c.cfg.ExpiryTicker != nil {
+		c.cfg.ExpiryTicker.Resume()
+		defer c.cfg.ExpiryTicker.Stop()
+
+		expiryTickChan = c.cfg.ExpiryTicker.Ticks()
+	}
+
 	log.Infof("Starting main custodian event loop")
 	for {
 		var err error
@@ -251,9 +432,24 @@ func (c *Custodian) watchInboundAssets() {
 		case newProof := <-c.proofSubscription.NewItemCreated.ChanOut():
 			err = c.mapProofToEvent(newProof)
 
+		case receive := <-c.proofConfs:
+			err = c.receiveConfirmedProof(receive)
+
+		case reOrg := <-reOrgChan:
+			err = c.handleReOrg(reOrg)
+
+		case height := <-blockChan:
+			err = c.handleNewBlock(uint32(height))
+
+		case <-expiryTickChan:
+			err = c.retireExpiredAddrs()
+
 		case err = <-txErrChan:
 			break
 
+		case err = <-blockErrChan:
+			break
+
 		case <-c.Quit:
 			return
 		}
@@ -298,8 +494,7 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 				var err error
 				ctxt, cancel := c.CtxBlocking()
 				event, err = c.cfg.AddrBook.GetOrCreateEvent(
-					ctxt,
-					address.StatusTransactionConfirmed,
+					ctxt, c.eventStatus(walletTx),
 					event.Addr, walletTx, uint32(idx), nil,
 				)
 				cancel()
@@ -309,6 +504,14 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 				}
 
 				c.events[op] = event
+				c.watchForReOrg(walletTx)
+
+				// The proof might have arrived before the
+				// transaction was final.
+				err = c.checkProofFinal(event)
+				if err != nil {
+					return err
+				}
 			}
 
 			continue
@@ -321,43 +524,142 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 			return err
 		}
 
-		if c.cfg.ProofCourier == nil || addr == nil {
+		// The output might belong to a lost address in recovery mode,
+		// so we need to receive the proofs sent to any of the keys
+		// that address could have used.
+		if addr == nil {
+			if _, ok := c.recoveredOutputs[op]; ok {
+				c.startRecoveryCouriers()
+			}
+
 			continue
 		}
 
-		// Now that we've seen this output on chain, we'll launch a
-		// goroutine to use the ProofCourier to import the proof into
-		// our local DB.
-		c.Wg.Add(1)
-		go func() {
-			defer c.Wg.Done()
+		// Now that we've seen this output on chain, we'll use the
+		// ProofCourier to import the proof into our local DB.
+		c.startProofCourier(addr, false)
+	}
 
-			ctx, cancel := c.WithCtxQuitNoTimeout()
-			defer cancel()
+	return nil
+}
+
+// startProofCourier launches a goroutine that uses the ProofCourier, if there
+// is one, to receive the proof of an inbound transfer to the given address and
+// to import it into our local DB. If multiple transfers are expected, we keep
+// receiving proofs until we shut down.
+func (c *Custodian) startProofCourier(addr *address.Taro, multiple bool) {
+	if c.cfg.ProofCourier == nil {
+		return
+	}
+
+	// The asset ID of an asset sent to a family address is only known
+	// from its proof.
+	locator := proof.Locator{
+		FamilyKey: addr.FamilyKey,
+		ScriptKey: addr.ScriptKey,
+	}
+	if !addr.IsFamilyAddr() {
+		assetID := addr.ID()
+		locator.AssetID = &assetID
+	}
+
+	c.receiveProofs(*addr, locator, multiple)
+}
+
+// startRecoveryCouriers uses the ProofCourier, if there is one, to receive the
+// proofs of inbound transfers to addresses that were lost from the database.
+// We can't tell which of the keys derived in recovery mode a lost address used
+// as its script key, so we receive the proofs sent to any of them that we
+// don't receive proofs for yet. The asset of such a transfer is only known
+// from its proof.
+func (c *Custodian) startRecoveryCouriers() {
+	if c.cfg.ProofCourier == nil {
+		return
+	}
+
+	for xOnly, scriptKey := range c.recoveryScriptKeys {
+		if _, ok := c.recoveryCouriers[xOnly]; ok {
+			continue
+		}
+		c.recoveryCouriers[xOnly] = struct{}{}
+
+		addr := address.Taro{
+			ScriptKey: *scriptKey.PubKey,
+		}
+		locator := proof.Locator{
+			ScriptKey: *scriptKey.PubKey,
+		}
+		c.receiveProofs(addr, locator, true)
+	}
+}
 
-			assetID := addr.ID()
+// receiveProofs launches a goroutine that uses the ProofCourier to receive the
+// proof identified by the given locator from the source encapsulated within the
+// given address and to import it into our local DB. If multiple transfers are
+// expected, we keep receiving proofs until we shut down.
+func (c *Custodian) receiveProofs(addr address.Taro, locator proof.Locator,
+	multiple bool) {
+
+	c.Wg.Add(1)
+	go func() {
+		defer c.Wg.Done()
+
+		for {
+			ctx, cancel := c.WithCtxQuitNoTimeout()
 			proof, err := c.cfg.ProofCourier.ReceiveProof(
-				ctx, *addr, proof.Locator{
-					AssetID:   &assetID,
-					ScriptKey: addr.ScriptKey,
-				},
+				ctx, addr, locator,
 			)
+			cancel()
 			if err != nil {
-				log.Errorf("unable to recv proof: %v", err)
+				select {
+				case <-c.Quit:
+				default:
+					log.Errorf("unable to recv proof: %v",
+						err)
+				}
 				return
 			}
 
 			ctx, cancel = c.CtxBlocking()
-			defer cancel()
-
 			err = c.cfg.ProofArchive.ImportProofs(ctx, proof)
+			cancel()
 			if err != nil {
 				log.Errorf("unable to import proofs: %v", err)
 				return
 			}
 
-			return
-		}()
+			if !multiple {
+				return
+			}
+		}
+	}()
+}
+
+// startDynamicAddrCouriers starts receiving the proofs of inbound transfers to
+// all managed addresses whose on-chain output can't be known in advance. We
+// only learn about transfers to those addresses through their proofs.
+func (c *Custodian) startDynamicAddrCouriers() error {
+	if c.cfg.ProofCourier == nil {
+		return nil
+	}
+
+	ctxt, cancel := c.WithCtxQuit()
+	addrs, err := c.cfg.AddrBook.ListAddrs(ctxt, address.QueryParams{})
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error querying addresses: %w", err)
+	}
+
+	now := time.Now()
+	for idx := range addrs {
+		addr := addrs[idx]
+		if addr.ManagedAfter.IsZero() || !addr.HasDynamicOutput() ||
+			addr.IsExpired(now) {
+
+			continue
+		}
+
+		c.startProofCourier(addr.Taro, !addr.SingleUse)
 	}
 
 	return nil
@@ -379,8 +681,30 @@ func (c *Custodian) mapToTaroAddr(walletTx *lndclient.Transaction,
 	cancel()
 	switch {
 	// There is no Taro address that expects an asset for the given on-chain
-	// output. This probably wasn't a Taro transaction at all then.
+	// output. This probably wasn't a Taro transaction at all then, unless
+	// we're recovering addresses that were lost. Whether the output
+	// belongs to one of them can only be told from the proof of the
+	// transfer, which reveals the keys the output commits to.
 	case errors.Is(err, address.ErrNoAddr):
+		if c.cfg.RecoveryWindow == 0 {
+			return nil, nil
+		}
+
+		// We store the output, so it's listed as an event that
+		// awaits its proof, even across restarts.
+		log.Debugf("Output %v awaits proof for address recovery", op)
+
+		ctxt, cancel := c.CtxBlocking()
+		err := c.cfg.AddrBook.InsertRecoveryOutput(
+			ctxt, walletTx, outputIdx,
+		)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("unable to store recovery "+
+				"output: %w", err)
+		}
+		c.recoveredOutputs[op] = walletTx
+
 		return nil, nil
 
 	case err != nil:
@@ -398,15 +722,21 @@ func (c *Custodian) mapToTaroAddr(walletTx *lndclient.Transaction,
 	// a Taro address.
 	log.Infof("Found inbound asset transfer for Taro address %s in %s",
 		addrStr, op.String())
-	status := address.StatusTransactionDetected
-	if walletTx.Confirmations > 0 {
-		status = address.StatusTransactionConfirmed
+
+	// The address might not accept any transfers anymore, in which case
+	// we only record the late payment so it can be refunded.
+	isLate, err := c.isLatePayment(addr, walletTx, op)
+	if err != nil {
+		return nil, err
+	}
+	if isLate {
+		return nil, c.recordLatePayment(addr, walletTx, op, nil)
 	}
 
 	// Block here, a shutdown can wait on this operation.
 	ctxt, cancel = c.CtxBlocking()
 	event, err := c.cfg.AddrBook.GetOrCreateEvent(
-		ctxt, status, addr, walletTx, outputIdx, nil,
+		ctxt, c.eventStatus(walletTx), addr, walletTx, outputIdx, nil,
 	)
 	cancel()
 	if err != nil {
@@ -415,10 +745,142 @@ func (c *Custodian) mapToTaroAddr(walletTx *lndclient.Transaction,
 
 	// Let's update our cache of ongoing events.
 	c.events[op] = event
+	c.watchForReOrg(walletTx)
+
+	// A single-use address has now served its purpose.
+	if addr.SingleUse {
+		if err := c.retireAddr(addr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.checkProofFinal(event); err != nil {
+		return nil, err
+	}
 
 	return addr.Taro, nil
 }
 
+// isLatePayment returns true if the given inbound transfer was sent to an
+// address after it expired or, in case of a single-use address, after the
+// address already received a different transfer. A transfer that was already
+// accepted before is never considered late.
+func (c *Custodian) isLatePayment(addr *address.AddrWithKeyInfo,
+	walletTx *lndclient.Transaction, op wire.OutPoint) (bool, error) {
+
+	if !addr.SingleUse && addr.ExpiryTime.IsZero() {
+		return false, nil
+	}
+
+	ctxt, cancel := c.WithCtxQuit()
+	events, err := c.cfg.AddrBook.QueryEvents(
+		ctxt, address.EventQueryParams{
+			AddrTaprootOutputKey: schnorr.SerializePubKey(
+				&addr.TaprootOutputKey,
+			),
+		},
+	)
+	cancel()
+	if err != nil {
+		return false, fmt.Errorf("error querying address events: %w",
+			err)
+	}
+
+	var used bool
+	for _, event := range events {
+		if event.Status == address.StatusLatePayment {
+			continue
+		}
+
+		if event.Outpoint == op {
+			return false, nil
+		}
+
+		used = true
+	}
+
+	if addr.SingleUse && used {
+		return true, nil
+	}
+
+	// We use the time the wallet first saw the transaction, so the
+	// decision doesn't change when inspecting the same transaction again.
+	receiveTime := walletTx.Timestamp
+	if receiveTime.IsZero() {
+		receiveTime = time.Now()
+	}
+
+	return addr.IsExpired(receiveTime), nil
+}
+
+// recordLatePayment creates or updates the event of an inbound transfer that
+// arrived too late. We don't take custody of the assets of such a transfer, so
+// the event isn't tracked any further.
+func (c *Custodian) recordLatePayment(addr *address.AddrWithKeyInfo,
+	walletTx *lndclient.Transaction, op wire.OutPoint,
+	tapscriptSibling *chainhash.Hash) error {
+
+	log.Warnf("Inbound asset transfer in %v arrived after Taro address "+
+		"with output key %x expired or was used, needs to be refunded",
+		op, schnorr.SerializePubKey(&addr.TaprootOutputKey))
+
+	// Block here, a shutdown can wait on this operation.
+	ctxt, cancel := c.CtxBlocking()
+	_, err := c.cfg.AddrBook.GetOrCreateEvent(
+		ctxt, address.StatusLatePayment, addr, walletTx, op.Index,
+		tapscriptSibling,
+	)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error creating event: %w", err)
+	}
+
+	return c.retireAddr(addr)
+}
+
+// retireAddr marks the given address as no longer being managed, as it doesn't
+// accept any inbound transfers anymore. The address won't be imported into the
+// wallet again after a restart.
+func (c *Custodian) retireAddr(addr *address.AddrWithKeyInfo) error {
+	if addr.ManagedAfter.IsZero() {
+		return nil
+	}
+
+	// Let's not be interrupted by a shutdown.
+	ctxt, cancel := c.CtxBlocking()
+	err := c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Time{})
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to retire address: %w", err)
+	}
+	addr.ManagedAfter = time.Time{}
+
+	log.Infof("Retired Taro address with output key %x",
+		schnorr.SerializePubKey(&addr.TaprootOutputKey))
+
+	return nil
+}
+
+// retireExpiredAddrs retires all managed addresses that expired.
+func (c *Custodian) retireExpiredAddrs() error {
+	ctxt, cancel := c.WithCtxQuit()
+	addrs, err := c.cfg.AddrBook.ListAddrs(ctxt, address.QueryParams{
+		ExpiredBefore: time.Now(),
+	})
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error querying expired addresses: %w", err)
+	}
+
+	for idx := range addrs {
+		if err := c.retireAddr(&addrs[idx]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // importAddrToWallet imports the given Taro address into the lnd-internal
 // btcwallet instance by tracking the on-chain Taproot output key the assets
 // must be sent to in order to be received.
@@ -428,49 +890,76 @@ func (c *Custodian) importAddrToWallet(addr *address.AddrWithKeyInfo) error {
 		return fmt.Errorf("unable to encode address: %w", err)
 	}
 
+	// There's no point in watching an address that expired already.
+	if addr.IsExpired(time.Now()) {
+		log.Debugf("Not importing expired Taro address %v", addrStr)
+		return nil
+	}
+
+	// The on-chain output of a transfer to a family address depends on
+	// the asset ID chosen by the sender, and the output of a transfer to
+	// an address without an exact amount depends on the amount the sender
+	// chose. So there's nothing we could watch before we receive the proof
+	// of the transfer.
+	switch {
+	case addr.HasDynamicOutput():
+		log.Infof("Waiting for proofs of Taro address %v", addrStr)
+
+		c.startProofCourier(addr.Taro, !addr.SingleUse)
+
+	default:
+		err := c.importTaprootOutput(&addr.TaprootOutputKey)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("Imported Taro address %v into wallet", addrStr)
+	}
+
+	// Let's not be interrupted by a shutdown.
+	ctxt, cancel := c.CtxBlocking()
+	defer cancel()
+
+	return c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Now())
+}
+
+// importTaprootOutput imports the given Taproot output key into the
+// lnd-internal btcwallet instance, so the output is tracked on chain.
+func (c *Custodian) importTaprootOutput(outputKey *btcec.PublicKey) error {
 	// Let's not be interrupted by a shutdown.
 	ctxt, cancel := c.CtxBlocking()
 	defer cancel()
 
 	p2trAddr, err := c.cfg.WalletAnchor.ImportTaprootOutput(
-		ctxt, &addr.TaprootOutputKey,
+		ctxt, outputKey,
 	)
 	switch {
 	case err == nil:
-		log.Warnf("Taproot addr %v was already added to "+
-			"wallet before, skipping", p2trAddr.String())
 		break
 
 	// On restart, we'll get an error that the output has already
 	// been added to the wallet, so we'll catch this now and move
 	// along if so.
 	case strings.Contains(err.Error(), "already exists"):
-		break
+		log.Warnf("Taproot output key %x was already added to "+
+			"wallet before, skipping",
+			schnorr.SerializePubKey(outputKey))
+		return nil
 
-	case err != nil:
+	default:
 		return err
 	}
 
-	log.Infof("Imported Taro address %v into wallet, watching p2tr "+
-		"address %v on chain", addrStr, p2trAddr.String())
+	log.Infof("Watching p2tr address %v on chain", p2trAddr.String())
 
-	return c.cfg.AddrBook.SetAddrManaged(ctxt, addr, time.Now())
+	return nil
 }
 
 // checkProofAvailable checks the proof storage if a proof for the given event
 // is already available. If it is, and it checks out, the event is updated.
 func (c *Custodian) checkProofAvailable(event *address.Event) error {
-	ctxt, cancel := c.WithCtxQuit()
-	defer cancel()
-
 	// TODO(roasbeef): use the courier here?
-
-	id := event.Addr.ID()
-	blob, err := c.cfg.ProofArchive.FetchProof(ctxt, proof.Locator{
-		AssetID:   &id,
-		FamilyKey: event.Addr.FamilyKey,
-		ScriptKey: event.Addr.ScriptKey,
-	})
+	blob, err := c.fetchAddrProof(event.Addr)
 	switch {
 	case errors.Is(err, proof.ErrProofNotFound):
 		return nil
@@ -503,6 +992,41 @@ func (c *Custodian) checkProofAvailable(event *address.Event) error {
 	return nil
 }
 
+// fetchAddrProof fetches the proof of the asset received with the given
+// address from the proof archive. The asset ID of an asset received with a
+// family address is only known from its proof, so we look through the proofs
+// of all assets of the family in that case.
+func (c *Custodian) fetchAddrProof(
+	addr *address.AddrWithKeyInfo) (proof.Blob, error) {
+
+	ctxt, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	if !addr.IsFamilyAddr() {
+		id := addr.ID()
+		return c.cfg.ProofArchive.FetchProof(ctxt, proof.Locator{
+			AssetID:   &id,
+			FamilyKey: addr.FamilyKey,
+			ScriptKey: addr.ScriptKey,
+		})
+	}
+
+	familyProofs, err := c.cfg.ProofArchive.FetchProofs(
+		ctxt, nil, addr.FamilyKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range familyProofs {
+		if p.ScriptKey.IsEqual(&addr.ScriptKey) {
+			return p.Blob, nil
+		}
+	}
+
+	return nil, proof.ErrProofNotFound
+}
+
 // mapProofToEvent inspects a new proof and attempts to match it to an existing
 // and pending address event. If a proof successfully matches the desired state
 // of the address, that completes the inbound transfer of an asset.
@@ -539,26 +1063,825 @@ func (c *Custodian) mapProofToEvent(p proof.Blob) error {
 		}
 	}
 
-	return nil
-}
-
-// setReceiveCompleted updates the address event in the database to mark it as
-// completed successfully and to link it to the proof we received.
-func (c *Custodian) setReceiveCompleted(event *address.Event,
-	p proof.Proof) error {
-
-	// Let's not be interrupted by a shutdown.
-	ctxt, cancel := c.CtxBlocking()
-	defer cancel()
+	// None of our in-flight events match. But the asset might have been
+	// sent to one of our family or payment request addresses, for which we
+	// can't know the on-chain output before we know the asset ID and
+	// amount the sender chose.
+	isDynamicReceive, err := c.mapProofToDynamicAddr(lastProof)
+	if err != nil || isDynamicReceive {
+		return err
+	}
 
-	anchorPoint := wire.OutPoint{
-		Hash:  p.AnchorTx.TxHash(),
-		Index: p.InclusionProof.OutputIndex,
+	// Or it might have been sent to one of our addresses in an output that
+	// also commits to a tapscript sibling, which we can't detect on chain
+	// before we know the sibling.
+	if err := c.mapProofToSiblingOutput(lastProof); err != nil {
+		return err
 	}
 
-	return c.cfg.AddrBook.CompleteEvent(
-		ctxt, event, address.StatusCompleted, anchorPoint,
-	)
+	// Finally, it might have been sent to an address that was lost from
+	// the database and that we're trying to recover.
+	return c.mapProofToRecoveredOutput(lastProof)
+}
+
+// deriveRecoveryKeys derives the keys of the Taro key family up to the given
+// index, if they weren't derived before, and keeps them in memory along with
+// their BIP 86 script keys. Every key derived by the key ring could have been
+// used as either the internal key or the raw script key of a lost address. Only
+// the keys of recovered addresses are stored in the database.
+func (c *Custodian) deriveRecoveryKeys(endIndex uint32) error {
+	if endIndex <= c.nextRecoveryIndex {
+		return nil
+	}
+
+	log.Debugf("Deriving recovery keys from index %d to %d",
+		c.nextRecoveryIndex, endIndex)
+
+	for ; c.nextRecoveryIndex < endIndex; c.nextRecoveryIndex++ {
+		ctxt, cancel := c.WithCtxQuit()
+		keyDesc, err := c.cfg.KeyRing.DeriveKey(
+			ctxt, keychain.KeyLocator{
+				Family: TaroKeyFamily,
+				Index:  c.nextRecoveryIndex,
+			},
+		)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to derive key: %w", err)
+		}
+
+		scriptKey := asset.NewScriptKeyBIP0086(keyDesc)
+		c.recoveryKeys[xOnlyKey(keyDesc.PubKey)] = keyDesc
+		c.recoveryScriptKeys[xOnlyKey(scriptKey.PubKey)] = scriptKey
+	}
+
+	// If there are outputs waiting for their proof already, the proof
+	// might also be sent to one of the new keys.
+	if len(c.recoveredOutputs) > 0 {
+		c.startRecoveryCouriers()
+	}
+
+	return nil
+}
+
+// mapProofToRecoveredOutput checks whether the given proof reveals that an
+// asset was sent to an address that was lost from the database. This is the
+// case if the asset is anchored in one of the wallet outputs we couldn't map
+// to any address and both the internal key of the output and the raw script
+// key of the asset were derived in recovery mode. If so, the address is
+// reconstructed and the inbound transfer completed.
+//
+// NOTE: The amount policy, memo and other metadata of a lost address can't be
+// recovered, so the address is reconstructed as one that requests exactly the
+// received asset.
+func (c *Custodian) mapProofToRecoveredOutput(p *proof.Proof) error {
+	op := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: p.InclusionProof.OutputIndex,
+	}
+	walletTx, ok := c.recoveredOutputs[op]
+	if !ok || p.InclusionProof.InternalKey == nil {
+		return nil
+	}
+
+	// The output might have been mapped to an address in the meantime.
+	if _, ok := c.events[op]; ok {
+		return c.removeRecoveredOutput(op)
+	}
+
+	internalKeyDesc, ok := c.recoveryKeys[xOnlyKey(
+		p.InclusionProof.InternalKey,
+	)]
+	if !ok {
+		return nil
+	}
+	scriptKey, ok := c.recoveryScriptKeys[xOnlyKey(
+		p.Asset.ScriptKey.PubKey,
+	)]
+	if !ok {
+		return nil
+	}
+
+	// The keys we find in use tell us which part of the key family was
+	// used, so we make sure to look ahead of them for further addresses.
+	lastIndex := internalKeyDesc.Index
+	if scriptKey.RawKey.Index > lastIndex {
+		lastIndex = scriptKey.RawKey.Index
+	}
+	err := c.deriveRecoveryKeys(lastIndex + 1 + c.cfg.RecoveryWindow)
+	if err != nil {
+		return err
+	}
+
+	var famKey *btcec.PublicKey
+	if p.Asset.FamilyKey != nil {
+		famKey = &p.Asset.FamilyKey.FamKey
+	}
+	taroAddr, err := address.New(
+		p.Asset.Genesis, famKey, *scriptKey.PubKey,
+		*internalKeyDesc.PubKey, p.Asset.Amount, c.cfg.ChainParams,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to reconstruct addr: %w", err)
+	}
+	taprootOutputKey, err := taroAddr.TaprootOutputKey(nil)
+	if err != nil {
+		return fmt.Errorf("unable to derive Taproot output key: %w",
+			err)
+	}
+
+	addr := &address.AddrWithKeyInfo{
+		Taro:             taroAddr,
+		ScriptKeyTweak:   *scriptKey.TweakedScriptKey,
+		InternalKeyDesc:  internalKeyDesc,
+		TaprootOutputKey: *taprootOutputKey,
+		CreationTime:     walletTx.Timestamp,
+	}
+
+	ctxt, cancel := c.CtxBlocking()
+	_, err = c.cfg.AddrBook.RestoreAddrs(
+		ctxt, []address.AddrWithKeyInfo{*addr},
+	)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to store recovered addr: %w", err)
+	}
+
+	var sibling *chainhash.Hash
+	commitmentProof := p.InclusionProof.CommitmentProof
+	if commitmentProof != nil &&
+		!commitmentProof.TapSiblingPreimage.IsEmpty() {
+
+		sibling, err = commitmentProof.TapSiblingPreimage.TapHash()
+		if err != nil {
+			return fmt.Errorf("error hashing tapscript sibling: %w",
+				err)
+		}
+	}
+
+	outputKey, _, err := p.InclusionProof.DeriveByAssetInclusion(&p.Asset)
+	if err != nil {
+		return fmt.Errorf("error deriving taproot key: %w", err)
+	}
+
+	assetID := p.Asset.ID()
+	log.Infof("Recovered Taro address with script key %x for inbound "+
+		"transfer of asset_id=%x, amount=%d in %v",
+		addr.ScriptKey.SerializeCompressed(), assetID[:],
+		p.Asset.Amount, op)
+
+	return c.receiveFromProof(addr, p, op, outputKey, sibling)
+}
+
+// removeRecoveredOutput removes a wallet output that awaited its proof in
+// recovery mode, once it's clear which address it belongs to. The output might
+// have been stored in an earlier run in recovery mode, so we always remove it
+// from the database.
+func (c *Custodian) removeRecoveredOutput(op wire.OutPoint) error {
+	ctxt, cancel := c.CtxBlocking()
+	err := c.cfg.AddrBook.DeleteRecoveryOutput(ctxt, op)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to delete recovery output: %w", err)
+	}
+
+	delete(c.recoveredOutputs, op)
+
+	return nil
+}
+
+// mapProofToDynamicAddr checks whether the given proof reveals that an asset
+// was sent to one of our addresses whose on-chain output can't be known in
+// advance, which are family addresses and addresses that don't require an
+// exact amount. If so, we create the address event for the output
+// retroactively, import the output into the wallet and complete the inbound
+// transfer. True is returned if the proof belongs to a transfer to one of
+// those addresses.
+func (c *Custodian) mapProofToDynamicAddr(p *proof.Proof) (bool, error) {
+	// The script key of each address is unique, so we can use it to look
+	// up the address.
+	ctxt, cancel := c.WithCtxQuit()
+	addr, err := c.cfg.AddrBook.AddrByScriptKey(
+		ctxt, p.Asset.ScriptKey.PubKey,
+	)
+	cancel()
+	switch {
+	case errors.Is(err, address.ErrNoAddr):
+		return false, nil
+
+	case err != nil:
+		return false, fmt.Errorf("error querying addresses by script "+
+			"key: %w", err)
+	}
+
+	if !addr.HasDynamicOutput() || !AddrMatchesAsset(addr, &p.Asset) {
+		return false, nil
+	}
+
+	var sibling *chainhash.Hash
+	commitmentProof := p.InclusionProof.CommitmentProof
+	if commitmentProof != nil &&
+		!commitmentProof.TapSiblingPreimage.IsEmpty() {
+
+		sibling, err = commitmentProof.TapSiblingPreimage.TapHash()
+		if err != nil {
+			return false, fmt.Errorf("error hashing tapscript "+
+				"sibling: %w", err)
+		}
+	}
+
+	outputKey, _, err := p.InclusionProof.DeriveByAssetInclusion(&p.Asset)
+	if err != nil {
+		return false, fmt.Errorf("error deriving taproot key: %w", err)
+	}
+
+	op := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: p.InclusionProof.OutputIndex,
+	}
+	assetID := p.Asset.ID()
+	log.Infof("Found inbound transfer of asset_id=%x, amount=%d for Taro "+
+		"address with script key %x in %v", assetID[:], p.Asset.Amount,
+		addr.ScriptKey.SerializeCompressed(), op)
+
+	return true, c.receiveFromProof(addr, p, op, outputKey, sibling)
+}
+
+// mapProofToSiblingOutput checks whether the given proof reveals that an asset
+// was sent to one of our addresses in an on-chain output that commits to a
+// tapscript sibling next to the Taro commitment. If so, we create the address
+// event for the output retroactively, import the output into the wallet and
+// complete the inbound transfer.
+func (c *Custodian) mapProofToSiblingOutput(p *proof.Proof) error {
+	commitmentProof := p.InclusionProof.CommitmentProof
+	if commitmentProof == nil ||
+		commitmentProof.TapSiblingPreimage.IsEmpty() {
+
+		return nil
+	}
+
+	sibling, err := commitmentProof.TapSiblingPreimage.TapHash()
+	if err != nil {
+		return fmt.Errorf("error hashing tapscript sibling: %w", err)
+	}
+
+	// Our addresses commit to the Taro commitment as the only leaf of the
+	// output, so we'll look up the address by the output key without the
+	// sibling.
+	outputKey, taroCommitment, err := p.InclusionProof.DeriveByAssetInclusion(
+		&p.Asset,
+	)
+	if err != nil {
+		return fmt.Errorf("error deriving taproot key: %w", err)
+	}
+	taroRoot := taroCommitment.TapscriptRoot(nil)
+	addrKey := txscript.ComputeTaprootOutputKey(
+		p.InclusionProof.InternalKey, taroRoot[:],
+	)
+
+	ctxt, cancel := c.WithCtxQuit()
+	addr, err := c.cfg.AddrBook.AddrByTaprootOutput(ctxt, addrKey)
+	cancel()
+	switch {
+	case errors.Is(err, address.ErrNoAddr):
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("error querying addresses by taro key: %w",
+			err)
+	}
+
+	if !AddrMatchesAsset(addr, &p.Asset) {
+		return nil
+	}
+
+	op := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: p.InclusionProof.OutputIndex,
+	}
+	log.Infof("Found inbound asset transfer with tapscript sibling %x "+
+		"for Taro address with output key %x in %v", sibling[:],
+		schnorr.SerializePubKey(&addr.TaprootOutputKey), op)
+
+	return c.receiveFromProof(addr, p, op, outputKey, sibling)
+}
+
+// proofReceive is an inbound asset transfer that was only discovered through
+// its proof. Such a transfer is only accepted once we know its anchor
+// transaction confirmed on chain, either from the wallet or from the chain
+// backend.
+type proofReceive struct {
+	// addr is the address the assets were sent to.
+	addr *address.AddrWithKeyInfo
+
+	// proof is the last proof of the transfer.
+	proof *proof.Proof
+
+	// outPoint is the on-chain output the assets are anchored in.
+	outPoint wire.OutPoint
+
+	// sibling is the optional tapscript sibling the output commits to.
+	sibling *chainhash.Hash
+
+	// conf is the confirmation of the anchor transaction as reported by
+	// the chain backend.
+	conf *chainntnfs.TxConfirmation
+}
+
+// receiveFromProof imports the on-chain output of an inbound asset transfer
+// that was only discovered through its proof into the wallet. If the wallet
+// already knows the confirmed anchor transaction, the address event is created
+// and the transfer completed right away. Otherwise, we wait for the chain
+// backend to confirm the anchor transaction first.
+func (c *Custodian) receiveFromProof(addr *address.AddrWithKeyInfo,
+	p *proof.Proof, op wire.OutPoint, outputKey *btcec.PublicKey,
+	sibling *chainhash.Hash) error {
+
+	// If the output was found in recovery mode, it doesn't await its
+	// proof anymore.
+	if err := c.removeRecoveredOutput(op); err != nil {
+		return err
+	}
+
+	// The wallet should watch the actual output from now on, so the
+	// assets can be spent later. We do this first, so the wallet can pick
+	// up the anchor transaction when rescanning.
+	if err := c.importTaprootOutput(outputKey); err != nil {
+		return err
+	}
+
+	receive := &proofReceive{
+		addr:     addr,
+		proof:    p,
+		outPoint: op,
+		sibling:  sibling,
+	}
+
+	walletTx, err := c.findConfirmedWalletTx(op, p.BlockHeight)
+	if err != nil {
+		return err
+	}
+
+	// The proof alone doesn't tell us whether the anchor transaction is
+	// still in the chain. So if the wallet doesn't know about it (yet), we
+	// ask the chain backend to tell us once it's confirmed.
+	if walletTx == nil {
+		return c.waitForProofConf(receive)
+	}
+
+	return c.receiveWalletTx(receive, walletTx)
+}
+
+// findConfirmedWalletTx looks up the confirmed wallet transaction that created
+// the given output, starting at the given height. If the wallet doesn't know
+// the transaction or it isn't confirmed, nil is returned.
+func (c *Custodian) findConfirmedWalletTx(op wire.OutPoint,
+	startHeight uint32) (*lndclient.Transaction, error) {
+
+	ctxt, cancel := c.WithCtxQuit()
+	walletTxns, err := c.cfg.WalletAnchor.ListTransactions(
+		ctxt, int32(startHeight), -1, "",
+	)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("error listing wallet transactions: %w",
+			err)
+	}
+
+	for idx := range walletTxns {
+		walletTx := walletTxns[idx]
+		if walletTx.Tx == nil || walletTx.Tx.TxHash() != op.Hash ||
+			len(walletTx.OutputDetails) <= int(op.Index) {
+
+			continue
+		}
+
+		if walletTx.Confirmations > 0 {
+			return &walletTx, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// waitForProofConf registers for the confirmation of the anchor transaction
+// of the given inbound transfer and hands the transfer back to the main event
+// loop once the transaction is confirmed.
+func (c *Custodian) waitForProofConf(receive *proofReceive) error {
+	anchorTx := &receive.proof.AnchorTx
+	if int(receive.outPoint.Index) >= len(anchorTx.TxOut) {
+		return fmt.Errorf("invalid anchor output index %d",
+			receive.outPoint.Index)
+	}
+	pkScript := anchorTx.TxOut[receive.outPoint.Index].PkScript
+
+	confCtx, confCancel := c.WithCtxQuitNoTimeout()
+	confNtfn, errChan, err := c.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &receive.outPoint.Hash, pkScript, 1,
+		receive.proof.BlockHeight, true,
+	)
+	if err != nil {
+		confCancel()
+		return fmt.Errorf("unable to register for anchor tx conf: %w",
+			err)
+	}
+
+	log.Infof("Waiting for confirmation of inbound asset transfer in %v",
+		receive.outPoint)
+
+	c.Wg.Add(1)
+	go func() {
+		defer c.Wg.Done()
+		defer confCancel()
+
+		select {
+		case conf, ok := <-confNtfn.Confirmed:
+			if !ok || conf == nil {
+				log.Warnf("Conf ntfn for inbound asset "+
+					"transfer in %v closed",
+					receive.outPoint)
+				return
+			}
+			receive.conf = conf
+
+		case err := <-errChan:
+			select {
+			case c.cfg.ErrChan <- fmt.Errorf("error waiting for "+
+				"anchor tx conf: %w", err):
+			case <-c.Quit:
+			}
+			return
+
+		case <-c.Quit:
+			return
+		}
+
+		select {
+		case c.proofConfs <- receive:
+		case <-c.Quit:
+		}
+	}()
+
+	return nil
+}
+
+// receiveConfirmedProof completes an inbound transfer that was only discovered
+// through its proof once the chain backend confirmed its anchor transaction.
+func (c *Custodian) receiveConfirmedProof(receive *proofReceive) error {
+	conf := receive.conf
+	if conf.Tx == nil || conf.Tx.TxHash() != receive.outPoint.Hash {
+		return fmt.Errorf("invalid confirmation for anchor tx %v",
+			receive.outPoint.Hash)
+	}
+
+	// The wallet might have found the transaction in the meantime, in
+	// which case we prefer its view.
+	walletTx, err := c.findConfirmedWalletTx(
+		receive.outPoint, conf.BlockHeight,
+	)
+	if err != nil {
+		return err
+	}
+	if walletTx != nil {
+		return c.receiveWalletTx(receive, walletTx)
+	}
+
+	ctxt, cancel := c.WithCtxQuit()
+	currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctxt)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error querying current height: %w",
+			err)
+	}
+
+	// Otherwise, we describe the output as the wallet would, using the
+	// transaction and block the chain backend confirmed.
+	walletTx = newWalletTx(conf.Tx, receive.outPoint.Index)
+	walletTx.Confirmations = 1
+	if currentHeight > conf.BlockHeight {
+		walletTx.Confirmations += int32(currentHeight - conf.BlockHeight)
+	}
+	walletTx.BlockHash = conf.BlockHash.String()
+	walletTx.BlockHeight = int32(conf.BlockHeight)
+	if conf.Block != nil {
+		walletTx.Timestamp = conf.Block.Header.Timestamp
+	}
+
+	return c.receiveWalletTx(receive, walletTx)
+}
+
+// receiveWalletTx creates the address event for an inbound transfer that was
+// only discovered through its proof, using the given confirmed wallet
+// transaction, and completes the transfer.
+func (c *Custodian) receiveWalletTx(receive *proofReceive,
+	walletTx *lndclient.Transaction) error {
+
+	addr, op := receive.addr, receive.outPoint
+	isLate, err := c.isLatePayment(addr, walletTx, op)
+	if err != nil {
+		return err
+	}
+	if isLate {
+		return c.recordLatePayment(addr, walletTx, op, receive.sibling)
+	}
+
+	// Block here, a shutdown can wait on this operation.
+	ctxt, cancel := c.CtxBlocking()
+	event, err := c.cfg.AddrBook.GetOrCreateEvent(
+		ctxt, c.eventStatus(walletTx), addr, walletTx, op.Index,
+		receive.sibling,
+	)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error creating event: %w", err)
+	}
+	c.events[op] = event
+	c.watchForReOrg(walletTx)
+
+	if addr.SingleUse {
+		if err := c.retireAddr(addr); err != nil {
+			return err
+		}
+	}
+
+	return c.setReceiveCompleted(event, *receive.proof)
+}
+
+// newWalletTx describes the given on-chain transaction as the wallet would if
+// it was unconfirmed and only had a single output that belongs to our wallet.
+func newWalletTx(tx *wire.MsgTx, ourOutput uint32) *lndclient.Transaction {
+	walletTx := &lndclient.Transaction{
+		Tx:     tx,
+		TxHash: tx.TxHash().String(),
+	}
+	for idx, txOut := range tx.TxOut {
+		walletTx.OutputDetails = append(
+			walletTx.OutputDetails, &lnrpc.OutputDetail{
+				OutputType:   lnrpc.OutputScriptType_SCRIPT_TYPE_WITNESS_V1_TAPROOT,
+				PkScript:     hex.EncodeToString(txOut.PkScript),
+				OutputIndex:  int64(idx),
+				Amount:       txOut.Value,
+				IsOurAddress: uint32(idx) == ourOutput,
+			},
+		)
+	}
+
+	return walletTx
+}
+
+// watchForReOrg hands the given confirmed wallet transaction over to the
+// re-org watcher, if we have one.
+func (c *Custodian) watchForReOrg(walletTx *lndclient.Transaction) {
+	if c.cfg.ReOrgWatcher == nil || walletTx.Confirmations == 0 {
+		return
+	}
+
+	blockHash, err := chainhash.NewHashFromStr(walletTx.BlockHash)
+	if err != nil {
+		log.Warnf("Unable to watch tx %v for re-orgs, invalid block "+
+			"hash: %v", walletTx.TxHash, err)
+		return
+	}
+
+	c.cfg.ReOrgWatcher.WatchTx(&chainntnfs.TxConfirmation{
+		BlockHash:   blockHash,
+		BlockHeight: uint32(walletTx.BlockHeight),
+		Tx:          walletTx.Tx,
+	})
+}
+
+// handleReOrg updates all address events of the transaction affected by a
+// chain re-org. If the transaction was re-organized out of the chain, the
+// events are rolled back to the detected state. Once it confirms again, the
+// events are marked as confirmed and, if the proof was already imported
+// before, as completed again. The proofs themselves are re-anchored by the
+// re-org watcher before we're notified.
+func (c *Custodian) handleReOrg(reOrg *ReOrgEvent) error {
+	ctxt, cancel := c.WithCtxQuit()
+	events, err := c.cfg.AddrBook.QueryEvents(
+		ctxt, address.EventQueryParams{},
+	)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("error querying events: %w", err)
+	}
+
+	var currentHeight uint32
+	if reOrg.Conf != nil {
+		ctxt, cancel = c.WithCtxQuit()
+		currentHeight, err = c.cfg.ChainBridge.CurrentHeight(ctxt)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error querying current height: %w",
+				err)
+		}
+	}
+
+	for _, event := range events {
+		if event.Outpoint.Hash != reOrg.Txid {
+			continue
+		}
+
+		walletTx := newWalletTx(reOrg.Tx, event.Outpoint.Index)
+		if reOrg.Conf != nil {
+			walletTx.Confirmations = 1
+			if currentHeight > reOrg.Conf.BlockHeight {
+				walletTx.Confirmations += int32(
+					currentHeight - reOrg.Conf.BlockHeight,
+				)
+			}
+			walletTx.BlockHash = reOrg.Conf.BlockHash.String()
+			walletTx.BlockHeight = int32(reOrg.Conf.BlockHeight)
+		}
+		status := c.eventStatus(walletTx)
+
+		log.Infof("Inbound asset transfer in %v affected by re-org, "+
+			"new status %v", event.Outpoint, status)
+
+		var sibling *chainhash.Hash
+		if len(event.TapscriptSibling) > 0 {
+			sibling, err = chainhash.NewHash(event.TapscriptSibling)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Block here, a shutdown can wait on this operation.
+		ctxt, cancel := c.CtxBlocking()
+		newEvent, err := c.cfg.AddrBook.GetOrCreateEvent(
+			ctxt, status, event.Addr, walletTx,
+			event.Outpoint.Index, sibling,
+		)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error updating event: %w", err)
+		}
+		c.events[event.Outpoint] = newEvent
+
+		// If the transaction isn't final yet, the event is completed
+		// again once it is.
+		if status != address.StatusTransactionFinal || !event.HasProof {
+			continue
+		}
+
+		ctxt, cancel = c.CtxBlocking()
+		err = c.cfg.AddrBook.CompleteEvent(
+			ctxt, newEvent, address.StatusCompleted, event.Outpoint,
+		)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error completing event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// numConfs returns the number of confirmations the transaction of an inbound
+// asset transfer needs before it is considered final.
+func (c *Custodian) numConfs() uint32 {
+	if c.cfg.NumConfs == 0 {
+		return 1
+	}
+
+	return c.cfg.NumConfs
+}
+
+// eventStatus returns the address event status that corresponds to the number
+// of confirmations of the given wallet transaction.
+func (c *Custodian) eventStatus(
+	walletTx *lndclient.Transaction) address.Status {
+
+	switch {
+	case walletTx.Confirmations <= 0:
+		return address.StatusTransactionDetected
+
+	case uint32(walletTx.Confirmations) < c.numConfs():
+		return address.StatusTransactionConfirmed
+
+	default:
+		return address.StatusTransactionFinal
+	}
+}
+
+// checkProofFinal checks whether the proof of the given event is already
+// available if the event's transaction is final.
+func (c *Custodian) checkProofFinal(event *address.Event) error {
+	if event.Status != address.StatusTransactionFinal &&
+		event.Status != address.StatusProofReceived {
+
+		return nil
+	}
+
+	return c.checkProofAvailable(event)
+}
+
+// handleNewBlock marks all events that are confirmed and reached the required
+// number of confirmations with the new block as final. If the proof for any of
+// them was already received, the inbound transfer is completed.
+func (c *Custodian) handleNewBlock(height uint32) error {
+	finalEvents, err := c.promoteFinalEvents(height)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range finalEvents {
+		if err := c.checkProofAvailable(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promoteFinalEvents marks all events that are confirmed and reached the
+// required number of confirmations at the given height as final and returns
+// them.
+func (c *Custodian) promoteFinalEvents(height uint32) ([]*address.Event,
+	error) {
+
+	var (
+		numConfs    = c.numConfs()
+		finalEvents []*address.Event
+	)
+	for op, event := range c.events {
+		// With a single required confirmation, any confirmed event is
+		// final, independent of the height.
+		if event.Status != address.StatusTransactionConfirmed ||
+			event.ConfirmationHeight == 0 ||
+			(numConfs > 1 &&
+				height+1 < event.ConfirmationHeight+numConfs) {
+
+			continue
+		}
+
+		log.Infof("Inbound asset transfer in %v reached %d "+
+			"confirmations at height %d", op, numConfs, height)
+
+		// Block here, a shutdown can wait on this operation.
+		ctxt, cancel := c.CtxBlocking()
+		err := c.cfg.AddrBook.SetEventStatus(
+			ctxt, event, address.StatusTransactionFinal,
+		)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("error updating event: %w", err)
+		}
+
+		finalEvent := *event
+		finalEvent.Status = address.StatusTransactionFinal
+		c.events[op] = &finalEvent
+		finalEvents = append(finalEvents, &finalEvent)
+	}
+
+	return finalEvents, nil
+}
+
+// setReceiveCompleted updates the address event in the database to mark it as
+// completed successfully and to link it to the proof we received. If the
+// event's transaction isn't final yet, this is deferred until it is.
+func (c *Custodian) setReceiveCompleted(event *address.Event,
+	p proof.Proof) error {
+
+	// The proof is already stored in the archive, so we can find it again
+	// once the transaction reached the required number of confirmations.
+	if event.Status < address.StatusTransactionFinal {
+		log.Infof("Received proof for inbound asset transfer in %v, "+
+			"waiting for transaction to be final", event.Outpoint)
+
+		return nil
+	}
+
+	// Let's not be interrupted by a shutdown.
+	ctxt, cancel := c.CtxBlocking()
+	defer cancel()
+
+	// We let our subscribers know that the proof arrived before we take
+	// custody of the assets. If we're interrupted in between, the event
+	// stays in this state until we find the proof again on restart.
+	if event.Status != address.StatusProofReceived {
+		err := c.cfg.AddrBook.SetEventStatus(
+			ctxt, event, address.StatusProofReceived,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update event status: %w",
+				err)
+		}
+	}
+
+	anchorPoint := wire.OutPoint{
+		Hash:  p.AnchorTx.TxHash(),
+		Index: p.InclusionProof.OutputIndex,
+	}
+
+	// The amount received with an address that doesn't require an exact
+	// amount is only known from the proof.
+	completedEvent := *event
+	completedEvent.AssetAmount = p.Asset.Amount
+
+	return c.cfg.AddrBook.CompleteEvent(
+		ctxt, &completedEvent, address.StatusCompleted, anchorPoint,
+	)
 }
 
 // hasWalletTaprootOutput returns true if one of the outputs of the given
@@ -585,8 +1908,18 @@ func isWalletTaprootOutput(out *lnrpc.OutputDetail) bool {
 	return out.IsOurAddress && out.OutputType == p2trType
 }
 
+// xOnlyKey returns the x-only serialization of the given public key.
+func xOnlyKey(key *btcec.PublicKey) [32]byte {
+	var xOnly [32]byte
+	copy(xOnly[:], schnorr.SerializePubKey(key))
+
+	return xOnly
+}
+
 // AddrMatchesAsset returns true if the given asset state (ID, family key,
-// script key) matches the state represented in the address.
+// script key, amount) matches the state represented in the address. A family
+// address matches any asset ID of its type within the family, and the amount
+// only needs to be accepted by the amount policy of the address.
 func AddrMatchesAsset(addr *address.AddrWithKeyInfo, a *asset.Asset) bool {
 	famKeyBothNil := (addr.FamilyKey == nil) && (a.FamilyKey == nil)
 	famKeyNoneNil := (addr.FamilyKey != nil) && (a.FamilyKey != nil)
@@ -600,6 +1933,11 @@ func AddrMatchesAsset(addr *address.AddrWithKeyInfo, a *asset.Asset) bool {
 	famKeyEqual := famKeyBothNil ||
 		addr.FamilyKey.IsEqual(&a.FamilyKey.FamKey)
 
-	return addr.ID() == a.ID() && famKeyEqual &&
+	idEqual := addr.ID() == a.ID()
+	if addr.IsFamilyAddr() {
+		idEqual = addr.Type == a.Type
+	}
+
+	return idEqual && famKeyEqual && addr.AcceptsAmount(a.Amount) &&
 		addr.ScriptKey.IsEqual(a.ScriptKey.PubKey)
 }
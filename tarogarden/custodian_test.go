@@ -1,6 +1,7 @@
 package tarogarden_test
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"math/rand"
@@ -9,15 +10,16 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/commitment"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarodb"
-	"github.com/lightninglabs/taro/tarodb/sqlc"
 	"github.com/lightninglabs/taro/tarogarden"
 	"github.com/lightninglabs/taro/taroscript"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -34,10 +36,9 @@ var (
 )
 
 // newAddrBook creates a new instance of the TaroAddressBook book.
-func newAddrBook(t *testing.T, keyRing *tarogarden.MockKeyRing) (*address.Book,
-	*tarodb.TaroAddressBook, sqlc.Querier) {
-
-	db := tarodb.NewTestDB(t)
+func newAddrBook(db *tarodb.BaseDB,
+	keyRing *tarogarden.MockKeyRing) (*address.Book,
+	*tarodb.TaroAddressBook) {
 
 	txCreator := func(tx *sql.Tx) tarodb.AddrBook {
 		return db.WithTx(tx)
@@ -50,13 +51,14 @@ func newAddrBook(t *testing.T, keyRing *tarogarden.MockKeyRing) (*address.Book,
 		StoreTimeout: testTimeout,
 		Chain:        *chainParams,
 		KeyRing:      keyRing,
+		MemoSigner:   keyRing,
 	})
-	return book, tarodbBook, db
+	return book, tarodbBook
 }
 
 // newProofArchive creates a new instance of the MultiArchiver.
-func newProofArchive(t *testing.T) (*proof.MultiArchiver, *tarodb.AssetStore) {
-	db := tarodb.NewTestDB(t)
+func newProofArchive(t *testing.T,
+	db *tarodb.BaseDB) (*proof.MultiArchiver, *tarodb.AssetStore) {
 
 	txCreator := func(tx *sql.Tx) tarodb.ActiveAssetsStore {
 		return db.WithTx(tx)
@@ -132,8 +134,12 @@ func newHarness(t *testing.T,
 	chainBridge := tarogarden.NewMockChainBridge()
 	walletAnchor := tarogarden.NewMockWalletAnchor()
 	keyRing := tarogarden.NewMockKeyRing()
-	addrBook, tarodbBook, _ := newAddrBook(t, keyRing)
-	proofArchive, assetDB := newProofArchive(t)
+
+	// The address book and the asset store share a database, as completing
+	// an address event requires the proof of the inbound asset.
+	db := tarodb.NewTestDB(t)
+	addrBook, tarodbBook := newAddrBook(db.BaseDB, keyRing)
+	proofArchive, assetDB := newProofArchive(t, db.BaseDB)
 
 	ctxb := context.Background()
 	for _, initialAddr := range initialAddrs {
@@ -280,7 +286,7 @@ func TestCustodianNewAddr(t *testing.T) {
 	ctx := context.Background()
 	addr := randAddr(t)
 	dbAddr, err := h.addrBook.NewAddress(
-		ctx, addr.Genesis, addr.FamilyKey, addr.Amount,
+		ctx, addr.Genesis, addr.FamilyKey, addr.Amount, nil,
 	)
 	require.NoError(t, err)
 
@@ -297,6 +303,218 @@ func TestCustodianNewAddr(t *testing.T) {
 	})
 }
 
+// mockCourier is a proof courier that reports each attempt to receive a proof
+// and then blocks until the attempt is cancelled.
+type mockCourier struct {
+	receiveReqs chan address.Taro
+}
+
+func (m *mockCourier) DeliverProof(context.Context, address.Taro,
+	*proof.AnnotatedProof) error {
+
+	return nil
+}
+
+func (m *mockCourier) ReceiveProof(ctx context.Context, addr address.Taro,
+	_ proof.Locator) (*proof.AnnotatedProof, error) {
+
+	select {
+	case m.receiveReqs <- addr:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// TestCustodianDynamicAddrCourier makes sure that the proof courier is used to
+// receive the proofs of transfers to an address with a dynamic on-chain output
+// as soon as the address is created, and again after a restart.
+func TestCustodianDynamicAddrCourier(t *testing.T) {
+	t.Parallel()
+
+	courier := &mockCourier{
+		receiveReqs: make(chan address.Taro),
+	}
+	h := newHarness(t, nil)
+	h.cfg.ProofCourier = courier
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// Create a new family address and an open address that accepts any
+	// amount. We need to acknowledge the creation of two keys per address
+	// in a goroutine to unblock the underlying key ring.
+	go func() {
+		for i := 0; i < 4; i++ {
+			<-h.keyRing.ReqKeys
+		}
+	}()
+	ctx := context.Background()
+	famAddr, err := h.addrBook.NewFamilyAddress(
+		ctx, test.RandPubKey(t), asset.Normal, 10, nil,
+	)
+	require.NoError(t, err)
+
+	openAddr, err := h.addrBook.NewAddress(
+		ctx, asset.RandGenesis(t, asset.Normal), nil, 0, nil,
+		address.WithAmountPolicy(address.AmountOpen),
+		address.WithMemo("donation"),
+	)
+	require.NoError(t, err)
+	require.NoError(t, openAddr.VerifyMemo())
+
+	assertReceive := func() {
+		var famReceived, openReceived bool
+		for i := 0; i < 2; i++ {
+			addr, err := chanutils.RecvOrTimeout(
+				courier.receiveReqs, testTimeout,
+			)
+			require.NoError(t, err)
+
+			switch {
+			case addr.ScriptKey.IsEqual(&famAddr.ScriptKey):
+				require.True(t, addr.IsFamilyAddr())
+				famReceived = true
+
+			case addr.ScriptKey.IsEqual(&openAddr.ScriptKey):
+				require.Equal(
+					t, address.AmountOpen,
+					addr.AmountPolicy,
+				)
+				openReceived = true
+			}
+		}
+		require.True(t, famReceived)
+		require.True(t, openReceived)
+	}
+	assertReceive()
+
+	h.eventually(func() bool {
+		addrs, err := h.tarodbBook.QueryAddrs(
+			ctx, address.QueryParams{},
+		)
+		require.NoError(t, err)
+		require.Len(t, addrs, 2)
+
+		return !addrs[0].ManagedAfter.IsZero() &&
+			!addrs[1].ManagedAfter.IsZero()
+	})
+
+	// The addresses are managed now, so they aren't delivered by the
+	// address book again after a restart. But we still need to receive
+	// their proofs.
+	require.NoError(t, h.c.Stop())
+	h.c = tarogarden.NewCustodian(h.cfg)
+	require.NoError(t, h.c.Start())
+	h.assertStartup()
+
+	assertReceive()
+}
+
+// TestCustodianImportAddrs makes sure that addresses imported from another
+// address book are watched for inbound transfers, even though their keys were
+// never derived by our key ring.
+func TestCustodianImportAddrs(t *testing.T) {
+	t.Parallel()
+
+	h := newHarness(t, nil)
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err := h.addrBook.RegisterEventSubscriber(
+		eventSub, false, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.addrBook.RemoveEventSubscriber(eventSub))
+	})
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// We import two addresses that are managed by the other address book,
+	// one of them twice. The duplicate should be skipped. A third address
+	// was already retired by the other address book.
+	ctx := context.Background()
+	addr1, addr2, retiredAddr := randAddr(t), randAddr(t), randAddr(t)
+	addr1.ManagedAfter = time.Now()
+	addr2.ManagedAfter = time.Now()
+	imported, err := h.addrBook.ImportAddrs(
+		ctx, []address.AddrWithKeyInfo{
+			*addr1, *addr2, *addr1, *retiredAddr,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, imported, 3)
+	for _, addr := range imported {
+		require.True(t, addr.WatchOnly)
+	}
+	h.assertAddrsRegistered(addr1, addr2)
+
+	// Importing them again shouldn't do anything.
+	imported, err = h.addrBook.ImportAddrs(
+		ctx, []address.AddrWithKeyInfo{*addr1, *addr2, *retiredAddr},
+	)
+	require.NoError(t, err)
+	require.Empty(t, imported)
+
+	// The retired address must not be managed again, even after a
+	// restart.
+	require.NoError(t, h.c.Stop())
+	h.c = tarogarden.NewCustodian(h.cfg)
+	require.NoError(t, h.c.Start())
+	h.assertStartup()
+
+	unmanaged, err := h.addrBook.ListAddrs(ctx, address.QueryParams{
+		UnmanagedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, unmanaged)
+
+	dbAddr, err := h.tarodbBook.AddrByTaprootOutput(
+		ctx, &retiredAddr.TaprootOutputKey,
+	)
+	require.NoError(t, err)
+	require.True(t, dbAddr.ManagedAfter.IsZero())
+
+	// An inbound transfer to an imported address should now be detected
+	// like for any other address.
+	h.eventually(func() bool {
+		dbAddr, err := h.tarodbBook.AddrByTaprootOutput(
+			ctx, &addr2.TaprootOutputKey,
+		)
+		require.NoError(t, err)
+
+		return !dbAddr.ManagedAfter.IsZero()
+	})
+
+	outputIdx, tx := randWalletTx(addr2)
+	select {
+	case h.walletAnchor.SubscribeTx <- *tx:
+	case <-time.After(testTimeout):
+		t.Fatalf("unable to send tx")
+	}
+
+	event, err := chanutils.RecvOrTimeout(
+		eventSub.NewItemCreated.ChanOut(), testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, address.StatusTransactionDetected, (*event).Status)
+	require.EqualValues(t, outputIdx, (*event).Outpoint.Index)
+	require.Equal(
+		t, schnorr.SerializePubKey(&addr2.TaprootOutputKey),
+		schnorr.SerializePubKey(&(*event).Addr.TaprootOutputKey),
+	)
+}
+
 func TestTransactionHandling(t *testing.T) {
 	h := newHarness(t, nil)
 
@@ -315,6 +533,18 @@ func TestTransactionHandling(t *testing.T) {
 	outputIdx, tx := randWalletTx(addrs[0])
 	h.walletAnchor.Transactions = append(h.walletAnchor.Transactions, *tx)
 
+	// We also want to be notified about the new event.
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err := h.addrBook.RegisterEventSubscriber(
+		eventSub, false, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.addrBook.RemoveEventSubscriber(eventSub))
+	})
+
 	require.NoError(t, h.c.Start())
 	t.Cleanup(func() {
 		require.NoError(t, h.c.Stop())
@@ -324,6 +554,13 @@ func TestTransactionHandling(t *testing.T) {
 	// We expect all addresses to be watched by the wallet now.
 	h.assertAddrsRegistered(addrs...)
 
+	event, err := chanutils.RecvOrTimeout(
+		eventSub.NewItemCreated.ChanOut(), testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, address.StatusTransactionDetected, (*event).Status)
+	require.EqualValues(t, outputIdx, (*event).Outpoint.Index)
+
 	// Only one event should be registered though, as we've only created one
 	// transaction.
 	h.eventually(func() bool {
@@ -343,6 +580,602 @@ func TestTransactionHandling(t *testing.T) {
 	})
 }
 
+// TestTransactionFinality makes sure that an inbound transfer is only marked
+// as final once its transaction reached the configured number of
+// confirmations.
+func TestTransactionFinality(t *testing.T) {
+	h := newHarness(t, nil)
+	h.cfg.NumConfs = 3
+
+	ctx := context.Background()
+	addr := randAddr(t)
+	err := h.tarodbBook.InsertAddrs(ctx, *addr)
+	require.NoError(t, err)
+
+	// The transaction for the address is already confirmed once.
+	outputIdx, tx := randWalletTx(addr)
+	tx.Confirmations = 1
+	tx.BlockHeight = 100
+	tx.BlockHash = test.RandHash().String()
+	h.walletAnchor.Transactions = append(h.walletAnchor.Transactions, *tx)
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err = h.addrBook.RegisterEventSubscriber(
+		eventSub, false, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.addrBook.RemoveEventSubscriber(eventSub))
+	})
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(addr)
+
+	assertEventStatus := func(status address.Status) {
+		event, err := chanutils.RecvOrTimeout(
+			eventSub.NewItemCreated.ChanOut(), testTimeout,
+		)
+		require.NoError(t, err)
+		require.Equal(t, status, (*event).Status)
+		require.EqualValues(t, outputIdx, (*event).Outpoint.Index)
+	}
+	assertEventStatus(address.StatusTransactionConfirmed)
+
+	// As more than one confirmation is required, the custodian needs to
+	// watch new blocks.
+	_, err = chanutils.RecvOrTimeout(
+		h.chainBridge.BlockEpochSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	sendBlock := func(height int32) {
+		select {
+		case h.chainBridge.NewBlocks <- height:
+		case <-time.After(testTimeout):
+			t.Fatalf("unable to send block %d", height)
+		}
+	}
+
+	// With the second confirmation, the transaction isn't final yet.
+	sendBlock(101)
+	_, err = chanutils.RecvOrTimeout(
+		eventSub.NewItemCreated.ChanOut(), testPollInterval,
+	)
+	require.Error(t, err)
+
+	// The third confirmation makes it final.
+	sendBlock(102)
+	assertEventStatus(address.StatusTransactionFinal)
+
+	events, err := h.tarodbBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, address.StatusTransactionFinal, events[0].Status)
+}
+
+// TestAddrExpiryAndSingleUse makes sure that inbound transfers to addresses
+// that expired or were already used are recorded as late payments and that
+// such addresses are no longer managed.
+func TestAddrExpiryAndSingleUse(t *testing.T) {
+	h := newHarness(t, nil)
+
+	ctx := context.Background()
+	singleUseAddr := randAddr(t)
+	singleUseAddr.SingleUse = true
+	expiredAddr := randAddr(t)
+	expiredAddr.ExpiryTime = time.Now().Add(-time.Hour)
+	err := h.tarodbBook.InsertAddrs(ctx, *singleUseAddr, *expiredAddr)
+	require.NoError(t, err)
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err = h.addrBook.RegisterEventSubscriber(
+		eventSub, false, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.addrBook.RemoveEventSubscriber(eventSub))
+	})
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// Only the single-use address should be imported into the wallet, as
+	// the other one already expired.
+	h.assertAddrsRegistered(singleUseAddr)
+	_, err = chanutils.RecvOrTimeout(
+		h.walletAnchor.ImportPubKeySignal, testPollInterval,
+	)
+	require.Error(t, err)
+
+	isManaged := func() bool {
+		addr, err := h.tarodbBook.AddrByTaprootOutput(
+			ctx, &singleUseAddr.TaprootOutputKey,
+		)
+		require.NoError(t, err)
+
+		return !addr.ManagedAfter.IsZero()
+	}
+	h.eventually(isManaged)
+
+	sendTx := func(addr *address.AddrWithKeyInfo,
+		status address.Status) {
+
+		outputIdx, tx := randWalletTx(addr)
+		select {
+		case h.walletAnchor.SubscribeTx <- *tx:
+		case <-time.After(testTimeout):
+			t.Fatalf("unable to send tx")
+		}
+
+		event, err := chanutils.RecvOrTimeout(
+			eventSub.NewItemCreated.ChanOut(), testTimeout,
+		)
+		require.NoError(t, err)
+		require.Equal(t, status, (*event).Status)
+		require.EqualValues(t, outputIdx, (*event).Outpoint.Index)
+	}
+
+	// The first transfer to the single-use address is accepted, after
+	// which the address is no longer managed.
+	sendTx(singleUseAddr, address.StatusTransactionDetected)
+	h.eventually(func() bool {
+		return !isManaged()
+	})
+
+	// Any further transfer to it as well as a transfer to the expired
+	// address are late payments.
+	sendTx(singleUseAddr, address.StatusLatePayment)
+	sendTx(expiredAddr, address.StatusLatePayment)
+
+	// Late payments are not pending.
+	events, err := h.addrBook.GetPendingEvents(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(
+		t, address.StatusTransactionDetected, events[0].Status,
+	)
+
+	// The used single-use address shouldn't be imported again after a
+	// restart.
+	addrs, err := h.tarodbBook.QueryAddrs(ctx, address.QueryParams{
+		UnmanagedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, addrs, 1)
+	require.Equal(
+		t, schnorr.SerializePubKey(&expiredAddr.TaprootOutputKey),
+		schnorr.SerializePubKey(&addrs[0].TaprootOutputKey),
+	)
+}
+
+// TestTapscriptSiblingRescan makes sure that an inbound asset transfer to an
+// output that commits to a tapscript sibling is detected once the proof for it
+// is imported.
+func TestTapscriptSiblingRescan(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// The mock verifier doesn't return the actual asset of a proof, so we
+	// store the proof ourselves and use an archiver without any backends
+	// that just notifies the custodian.
+	h.proofArchive = proof.NewMultiArchiver(
+		proof.NewMockVerifier(t), testTimeout,
+	)
+	h.cfg.ProofArchive = h.proofArchive
+
+	// A commitment to an asset with a family key requires a valid genesis
+	// signature, so we use an address without one.
+	ctx := context.Background()
+	addr := randAddr(t)
+	addr.FamilyKey = nil
+	taprootOutputKey, err := addr.Taro.TaprootOutputKey(nil)
+	require.NoError(t, err)
+	addr.TaprootOutputKey = *taprootOutputKey
+
+	err = h.tarodbBook.InsertAddrs(ctx, *addr)
+	require.NoError(t, err)
+
+	eventSub := chanutils.NewEventReceiver[*address.Event](
+		chanutils.DefaultQueueSize,
+	)
+	err = h.addrBook.RegisterEventSubscriber(
+		eventSub, false, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.addrBook.RemoveEventSubscriber(eventSub))
+	})
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+	h.assertAddrsRegistered(addr)
+
+	// We now create the asset the address expects and anchor it in an
+	// output that also commits to a tapscript leaf next to the Taro
+	// commitment. The wallet can't know about that output.
+	newAsset, err := asset.New(
+		addr.Genesis, addr.Amount, 0, 0, asset.ScriptV0,
+		asset.NewScriptKey(&addr.ScriptKey), nil,
+	)
+	require.NoError(t, err)
+	assetCommitment, err := commitment.NewAssetCommitment(newAsset)
+	require.NoError(t, err)
+	taroCommitment, err := commitment.NewTaroCommitment(assetCommitment)
+	require.NoError(t, err)
+	_, commitmentProof, err := taroCommitment.Proof(
+		newAsset.TaroCommitmentKey(), newAsset.AssetCommitmentKey(),
+	)
+	require.NoError(t, err)
+
+	siblingLeaf := txscript.NewBaseTapLeaf([]byte{txscript.OP_TRUE})
+	siblingHash := siblingLeaf.TapHash()
+	outputKey, err := addr.Taro.TaprootOutputKey(&siblingHash)
+	require.NoError(t, err)
+	require.False(t, outputKey.IsEqual(&addr.TaprootOutputKey))
+
+	pkScript, err := taroscript.PayToTaprootScript(outputKey)
+	require.NoError(t, err)
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: pkScript,
+		Value:    1000,
+	})
+
+	siblingProof := proof.Proof{
+		BlockHeader: wire.BlockHeader{
+			Timestamp: time.Unix(time.Now().Unix(), 0),
+		},
+		BlockHeight: 100,
+		AnchorTx:    *anchorTx,
+		Asset:       *newAsset,
+		InclusionProof: proof.TaprootProof{
+			OutputIndex: 0,
+			InternalKey: &addr.InternalKey,
+			CommitmentProof: &proof.CommitmentProof{
+				Proof: *commitmentProof,
+				TapSiblingPreimage: &proof.TapscriptPreimage{
+					SiblingPreimage: append(
+						[]byte{byte(
+							siblingLeaf.LeafVersion,
+						)}, siblingLeaf.Script...,
+					),
+					SiblingType: proof.LeafPreimage,
+				},
+			},
+		},
+	}
+	proofFile, err := proof.NewFile(proof.V0, siblingProof)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&buf))
+
+	annotatedProof := &proof.AnnotatedProof{
+		Locator: proof.Locator{
+			ScriptKey: addr.ScriptKey,
+		},
+		Blob: buf.Bytes(),
+		AssetSnapshot: &proof.AssetSnapshot{
+			Asset: newAsset,
+			OutPoint: wire.OutPoint{
+				Hash:  anchorTx.TxHash(),
+				Index: 0,
+			},
+			AnchorBlockHash:   siblingProof.BlockHeader.BlockHash(),
+			AnchorBlockHeight: siblingProof.BlockHeight,
+			AnchorTx:          anchorTx,
+			InternalKey:       &addr.InternalKey,
+			ScriptRoot:        taroCommitment,
+		},
+	}
+	require.NoError(t, h.assetDB.ImportProofs(ctx, annotatedProof))
+	require.NoError(t, h.proofArchive.ImportProofs(ctx, annotatedProof))
+
+	// The custodian should now import the actual output key into the
+	// wallet and then look for the transaction in the wallet.
+	pubKey, err := chanutils.RecvOrTimeout(
+		h.walletAnchor.ImportPubKeySignal, testTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, schnorr.SerializePubKey(outputKey),
+		schnorr.SerializePubKey(*pubKey),
+	)
+	_, err = chanutils.RecvOrTimeout(
+		h.walletAnchor.ListTxnsSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	// The wallet doesn't know the transaction, so the custodian must wait
+	// for the chain backend to confirm it instead of trusting the proof.
+	confReq, err := chanutils.RecvOrTimeout(
+		h.chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	events, err := h.tarodbBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// Once the chain backend confirms the transaction, the event should
+	// be created from the actual confirmation.
+	confBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Timestamp: time.Unix(time.Now().Unix(), 0),
+		},
+		Transactions: []*wire.MsgTx{anchorTx},
+	}
+	confBlockHash := confBlock.BlockHash()
+	h.chainBridge.SendConfNtfn(
+		*confReq, &confBlockHash, 101, 0, confBlock, anchorTx,
+	)
+
+	// The custodian checks the wallet once more before creating the
+	// event.
+	_, err = chanutils.RecvOrTimeout(
+		h.walletAnchor.ListTxnsSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	// The event should be created with the sibling and be completed right
+	// away, since we already have the proof. Subscribers are told about
+	// every step.
+	for _, status := range []address.Status{
+		address.StatusTransactionFinal, address.StatusProofReceived,
+		address.StatusCompleted,
+	} {
+		event, err := chanutils.RecvOrTimeout(
+			eventSub.NewItemCreated.ChanOut(), testTimeout,
+		)
+		require.NoError(t, err)
+		require.Equal(t, status, (*event).Status)
+	}
+
+	events, err = h.tarodbBook.QueryAddrEvents(
+		ctx, address.EventQueryParams{},
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	require.Equal(t, siblingHash[:], event.TapscriptSibling)
+	require.Equal(t, anchorTx.TxHash(), event.Outpoint.Hash)
+	require.EqualValues(t, 101, event.ConfirmationHeight)
+	require.Equal(t, address.StatusCompleted, event.Status)
+}
+
+// TestAddrRecovery makes sure that an address that was lost from the database
+// is reconstructed in recovery mode once the proof of an inbound transfer to
+// one of the wallet's outputs reveals that it was created with our keys.
+func TestAddrRecovery(t *testing.T) {
+	courier := &mockCourier{
+		receiveReqs: make(chan address.Taro),
+	}
+	h := newHarness(t, nil)
+	h.cfg.KeyRing = h.keyRing
+	h.cfg.RecoveryWindow = 3
+	h.cfg.ProofCourier = courier
+
+	// The mock verifier doesn't return the actual asset of a proof, so we
+	// store the proof ourselves and use an archiver without any backends
+	// that just notifies the custodian.
+	h.proofArchive = proof.NewMultiArchiver(
+		proof.NewMockVerifier(t), testTimeout,
+	)
+	h.cfg.ProofArchive = h.proofArchive
+
+	// The lost address was created with the first two keys of the Taro
+	// key family, which we derive before the custodian does.
+	ctx := context.Background()
+	internalKeyDesc, err := h.keyRing.DeriveKey(ctx, keychain.KeyLocator{
+		Family: tarogarden.TaroKeyFamily,
+		Index:  0,
+	})
+	require.NoError(t, err)
+	rawScriptKeyDesc, err := h.keyRing.DeriveKey(ctx, keychain.KeyLocator{
+		Family: tarogarden.TaroKeyFamily,
+		Index:  1,
+	})
+	require.NoError(t, err)
+	scriptKey := asset.NewScriptKeyBIP0086(rawScriptKeyDesc)
+
+	const amount = 100
+	genesis := asset.RandGenesis(t, asset.Normal)
+	lostAddr, err := address.New(
+		genesis, nil, *scriptKey.PubKey, *internalKeyDesc.PubKey,
+		amount, chainParams,
+	)
+	require.NoError(t, err)
+	outputKey, err := lostAddr.TaprootOutputKey(nil)
+	require.NoError(t, err)
+
+	// The wallet still knows the transaction that sent to the address.
+	outputIdx, tx := randWalletTx(&address.AddrWithKeyInfo{
+		TaprootOutputKey: *outputKey,
+	})
+	tx.Confirmations = 1
+	tx.BlockHeight = 100
+	h.walletAnchor.Transactions = append(h.walletAnchor.Transactions, *tx)
+
+	require.NoError(t, h.c.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.c.Stop())
+	})
+	h.assertStartup()
+
+	// We don't know which of the derived keys the lost address used as its
+	// script key, so we receive the proofs sent to any of them.
+	derivedScriptKeys := make(map[[32]byte]struct{})
+	for i := uint32(0); i < h.cfg.RecoveryWindow; i++ {
+		keyDesc, err := h.keyRing.DeriveKey(ctx, keychain.KeyLocator{
+			Family: tarogarden.TaroKeyFamily,
+			Index:  i,
+		})
+		require.NoError(t, err)
+
+		derivedKey := asset.NewScriptKeyBIP0086(keyDesc)
+		xOnly := schnorr.SerializePubKey(derivedKey.PubKey)
+		derivedScriptKeys[*(*[32]byte)(xOnly)] = struct{}{}
+	}
+	for i := uint32(0); i < h.cfg.RecoveryWindow; i++ {
+		addr, err := chanutils.RecvOrTimeout(
+			courier.receiveReqs, testTimeout,
+		)
+		require.NoError(t, err)
+
+		xOnly := schnorr.SerializePubKey(&addr.ScriptKey)
+		require.Contains(t, derivedScriptKeys, *(*[32]byte)(xOnly))
+		delete(derivedScriptKeys, *(*[32]byte)(xOnly))
+	}
+
+	// The output is listed as an event without an address until its proof
+	// is imported.
+	recoveryEvents, err := h.tarodbBook.QueryRecoveryOutputs(ctx)
+	require.NoError(t, err)
+	require.Len(t, recoveryEvents, 1)
+	require.Nil(t, recoveryEvents[0].Addr)
+	require.Equal(t, tx.Tx.TxHash(), recoveryEvents[0].Outpoint.Hash)
+	require.EqualValues(t, outputIdx, recoveryEvents[0].Outpoint.Index)
+
+	// Once the proof of the transfer is imported, the address should be
+	// reconstructed.
+	newAsset, err := asset.New(
+		genesis, amount, 0, 0, asset.ScriptV0,
+		asset.NewScriptKey(scriptKey.PubKey), nil,
+	)
+	require.NoError(t, err)
+	assetCommitment, err := commitment.NewAssetCommitment(newAsset)
+	require.NoError(t, err)
+	taroCommitment, err := commitment.NewTaroCommitment(assetCommitment)
+	require.NoError(t, err)
+	_, commitmentProof, err := taroCommitment.Proof(
+		newAsset.TaroCommitmentKey(), newAsset.AssetCommitmentKey(),
+	)
+	require.NoError(t, err)
+
+	transferProof := proof.Proof{
+		BlockHeader: wire.BlockHeader{
+			Timestamp: time.Unix(time.Now().Unix(), 0),
+		},
+		BlockHeight: 100,
+		AnchorTx:    *tx.Tx,
+		Asset:       *newAsset,
+		InclusionProof: proof.TaprootProof{
+			OutputIndex: uint32(outputIdx),
+			InternalKey: internalKeyDesc.PubKey,
+			CommitmentProof: &proof.CommitmentProof{
+				Proof: *commitmentProof,
+			},
+		},
+	}
+	proofFile, err := proof.NewFile(proof.V0, transferProof)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&buf))
+
+	annotatedProof := &proof.AnnotatedProof{
+		Locator: proof.Locator{
+			ScriptKey: *scriptKey.PubKey,
+		},
+		Blob: buf.Bytes(),
+		AssetSnapshot: &proof.AssetSnapshot{
+			Asset: newAsset,
+			OutPoint: wire.OutPoint{
+				Hash:  tx.Tx.TxHash(),
+				Index: uint32(outputIdx),
+			},
+			AnchorBlockHash:   transferProof.BlockHeader.BlockHash(),
+			AnchorBlockHeight: transferProof.BlockHeight,
+			AnchorTx:          tx.Tx,
+			OutputIndex:       uint32(outputIdx),
+			InternalKey:       internalKeyDesc.PubKey,
+			ScriptRoot:        taroCommitment,
+		},
+	}
+	require.NoError(t, h.assetDB.ImportProofs(ctx, annotatedProof))
+	require.NoError(t, h.proofArchive.ImportProofs(ctx, annotatedProof))
+
+	// The custodian imports the output into the wallet and looks up the
+	// transaction again. The output is imported once more for the
+	// recovered address.
+	assertOutputImported := func() {
+		pubKey, err := chanutils.RecvOrTimeout(
+			h.walletAnchor.ImportPubKeySignal, testTimeout,
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, schnorr.SerializePubKey(outputKey),
+			schnorr.SerializePubKey(*pubKey),
+		)
+	}
+	assertOutputImported()
+	_, err = chanutils.RecvOrTimeout(
+		h.walletAnchor.ListTxnsSignal, testTimeout,
+	)
+	require.NoError(t, err)
+	assertOutputImported()
+
+	// The recovered address should have the key information of the keys
+	// we derived.
+	recoveredAddr, err := h.tarodbBook.AddrByScriptKey(
+		ctx, scriptKey.PubKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, genesis.ID(), recoveredAddr.ID())
+	require.EqualValues(t, amount, recoveredAddr.Amount)
+	require.Equal(
+		t, internalKeyDesc.KeyLocator,
+		recoveredAddr.InternalKeyDesc.KeyLocator,
+	)
+	require.Equal(
+		t, rawScriptKeyDesc.KeyLocator,
+		recoveredAddr.ScriptKeyTweak.RawKey.KeyLocator,
+	)
+
+	// And the event should be completed with the received amount.
+	h.eventually(func() bool {
+		events, err := h.tarodbBook.QueryAddrEvents(
+			ctx, address.EventQueryParams{},
+		)
+		require.NoError(t, err)
+
+		if len(events) != 1 {
+			return false
+		}
+
+		event := events[0]
+		require.Equal(t, tx.Tx.TxHash(), event.Outpoint.Hash)
+		require.EqualValues(t, outputIdx, event.Outpoint.Index)
+
+		return event.Status == address.StatusCompleted &&
+			event.AssetAmount == amount
+	})
+
+	// The output doesn't await its proof anymore.
+	recoveryEvents, err = h.tarodbBook.QueryRecoveryOutputs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, recoveryEvents)
+}
+
 // TestAddrMatchesAsset tests that the AddrMatchesAsset function works
 // correctly.
 func TestAddrMatchesAsset(t *testing.T) {
@@ -470,6 +1303,118 @@ func TestAddrMatchesAsset(t *testing.T) {
 			},
 		},
 		result: true,
+	}, {
+		name: "family address with any asset ID",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				FamilyKey:  randKey1,
+				FamilyOnly: true,
+				Genesis:    address.FamilyGenesis(asset.Normal),
+				ScriptKey:  *randKey2,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			FamilyKey: &asset.FamilyKey{
+				FamKey: *randKey1,
+			},
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: true,
+	}, {
+		name: "family address with type mismatch",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				FamilyKey:  randKey1,
+				FamilyOnly: true,
+				Genesis: address.FamilyGenesis(
+					asset.Collectible,
+				),
+				ScriptKey: *randKey2,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			FamilyKey: &asset.FamilyKey{
+				FamKey: *randKey1,
+			},
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: false,
+	}, {
+		name: "exact amount mismatch",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				Genesis:   randGen1,
+				ScriptKey: *randKey2,
+				Amount:    10,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			Amount:  11,
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: false,
+	}, {
+		name: "at least amount",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				Genesis:      randGen1,
+				ScriptKey:    *randKey2,
+				Amount:       10,
+				AmountPolicy: address.AmountAtLeast,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			Amount:  11,
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: true,
+	}, {
+		name: "at least amount too low",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				Genesis:      randGen1,
+				ScriptKey:    *randKey2,
+				Amount:       10,
+				AmountPolicy: address.AmountAtLeast,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			Amount:  9,
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: false,
+	}, {
+		name: "open amount",
+		addr: &address.AddrWithKeyInfo{
+			Taro: &address.Taro{
+				Genesis:      randGen1,
+				ScriptKey:    *randKey2,
+				AmountPolicy: address.AmountOpen,
+			},
+		},
+		a: &asset.Asset{
+			Genesis: randGen1,
+			Amount:  1234,
+			ScriptKey: asset.ScriptKey{
+				PubKey: randKey2,
+			},
+		},
+		result: true,
 	}}
 
 	for _, tc := range testCases {
@@ -0,0 +1,128 @@
+package tarogarden
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/proof"
+)
+
+const (
+	// DefaultHeaderLookupTimeout is the maximum amount of time we wait for
+	// the chain backend to find the confirmation of an anchor transaction
+	// when verifying the block header of a proof.
+	DefaultHeaderLookupTimeout = time.Minute
+)
+
+// GenHeaderVerifier generates a block header verifier that checks headers
+// against the main chain of the passed chain bridge.
+//
+// The chain bridge can't look up blocks by height directly. Instead, we look
+// up the confirmation of the anchor transaction the proof claims to be in the
+// block, using the claimed height as the height hint. If the transaction
+// isn't found at or after that height, or if it confirmed in a different
+// block, the header is rejected. Proofs without a block height are looked up
+// from the start of the chain.
+//
+// Proof files contain many proofs anchored in the same blocks, so the height
+// of each block we verified is cached by its hash and the block isn't looked
+// up again.
+func GenHeaderVerifier(chainBridge ChainBridge) proof.HeaderVerifier {
+	var (
+		verifiedMtx    sync.Mutex
+		verifiedBlocks = make(map[chainhash.Hash]uint32)
+	)
+
+	return func(ctx context.Context, header wire.BlockHeader,
+		height uint32, anchorTx *wire.MsgTx) (uint32, error) {
+
+		blockHash := header.BlockHash()
+
+		verifiedMtx.Lock()
+		verifiedHeight, ok := verifiedBlocks[blockHash]
+		verifiedMtx.Unlock()
+
+		if ok {
+			if height != 0 && height != verifiedHeight {
+				return 0, fmt.Errorf("block %v is at height "+
+					"%d, proof claims height %d",
+					blockHash, verifiedHeight, height)
+			}
+
+			return verifiedHeight, nil
+		}
+
+		currentHeight, err := chainBridge.CurrentHeight(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("unable to fetch current "+
+				"height: %w", err)
+		}
+		if height > currentHeight {
+			return 0, fmt.Errorf("block height %d is beyond chain "+
+				"tip %d", height, currentHeight)
+		}
+
+		if len(anchorTx.TxOut) == 0 {
+			return 0, fmt.Errorf("anchor tx has no outputs")
+		}
+
+		// The chain backend requires a height hint, so an unknown
+		// height means we need to look from the start of the chain.
+		heightHint := height
+		if heightHint == 0 {
+			heightHint = 1
+		}
+
+		ctxt, cancel := context.WithTimeout(
+			ctx, DefaultHeaderLookupTimeout,
+		)
+		defer cancel()
+
+		txHash := anchorTx.TxHash()
+		confNtfn, errChan, err := chainBridge.RegisterConfirmationsNtfn(
+			ctxt, &txHash, anchorTx.TxOut[0].PkScript, 1,
+			heightHint, false,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to register for anchor "+
+				"tx conf: %w", err)
+		}
+		defer confNtfn.Cancel()
+
+		select {
+		case conf, ok := <-confNtfn.Confirmed:
+			if !ok || conf == nil {
+				return 0, fmt.Errorf("conf ntfn for anchor tx "+
+					"%v closed", txHash)
+			}
+
+			if (height != 0 && conf.BlockHeight != height) ||
+				!conf.BlockHash.IsEqual(&blockHash) {
+
+				return 0, fmt.Errorf("anchor tx %v confirmed "+
+					"in block %v at height %d, proof "+
+					"claims block %v at height %d", txHash,
+					conf.BlockHash, conf.BlockHeight,
+					blockHash, height)
+			}
+
+			verifiedMtx.Lock()
+			verifiedBlocks[blockHash] = conf.BlockHeight
+			verifiedMtx.Unlock()
+
+			return conf.BlockHeight, nil
+
+		case err := <-errChan:
+			return 0, fmt.Errorf("unable to look up anchor tx "+
+				"conf: %w", err)
+
+		case <-ctxt.Done():
+			return 0, fmt.Errorf("anchor tx %v not found in main "+
+				"chain at height %d", txHash, height)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package tarogarden_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHeaderVerifier tests that the header verifier looks up the height of
+// proofs without a block height and caches the blocks it verified.
+func TestHeaderVerifier(t *testing.T) {
+	t.Parallel()
+
+	chainBridge := tarogarden.NewMockChainBridge()
+	headerVerifier := tarogarden.GenHeaderVerifier(chainBridge)
+	ctx := context.Background()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxOut(&wire.TxOut{PkScript: test.RandBytes(34)})
+	header := wire.BlockHeader{Nonce: 7}
+	blockHash := header.BlockHash()
+
+	// The height of a proof without a block height is looked up through
+	// the confirmation of the anchor transaction.
+	type result struct {
+		height uint32
+		err    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		height, err := headerVerifier(ctx, header, 0, anchorTx)
+		results <- result{height, err}
+	}()
+
+	reqNo, err := chanutils.RecvOrTimeout(
+		chainBridge.ConfReqSignal, defaultTimeout,
+	)
+	require.NoError(t, err)
+	chainBridge.SendConfNtfn(*reqNo, &blockHash, 42, 0, nil, anchorTx)
+
+	res, err := chanutils.RecvOrTimeout(results, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, res.err)
+	require.EqualValues(t, 42, res.height)
+
+	// The block is now known, so it isn't looked up again, no matter
+	// whether the height is known or not.
+	height, err := headerVerifier(ctx, header, 0, anchorTx)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, height)
+
+	height, err = headerVerifier(ctx, header, 42, anchorTx)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, height)
+	require.Equal(t, 1, chainBridge.ReqCount)
+
+	// A proof claiming a different height for the block is rejected.
+	_, err = headerVerifier(ctx, header, 41, anchorTx)
+	require.ErrorContains(t, err, "proof claims height 41")
+}
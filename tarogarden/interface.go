@@ -169,6 +169,14 @@ type ChainBridge interface {
 		includeBlock bool) (*chainntnfs.ConfirmationEvent, chan error,
 		error)
 
+	// RegisterBlockEpochNtfn registers an intent to be notified of each
+	// new block connected to the tip of the main chain. The height of the
+	// new block is sent on the returned channel. After a chain
+	// re-organization, the height of the new tip may be equal to or lower
+	// than a previously notified height.
+	RegisterBlockEpochNtfn(ctx context.Context) (chan int32, chan error,
+		error)
+
 	// CurrentHeight return the current height of the main chain.
 	CurrentHeight(context.Context) (uint32, error)
 
@@ -181,6 +189,30 @@ type ChainBridge interface {
 		confTarget uint32) (chainfee.SatPerKWeight, error)
 }
 
+// AnchorTxStore is used to look up and update the on-chain anchor
+// transactions of assets, as well as the proofs anchored in them. It is used
+// to re-anchor assets after a chain re-organization.
+type AnchorTxStore interface {
+	// FetchRecentAnchorTxns returns the confirmation details of all
+	// anchor transactions that were confirmed at or above the given block
+	// height. The full block is not populated in the returned
+	// confirmations.
+	FetchRecentAnchorTxns(ctx context.Context,
+		minHeight uint32) ([]*chainntnfs.TxConfirmation, error)
+
+	// FetchAnchoredProofs returns all proof files that contain a state
+	// transition proof anchored in the transaction with the given txid,
+	// including the files of assets that were sent away or spent since.
+	FetchAnchoredProofs(ctx context.Context,
+		txid chainhash.Hash) (proof.AssetBlobs, error)
+
+	// UpdateAnchorTxConf updates the confirmation details of the anchor
+	// transaction with the given txid. A nil confirmation marks the
+	// transaction as unconfirmed again.
+	UpdateAnchorTxConf(ctx context.Context, txid chainhash.Hash,
+		conf *chainntnfs.TxConfirmation) error
+}
+
 // TaroKeyFamily is the key family used to generate internal keys that taro
 // will use creating internal taproot keys and also any other keys used for
 // asset script keys. This was derived via: sum(map(lambda y: ord(y), 'taro')).
@@ -18,6 +18,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taro/address"
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/internal/test"
 	"github.com/lightninglabs/taro/proof"
@@ -33,11 +34,22 @@ func RandSeedlings(t testing.TB, numSeedlings int) map[string]*Seedling {
 	for i := 0; i < numSeedlings; i++ {
 		assetName := hex.EncodeToString(test.RandBytes(32))
 		seedlings[assetName] = &Seedling{
-			AssetType:      asset.Type(rand.Int31n(2)),
-			AssetName:      assetName,
-			Metadata:       test.RandBytes(32),
-			Amount:         uint64(rand.Int63()),
-			EnableEmission: test.RandBool(),
+			AssetType:        asset.Type(rand.Int31n(2)),
+			AssetName:        assetName,
+			Metadata:         test.RandBytes(32),
+			Amount:           uint64(rand.Int63()),
+			LockTime:         uint64(rand.Int31()),
+			RelativeLockTime: uint64(rand.Int31()),
+			ScriptVersion:    asset.ScriptVersion(rand.Int31n(2)),
+			EnableEmission:   test.RandBool(),
+			Label:            hex.EncodeToString(test.RandBytes(8)),
+		}
+
+		// Half of the seedlings also carry external metadata.
+		if test.RandBool() {
+			seedlings[assetName].ExternalMetadata = map[string]string{
+				"ref": hex.EncodeToString(test.RandBytes(8)),
+			}
 		}
 	}
 
@@ -230,6 +242,8 @@ type MockChainBridge struct {
 	FeeEstimateSignal chan struct{}
 	PublishReq        chan *wire.MsgTx
 	ConfReqSignal     chan int
+	BlockEpochSignal  chan struct{}
+	NewBlocks         chan int32
 
 	ReqCount int
 	ConfReqs map[int]*chainntnfs.ConfirmationEvent
@@ -241,6 +255,8 @@ func NewMockChainBridge() *MockChainBridge {
 		PublishReq:        make(chan *wire.MsgTx),
 		ConfReqs:          make(map[int]*chainntnfs.ConfirmationEvent),
 		ConfReqSignal:     make(chan int),
+		BlockEpochSignal:  make(chan struct{}, 1),
+		NewBlocks:         make(chan int32),
 	}
 }
 
@@ -258,6 +274,13 @@ func (m *MockChainBridge) SendConfNtfn(reqNo int, blockHash *chainhash.Hash,
 	}
 }
 
+// SendReOrgNtfn notifies the given confirmation request that the transaction
+// was re-organized out of the chain.
+func (m *MockChainBridge) SendReOrgNtfn(reqNo int) {
+	req := m.ConfReqs[reqNo]
+	req.NegativeConf <- 0
+}
+
 func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	_ *chainhash.Hash, _ []byte, _, _ uint32,
 	_ bool) (*chainntnfs.ConfirmationEvent, chan error, error) {
@@ -273,7 +296,10 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	}()
 
 	req := &chainntnfs.ConfirmationEvent{
-		Confirmed: make(chan *chainntnfs.TxConfirmation),
+		Confirmed:    make(chan *chainntnfs.TxConfirmation),
+		NegativeConf: make(chan int32),
+		Done:         make(chan struct{}),
+		Cancel:       func() {},
 	}
 	errChan := make(chan error)
 
@@ -287,6 +313,18 @@ func (m *MockChainBridge) RegisterConfirmationsNtfn(ctx context.Context,
 	return req, errChan, nil
 }
 
+func (m *MockChainBridge) RegisterBlockEpochNtfn(
+	ctx context.Context) (chan int32, chan error, error) {
+
+	select {
+	case m.BlockEpochSignal <- struct{}{}:
+	case <-ctx.Done():
+		return nil, nil, fmt.Errorf("shutting down")
+	}
+
+	return m.NewBlocks, make(chan error), nil
+}
+
 func (m *MockChainBridge) CurrentHeight(_ context.Context) (uint32, error) {
 	return 0, nil
 }
@@ -375,8 +413,10 @@ func (m *MockKeyRing) DeriveNextKey(ctx context.Context,
 	return desc, nil
 }
 
+// DeriveKey returns the key at the given locator, creating a new random key
+// if none was derived for it before.
 func (m *MockKeyRing) DeriveKey(ctx context.Context,
-	_ keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+	loc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
 
 	select {
 	case <-ctx.Done():
@@ -384,7 +424,37 @@ func (m *MockKeyRing) DeriveKey(ctx context.Context,
 	default:
 	}
 
-	return keychain.KeyDescriptor{}, nil
+	priv, ok := m.Keys[loc]
+	if !ok {
+		var err error
+		priv, err = btcec.NewPrivateKey()
+		if err != nil {
+			return keychain.KeyDescriptor{}, err
+		}
+
+		m.Keys[loc] = priv
+	}
+
+	return keychain.KeyDescriptor{
+		PubKey:     priv.PubKey(),
+		KeyLocator: loc,
+	}, nil
+}
+
+// SignMemo signs the digest of the given memo with the memo key of the
+// internal key identified by the passed key descriptor.
+func (m *MockKeyRing) SignMemo(_ context.Context,
+	keyDesc keychain.KeyDescriptor, memo string) (*schnorr.Signature,
+	error) {
+
+	priv, ok := m.Keys[keyDesc.KeyLocator]
+	if !ok {
+		return nil, fmt.Errorf("unknown key")
+	}
+
+	memoKey := txscript.TweakTaprootPrivKey(*priv, address.MemoKeyTweak[:])
+	digest := address.MemoDigest(memo)
+	return schnorr.Sign(memoKey, digest[:])
 }
 
 type MockGenSigner struct {
@@ -414,6 +484,12 @@ func (m *MockProofArchive) FetchProof(ctx context.Context,
 	return nil, nil
 }
 
+func (m *MockProofArchive) FetchProofs(ctx context.Context, assetID *asset.ID,
+	familyKey *btcec.PublicKey) ([]*proof.AnnotatedProof, error) {
+
+	return nil, nil
+}
+
 func (m *MockProofArchive) ImportProofs(ctx context.Context,
 	proofs ...*proof.AnnotatedProof) error {
 
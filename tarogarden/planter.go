@@ -36,8 +36,23 @@ type GardenKit struct {
 	// emission.
 	GenSigner asset.GenesisSigner
 
-	// ProofFiles stores the set of flat proof files.
+	// ProofFiles is the proof archive used to fetch the minting proofs of
+	// confirmed batches. The minting proofs themselves are stored by the
+	// MintingStore when a batch is marked as confirmed.
 	ProofFiles proof.Archiver
+
+	// HeaderVerifier is used to verify the block header of the minting
+	// proofs against the main chain.
+	HeaderVerifier proof.HeaderVerifier
+
+	// ReOrgWatcher is used to watch the minting transaction of a batch for
+	// chain re-organizations after it confirmed. This is optional.
+	ReOrgWatcher *ReOrgWatcher
+
+	// NumConfs is the default number of confirmations the minting
+	// transaction of a batch needs before the batch is considered final.
+	// A value of zero is treated as a single confirmation.
+	NumConfs uint32
 }
 
 // PlanterConfig is the main config for the ChainPlanter.
@@ -481,6 +496,7 @@ func (c *ChainPlanter) prepTaroSeedling(ctx context.Context,
 			Seedlings: map[string]*Seedling{
 				req.AssetName: req,
 			},
+			NumConfs: req.NumConfs,
 		}
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
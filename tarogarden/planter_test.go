@@ -19,6 +19,7 @@ import (
 	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/chanutils"
 	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/proof"
 	"github.com/lightninglabs/taro/tarodb"
 	_ "github.com/lightninglabs/taro/tarodb" // Register relevant drivers.
 	"github.com/lightninglabs/taro/tarogarden"
@@ -103,12 +104,13 @@ func (t *mintingTestHarness) refreshChainPlanter() {
 
 	t.planter = tarogarden.NewChainPlanter(tarogarden.PlanterConfig{
 		GardenKit: tarogarden.GardenKit{
-			Wallet:      t.wallet,
-			ChainBridge: t.chain,
-			Log:         t.store,
-			KeyRing:     t.keyRing,
-			GenSigner:   t.genSigner,
-			ProofFiles:  t.proofFiles,
+			Wallet:         t.wallet,
+			ChainBridge:    t.chain,
+			Log:            t.store,
+			KeyRing:        t.keyRing,
+			GenSigner:      t.genSigner,
+			ProofFiles:     t.proofFiles,
+			HeaderVerifier: proof.MockHeaderVerifier,
 		},
 		BatchTicker: t.ticker,
 		ErrChan:     t.errChan,
@@ -533,6 +535,62 @@ func testBasicAssetCreation(t *mintingTestHarness) {
 	t.assertNumCaretakersActive(0)
 }
 
+// testMintingFinality tests that a batch is only finalized once the minting
+// transaction reached the number of confirmations requested by its seedlings.
+func testMintingFinality(t *mintingTestHarness) {
+	t.Helper()
+
+	t.refreshChainPlanter()
+
+	// We'll create two seedlings, one of which requires the minting
+	// transaction to be confirmed three times.
+	const numSeedlings = 2
+	seedlings := t.newRandSeedlings(numSeedlings)
+	seedlings[0].NumConfs = 3
+	t.queueSeedlingsInBatch(seedlings...)
+	t.assertPendingBatchExists(numSeedlings)
+
+	// We'll now tick the batch and drive the caretaker all the way to
+	// broadcasting the minting transaction.
+	t.tickMintingBatch()
+	_ = t.assertGenesisTxFunded()
+	for i := 0; i < numSeedlings; i++ {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+	t.assertGenesisPsbtFinalized()
+	tx := t.assertTxPublished()
+
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{tx},
+	}
+
+	// The first confirmation confirms the batch, but the caretaker should
+	// now wait for the batch to become final.
+	sendConfNtfn := t.assertConfReqSent(tx, block)
+	sendConfNtfn()
+
+	sendFinalNtfn := t.assertConfReqSent(tx, block)
+	t.assertNumCaretakersActive(1)
+
+	// Once the transaction reached the required depth, the batch is
+	// finalized and the caretaker cleaned up.
+	sendFinalNtfn()
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+}
+
 // mintingStoreTestCase is used to programmatically run a series of test cases
 // that are parametrized based on a fresh minting store.
 type mintingStoreTestCase struct {
@@ -546,6 +604,10 @@ var testCases = []mintingStoreTestCase{
 		name:     "basic_asset_creation",
 		testFunc: testBasicAssetCreation,
 	},
+	{
+		name:     "minting_finality",
+		testFunc: testMintingFinality,
+	},
 }
 
 // TestBatchedAssetIssuance runs a test of tests to ensure that the set of
@@ -554,11 +616,13 @@ var testCases = []mintingStoreTestCase{
 func TestBatchedAssetIssuance(t *testing.T) {
 	t.Helper()
 
-	mintingStore := newMintingStore(t)
-
 	for _, testCase := range testCases {
 		testCase := testCase
 
+		// Each test case gets a fresh store, as the mock key ring of
+		// each harness starts deriving keys at the same locators.
+		mintingStore := newMintingStore(t)
+
 		t.Run(testCase.name, func(t *testing.T) {
 			mintTest := newMintingTestHarness(t, mintingStore)
 			testCase.testFunc(mintTest)
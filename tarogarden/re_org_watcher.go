@@ -0,0 +1,580 @@
+package tarogarden
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/proof"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+const (
+	// DefaultReOrgSafeDepth is the default number of confirmations after
+	// which we consider an anchor transaction to be safe from chain
+	// re-organizations and stop watching it.
+	DefaultReOrgSafeDepth = 6
+)
+
+// ReOrgEvent is sent to subscribers of the ReOrgWatcher whenever the
+// confirmation of a watched anchor transaction changed because of a chain
+// re-organization.
+type ReOrgEvent struct {
+	// Txid is the ID of the affected anchor transaction.
+	Txid chainhash.Hash
+
+	// Tx is the affected anchor transaction.
+	Tx *wire.MsgTx
+
+	// Conf is the new confirmation of the transaction. If this is nil, the
+	// transaction was re-organized out of the main chain and is currently
+	// unconfirmed.
+	Conf *chainntnfs.TxConfirmation
+}
+
+// ReOrgWatcherConfig houses all the items that the ReOrgWatcher needs to carry
+// out its duties.
+type ReOrgWatcherConfig struct {
+	// ChainBridge is the main interface for interacting with the chain
+	// backend.
+	ChainBridge ChainBridge
+
+	// AnchorStore is used to look up and update anchor transactions and
+	// the proofs anchored in them.
+	AnchorStore AnchorTxStore
+
+	// ProofArchive is used to replace the proofs of assets whose anchor
+	// transaction was re-confirmed in a different block.
+	ProofArchive *proof.MultiArchiver
+
+	// SafeDepth is the number of confirmations after which an anchor
+	// transaction is considered safe from re-organizations.
+	SafeDepth uint32
+
+	// ErrChan is the main error channel the watcher will report back
+	// critical errors to the main server.
+	ErrChan chan<- error
+}
+
+// watchedTx is an anchor transaction that is watched for re-organizations.
+type watchedTx struct {
+	// conf is the last known confirmation of the transaction.
+	conf *chainntnfs.TxConfirmation
+
+	// reOrged is true if the transaction was re-organized out of the main
+	// chain and hasn't been confirmed again yet.
+	reOrged bool
+
+	// cancel stops the confirmation notifications for the transaction.
+	cancel func()
+}
+
+// confResult is a confirmation notification of a watched transaction.
+type confResult struct {
+	txid chainhash.Hash
+
+	// conf is the new confirmation of the transaction. If this is nil, the
+	// transaction was re-organized out of the main chain.
+	conf *chainntnfs.TxConfirmation
+}
+
+// ReOrgWatcher watches the anchor transactions of minted, sent and received
+// assets until they reached a safe confirmation depth. If a transaction is
+// re-organized out of the main chain or re-confirmed in a different block, the
+// stored confirmation information and the proofs of all assets anchored in
+// the transaction are updated and subscribers are notified.
+type ReOrgWatcher struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	cfg *ReOrgWatcherConfig
+
+	// newWatch is used to hand new transactions to watch to the main
+	// event loop.
+	newWatch chan *chainntnfs.TxConfirmation
+
+	// confResults is used to deliver the confirmation notifications of
+	// watched transactions to the main event loop.
+	confResults chan *confResult
+
+	// watched is the set of transactions currently being watched. This is
+	// only accessed by the main event loop.
+	watched map[chainhash.Hash]*watchedTx
+
+	// subscribers is a map of components that want to be notified on
+	// re-org events, keyed by their subscription ID.
+	subscribers map[uint64]*chanutils.EventReceiver[*ReOrgEvent]
+
+	// subscriberMtx guards the subscribers map.
+	subscriberMtx sync.Mutex
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*chanutils.ContextGuard
+}
+
+// NewReOrgWatcher creates a new re-org watcher based on the passed config.
+func NewReOrgWatcher(cfg *ReOrgWatcherConfig) *ReOrgWatcher {
+	return &ReOrgWatcher{
+		cfg:         cfg,
+		newWatch:    make(chan *chainntnfs.TxConfirmation),
+		confResults: make(chan *confResult),
+		watched:     make(map[chainhash.Hash]*watchedTx),
+		subscribers: make(
+			map[uint64]*chanutils.EventReceiver[*ReOrgEvent],
+		),
+		ContextGuard: &chanutils.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start attempts to start a new re-org watcher.
+func (w *ReOrgWatcher) Start() error {
+	w.startOnce.Do(func() {
+		log.Info("Starting re-org watcher")
+
+		w.Wg.Add(1)
+		go w.watchChain()
+	})
+
+	return nil
+}
+
+// Stop signals for a re-org watcher to gracefully exit.
+func (w *ReOrgWatcher) Stop() error {
+	w.stopOnce.Do(func() {
+		close(w.Quit)
+		w.Wg.Wait()
+	})
+
+	return nil
+}
+
+// WatchTx adds the confirmed anchor transaction to the set of transactions
+// that are watched for re-organizations until they reach the safe depth.
+func (w *ReOrgWatcher) WatchTx(conf *chainntnfs.TxConfirmation) {
+	select {
+	case w.newWatch <- conf:
+	case <-w.Quit:
+	}
+}
+
+// watchChain is the main event loop of the re-org watcher. It reacts to
+// confirmation changes of the watched transactions and stops watching them
+// once new blocks bury them deep enough.
+func (w *ReOrgWatcher) watchChain() {
+	defer w.Wg.Done()
+
+	reportErr := func(err error) {
+		select {
+		case w.cfg.ErrChan <- err:
+		case <-w.Quit:
+		}
+	}
+
+	ctxStream, cancel := w.WithCtxQuitNoTimeout()
+	defer cancel()
+	chainBridge := w.cfg.ChainBridge
+	blockChan, blockErrChan, err := chainBridge.RegisterBlockEpochNtfn(
+		ctxStream,
+	)
+	if err != nil {
+		reportErr(fmt.Errorf("unable to register for blocks: %w", err))
+		return
+	}
+
+	// Load all anchor transactions that were confirmed recently enough to
+	// still be affected by a re-org.
+	ctxt, cancel := w.WithCtxQuit()
+	currentHeight, err := w.cfg.ChainBridge.CurrentHeight(ctxt)
+	cancel()
+	if err != nil {
+		reportErr(fmt.Errorf("unable to fetch height: %w", err))
+		return
+	}
+
+	var minHeight uint32
+	if currentHeight > w.cfg.SafeDepth {
+		minHeight = currentHeight - w.cfg.SafeDepth
+	}
+
+	ctxt, cancel = w.WithCtxQuit()
+	recentTxns, err := w.cfg.AnchorStore.FetchRecentAnchorTxns(
+		ctxt, minHeight,
+	)
+	cancel()
+	if err != nil {
+		reportErr(fmt.Errorf("unable to fetch recent anchor txns: %w",
+			err))
+		return
+	}
+
+	log.Infof("Watching %d recent anchor transactions for re-orgs",
+		len(recentTxns))
+	for _, conf := range recentTxns {
+		w.addWatch(conf)
+	}
+
+	for {
+		var err error
+		select {
+		case conf := <-w.newWatch:
+			w.addWatch(conf)
+
+		case height := <-blockChan:
+			err = w.handleNewBlock(uint32(height))
+
+		case result := <-w.confResults:
+			err = w.handleConf(result)
+
+		case err = <-blockErrChan:
+			break
+
+		case <-w.Quit:
+			for _, tx := range w.watched {
+				tx.cancel()
+			}
+
+			return
+		}
+
+		if err != nil {
+			log.Errorf("Aborting main re-org watcher event "+
+				"loop: %v", err)
+
+			reportErr(err)
+			return
+		}
+	}
+}
+
+// addWatch starts watching the given confirmed transaction, if it isn't
+// already being watched.
+func (w *ReOrgWatcher) addWatch(conf *chainntnfs.TxConfirmation) {
+	txid := conf.Tx.TxHash()
+	if _, ok := w.watched[txid]; ok {
+		return
+	}
+
+	log.Debugf("Watching anchor tx %v (block_hash=%v, height=%d) for "+
+		"re-orgs", txid, conf.BlockHash, conf.BlockHeight)
+
+	ctx, cancel := w.WithCtxQuitNoTimeout()
+	w.watched[txid] = &watchedTx{
+		conf:   conf,
+		cancel: cancel,
+	}
+
+	w.Wg.Add(1)
+	go w.watchConf(ctx, txid, conf)
+}
+
+// handleNewBlock stops watching all transactions that reached the safe depth
+// with the new block.
+func (w *ReOrgWatcher) handleNewBlock(height uint32) error {
+	for txid, tx := range w.watched {
+		// A transaction that is currently re-organized out of the
+		// chain needs to confirm again before it can become safe.
+		safeHeight := tx.conf.BlockHeight + w.cfg.SafeDepth - 1
+		if tx.reOrged || height < safeHeight {
+			continue
+		}
+
+		log.Debugf("Anchor tx %v reached safe depth, no longer "+
+			"watching for re-orgs", txid)
+
+		tx.cancel()
+		delete(w.watched, txid)
+	}
+
+	return nil
+}
+
+// watchConf registers for confirmation notifications of the given
+// transaction and delivers every confirmation and re-org notification to the
+// main event loop until the context is canceled.
+//
+// NOTE: A transaction that was re-organized out of the chain while we were
+// offline is only noticed once it confirms again, as the chain backend only
+// notifies us about re-orgs of confirmations it delivered to us before.
+//
+// NOTE: This MUST be run as a goroutine.
+func (w *ReOrgWatcher) watchConf(ctx context.Context, txid chainhash.Hash,
+	conf *chainntnfs.TxConfirmation) {
+
+	defer w.Wg.Done()
+
+	// The transaction can only be re-confirmed in a block that is above
+	// the fork point, which must be within the safe depth of the original
+	// confirmation.
+	var heightHint uint32
+	if conf.BlockHeight > w.cfg.SafeDepth {
+		heightHint = conf.BlockHeight - w.cfg.SafeDepth
+	}
+
+	// Any output script of the transaction is sufficient to identify it
+	// for the chain backend.
+	var pkScript []byte
+	if len(conf.Tx.TxOut) > 0 {
+		pkScript = conf.Tx.TxOut[0].PkScript
+	}
+
+	confNtfn, errChan, err := w.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, &txid, pkScript, 1, heightHint, true,
+	)
+	if err != nil {
+		log.Errorf("Unable to register for conf of anchor tx %v: %v",
+			txid, err)
+		return
+	}
+	defer confNtfn.Cancel()
+
+	for {
+		var result *confResult
+		select {
+		case newConf, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return
+			}
+			result = &confResult{txid: txid, conf: newConf}
+
+		case _, ok := <-confNtfn.NegativeConf:
+			if !ok {
+				return
+			}
+			result = &confResult{txid: txid}
+
+		// The chain backend considers the transaction final, there
+		// won't be any more notifications.
+		case <-confNtfn.Done:
+			return
+
+		case err := <-errChan:
+			log.Errorf("Error waiting for conf of anchor tx %v: %v",
+				txid, err)
+			return
+
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case w.confResults <- result:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// handleConf processes a confirmation notification. If the transaction was
+// re-organized out of the chain, it is marked as unconfirmed. If it is now
+// confirmed in a different block than before, the stored confirmation and all
+// proofs anchored in the transaction are updated.
+func (w *ReOrgWatcher) handleConf(result *confResult) error {
+	tx, ok := w.watched[result.txid]
+	if !ok {
+		return nil
+	}
+
+	newConf := result.conf
+	if newConf == nil {
+		if tx.reOrged {
+			return nil
+		}
+
+		log.Warnf("Anchor tx %v was re-organized out of the main "+
+			"chain (old_block_hash=%v)", result.txid,
+			tx.conf.BlockHash)
+
+		ctxt, cancel := w.WithCtxQuit()
+		err := w.cfg.AnchorStore.UpdateAnchorTxConf(
+			ctxt, result.txid, nil,
+		)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to mark anchor tx %v as "+
+				"unconfirmed: %w", result.txid, err)
+		}
+
+		tx.reOrged = true
+		w.publishEvent(&ReOrgEvent{
+			Txid: result.txid,
+			Tx:   tx.conf.Tx,
+		})
+
+		return nil
+	}
+	if newConf.Tx == nil {
+		newConf.Tx = tx.conf.Tx
+	}
+
+	// Nothing changed, the transaction is still in the same block.
+	if !tx.reOrged && *newConf.BlockHash == *tx.conf.BlockHash {
+		return nil
+	}
+
+	log.Infof("Anchor tx %v re-confirmed in block %v (height=%d) after "+
+		"re-org", result.txid, newConf.BlockHash, newConf.BlockHeight)
+
+	ctxt, cancel := w.CtxBlocking()
+	err := w.cfg.AnchorStore.UpdateAnchorTxConf(ctxt, result.txid, newConf)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to update conf of anchor tx %v: %w",
+			result.txid, err)
+	}
+
+	if err := w.updateProofs(result.txid, newConf); err != nil {
+		return err
+	}
+
+	tx.conf = newConf
+	tx.reOrged = false
+	w.publishEvent(&ReOrgEvent{
+		Txid: result.txid,
+		Tx:   newConf.Tx,
+		Conf: newConf,
+	})
+
+	return nil
+}
+
+// updateProofs re-anchors all proofs that are anchored in the given
+// transaction in the new block it was confirmed in.
+func (w *ReOrgWatcher) updateProofs(txid chainhash.Hash,
+	conf *chainntnfs.TxConfirmation) error {
+
+	if conf.Block == nil {
+		return fmt.Errorf("missing block for conf of anchor tx %v",
+			txid)
+	}
+
+	ctxt, cancel := w.WithCtxQuit()
+	blobs, err := w.cfg.AnchorStore.FetchAnchoredProofs(ctxt, txid)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("unable to fetch proofs anchored in %v: %w",
+			txid, err)
+	}
+
+	params := &proof.BaseProofParams{
+		Block:       conf.Block,
+		BlockHeight: conf.BlockHeight,
+		Tx:          conf.Tx,
+		TxIndex:     int(conf.TxIndex),
+	}
+
+	updatedProofs := make([]*proof.AnnotatedProof, 0, len(blobs))
+	for _, blob := range blobs {
+		file := proof.NewEmptyFile(proof.V0)
+		if err := file.Decode(bytes.NewReader(blob)); err != nil {
+			return fmt.Errorf("unable to decode proof file: %w",
+				err)
+		}
+
+		numUpdated, err := file.UpdateAnchorBlock(params)
+		if err != nil {
+			return fmt.Errorf("unable to update proof file: %w",
+				err)
+		}
+		if numUpdated == 0 {
+			continue
+		}
+
+		lastProof, err := file.LastProof()
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		if err := file.Encode(&buf); err != nil {
+			return fmt.Errorf("unable to encode proof file: %w",
+				err)
+		}
+
+		assetID := lastProof.Asset.ID()
+		locator := proof.Locator{
+			AssetID:   &assetID,
+			ScriptKey: *lastProof.Asset.ScriptKey.PubKey,
+		}
+		if lastProof.Asset.FamilyKey != nil {
+			locator.FamilyKey = &lastProof.Asset.FamilyKey.FamKey
+		}
+
+		updatedProofs = append(updatedProofs, &proof.AnnotatedProof{
+			Locator: locator,
+			Blob:    buf.Bytes(),
+		})
+	}
+
+	if len(updatedProofs) == 0 {
+		return nil
+	}
+
+	log.Infof("Re-anchoring %d proofs in block %v after re-org of tx %v",
+		len(updatedProofs), conf.BlockHash, txid)
+
+	ctxt, cancel = w.CtxBlocking()
+	defer cancel()
+	err = w.cfg.ProofArchive.ReplaceProofs(ctxt, updatedProofs...)
+	if err != nil {
+		return fmt.Errorf("unable to replace proofs: %w", err)
+	}
+
+	return nil
+}
+
+// publishEvent delivers the given event to all subscribers.
+func (w *ReOrgWatcher) publishEvent(event *ReOrgEvent) {
+	w.subscriberMtx.Lock()
+	defer w.subscriberMtx.Unlock()
+
+	for _, sub := range w.subscribers {
+		sub.NewItemCreated.ChanIn() <- event
+	}
+}
+
+// RegisterSubscriber adds a new subscriber for receiving events. Re-org
+// events are only delivered as they happen, so deliverExisting and
+// deliverFrom are ignored.
+func (w *ReOrgWatcher) RegisterSubscriber(
+	receiver *chanutils.EventReceiver[*ReOrgEvent],
+	deliverExisting bool, deliverFrom struct{}) error {
+
+	w.subscriberMtx.Lock()
+	defer w.subscriberMtx.Unlock()
+
+	w.subscribers[receiver.ID()] = receiver
+
+	return nil
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+func (w *ReOrgWatcher) RemoveSubscriber(
+	subscriber *chanutils.EventReceiver[*ReOrgEvent]) error {
+
+	w.subscriberMtx.Lock()
+	defer w.subscriberMtx.Unlock()
+
+	_, ok := w.subscribers[subscriber.ID()]
+	if !ok {
+		return fmt.Errorf("subscriber with ID %d not found",
+			subscriber.ID())
+	}
+
+	subscriber.Stop()
+	delete(w.subscribers, subscriber.ID())
+
+	return nil
+}
+
+// A compile-time assertion to make sure ReOrgWatcher satisfies the
+// chanutils.EventPublisher interface.
+var _ chanutils.EventPublisher[*ReOrgEvent, struct{}] = (*ReOrgWatcher)(nil)
@@ -0,0 +1,241 @@
+package tarogarden_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/chanutils"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/proof"
+	"github.com/lightninglabs/taro/tarogarden"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAnchorStore is a mock implementation of the tarogarden.AnchorTxStore
+// interface.
+type mockAnchorStore struct {
+	sync.Mutex
+
+	recent  []*chainntnfs.TxConfirmation
+	proofs  proof.AssetBlobs
+	updates chan *chainntnfs.TxConfirmation
+}
+
+func (m *mockAnchorStore) FetchRecentAnchorTxns(_ context.Context,
+	_ uint32) ([]*chainntnfs.TxConfirmation, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.recent, nil
+}
+
+func (m *mockAnchorStore) FetchAnchoredProofs(_ context.Context,
+	_ chainhash.Hash) (proof.AssetBlobs, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.proofs, nil
+}
+
+func (m *mockAnchorStore) UpdateAnchorTxConf(_ context.Context,
+	_ chainhash.Hash, conf *chainntnfs.TxConfirmation) error {
+
+	m.updates <- conf
+	return nil
+}
+
+// newTestBlock creates a new block that only contains the given transaction.
+func newTestBlock(tx *wire.MsgTx, nonce uint32) *wire.MsgBlock {
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	header := wire.NewBlockHeader(
+		0, &chainhash.Hash{}, merkleRoot, 0, nonce,
+	)
+
+	return &wire.MsgBlock{
+		Header:       *header,
+		Transactions: []*wire.MsgTx{tx},
+	}
+}
+
+// TestReOrgWatcher tests that the re-org watcher detects an anchor transaction
+// being re-organized out of the chain, updates the proofs once it's confirmed
+// again and stops watching it after it reached the safe depth.
+func TestReOrgWatcher(t *testing.T) {
+	t.Parallel()
+
+	// We start with an asset that is anchored in a transaction confirmed
+	// in block A.
+	newAsset, err := asset.New(
+		asset.RandGenesis(t, asset.Normal), 100, 0, 0,
+		asset.ScriptV0,
+		asset.NewScriptKey(test.RandPubKey(t)), nil,
+	)
+	require.NoError(t, err)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: []byte{0x51, 0x20},
+		Value:    1000,
+	})
+	txid := anchorTx.TxHash()
+
+	blockA := newTestBlock(anchorTx, 1)
+	blockAHash := blockA.BlockHash()
+	blockB := newTestBlock(anchorTx, 2)
+	blockBHash := blockB.BlockHash()
+
+	proofFile, err := proof.NewFile(proof.V0, proof.Proof{
+		BlockHeader: blockA.Header,
+		BlockHeight: 100,
+		AnchorTx:    *anchorTx,
+		Asset:       *newAsset,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, proofFile.Encode(&buf))
+
+	fileArchive, err := proof.NewFileArchiver(t.TempDir())
+	require.NoError(t, err)
+	proofArchive := proof.NewMultiArchiver(
+		proof.NewMockVerifier(t), testTimeout, fileArchive,
+	)
+
+	chainBridge := tarogarden.NewMockChainBridge()
+	scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
+	anchorStore := &mockAnchorStore{
+		recent: []*chainntnfs.TxConfirmation{{
+			BlockHash:   &blockAHash,
+			BlockHeight: 100,
+			Tx:          anchorTx,
+		}},
+		proofs: proof.AssetBlobs{
+			scriptKey: buf.Bytes(),
+		},
+		updates: make(chan *chainntnfs.TxConfirmation, 1),
+	}
+	errChan := make(chan error, 1)
+	watcher := tarogarden.NewReOrgWatcher(&tarogarden.ReOrgWatcherConfig{
+		ChainBridge:  chainBridge,
+		AnchorStore:  anchorStore,
+		ProofArchive: proofArchive,
+		SafeDepth:    3,
+		ErrChan:      errChan,
+	})
+
+	sub := chanutils.NewEventReceiver[*tarogarden.ReOrgEvent](
+		chanutils.DefaultQueueSize,
+	)
+	require.NoError(t, watcher.RegisterSubscriber(sub, false, struct{}{}))
+
+	require.NoError(t, watcher.Start())
+	t.Cleanup(func() {
+		require.NoError(t, watcher.RemoveSubscriber(sub))
+		require.NoError(t, watcher.Stop())
+	})
+
+	_, err = chanutils.RecvOrTimeout(
+		chainBridge.BlockEpochSignal, testTimeout,
+	)
+	require.NoError(t, err)
+
+	sendBlock := func(height int32) {
+		select {
+		case chainBridge.NewBlocks <- height:
+		case <-time.After(testTimeout):
+			t.Fatalf("unable to send block %d", height)
+		}
+	}
+	assertEvent := func(conf *chainntnfs.TxConfirmation) {
+		event, err := chanutils.RecvOrTimeout(
+			sub.NewItemCreated.ChanOut(), testTimeout,
+		)
+		require.NoError(t, err)
+		require.Equal(t, txid, (*event).Txid)
+		require.Equal(t, conf, (*event).Conf)
+
+		update, err := chanutils.RecvOrTimeout(
+			anchorStore.updates, testTimeout,
+		)
+		require.NoError(t, err)
+		require.Equal(t, conf, *update)
+	}
+
+	// The watcher registers for confirmations of the recent anchor
+	// transaction. The chain backend first confirms the transaction in the
+	// block we already know, which doesn't change anything.
+	reqNo, err := chanutils.RecvOrTimeout(
+		chainBridge.ConfReqSignal, testTimeout,
+	)
+	require.NoError(t, err)
+	chainBridge.SendConfNtfn(*reqNo, &blockAHash, 100, 0, blockA, anchorTx)
+
+	// A new block alone doesn't make the transaction look re-organized.
+	sendBlock(101)
+
+	// Once the chain backend tells us about the re-org, the transaction is
+	// marked as unconfirmed.
+	chainBridge.SendReOrgNtfn(*reqNo)
+	assertEvent(nil)
+
+	// The transaction now confirms in block B, which should update the
+	// proofs anchored in it.
+	newConf := &chainntnfs.TxConfirmation{
+		BlockHash:   &blockBHash,
+		BlockHeight: 102,
+		TxIndex:     0,
+		Block:       blockB,
+		Tx:          anchorTx,
+	}
+	chainBridge.SendConfNtfn(*reqNo, &blockBHash, 102, 0, blockB, anchorTx)
+	assertEvent(newConf)
+
+	assetID := newAsset.ID()
+	blob, err := fileArchive.FetchProof(context.Background(), proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *newAsset.ScriptKey.PubKey,
+	})
+	require.NoError(t, err)
+
+	updatedFile := proof.NewEmptyFile(proof.V0)
+	require.NoError(t, updatedFile.Decode(bytes.NewReader(blob)))
+	updatedProof, err := updatedFile.LastProof()
+	require.NoError(t, err)
+	require.Equal(t, blockB.Header, updatedProof.BlockHeader)
+	require.EqualValues(t, 102, updatedProof.BlockHeight)
+
+	// Once the safe depth is reached, the transaction is no longer
+	// watched, so no further events are published.
+	sendBlock(103)
+	sendBlock(104)
+	sendBlock(105)
+	_, err = chanutils.RecvOrTimeout(
+		sub.NewItemCreated.ChanOut(), testPollInterval,
+	)
+	require.Error(t, err)
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("unexpected error: %v", err)
+	default:
+	}
+}
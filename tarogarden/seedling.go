@@ -2,6 +2,7 @@ package tarogarden
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taro/asset"
@@ -21,6 +22,14 @@ var (
 	// ErrInvalidAssetAmt is returned in an asset request has an invalid
 	// amount.
 	ErrInvalidAssetAmt = fmt.Errorf("asset amt cannot be zero")
+
+	// ErrInvalidLockTime is returned if an asset request has a lock time
+	// or relative lock time that is out of range.
+	ErrInvalidLockTime = fmt.Errorf("asset lock time out of range")
+
+	// ErrInvalidScriptVersion is returned if an asset request has an
+	// unknown script version.
+	ErrInvalidScriptVersion = fmt.Errorf("unknown asset script version")
 )
 
 // MintingState is an enum that tracks an asset through the various minting
@@ -84,6 +93,20 @@ type Seedling struct {
 	// Amount is the total amount of the asset.
 	Amount uint64
 
+	// LockTime, if non-zero, is the block height before which the asset
+	// can't be moved.
+	LockTime uint64
+
+	// RelativeLockTime, if non-zero, is the number of blocks that need to
+	// pass after the confirmation of the asset's last transfer before it
+	// can be moved.
+	RelativeLockTime uint64
+
+	// ScriptVersion is the script version the asset is created with, which
+	// determines how its script key is validated. Assets created with
+	// ScriptV1 can only be spent by nodes that enable it.
+	ScriptVersion asset.ScriptVersion
+
 	// EnableEmission if true, then an asset family key will be specified
 	// for this asset meaning future assets linked to it can be created.
 	EnableEmission bool
@@ -92,6 +115,21 @@ type Seedling struct {
 	// batch without waiting for the normal ticker.
 	NoBatch bool
 
+	// NumConfs, if non-zero, overrides the number of confirmations the
+	// minting transaction needs before the batch this seedling is part of
+	// is considered final.
+	NumConfs uint32
+
+	// Label is an optional free-form label of the seedling that can be
+	// used to map it to an entity of an external system. Unlike the asset
+	// metadata, it doesn't affect the asset ID.
+	Label string
+
+	// ExternalMetadata is an optional set of key/value pairs that describe
+	// the seedling in the context of an external system. Unlike the asset
+	// metadata, it doesn't affect the asset ID.
+	ExternalMetadata map[string]string
+
 	// update is used to send updates w.r.t the state of the batch.
 	updates SeedlingUpdates
 }
@@ -119,6 +157,16 @@ func (c Seedling) validateFields() error {
 	// Creating an asset with zero available supply is not allowed.
 	case c.Amount == 0:
 		return ErrInvalidAssetAmt
+
+	// Lock times are block heights (or a number of blocks), which we
+	// store as 32-bit integers.
+	case c.LockTime > math.MaxInt32 || c.RelativeLockTime > math.MaxInt32:
+		return ErrInvalidLockTime
+
+	case c.ScriptVersion != asset.ScriptV0 &&
+		c.ScriptVersion != asset.ScriptV1:
+
+		return ErrInvalidScriptVersion
 	}
 
 	return nil
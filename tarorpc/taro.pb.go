@@ -74,6 +74,241 @@ func (AssetType) EnumDescriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{0}
 }
 
+type AmountPolicy int32
+
+const (
+	// The address only accepts exactly the requested amount.
+	AmountPolicy_AMOUNT_POLICY_EXACT AmountPolicy = 0
+	// The address accepts the requested amount or any larger amount.
+	AmountPolicy_AMOUNT_POLICY_AT_LEAST AmountPolicy = 1
+	//
+	//The address accepts any amount. The requested amount of such an address
+	//must be zero.
+	AmountPolicy_AMOUNT_POLICY_OPEN AmountPolicy = 2
+)
+
+// Enum value maps for AmountPolicy.
+var (
+	AmountPolicy_name = map[int32]string{
+		0: "AMOUNT_POLICY_EXACT",
+		1: "AMOUNT_POLICY_AT_LEAST",
+		2: "AMOUNT_POLICY_OPEN",
+	}
+	AmountPolicy_value = map[string]int32{
+		"AMOUNT_POLICY_EXACT":    0,
+		"AMOUNT_POLICY_AT_LEAST": 1,
+		"AMOUNT_POLICY_OPEN":     2,
+	}
+)
+
+func (x AmountPolicy) Enum() *AmountPolicy {
+	p := new(AmountPolicy)
+	*p = x
+	return p
+}
+
+func (x AmountPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmountPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[1].Descriptor()
+}
+
+func (AmountPolicy) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[1]
+}
+
+func (x AmountPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmountPolicy.Descriptor instead.
+func (AmountPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{1}
+}
+
+// ValidationErrorCode identifies the reason an asset state transition, proof or
+// commitment failed validation. Invalid requests are returned with the gRPC
+// status code INVALID_ARGUMENT and a ValidationError in the status details.
+type ValidationErrorCode int32
+
+const (
+	ValidationErrorCode_VALIDATION_ERROR_UNKNOWN ValidationErrorCode = 0
+	// Taro VM errors.
+	ValidationErrorCode_VALIDATION_ERROR_VM_NO_SPLIT_COMMITMENT              ValidationErrorCode = 1
+	ValidationErrorCode_VALIDATION_ERROR_VM_ID_MISMATCH                      ValidationErrorCode = 2
+	ValidationErrorCode_VALIDATION_ERROR_VM_TYPE_MISMATCH                    ValidationErrorCode = 3
+	ValidationErrorCode_VALIDATION_ERROR_VM_SCRIPT_KEY_MISMATCH              ValidationErrorCode = 4
+	ValidationErrorCode_VALIDATION_ERROR_VM_AMOUNT_MISMATCH                  ValidationErrorCode = 5
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SIG_HASH_FLAG            ValidationErrorCode = 6
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_GENESIS_STATE_TRANSITION ValidationErrorCode = 7
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_TRANSFER_WITNESS         ValidationErrorCode = 8
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SPLIT_ASSET_TYPE         ValidationErrorCode = 9
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_WITNESS ValidationErrorCode = 10
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_PROOF   ValidationErrorCode = 11
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_ROOT_ASSET               ValidationErrorCode = 12
+	ValidationErrorCode_VALIDATION_ERROR_VM_LOCK_TIME_NOT_REACHED            ValidationErrorCode = 13
+	ValidationErrorCode_VALIDATION_ERROR_VM_RELATIVE_LOCK_TIME_NOT_REACHED   ValidationErrorCode = 14
+	ValidationErrorCode_VALIDATION_ERROR_VM_MISSING_INPUT_HEIGHT             ValidationErrorCode = 15
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_ASSET_OPCODE             ValidationErrorCode = 16
+	ValidationErrorCode_VALIDATION_ERROR_VM_ASSET_COVENANT_VIOLATION         ValidationErrorCode = 17
+	ValidationErrorCode_VALIDATION_ERROR_VM_COLLECTIBLE_MERGE                ValidationErrorCode = 18
+	ValidationErrorCode_VALIDATION_ERROR_VM_COLLECTIBLE_SPLIT                ValidationErrorCode = 19
+	ValidationErrorCode_VALIDATION_ERROR_VM_INVALID_SCRIPT_VERSION           ValidationErrorCode = 20
+	ValidationErrorCode_VALIDATION_ERROR_VM_INPUT_MISMATCH                   ValidationErrorCode = 21
+	ValidationErrorCode_VALIDATION_ERROR_VM_NO_INPUTS                        ValidationErrorCode = 22
+	// Proof verification errors.
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_TAPROOT_PROOF    ValidationErrorCode = 100
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_TX_MERKLE_PROOF  ValidationErrorCode = 101
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_MISSING_EXCLUSION_PROOFS ValidationErrorCode = 102
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_MISSING_SPLIT_ROOT_PROOF ValidationErrorCode = 103
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_COMMITMENT_PROOF ValidationErrorCode = 104
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_TAPSCRIPT_PROOF  ValidationErrorCode = 105
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_INVALID_CHECKSUM         ValidationErrorCode = 106
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_UNTRUSTED_CHECKPOINT     ValidationErrorCode = 107
+	ValidationErrorCode_VALIDATION_ERROR_PROOF_CHECKPOINT_MISMATCH      ValidationErrorCode = 108
+	// Commitment errors.
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_MISSING_ASSET_PROOF          ValidationErrorCode = 200
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_DUPLICATE_SPLIT_OUTPUT_INDEX ValidationErrorCode = 201
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_AMOUNT         ValidationErrorCode = 202
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_LOCATOR        ValidationErrorCode = 203
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_INVALID_SCRIPT_KEY           ValidationErrorCode = 204
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_ZERO_SPLIT_AMOUNT            ValidationErrorCode = 205
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_NON_ZERO_SPLIT_AMOUNT        ValidationErrorCode = 206
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_COLLECTIBLE_SPLIT            ValidationErrorCode = 207
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_NO_ASSETS                    ValidationErrorCode = 208
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_GENESIS_MISMATCH             ValidationErrorCode = 209
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_FAMILY_KEY_MISMATCH          ValidationErrorCode = 210
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_DUPLICATE_SCRIPT_KEY         ValidationErrorCode = 211
+	ValidationErrorCode_VALIDATION_ERROR_COMMITMENT_GENESIS_INVALID_SIG          ValidationErrorCode = 212
+)
+
+// Enum value maps for ValidationErrorCode.
+var (
+	ValidationErrorCode_name = map[int32]string{
+		0:   "VALIDATION_ERROR_UNKNOWN",
+		1:   "VALIDATION_ERROR_VM_NO_SPLIT_COMMITMENT",
+		2:   "VALIDATION_ERROR_VM_ID_MISMATCH",
+		3:   "VALIDATION_ERROR_VM_TYPE_MISMATCH",
+		4:   "VALIDATION_ERROR_VM_SCRIPT_KEY_MISMATCH",
+		5:   "VALIDATION_ERROR_VM_AMOUNT_MISMATCH",
+		6:   "VALIDATION_ERROR_VM_INVALID_SIG_HASH_FLAG",
+		7:   "VALIDATION_ERROR_VM_INVALID_GENESIS_STATE_TRANSITION",
+		8:   "VALIDATION_ERROR_VM_INVALID_TRANSFER_WITNESS",
+		9:   "VALIDATION_ERROR_VM_INVALID_SPLIT_ASSET_TYPE",
+		10:  "VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_WITNESS",
+		11:  "VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_PROOF",
+		12:  "VALIDATION_ERROR_VM_INVALID_ROOT_ASSET",
+		13:  "VALIDATION_ERROR_VM_LOCK_TIME_NOT_REACHED",
+		14:  "VALIDATION_ERROR_VM_RELATIVE_LOCK_TIME_NOT_REACHED",
+		15:  "VALIDATION_ERROR_VM_MISSING_INPUT_HEIGHT",
+		16:  "VALIDATION_ERROR_VM_INVALID_ASSET_OPCODE",
+		17:  "VALIDATION_ERROR_VM_ASSET_COVENANT_VIOLATION",
+		18:  "VALIDATION_ERROR_VM_COLLECTIBLE_MERGE",
+		19:  "VALIDATION_ERROR_VM_COLLECTIBLE_SPLIT",
+		20:  "VALIDATION_ERROR_VM_INVALID_SCRIPT_VERSION",
+		21:  "VALIDATION_ERROR_VM_INPUT_MISMATCH",
+		22:  "VALIDATION_ERROR_VM_NO_INPUTS",
+		100: "VALIDATION_ERROR_PROOF_INVALID_TAPROOT_PROOF",
+		101: "VALIDATION_ERROR_PROOF_INVALID_TX_MERKLE_PROOF",
+		102: "VALIDATION_ERROR_PROOF_MISSING_EXCLUSION_PROOFS",
+		103: "VALIDATION_ERROR_PROOF_MISSING_SPLIT_ROOT_PROOF",
+		104: "VALIDATION_ERROR_PROOF_INVALID_COMMITMENT_PROOF",
+		105: "VALIDATION_ERROR_PROOF_INVALID_TAPSCRIPT_PROOF",
+		106: "VALIDATION_ERROR_PROOF_INVALID_CHECKSUM",
+		107: "VALIDATION_ERROR_PROOF_UNTRUSTED_CHECKPOINT",
+		108: "VALIDATION_ERROR_PROOF_CHECKPOINT_MISMATCH",
+		200: "VALIDATION_ERROR_COMMITMENT_MISSING_ASSET_PROOF",
+		201: "VALIDATION_ERROR_COMMITMENT_DUPLICATE_SPLIT_OUTPUT_INDEX",
+		202: "VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_AMOUNT",
+		203: "VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_LOCATOR",
+		204: "VALIDATION_ERROR_COMMITMENT_INVALID_SCRIPT_KEY",
+		205: "VALIDATION_ERROR_COMMITMENT_ZERO_SPLIT_AMOUNT",
+		206: "VALIDATION_ERROR_COMMITMENT_NON_ZERO_SPLIT_AMOUNT",
+		207: "VALIDATION_ERROR_COMMITMENT_COLLECTIBLE_SPLIT",
+		208: "VALIDATION_ERROR_COMMITMENT_NO_ASSETS",
+		209: "VALIDATION_ERROR_COMMITMENT_GENESIS_MISMATCH",
+		210: "VALIDATION_ERROR_COMMITMENT_FAMILY_KEY_MISMATCH",
+		211: "VALIDATION_ERROR_COMMITMENT_DUPLICATE_SCRIPT_KEY",
+		212: "VALIDATION_ERROR_COMMITMENT_GENESIS_INVALID_SIG",
+	}
+	ValidationErrorCode_value = map[string]int32{
+		"VALIDATION_ERROR_UNKNOWN":                                 0,
+		"VALIDATION_ERROR_VM_NO_SPLIT_COMMITMENT":                  1,
+		"VALIDATION_ERROR_VM_ID_MISMATCH":                          2,
+		"VALIDATION_ERROR_VM_TYPE_MISMATCH":                        3,
+		"VALIDATION_ERROR_VM_SCRIPT_KEY_MISMATCH":                  4,
+		"VALIDATION_ERROR_VM_AMOUNT_MISMATCH":                      5,
+		"VALIDATION_ERROR_VM_INVALID_SIG_HASH_FLAG":                6,
+		"VALIDATION_ERROR_VM_INVALID_GENESIS_STATE_TRANSITION":     7,
+		"VALIDATION_ERROR_VM_INVALID_TRANSFER_WITNESS":             8,
+		"VALIDATION_ERROR_VM_INVALID_SPLIT_ASSET_TYPE":             9,
+		"VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_WITNESS":     10,
+		"VALIDATION_ERROR_VM_INVALID_SPLIT_COMMITMENT_PROOF":       11,
+		"VALIDATION_ERROR_VM_INVALID_ROOT_ASSET":                   12,
+		"VALIDATION_ERROR_VM_LOCK_TIME_NOT_REACHED":                13,
+		"VALIDATION_ERROR_VM_RELATIVE_LOCK_TIME_NOT_REACHED":       14,
+		"VALIDATION_ERROR_VM_MISSING_INPUT_HEIGHT":                 15,
+		"VALIDATION_ERROR_VM_INVALID_ASSET_OPCODE":                 16,
+		"VALIDATION_ERROR_VM_ASSET_COVENANT_VIOLATION":             17,
+		"VALIDATION_ERROR_VM_COLLECTIBLE_MERGE":                    18,
+		"VALIDATION_ERROR_VM_COLLECTIBLE_SPLIT":                    19,
+		"VALIDATION_ERROR_VM_INVALID_SCRIPT_VERSION":               20,
+		"VALIDATION_ERROR_VM_INPUT_MISMATCH":                       21,
+		"VALIDATION_ERROR_VM_NO_INPUTS":                            22,
+		"VALIDATION_ERROR_PROOF_INVALID_TAPROOT_PROOF":             100,
+		"VALIDATION_ERROR_PROOF_INVALID_TX_MERKLE_PROOF":           101,
+		"VALIDATION_ERROR_PROOF_MISSING_EXCLUSION_PROOFS":          102,
+		"VALIDATION_ERROR_PROOF_MISSING_SPLIT_ROOT_PROOF":          103,
+		"VALIDATION_ERROR_PROOF_INVALID_COMMITMENT_PROOF":          104,
+		"VALIDATION_ERROR_PROOF_INVALID_TAPSCRIPT_PROOF":           105,
+		"VALIDATION_ERROR_PROOF_INVALID_CHECKSUM":                  106,
+		"VALIDATION_ERROR_PROOF_UNTRUSTED_CHECKPOINT":              107,
+		"VALIDATION_ERROR_PROOF_CHECKPOINT_MISMATCH":               108,
+		"VALIDATION_ERROR_COMMITMENT_MISSING_ASSET_PROOF":          200,
+		"VALIDATION_ERROR_COMMITMENT_DUPLICATE_SPLIT_OUTPUT_INDEX": 201,
+		"VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_AMOUNT":         202,
+		"VALIDATION_ERROR_COMMITMENT_INVALID_SPLIT_LOCATOR":        203,
+		"VALIDATION_ERROR_COMMITMENT_INVALID_SCRIPT_KEY":           204,
+		"VALIDATION_ERROR_COMMITMENT_ZERO_SPLIT_AMOUNT":            205,
+		"VALIDATION_ERROR_COMMITMENT_NON_ZERO_SPLIT_AMOUNT":        206,
+		"VALIDATION_ERROR_COMMITMENT_COLLECTIBLE_SPLIT":            207,
+		"VALIDATION_ERROR_COMMITMENT_NO_ASSETS":                    208,
+		"VALIDATION_ERROR_COMMITMENT_GENESIS_MISMATCH":             209,
+		"VALIDATION_ERROR_COMMITMENT_FAMILY_KEY_MISMATCH":          210,
+		"VALIDATION_ERROR_COMMITMENT_DUPLICATE_SCRIPT_KEY":         211,
+		"VALIDATION_ERROR_COMMITMENT_GENESIS_INVALID_SIG":          212,
+	}
+)
+
+func (x ValidationErrorCode) Enum() *ValidationErrorCode {
+	p := new(ValidationErrorCode)
+	*p = x
+	return p
+}
+
+func (x ValidationErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_taro_proto_enumTypes[2].Descriptor()
+}
+
+func (ValidationErrorCode) Type() protoreflect.EnumType {
+	return &file_taro_proto_enumTypes[2]
+}
+
+func (x ValidationErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationErrorCode.Descriptor instead.
+func (ValidationErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{2}
+}
+
 type AddrEventStatus int32
 
 const (
@@ -82,6 +317,8 @@ const (
 	AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED AddrEventStatus = 2
 	AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED        AddrEventStatus = 3
 	AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED             AddrEventStatus = 4
+	AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_FINAL     AddrEventStatus = 5
+	AddrEventStatus_ADDR_EVENT_STATUS_LATE_PAYMENT          AddrEventStatus = 6
 )
 
 // Enum value maps for AddrEventStatus.
@@ -92,6 +329,8 @@ var (
 		2: "ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED",
 		3: "ADDR_EVENT_STATUS_PROOF_RECEIVED",
 		4: "ADDR_EVENT_STATUS_COMPLETED",
+		5: "ADDR_EVENT_STATUS_TRANSACTION_FINAL",
+		6: "ADDR_EVENT_STATUS_LATE_PAYMENT",
 	}
 	AddrEventStatus_value = map[string]int32{
 		"ADDR_EVENT_STATUS_UNKNOWN":               0,
@@ -99,6 +338,8 @@ var (
 		"ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED": 2,
 		"ADDR_EVENT_STATUS_PROOF_RECEIVED":        3,
 		"ADDR_EVENT_STATUS_COMPLETED":             4,
+		"ADDR_EVENT_STATUS_TRANSACTION_FINAL":     5,
+		"ADDR_EVENT_STATUS_LATE_PAYMENT":          6,
 	}
 )
 
@@ -113,11 +354,11 @@ func (x AddrEventStatus) String() string {
 }
 
 func (AddrEventStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_taro_proto_enumTypes[1].Descriptor()
+	return file_taro_proto_enumTypes[3].Descriptor()
 }
 
 func (AddrEventStatus) Type() protoreflect.EnumType {
-	return &file_taro_proto_enumTypes[1]
+	return &file_taro_proto_enumTypes[3]
 }
 
 func (x AddrEventStatus) Number() protoreflect.EnumNumber {
@@ -126,7 +367,7 @@ func (x AddrEventStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AddrEventStatus.Descriptor instead.
 func (AddrEventStatus) EnumDescriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{1}
+	return file_taro_proto_rawDescGZIP(), []int{3}
 }
 
 type MintAssetRequest struct {
@@ -154,6 +395,34 @@ type MintAssetRequest struct {
 	//If true, then a batch will be created immediately. Otherwise the asset
 	//creation transaction may be batched with other pending minting requests.
 	SkipBatch bool `protobuf:"varint,6,opt,name=skip_batch,json=skipBatch,proto3" json:"skip_batch,omitempty"`
+	//
+	//An optional absolute lock time. If set, the asset can't be moved before
+	//the block with this height.
+	LockTime int32 `protobuf:"varint,7,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	//
+	//An optional relative lock time. If set, the asset can't be moved until
+	//this number of blocks has passed since its last transfer was confirmed.
+	RelativeLockTime int32 `protobuf:"varint,8,opt,name=relative_lock_time,json=relativeLockTime,proto3" json:"relative_lock_time,omitempty"`
+	//
+	//An optional override of the number of confirmations the minting
+	//transaction needs before the batch is considered final. If the asset is
+	//batched with other assets, the highest requested number is used.
+	NumConfs uint32 `protobuf:"varint,9,opt,name=num_confs,json=numConfs,proto3" json:"num_confs,omitempty"`
+	//
+	//An optional free-form label of the asset that can be used to map it to an
+	//entity of an external system. Unlike the meta data, it doesn't affect the
+	//final asset ID.
+	Label string `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional set of key/value pairs that describe the asset in the context
+	//of an external system. Unlike the meta data, it doesn't affect the final
+	//asset ID.
+	ExternalMetadata map[string]string `protobuf:"bytes,11,rep,name=external_metadata,json=externalMetadata,proto3" json:"external_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The script version the asset is created with. Version 1 adds asset-aware
+	//opcodes to tapscript and is experimental, assets using it can only be
+	//spent by nodes that enable it.
+	ScriptVersion int32 `protobuf:"varint,12,opt,name=script_version,json=scriptVersion,proto3" json:"script_version,omitempty"`
 }
 
 func (x *MintAssetRequest) Reset() {
@@ -230,6 +499,48 @@ func (x *MintAssetRequest) GetSkipBatch() bool {
 	return false
 }
 
+func (x *MintAssetRequest) GetLockTime() int32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+func (x *MintAssetRequest) GetRelativeLockTime() int32 {
+	if x != nil {
+		return x.RelativeLockTime
+	}
+	return 0
+}
+
+func (x *MintAssetRequest) GetNumConfs() uint32 {
+	if x != nil {
+		return x.NumConfs
+	}
+	return 0
+}
+
+func (x *MintAssetRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MintAssetRequest) GetExternalMetadata() map[string]string {
+	if x != nil {
+		return x.ExternalMetadata
+	}
+	return nil
+}
+
+func (x *MintAssetRequest) GetScriptVersion() int32 {
+	if x != nil {
+		return x.ScriptVersion
+	}
+	return 0
+}
+
 type MintAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -285,6 +596,11 @@ type ListAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, only assets whose anchor transaction has at least this number of
+	//confirmations are returned.
+	MinConfs uint32 `protobuf:"varint,1,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
 }
 
 func (x *ListAssetRequest) Reset() {
@@ -319,6 +635,13 @@ func (*ListAssetRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *ListAssetRequest) GetMinConfs() uint32 {
+	if x != nil {
+		return x.MinConfs
+	}
+	return 0
+}
+
 type AnchorInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -754,26 +1077,14 @@ func (x *ListAssetResponse) GetAssets() []*Asset {
 	return nil
 }
 
-type ListBalancesRequest struct {
+type ListCollectiblesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to GroupBy:
-	//	*ListBalancesRequest_AssetId
-	//	*ListBalancesRequest_FamKey
-	GroupBy isListBalancesRequest_GroupBy `protobuf_oneof:"group_by"`
-	// If the query results should grouped by asset ids, then an optional asset
-	// filter may be provided to query balance of a specific asset.
-	AssetFilter []byte `protobuf:"bytes,3,opt,name=asset_filter,json=assetFilter,proto3" json:"asset_filter,omitempty"`
-	// If the query results should be grouped by family keys, then an optional
-	// family key filter may be provided to query the balance of a specific
-	// asset family.
-	FamilyKeyFilter []byte `protobuf:"bytes,4,opt,name=family_key_filter,json=familyKeyFilter,proto3" json:"family_key_filter,omitempty"`
 }
 
-func (x *ListBalancesRequest) Reset() {
-	*x = ListBalancesRequest{}
+func (x *ListCollectiblesRequest) Reset() {
+	*x = ListCollectiblesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -781,13 +1092,13 @@ func (x *ListBalancesRequest) Reset() {
 	}
 }
 
-func (x *ListBalancesRequest) String() string {
+func (x *ListCollectiblesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBalancesRequest) ProtoMessage() {}
+func (*ListCollectiblesRequest) ProtoMessage() {}
 
-func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCollectiblesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -799,79 +1110,27 @@ func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBalancesRequest.ProtoReflect.Descriptor instead.
-func (*ListBalancesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCollectiblesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectiblesRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *ListBalancesRequest) GetGroupBy() isListBalancesRequest_GroupBy {
-	if m != nil {
-		return m.GroupBy
-	}
-	return nil
-}
-
-func (x *ListBalancesRequest) GetAssetId() bool {
-	if x, ok := x.GetGroupBy().(*ListBalancesRequest_AssetId); ok {
-		return x.AssetId
-	}
-	return false
-}
-
-func (x *ListBalancesRequest) GetFamKey() bool {
-	if x, ok := x.GetGroupBy().(*ListBalancesRequest_FamKey); ok {
-		return x.FamKey
-	}
-	return false
-}
-
-func (x *ListBalancesRequest) GetAssetFilter() []byte {
-	if x != nil {
-		return x.AssetFilter
-	}
-	return nil
-}
-
-func (x *ListBalancesRequest) GetFamilyKeyFilter() []byte {
-	if x != nil {
-		return x.FamilyKeyFilter
-	}
-	return nil
-}
-
-type isListBalancesRequest_GroupBy interface {
-	isListBalancesRequest_GroupBy()
-}
-
-type ListBalancesRequest_AssetId struct {
-	// Group results by asset IDs.
-	AssetId bool `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3,oneof"`
-}
-
-type ListBalancesRequest_FamKey struct {
-	// Group results by family keys.
-	FamKey bool `protobuf:"varint,2,opt,name=fam_key,json=famKey,proto3,oneof"`
-}
-
-func (*ListBalancesRequest_AssetId) isListBalancesRequest_GroupBy() {}
-
-func (*ListBalancesRequest_FamKey) isListBalancesRequest_GroupBy() {}
-
-type AssetBalance struct {
+type CollectibleOwner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The base genesis information of an asset. This information never changes.
-	AssetGenesis *GenesisInfo `protobuf:"bytes,1,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
-	// The type of the asset.
-	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
-	// The balance of the asset owned by the target daemon.
-	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The outpoint of the anchor transaction output that held the
+	// collectible, formatted as txid:index.
+	AnchorOutpoint string `protobuf:"bytes,1,opt,name=anchor_outpoint,json=anchorOutpoint,proto3" json:"anchor_outpoint,omitempty"`
+	// The hash of the block the anchor transaction was confirmed in.
+	AnchorBlockHash []byte `protobuf:"bytes,2,opt,name=anchor_block_hash,json=anchorBlockHash,proto3" json:"anchor_block_hash,omitempty"`
+	// The script key the collectible was locked to at this outpoint.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
 }
 
-func (x *AssetBalance) Reset() {
-	*x = AssetBalance{}
+func (x *CollectibleOwner) Reset() {
+	*x = CollectibleOwner{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -879,13 +1138,13 @@ func (x *AssetBalance) Reset() {
 	}
 }
 
-func (x *AssetBalance) String() string {
+func (x *CollectibleOwner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetBalance) ProtoMessage() {}
+func (*CollectibleOwner) ProtoMessage() {}
 
-func (x *AssetBalance) ProtoReflect() protoreflect.Message {
+func (x *CollectibleOwner) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -897,45 +1156,46 @@ func (x *AssetBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetBalance.ProtoReflect.Descriptor instead.
-func (*AssetBalance) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectibleOwner.ProtoReflect.Descriptor instead.
+func (*CollectibleOwner) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AssetBalance) GetAssetGenesis() *GenesisInfo {
+func (x *CollectibleOwner) GetAnchorOutpoint() string {
 	if x != nil {
-		return x.AssetGenesis
+		return x.AnchorOutpoint
 	}
-	return nil
+	return ""
 }
 
-func (x *AssetBalance) GetAssetType() AssetType {
+func (x *CollectibleOwner) GetAnchorBlockHash() []byte {
 	if x != nil {
-		return x.AssetType
+		return x.AnchorBlockHash
 	}
-	return AssetType_NORMAL
+	return nil
 }
 
-func (x *AssetBalance) GetBalance() int64 {
+func (x *CollectibleOwner) GetScriptKey() []byte {
 	if x != nil {
-		return x.Balance
+		return x.ScriptKey
 	}
-	return 0
+	return nil
 }
 
-type AssetFamilyBalance struct {
+type Collectible struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The family key or nil aggregating assets that don't have a family.
-	FamilyKey []byte `protobuf:"bytes,1,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
-	// The total balance of the assets in the family.
-	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The collectible as currently owned by the daemon.
+	Asset *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Every owner of the collectible, starting with its genesis and ending
+	// with the current owner.
+	Provenance []*CollectibleOwner `protobuf:"bytes,2,rep,name=provenance,proto3" json:"provenance,omitempty"`
 }
 
-func (x *AssetFamilyBalance) Reset() {
-	*x = AssetFamilyBalance{}
+func (x *Collectible) Reset() {
+	*x = Collectible{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,13 +1203,13 @@ func (x *AssetFamilyBalance) Reset() {
 	}
 }
 
-func (x *AssetFamilyBalance) String() string {
+func (x *Collectible) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetFamilyBalance) ProtoMessage() {}
+func (*Collectible) ProtoMessage() {}
 
-func (x *AssetFamilyBalance) ProtoReflect() protoreflect.Message {
+func (x *Collectible) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,36 +1221,38 @@ func (x *AssetFamilyBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetFamilyBalance.ProtoReflect.Descriptor instead.
-func (*AssetFamilyBalance) Descriptor() ([]byte, []int) {
+// Deprecated: Use Collectible.ProtoReflect.Descriptor instead.
+func (*Collectible) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AssetFamilyBalance) GetFamilyKey() []byte {
+func (x *Collectible) GetAsset() *Asset {
 	if x != nil {
-		return x.FamilyKey
+		return x.Asset
 	}
 	return nil
 }
 
-func (x *AssetFamilyBalance) GetBalance() int64 {
+func (x *Collectible) GetProvenance() []*CollectibleOwner {
 	if x != nil {
-		return x.Balance
+		return x.Provenance
 	}
-	return 0
+	return nil
 }
 
-type ListBalancesResponse struct {
+type CollectibleFamily struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetBalances       map[string]*AssetBalance       `protobuf:"bytes,1,rep,name=asset_balances,json=assetBalances,proto3" json:"asset_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	AssetFamilyBalances map[string]*AssetFamilyBalance `protobuf:"bytes,2,rep,name=asset_family_balances,json=assetFamilyBalances,proto3" json:"asset_family_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The tweaked family key of the collectibles, or empty for collectibles
+	// that don't belong to an asset family.
+	TweakedFamilyKey []byte         `protobuf:"bytes,1,opt,name=tweaked_family_key,json=tweakedFamilyKey,proto3" json:"tweaked_family_key,omitempty"`
+	Collectibles     []*Collectible `protobuf:"bytes,2,rep,name=collectibles,proto3" json:"collectibles,omitempty"`
 }
 
-func (x *ListBalancesResponse) Reset() {
-	*x = ListBalancesResponse{}
+func (x *CollectibleFamily) Reset() {
+	*x = CollectibleFamily{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,13 +1260,13 @@ func (x *ListBalancesResponse) Reset() {
 	}
 }
 
-func (x *ListBalancesResponse) String() string {
+func (x *CollectibleFamily) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBalancesResponse) ProtoMessage() {}
+func (*CollectibleFamily) ProtoMessage() {}
 
-func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectibleFamily) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1016,33 +1278,35 @@ func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBalancesResponse.ProtoReflect.Descriptor instead.
-func (*ListBalancesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectibleFamily.ProtoReflect.Descriptor instead.
+func (*CollectibleFamily) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListBalancesResponse) GetAssetBalances() map[string]*AssetBalance {
+func (x *CollectibleFamily) GetTweakedFamilyKey() []byte {
 	if x != nil {
-		return x.AssetBalances
+		return x.TweakedFamilyKey
 	}
 	return nil
 }
 
-func (x *ListBalancesResponse) GetAssetFamilyBalances() map[string]*AssetFamilyBalance {
+func (x *CollectibleFamily) GetCollectibles() []*Collectible {
 	if x != nil {
-		return x.AssetFamilyBalances
+		return x.Collectibles
 	}
 	return nil
 }
 
-type ListTransfersRequest struct {
+type ListCollectiblesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Families []*CollectibleFamily `protobuf:"bytes,1,rep,name=families,proto3" json:"families,omitempty"`
 }
 
-func (x *ListTransfersRequest) Reset() {
-	*x = ListTransfersRequest{}
+func (x *ListCollectiblesResponse) Reset() {
+	*x = ListCollectiblesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,13 +1314,13 @@ func (x *ListTransfersRequest) Reset() {
 	}
 }
 
-func (x *ListTransfersRequest) String() string {
+func (x *ListCollectiblesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersRequest) ProtoMessage() {}
+func (*ListCollectiblesResponse) ProtoMessage() {}
 
-func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCollectiblesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1068,22 +1332,38 @@ func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
-func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCollectiblesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectiblesResponse) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{12}
 }
 
-type ListTransfersResponse struct {
+func (x *ListCollectiblesResponse) GetFamilies() []*CollectibleFamily {
+	if x != nil {
+		return x.Families
+	}
+	return nil
+}
+
+type ListBalancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unordered list of outgoing asset transfers.
-	Transfers []*AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// Types that are assignable to GroupBy:
+	//	*ListBalancesRequest_AssetId
+	//	*ListBalancesRequest_FamKey
+	GroupBy isListBalancesRequest_GroupBy `protobuf_oneof:"group_by"`
+	// If the query results should grouped by asset ids, then an optional asset
+	// filter may be provided to query balance of a specific asset.
+	AssetFilter []byte `protobuf:"bytes,3,opt,name=asset_filter,json=assetFilter,proto3" json:"asset_filter,omitempty"`
+	// If the query results should be grouped by family keys, then an optional
+	// family key filter may be provided to query the balance of a specific
+	// asset family.
+	FamilyKeyFilter []byte `protobuf:"bytes,4,opt,name=family_key_filter,json=familyKeyFilter,proto3" json:"family_key_filter,omitempty"`
 }
 
-func (x *ListTransfersResponse) Reset() {
-	*x = ListTransfersResponse{}
+func (x *ListBalancesRequest) Reset() {
+	*x = ListBalancesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,13 +1371,13 @@ func (x *ListTransfersResponse) Reset() {
 	}
 }
 
-func (x *ListTransfersResponse) String() string {
+func (x *ListBalancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransfersResponse) ProtoMessage() {}
+func (*ListBalancesRequest) ProtoMessage() {}
 
-func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,43 +1389,79 @@ func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
-func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListBalancesRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListTransfersResponse) GetTransfers() []*AssetTransfer {
+func (m *ListBalancesRequest) GetGroupBy() isListBalancesRequest_GroupBy {
+	if m != nil {
+		return m.GroupBy
+	}
+	return nil
+}
+
+func (x *ListBalancesRequest) GetAssetId() bool {
+	if x, ok := x.GetGroupBy().(*ListBalancesRequest_AssetId); ok {
+		return x.AssetId
+	}
+	return false
+}
+
+func (x *ListBalancesRequest) GetFamKey() bool {
+	if x, ok := x.GetGroupBy().(*ListBalancesRequest_FamKey); ok {
+		return x.FamKey
+	}
+	return false
+}
+
+func (x *ListBalancesRequest) GetAssetFilter() []byte {
 	if x != nil {
-		return x.Transfers
+		return x.AssetFilter
 	}
 	return nil
 }
 
-type AssetTransfer struct {
+func (x *ListBalancesRequest) GetFamilyKeyFilter() []byte {
+	if x != nil {
+		return x.FamilyKeyFilter
+	}
+	return nil
+}
+
+type isListBalancesRequest_GroupBy interface {
+	isListBalancesRequest_GroupBy()
+}
+
+type ListBalancesRequest_AssetId struct {
+	// Group results by asset IDs.
+	AssetId bool `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type ListBalancesRequest_FamKey struct {
+	// Group results by family keys.
+	FamKey bool `protobuf:"varint,2,opt,name=fam_key,json=famKey,proto3,oneof"`
+}
+
+func (*ListBalancesRequest_AssetId) isListBalancesRequest_GroupBy() {}
+
+func (*ListBalancesRequest_FamKey) isListBalancesRequest_GroupBy() {}
+
+type AssetBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransferTimestamp int64 `protobuf:"varint,1,opt,name=transfer_timestamp,json=transferTimestamp,proto3" json:"transfer_timestamp,omitempty"`
-	// The old/current location of the Taro commitment that was spent as an
-	// input.
-	OldAnchorPoint string `protobuf:"bytes,2,opt,name=old_anchor_point,json=oldAnchorPoint,proto3" json:"old_anchor_point,omitempty"`
-	// The new location of the Taro commitment referenced by the old anchor
-	// point.
-	NewAnchorPoint string `protobuf:"bytes,3,opt,name=new_anchor_point,json=newAnchorPoint,proto3" json:"new_anchor_point,omitempty"`
-	// The new Taro root that commits to the set of modified and unmodified
-	// assets.
-	TaroRoot []byte `protobuf:"bytes,4,opt,name=taro_root,json=taroRoot,proto3" json:"taro_root,omitempty"`
-	// The new transaction that commits to the set of Taro assets found at the
-	// above new anchor point.
-	AnchorTxHash []byte `protobuf:"bytes,5,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
-	// Describes the set of mutated assets that now live at the new anchor tx
-	// point.
-	AssetSpendDeltas []*AssetSpendDelta `protobuf:"bytes,6,rep,name=asset_spend_deltas,json=assetSpendDeltas,proto3" json:"asset_spend_deltas,omitempty"`
+	// The base genesis information of an asset. This information never changes.
+	AssetGenesis *GenesisInfo `protobuf:"bytes,1,opt,name=asset_genesis,json=assetGenesis,proto3" json:"asset_genesis,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The balance of the asset owned by the target daemon.
+	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *AssetTransfer) Reset() {
-	*x = AssetTransfer{}
+func (x *AssetBalance) Reset() {
+	*x = AssetBalance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1153,13 +1469,13 @@ func (x *AssetTransfer) Reset() {
 	}
 }
 
-func (x *AssetTransfer) String() string {
+func (x *AssetBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetTransfer) ProtoMessage() {}
+func (*AssetBalance) ProtoMessage() {}
 
-func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+func (x *AssetBalance) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1171,71 +1487,45 @@ func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
-func (*AssetTransfer) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetBalance.ProtoReflect.Descriptor instead.
+func (*AssetBalance) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AssetTransfer) GetTransferTimestamp() int64 {
-	if x != nil {
-		return x.TransferTimestamp
-	}
-	return 0
-}
-
-func (x *AssetTransfer) GetOldAnchorPoint() string {
-	if x != nil {
-		return x.OldAnchorPoint
-	}
-	return ""
-}
-
-func (x *AssetTransfer) GetNewAnchorPoint() string {
-	if x != nil {
-		return x.NewAnchorPoint
-	}
-	return ""
-}
-
-func (x *AssetTransfer) GetTaroRoot() []byte {
+func (x *AssetBalance) GetAssetGenesis() *GenesisInfo {
 	if x != nil {
-		return x.TaroRoot
+		return x.AssetGenesis
 	}
 	return nil
 }
 
-func (x *AssetTransfer) GetAnchorTxHash() []byte {
+func (x *AssetBalance) GetAssetType() AssetType {
 	if x != nil {
-		return x.AnchorTxHash
+		return x.AssetType
 	}
-	return nil
+	return AssetType_NORMAL
 }
 
-func (x *AssetTransfer) GetAssetSpendDeltas() []*AssetSpendDelta {
+func (x *AssetBalance) GetBalance() int64 {
 	if x != nil {
-		return x.AssetSpendDeltas
+		return x.Balance
 	}
-	return nil
+	return 0
 }
 
-type AssetSpendDelta struct {
+type AssetFamilyBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The asset ID that uniquely identifies the asset.
-	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	// The old script key that uniquely identified the spent asset on disk.
-	OldScriptKey []byte `protobuf:"bytes,2,opt,name=old_script_key,json=oldScriptKey,proto3" json:"old_script_key,omitempty"`
-	// The new script key. We assume BIP 86 usage when updating the script keys
-	// on disk.
-	NewScriptKey []byte `protobuf:"bytes,3,opt,name=new_script_key,json=newScriptKey,proto3" json:"new_script_key,omitempty"`
-	// The new amount for the asset.
-	NewAmt int64 `protobuf:"varint,4,opt,name=new_amt,json=newAmt,proto3" json:"new_amt,omitempty"`
+	// The family key or nil aggregating assets that don't have a family.
+	FamilyKey []byte `protobuf:"bytes,1,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// The total balance of the assets in the family.
+	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *AssetSpendDelta) Reset() {
-	*x = AssetSpendDelta{}
+func (x *AssetFamilyBalance) Reset() {
+	*x = AssetFamilyBalance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,13 +1533,13 @@ func (x *AssetSpendDelta) Reset() {
 	}
 }
 
-func (x *AssetSpendDelta) String() string {
+func (x *AssetFamilyBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetSpendDelta) ProtoMessage() {}
+func (*AssetFamilyBalance) ProtoMessage() {}
 
-func (x *AssetSpendDelta) ProtoReflect() protoreflect.Message {
+func (x *AssetFamilyBalance) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,47 +1551,36 @@ func (x *AssetSpendDelta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetSpendDelta.ProtoReflect.Descriptor instead.
-func (*AssetSpendDelta) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetFamilyBalance.ProtoReflect.Descriptor instead.
+func (*AssetFamilyBalance) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AssetSpendDelta) GetAssetId() []byte {
-	if x != nil {
-		return x.AssetId
-	}
-	return nil
-}
-
-func (x *AssetSpendDelta) GetOldScriptKey() []byte {
-	if x != nil {
-		return x.OldScriptKey
-	}
-	return nil
-}
-
-func (x *AssetSpendDelta) GetNewScriptKey() []byte {
+func (x *AssetFamilyBalance) GetFamilyKey() []byte {
 	if x != nil {
-		return x.NewScriptKey
+		return x.FamilyKey
 	}
 	return nil
 }
 
-func (x *AssetSpendDelta) GetNewAmt() int64 {
+func (x *AssetFamilyBalance) GetBalance() int64 {
 	if x != nil {
-		return x.NewAmt
+		return x.Balance
 	}
 	return 0
 }
 
-type StopRequest struct {
+type ListBalancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AssetBalances       map[string]*AssetBalance       `protobuf:"bytes,1,rep,name=asset_balances,json=assetBalances,proto3" json:"asset_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AssetFamilyBalances map[string]*AssetFamilyBalance `protobuf:"bytes,2,rep,name=asset_family_balances,json=assetFamilyBalances,proto3" json:"asset_family_balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
+func (x *ListBalancesResponse) Reset() {
+	*x = ListBalancesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,13 +1588,13 @@ func (x *StopRequest) Reset() {
 	}
 }
 
-func (x *StopRequest) String() string {
+func (x *ListBalancesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*ListBalancesResponse) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1327,19 +1606,44 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListBalancesResponse) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{16}
 }
 
-type StopResponse struct {
+func (x *ListBalancesResponse) GetAssetBalances() map[string]*AssetBalance {
+	if x != nil {
+		return x.AssetBalances
+	}
+	return nil
+}
+
+func (x *ListBalancesResponse) GetAssetFamilyBalances() map[string]*AssetFamilyBalance {
+	if x != nil {
+		return x.AssetFamilyBalances
+	}
+	return nil
+}
+
+type ListTransfersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// If set, then only transfers with exactly this label will be returned.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//If set, then only transfers with an external metadata entry with this key
+	//will be returned.
+	ExternalMetadataKey string `protobuf:"bytes,2,opt,name=external_metadata_key,json=externalMetadataKey,proto3" json:"external_metadata_key,omitempty"`
+	//
+	//If set, then only transfers whose external metadata entry with the above
+	//key has this value will be returned.
+	ExternalMetadataValue string `protobuf:"bytes,3,opt,name=external_metadata_value,json=externalMetadataValue,proto3" json:"external_metadata_value,omitempty"`
 }
 
-func (x *StopResponse) Reset() {
-	*x = StopResponse{}
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1347,13 +1651,13 @@ func (x *StopResponse) Reset() {
 	}
 }
 
-func (x *StopResponse) String() string {
+func (x *ListTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopResponse) ProtoMessage() {}
+func (*ListTransfersRequest) ProtoMessage() {}
 
-func (x *StopResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1365,92 +1669,58 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
-func (*StopResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{17}
 }
 
-type DebugLevelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// If true, all the valid debug sub-systems will be returned.
-	Show      bool   `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
-	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
-}
-
-func (x *DebugLevelRequest) Reset() {
-	*x = DebugLevelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DebugLevelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DebugLevelRequest) ProtoMessage() {}
-
-func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListTransfersRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{18}
+	return ""
 }
 
-func (x *DebugLevelRequest) GetShow() bool {
+func (x *ListTransfersRequest) GetExternalMetadataKey() string {
 	if x != nil {
-		return x.Show
+		return x.ExternalMetadataKey
 	}
-	return false
+	return ""
 }
 
-func (x *DebugLevelRequest) GetLevelSpec() string {
+func (x *ListTransfersRequest) GetExternalMetadataValue() string {
 	if x != nil {
-		return x.LevelSpec
+		return x.ExternalMetadataValue
 	}
 	return ""
 }
 
-type DebugLevelResponse struct {
+type ListTransfersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+	// The unordered list of outgoing asset transfers.
+	Transfers []*AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (x *DebugLevelResponse) Reset() {
-	*x = DebugLevelResponse{}
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[19]
+		mi := &file_taro_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugLevelResponse) String() string {
+func (x *ListTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugLevelResponse) ProtoMessage() {}
+func (*ListTransfersResponse) ProtoMessage() {}
 
-func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[19]
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,63 +1731,62 @@ func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DebugLevelResponse) GetSubSystems() string {
+func (x *ListTransfersResponse) GetTransfers() []*AssetTransfer {
 	if x != nil {
-		return x.SubSystems
+		return x.Transfers
 	}
-	return ""
+	return nil
 }
 
-type Addr struct {
+type AssetTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The bech32 encoded Taro address.
-	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
-	// The asset ID that uniquely identifies the asset.
-	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	// The type of the asset.
-	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
-	// The total amount of the asset stored in this Taro UTXO.
-	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The family key of the asset (if it exists)
-	FamilyKey []byte `protobuf:"bytes,5,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
-	//
-	//The specific script key the asset must commit to in order to transfer
-	//ownership to the creator of the address.
-	ScriptKey []byte `protobuf:"bytes,6,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
-	// The internal key used for the on-chain output.
-	InternalKey []byte `protobuf:"bytes,7,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
-	//
-	//The tweaked internal key that commits to the asset and represents the
-	//on-chain output key the Bitcoin transaction must send to in order to
-	//transfer assets described in this address.
-	TaprootOutputKey []byte `protobuf:"bytes,8,opt,name=taproot_output_key,json=taprootOutputKey,proto3" json:"taproot_output_key,omitempty"`
+	TransferTimestamp int64 `protobuf:"varint,1,opt,name=transfer_timestamp,json=transferTimestamp,proto3" json:"transfer_timestamp,omitempty"`
+	// The old/current location of the Taro commitment that was spent as an
+	// input.
+	OldAnchorPoint string `protobuf:"bytes,2,opt,name=old_anchor_point,json=oldAnchorPoint,proto3" json:"old_anchor_point,omitempty"`
+	// The new location of the Taro commitment referenced by the old anchor
+	// point.
+	NewAnchorPoint string `protobuf:"bytes,3,opt,name=new_anchor_point,json=newAnchorPoint,proto3" json:"new_anchor_point,omitempty"`
+	// The new Taro root that commits to the set of modified and unmodified
+	// assets.
+	TaroRoot []byte `protobuf:"bytes,4,opt,name=taro_root,json=taroRoot,proto3" json:"taro_root,omitempty"`
+	// The new transaction that commits to the set of Taro assets found at the
+	// above new anchor point.
+	AnchorTxHash []byte `protobuf:"bytes,5,opt,name=anchor_tx_hash,json=anchorTxHash,proto3" json:"anchor_tx_hash,omitempty"`
+	// Describes the set of mutated assets that now live at the new anchor tx
+	// point.
+	AssetSpendDeltas []*AssetSpendDelta `protobuf:"bytes,6,rep,name=asset_spend_deltas,json=assetSpendDeltas,proto3" json:"asset_spend_deltas,omitempty"`
+	// The free-form label of the transfer.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// The external key/value metadata of the transfer.
+	ExternalMetadata map[string]string `protobuf:"bytes,8,rep,name=external_metadata,json=externalMetadata,proto3" json:"external_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Addr) Reset() {
-	*x = Addr{}
+func (x *AssetTransfer) Reset() {
+	*x = AssetTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[20]
+		mi := &file_taro_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Addr) String() string {
+func (x *AssetTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Addr) ProtoMessage() {}
+func (*AssetTransfer) ProtoMessage() {}
 
-func (x *Addr) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[20]
+func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,103 +1797,100 @@ func (x *Addr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
-func (*Addr) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
+func (*AssetTransfer) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Addr) GetEncoded() string {
+func (x *AssetTransfer) GetTransferTimestamp() int64 {
 	if x != nil {
-		return x.Encoded
+		return x.TransferTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *Addr) GetAssetId() []byte {
+func (x *AssetTransfer) GetOldAnchorPoint() string {
 	if x != nil {
-		return x.AssetId
+		return x.OldAnchorPoint
 	}
-	return nil
+	return ""
 }
 
-func (x *Addr) GetAssetType() AssetType {
+func (x *AssetTransfer) GetNewAnchorPoint() string {
 	if x != nil {
-		return x.AssetType
+		return x.NewAnchorPoint
 	}
-	return AssetType_NORMAL
+	return ""
 }
 
-func (x *Addr) GetAmount() int64 {
+func (x *AssetTransfer) GetTaroRoot() []byte {
 	if x != nil {
-		return x.Amount
+		return x.TaroRoot
 	}
-	return 0
+	return nil
 }
 
-func (x *Addr) GetFamilyKey() []byte {
+func (x *AssetTransfer) GetAnchorTxHash() []byte {
 	if x != nil {
-		return x.FamilyKey
+		return x.AnchorTxHash
 	}
 	return nil
 }
 
-func (x *Addr) GetScriptKey() []byte {
+func (x *AssetTransfer) GetAssetSpendDeltas() []*AssetSpendDelta {
 	if x != nil {
-		return x.ScriptKey
+		return x.AssetSpendDeltas
 	}
 	return nil
 }
 
-func (x *Addr) GetInternalKey() []byte {
+func (x *AssetTransfer) GetLabel() string {
 	if x != nil {
-		return x.InternalKey
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *Addr) GetTaprootOutputKey() []byte {
+func (x *AssetTransfer) GetExternalMetadata() map[string]string {
 	if x != nil {
-		return x.TaprootOutputKey
+		return x.ExternalMetadata
 	}
 	return nil
 }
 
-type QueryAddrRequest struct {
+type AssetSpendDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//If set, then only addresses created after this Unix timestamp will be
-	//returned.
-	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
-	//
-	//If set, then only addresses created before this Unix timestamp will be
-	//returned.
-	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
-	// The max number of addresses that should be returned.
-	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// The offset from the addresses that should be returned.
-	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The asset ID that uniquely identifies the asset.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The old script key that uniquely identified the spent asset on disk.
+	OldScriptKey []byte `protobuf:"bytes,2,opt,name=old_script_key,json=oldScriptKey,proto3" json:"old_script_key,omitempty"`
+	// The new script key. We assume BIP 86 usage when updating the script keys
+	// on disk.
+	NewScriptKey []byte `protobuf:"bytes,3,opt,name=new_script_key,json=newScriptKey,proto3" json:"new_script_key,omitempty"`
+	// The new amount for the asset.
+	NewAmt int64 `protobuf:"varint,4,opt,name=new_amt,json=newAmt,proto3" json:"new_amt,omitempty"`
 }
 
-func (x *QueryAddrRequest) Reset() {
-	*x = QueryAddrRequest{}
+func (x *AssetSpendDelta) Reset() {
+	*x = AssetSpendDelta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[21]
+		mi := &file_taro_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAddrRequest) String() string {
+func (x *AssetSpendDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddrRequest) ProtoMessage() {}
+func (*AssetSpendDelta) ProtoMessage() {}
 
-func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[21]
+func (x *AssetSpendDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,64 +1901,62 @@ func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAddrRequest.ProtoReflect.Descriptor instead.
-func (*QueryAddrRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AssetSpendDelta.ProtoReflect.Descriptor instead.
+func (*AssetSpendDelta) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryAddrRequest) GetCreatedAfter() int64 {
+func (x *AssetSpendDelta) GetAssetId() []byte {
 	if x != nil {
-		return x.CreatedAfter
+		return x.AssetId
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryAddrRequest) GetCreatedBefore() int64 {
+func (x *AssetSpendDelta) GetOldScriptKey() []byte {
 	if x != nil {
-		return x.CreatedBefore
+		return x.OldScriptKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryAddrRequest) GetLimit() int32 {
+func (x *AssetSpendDelta) GetNewScriptKey() []byte {
 	if x != nil {
-		return x.Limit
+		return x.NewScriptKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QueryAddrRequest) GetOffset() int32 {
+func (x *AssetSpendDelta) GetNewAmt() int64 {
 	if x != nil {
-		return x.Offset
+		return x.NewAmt
 	}
 	return 0
 }
 
-type QueryAddrResponse struct {
+type StopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Addrs []*Addr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
 }
 
-func (x *QueryAddrResponse) Reset() {
-	*x = QueryAddrResponse{}
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[22]
+		mi := &file_taro_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAddrResponse) String() string {
+func (x *StopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAddrResponse) ProtoMessage() {}
+func (*StopRequest) ProtoMessage() {}
 
-func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[22]
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,30 +1967,61 @@ func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAddrResponse.ProtoReflect.Descriptor instead.
-func (*QueryAddrResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryAddrResponse) GetAddrs() []*Addr {
-	if x != nil {
-		return x.Addrs
+type StopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type NewAddrRequest struct {
+func (x *StopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResponse) ProtoMessage() {}
+
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{22}
+}
+
+type DebugLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GenesisBootstrapInfo []byte `protobuf:"bytes,1,opt,name=genesis_bootstrap_info,json=genesisBootstrapInfo,proto3" json:"genesis_bootstrap_info,omitempty"`
-	FamKey               []byte `protobuf:"bytes,2,opt,name=fam_key,json=famKey,proto3" json:"fam_key,omitempty"`
-	Amt                  int64  `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	// If true, all the valid debug sub-systems will be returned.
+	Show      bool   `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
 }
 
-func (x *NewAddrRequest) Reset() {
-	*x = NewAddrRequest{}
+func (x *DebugLevelRequest) Reset() {
+	*x = DebugLevelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_taro_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,13 +2029,13 @@ func (x *NewAddrRequest) Reset() {
 	}
 }
 
-func (x *NewAddrRequest) String() string {
+func (x *DebugLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewAddrRequest) ProtoMessage() {}
+func (*DebugLevelRequest) ProtoMessage() {}
 
-func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
+func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_taro_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,57 +2047,2309 @@ func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewAddrRequest.ProtoReflect.Descriptor instead.
-func (*NewAddrRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
 	return file_taro_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *NewAddrRequest) GetGenesisBootstrapInfo() []byte {
+func (x *DebugLevelRequest) GetShow() bool {
 	if x != nil {
-		return x.GenesisBootstrapInfo
+		return x.Show
+	}
+	return false
+}
+
+func (x *DebugLevelRequest) GetLevelSpec() string {
+	if x != nil {
+		return x.LevelSpec
+	}
+	return ""
+}
+
+type DebugLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubSystems string `protobuf:"bytes,1,opt,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+}
+
+func (x *DebugLevelResponse) Reset() {
+	*x = DebugLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelResponse) ProtoMessage() {}
+
+func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DebugLevelResponse) GetSubSystems() string {
+	if x != nil {
+		return x.SubSystems
+	}
+	return ""
+}
+
+type Addr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bech32 encoded Taro address.
+	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	// The asset ID that uniquely identifies the asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The type of the asset.
+	AssetType AssetType `protobuf:"varint,3,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	// The total amount of the asset stored in this Taro UTXO.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The family key of the asset (if it exists)
+	FamilyKey []byte `protobuf:"bytes,5,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	//
+	//The specific script key the asset must commit to in order to transfer
+	//ownership to the creator of the address.
+	ScriptKey []byte `protobuf:"bytes,6,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The internal key used for the on-chain output.
+	InternalKey []byte `protobuf:"bytes,7,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	//
+	//The tweaked internal key that commits to the asset and represents the
+	//on-chain output key the Bitcoin transaction must send to in order to
+	//transfer assets described in this address.
+	TaprootOutputKey []byte `protobuf:"bytes,8,opt,name=taproot_output_key,json=taprootOutputKey,proto3" json:"taproot_output_key,omitempty"`
+	//
+	//The Unix timestamp after which the address no longer accepts inbound
+	//transfers, or zero if the address never expires.
+	ExpiryTimeUnix int64 `protobuf:"varint,9,opt,name=expiry_time_unix,json=expiryTimeUnix,proto3" json:"expiry_time_unix,omitempty"`
+	// Whether the address only accepts a single inbound transfer.
+	SingleUse bool `protobuf:"varint,10,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	// The free-form label of the address.
+	Label string `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+	// The external key/value metadata of the address.
+	ExternalMetadata map[string]string `protobuf:"bytes,12,rep,name=external_metadata,json=externalMetadata,proto3" json:"external_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//If true, the address accepts any asset ID within the asset family of the
+	//family key and the asset ID field is unset.
+	FamilyOnly bool `protobuf:"varint,13,opt,name=family_only,json=familyOnly,proto3" json:"family_only,omitempty"`
+	// The policy that defines which amounts the address accepts.
+	AmountPolicy AmountPolicy `protobuf:"varint,14,opt,name=amount_policy,json=amountPolicy,proto3,enum=tarorpc.AmountPolicy" json:"amount_policy,omitempty"`
+	// The optional memo of the address.
+	Memo string `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Whether the memo is signed by the memo key of the address.
+	MemoSigned bool `protobuf:"varint,16,opt,name=memo_signed,json=memoSigned,proto3" json:"memo_signed,omitempty"`
+	// The script version of the asset the address expects to receive.
+	ScriptVersion int32 `protobuf:"varint,17,opt,name=script_version,json=scriptVersion,proto3" json:"script_version,omitempty"`
+}
+
+func (x *Addr) Reset() {
+	*x = Addr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addr) ProtoMessage() {}
+
+func (x *Addr) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addr.ProtoReflect.Descriptor instead.
+func (*Addr) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Addr) GetEncoded() string {
+	if x != nil {
+		return x.Encoded
+	}
+	return ""
+}
+
+func (x *Addr) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
 	}
 	return nil
 }
 
-func (x *NewAddrRequest) GetFamKey() []byte {
+func (x *Addr) GetAssetType() AssetType {
 	if x != nil {
-		return x.FamKey
+		return x.AssetType
+	}
+	return AssetType_NORMAL
+}
+
+func (x *Addr) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Addr) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
 	}
 	return nil
 }
 
-func (x *NewAddrRequest) GetAmt() int64 {
+func (x *Addr) GetScriptKey() []byte {
 	if x != nil {
-		return x.Amt
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *Addr) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+func (x *Addr) GetTaprootOutputKey() []byte {
+	if x != nil {
+		return x.TaprootOutputKey
+	}
+	return nil
+}
+
+func (x *Addr) GetExpiryTimeUnix() int64 {
+	if x != nil {
+		return x.ExpiryTimeUnix
 	}
 	return 0
 }
 
-type DecodeAddrRequest struct {
+func (x *Addr) GetSingleUse() bool {
+	if x != nil {
+		return x.SingleUse
+	}
+	return false
+}
+
+func (x *Addr) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Addr) GetExternalMetadata() map[string]string {
+	if x != nil {
+		return x.ExternalMetadata
+	}
+	return nil
+}
+
+func (x *Addr) GetFamilyOnly() bool {
+	if x != nil {
+		return x.FamilyOnly
+	}
+	return false
+}
+
+func (x *Addr) GetAmountPolicy() AmountPolicy {
+	if x != nil {
+		return x.AmountPolicy
+	}
+	return AmountPolicy_AMOUNT_POLICY_EXACT
+}
+
+func (x *Addr) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Addr) GetMemoSigned() bool {
+	if x != nil {
+		return x.MemoSigned
+	}
+	return false
+}
+
+func (x *Addr) GetScriptVersion() int32 {
+	if x != nil {
+		return x.ScriptVersion
+	}
+	return 0
+}
+
+type QueryAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, then only addresses created after this Unix timestamp will be
+	//returned.
+	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	//
+	//If set, then only addresses created before this Unix timestamp will be
+	//returned.
+	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// The max number of addresses that should be returned.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The offset from the addresses that should be returned.
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// If set, then only addresses with exactly this label will be returned.
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//If set, then only addresses with an external metadata entry with this key
+	//will be returned.
+	ExternalMetadataKey string `protobuf:"bytes,6,opt,name=external_metadata_key,json=externalMetadataKey,proto3" json:"external_metadata_key,omitempty"`
+	//
+	//If set, then only addresses whose external metadata entry with the above
+	//key has this value will be returned.
+	ExternalMetadataValue string `protobuf:"bytes,7,opt,name=external_metadata_value,json=externalMetadataValue,proto3" json:"external_metadata_value,omitempty"`
+}
+
+func (x *QueryAddrRequest) Reset() {
+	*x = QueryAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAddrRequest) ProtoMessage() {}
+
+func (x *QueryAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAddrRequest.ProtoReflect.Descriptor instead.
+func (*QueryAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryAddrRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *QueryAddrRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *QueryAddrRequest) GetExternalMetadataKey() string {
+	if x != nil {
+		return x.ExternalMetadataKey
+	}
+	return ""
+}
+
+func (x *QueryAddrRequest) GetExternalMetadataValue() string {
+	if x != nil {
+		return x.ExternalMetadataValue
+	}
+	return ""
+}
+
+type QueryAddrResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addrs []*Addr `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *QueryAddrResponse) Reset() {
+	*x = QueryAddrResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAddrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAddrResponse) ProtoMessage() {}
+
+func (x *QueryAddrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAddrResponse.ProtoReflect.Descriptor instead.
+func (*QueryAddrResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryAddrResponse) GetAddrs() []*Addr {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type NewAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GenesisBootstrapInfo []byte `protobuf:"bytes,1,opt,name=genesis_bootstrap_info,json=genesisBootstrapInfo,proto3" json:"genesis_bootstrap_info,omitempty"`
+	FamKey               []byte `protobuf:"bytes,2,opt,name=fam_key,json=famKey,proto3" json:"fam_key,omitempty"`
+	Amt                  int64  `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//An optional set of tapscript leaves the script key of the address should
+	//commit to. If set, then the received assets can also be spent through any
+	//of these leaves.
+	TapscriptLeaves []*TapLeaf `protobuf:"bytes,4,rep,name=tapscript_leaves,json=tapscriptLeaves,proto3" json:"tapscript_leaves,omitempty"`
+	//
+	//An optional Unix timestamp after which the address no longer accepts
+	//inbound transfers. Transfers received after this time are reported with
+	//the late payment event status and need to be refunded.
+	ExpiryTimeUnix int64 `protobuf:"varint,5,opt,name=expiry_time_unix,json=expiryTimeUnix,proto3" json:"expiry_time_unix,omitempty"`
+	//
+	//If set, the address only accepts a single inbound transfer. Any further
+	//transfers are reported with the late payment event status and need to be
+	//refunded.
+	SingleUse bool `protobuf:"varint,6,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	//
+	//An optional free-form label of the address that can be used to map it to
+	//an entity of an external system.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional set of key/value pairs that describe the address in the
+	//context of an external system.
+	ExternalMetadata map[string]string `protobuf:"bytes,8,rep,name=external_metadata,json=externalMetadata,proto3" json:"external_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//If set, the address accepts any asset ID within the asset family
+	//identified by fam_key, which then must be set. The genesis_bootstrap_info
+	//is ignored in that case and the asset type is taken from asset_type
+	//instead.
+	FamilyOnly bool `protobuf:"varint,9,opt,name=family_only,json=familyOnly,proto3" json:"family_only,omitempty"`
+	// The type of the asset the family address accepts.
+	AssetType AssetType `protobuf:"varint,10,opt,name=asset_type,json=assetType,proto3,enum=tarorpc.AssetType" json:"asset_type,omitempty"`
+	//
+	//The policy that defines which amounts the address accepts. Addresses
+	//that don't require an exact amount can only be used with normal assets.
+	AmountPolicy AmountPolicy `protobuf:"varint,11,opt,name=amount_policy,json=amountPolicy,proto3,enum=tarorpc.AmountPolicy" json:"amount_policy,omitempty"`
+	//
+	//An optional memo that is encoded in the address and signed with a
+	//key derived from the internal key of the address.
+	Memo string `protobuf:"bytes,12,opt,name=memo,proto3" json:"memo,omitempty"`
+	//
+	//The script version of the asset the address expects to receive. It must
+	//match the script version the asset was minted with.
+	ScriptVersion int32 `protobuf:"varint,13,opt,name=script_version,json=scriptVersion,proto3" json:"script_version,omitempty"`
+}
+
+func (x *NewAddrRequest) Reset() {
+	*x = NewAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewAddrRequest) ProtoMessage() {}
+
+func (x *NewAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewAddrRequest.ProtoReflect.Descriptor instead.
+func (*NewAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NewAddrRequest) GetGenesisBootstrapInfo() []byte {
+	if x != nil {
+		return x.GenesisBootstrapInfo
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetFamKey() []byte {
+	if x != nil {
+		return x.FamKey
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *NewAddrRequest) GetTapscriptLeaves() []*TapLeaf {
+	if x != nil {
+		return x.TapscriptLeaves
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetExpiryTimeUnix() int64 {
+	if x != nil {
+		return x.ExpiryTimeUnix
+	}
+	return 0
+}
+
+func (x *NewAddrRequest) GetSingleUse() bool {
+	if x != nil {
+		return x.SingleUse
+	}
+	return false
+}
+
+func (x *NewAddrRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NewAddrRequest) GetExternalMetadata() map[string]string {
+	if x != nil {
+		return x.ExternalMetadata
+	}
+	return nil
+}
+
+func (x *NewAddrRequest) GetFamilyOnly() bool {
+	if x != nil {
+		return x.FamilyOnly
+	}
+	return false
+}
+
+func (x *NewAddrRequest) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_NORMAL
+}
+
+func (x *NewAddrRequest) GetAmountPolicy() AmountPolicy {
+	if x != nil {
+		return x.AmountPolicy
+	}
+	return AmountPolicy_AMOUNT_POLICY_EXACT
+}
+
+func (x *NewAddrRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *NewAddrRequest) GetScriptVersion() int32 {
+	if x != nil {
+		return x.ScriptVersion
+	}
+	return 0
+}
+
+type TapLeaf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The script of the tapscript leaf, using the base leaf version.
+	Script []byte `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+}
+
+func (x *TapLeaf) Reset() {
+	*x = TapLeaf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapLeaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapLeaf) ProtoMessage() {}
+
+func (x *TapLeaf) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TapLeaf.ProtoReflect.Descriptor instead.
+func (*TapLeaf) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TapLeaf) GetScript() []byte {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+type DecodeAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *DecodeAddrRequest) Reset() {
+	*x = DecodeAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeAddrRequest) ProtoMessage() {}
+
+func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeAddrRequest.ProtoReflect.Descriptor instead.
+func (*DecodeAddrRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DecodeAddrRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type ProofFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawProof     []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
+	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+}
+
+func (x *ProofFile) Reset() {
+	*x = ProofFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofFile) ProtoMessage() {}
+
+func (x *ProofFile) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofFile.ProtoReflect.Descriptor instead.
+func (*ProofFile) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProofFile) GetRawProof() []byte {
+	if x != nil {
+		return x.RawProof
+	}
+	return nil
+}
+
+func (x *ProofFile) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+type ProofVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	//
+	//True if the proof file ends in a burn, meaning the asset it proves was
+	//sent to a provably unspendable script key.
+	IsBurn bool `protobuf:"varint,2,opt,name=is_burn,json=isBurn,proto3" json:"is_burn,omitempty"`
+	// The machine-readable reason the proof file is invalid, if it's invalid.
+	ValidationError *ValidationError `protobuf:"bytes,3,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+}
+
+func (x *ProofVerifyResponse) Reset() {
+	*x = ProofVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofVerifyResponse) ProtoMessage() {}
+
+func (x *ProofVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofVerifyResponse.ProtoReflect.Descriptor instead.
+func (*ProofVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProofVerifyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ProofVerifyResponse) GetIsBurn() bool {
+	if x != nil {
+		return x.IsBurn
+	}
+	return false
+}
+
+func (x *ProofVerifyResponse) GetValidationError() *ValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type ExportProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	//
+	//The optional chained hash of the last proof the caller already has. If
+	//set, only the proofs following it are returned, encoded as a proof file
+	//suffix that can be merged into the caller's existing proof file.
+	SinceHash []byte `protobuf:"bytes,3,opt,name=since_hash,json=sinceHash,proto3" json:"since_hash,omitempty"`
+}
+
+func (x *ExportProofRequest) Reset() {
+	*x = ExportProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProofRequest) ProtoMessage() {}
+
+func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProofRequest.ProtoReflect.Descriptor instead.
+func (*ExportProofRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportProofRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ExportProofRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *ExportProofRequest) GetSinceHash() []byte {
+	if x != nil {
+		return x.SinceHash
+	}
+	return nil
+}
+
+type ImportProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProofFile    []byte `protobuf:"bytes,1,opt,name=proof_file,json=proofFile,proto3" json:"proof_file,omitempty"`
+	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+}
+
+func (x *ImportProofRequest) Reset() {
+	*x = ImportProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProofRequest) ProtoMessage() {}
+
+func (x *ImportProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProofRequest.ProtoReflect.Descriptor instead.
+func (*ImportProofRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportProofRequest) GetProofFile() []byte {
+	if x != nil {
+		return x.ProofFile
+	}
+	return nil
+}
+
+func (x *ImportProofRequest) GetGenesisPoint() string {
+	if x != nil {
+		return x.GenesisPoint
+	}
+	return ""
+}
+
+type ImportProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImportProofResponse) Reset() {
+	*x = ImportProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProofResponse) ProtoMessage() {}
+
+func (x *ImportProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProofResponse.ProtoReflect.Descriptor instead.
+func (*ImportProofResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{35}
+}
+
+type CompactProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset ID of the asset whose proof file should be compacted.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key of the asset whose proof file should be compacted.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	//
+	//The number of most recent state transitions to keep in the proof file. If
+	//this is zero, only the last state transition is kept.
+	NumKeep uint32 `protobuf:"varint,3,opt,name=num_keep,json=numKeep,proto3" json:"num_keep,omitempty"`
+}
+
+func (x *CompactProofRequest) Reset() {
+	*x = CompactProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactProofRequest) ProtoMessage() {}
+
+func (x *CompactProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactProofRequest.ProtoReflect.Descriptor instead.
+func (*CompactProofRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CompactProofRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *CompactProofRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *CompactProofRequest) GetNumKeep() uint32 {
+	if x != nil {
+		return x.NumKeep
+	}
+	return 0
+}
+
+type CompactProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The root of the checkpoint the compacted proof file starts at. This is the
+	//chained checksum of the last pruned state transition proof.
+	CheckpointRoot []byte `protobuf:"bytes,1,opt,name=checkpoint_root,json=checkpointRoot,proto3" json:"checkpoint_root,omitempty"`
+	//
+	//The total number of state transitions that are replaced by the checkpoint.
+	NumPruned uint64 `protobuf:"varint,2,opt,name=num_pruned,json=numPruned,proto3" json:"num_pruned,omitempty"`
+	// The number of state transitions that remain in the proof file.
+	NumProofs uint32 `protobuf:"varint,3,opt,name=num_proofs,json=numProofs,proto3" json:"num_proofs,omitempty"`
+}
+
+func (x *CompactProofResponse) Reset() {
+	*x = CompactProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactProofResponse) ProtoMessage() {}
+
+func (x *CompactProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactProofResponse.ProtoReflect.Descriptor instead.
+func (*CompactProofResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CompactProofResponse) GetCheckpointRoot() []byte {
+	if x != nil {
+		return x.CheckpointRoot
+	}
+	return nil
+}
+
+func (x *CompactProofResponse) GetNumPruned() uint64 {
+	if x != nil {
+		return x.NumPruned
+	}
+	return 0
+}
+
+func (x *CompactProofResponse) GetNumProofs() uint32 {
+	if x != nil {
+		return x.NumProofs
+	}
+	return 0
+}
+
+type TrustCheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The checkpoint root to mark as trusted.
+	CheckpointRoot []byte `protobuf:"bytes,1,opt,name=checkpoint_root,json=checkpointRoot,proto3" json:"checkpoint_root,omitempty"`
+	// The asset ID of the asset the checkpoint was created for.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key of the asset the checkpoint was created for.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The number of state transitions the checkpoint replaces.
+	NumPruned uint64 `protobuf:"varint,4,opt,name=num_pruned,json=numPruned,proto3" json:"num_pruned,omitempty"`
+}
+
+func (x *TrustCheckpointRequest) Reset() {
+	*x = TrustCheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustCheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustCheckpointRequest) ProtoMessage() {}
+
+func (x *TrustCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*TrustCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TrustCheckpointRequest) GetCheckpointRoot() []byte {
+	if x != nil {
+		return x.CheckpointRoot
+	}
+	return nil
+}
+
+func (x *TrustCheckpointRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *TrustCheckpointRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *TrustCheckpointRequest) GetNumPruned() uint64 {
+	if x != nil {
+		return x.NumPruned
+	}
+	return 0
+}
+
+type TrustCheckpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrustCheckpointResponse) Reset() {
+	*x = TrustCheckpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustCheckpointResponse) ProtoMessage() {}
+
+func (x *TrustCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*TrustCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{39}
+}
+
+type AuditProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuditProofsRequest) Reset() {
+	*x = AuditProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditProofsRequest) ProtoMessage() {}
+
+func (x *AuditProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditProofsRequest.ProtoReflect.Descriptor instead.
+func (*AuditProofsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{40}
+}
+
+type AssetProofAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset ID the proof file is stored for.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key the proof file is stored for.
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	//
+	//The reason the proof file isn't valid, for example because it is missing,
+	//fails verification, is stored for a different asset or doesn't match the
+	//state of the asset in the database.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AssetProofAudit) Reset() {
+	*x = AssetProofAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetProofAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetProofAudit) ProtoMessage() {}
+
+func (x *AssetProofAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetProofAudit.ProtoReflect.Descriptor instead.
+func (*AssetProofAudit) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AssetProofAudit) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetProofAudit) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *AssetProofAudit) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AuditProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of owned assets that were audited.
+	NumAssets uint32 `protobuf:"varint,1,opt,name=num_assets,json=numAssets,proto3" json:"num_assets,omitempty"`
+	// The number of proof files in the archive that were audited.
+	NumProofs uint32 `protobuf:"varint,2,opt,name=num_proofs,json=numProofs,proto3" json:"num_proofs,omitempty"`
+	//
+	//The list of proof files that aren't valid. Valid proof files are not
+	//listed.
+	Audits []*AssetProofAudit `protobuf:"bytes,3,rep,name=audits,proto3" json:"audits,omitempty"`
+}
+
+func (x *AuditProofsResponse) Reset() {
+	*x = AuditProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditProofsResponse) ProtoMessage() {}
+
+func (x *AuditProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditProofsResponse.ProtoReflect.Descriptor instead.
+func (*AuditProofsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuditProofsResponse) GetNumAssets() uint32 {
+	if x != nil {
+		return x.NumAssets
+	}
+	return 0
+}
+
+func (x *AuditProofsResponse) GetNumProofs() uint32 {
+	if x != nil {
+		return x.NumProofs
+	}
+	return 0
+}
+
+func (x *AuditProofsResponse) GetAudits() []*AssetProofAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type ListProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only proofs of assets with this asset ID are listed.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// If set, only proofs of assets with this family key are listed.
+	FamilyKey []byte `protobuf:"bytes,2,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+}
+
+func (x *ListProofsRequest) Reset() {
+	*x = ListProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProofsRequest) ProtoMessage() {}
+
+func (x *ListProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProofsRequest.ProtoReflect.Descriptor instead.
+func (*ListProofsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListProofsRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ListProofsRequest) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+type ProofLocator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The asset ID of the asset the proof is for.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The family key of the asset the proof is for, if it has one.
+	FamilyKey []byte `protobuf:"bytes,2,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+	// The script key of the asset the proof is for.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ProofLocator) Reset() {
+	*x = ProofLocator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofLocator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofLocator) ProtoMessage() {}
+
+func (x *ProofLocator) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofLocator.ProtoReflect.Descriptor instead.
+func (*ProofLocator) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ProofLocator) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ProofLocator) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+func (x *ProofLocator) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ListProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The locators of all matching proof files.
+	Proofs []*ProofLocator `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *ListProofsResponse) Reset() {
+	*x = ListProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProofsResponse) ProtoMessage() {}
+
+func (x *ListProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProofsResponse.ProtoReflect.Descriptor instead.
+func (*ListProofsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListProofsResponse) GetProofs() []*ProofLocator {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type ExportProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only proofs of assets with this asset ID are exported.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// If set, only proofs of assets with this family key are exported.
+	FamilyKey []byte `protobuf:"bytes,2,opt,name=family_key,json=familyKey,proto3" json:"family_key,omitempty"`
+}
+
+func (x *ExportProofsRequest) Reset() {
+	*x = ExportProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProofsRequest) ProtoMessage() {}
+
+func (x *ExportProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProofsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProofsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportProofsRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ExportProofsRequest) GetFamilyKey() []byte {
+	if x != nil {
+		return x.FamilyKey
+	}
+	return nil
+}
+
+type ExportProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw proof bundle.
+	ProofBundle []byte `protobuf:"bytes,1,opt,name=proof_bundle,json=proofBundle,proto3" json:"proof_bundle,omitempty"`
+	// The number of proof files contained in the bundle.
+	NumProofs uint32 `protobuf:"varint,2,opt,name=num_proofs,json=numProofs,proto3" json:"num_proofs,omitempty"`
+}
+
+func (x *ExportProofsResponse) Reset() {
+	*x = ExportProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProofsResponse) ProtoMessage() {}
+
+func (x *ExportProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProofsResponse.ProtoReflect.Descriptor instead.
+func (*ExportProofsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportProofsResponse) GetProofBundle() []byte {
+	if x != nil {
+		return x.ProofBundle
+	}
+	return nil
+}
+
+func (x *ExportProofsResponse) GetNumProofs() uint32 {
+	if x != nil {
+		return x.NumProofs
+	}
+	return 0
+}
+
+type ImportProofsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw proof bundle, as created by ExportProofs.
+	ProofBundle []byte `protobuf:"bytes,1,opt,name=proof_bundle,json=proofBundle,proto3" json:"proof_bundle,omitempty"`
+}
+
+func (x *ImportProofsRequest) Reset() {
+	*x = ImportProofsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProofsRequest) ProtoMessage() {}
+
+func (x *ImportProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProofsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProofsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportProofsRequest) GetProofBundle() []byte {
+	if x != nil {
+		return x.ProofBundle
+	}
+	return nil
+}
+
+type ImportProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of proof files that were imported.
+	NumImported uint32 `protobuf:"varint,1,opt,name=num_imported,json=numImported,proto3" json:"num_imported,omitempty"`
+	// The number of proof files that were skipped as they were already known.
+	NumSkipped uint32 `protobuf:"varint,2,opt,name=num_skipped,json=numSkipped,proto3" json:"num_skipped,omitempty"`
+}
+
+func (x *ImportProofsResponse) Reset() {
+	*x = ImportProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProofsResponse) ProtoMessage() {}
+
+func (x *ImportProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProofsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProofsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportProofsResponse) GetNumImported() uint32 {
+	if x != nil {
+		return x.NumImported
+	}
+	return 0
+}
+
+func (x *ImportProofsResponse) GetNumSkipped() uint32 {
+	if x != nil {
+		return x.NumSkipped
+	}
+	return 0
+}
+
+type VMTraceStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the executed step, such as split_validation, asset_params,
+	//virtual_tx, lock_times, input_tree or script.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Human readable details of the step, such as the values that were checked.
+	Details []string `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+	// The error the step failed with, or empty if it succeeded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *VMTraceStep) Reset() {
+	*x = VMTraceStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VMTraceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMTraceStep) ProtoMessage() {}
+
+func (x *VMTraceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMTraceStep.ProtoReflect.Descriptor instead.
+func (*VMTraceStep) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VMTraceStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VMTraceStep) GetDetails() []string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *VMTraceStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DebugStateTransitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// True if the state transition of the last proof is valid.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The error the state transition failed with, if it's invalid.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The steps the VM executed to validate the state transition.
+	Steps []*VMTraceStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	// The machine-readable reason the state transition is invalid, if it's
+	// invalid.
+	ValidationError *ValidationError `protobuf:"bytes,4,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+}
+
+func (x *DebugStateTransitionResponse) Reset() {
+	*x = DebugStateTransitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugStateTransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugStateTransitionResponse) ProtoMessage() {}
+
+func (x *DebugStateTransitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugStateTransitionResponse.ProtoReflect.Descriptor instead.
+func (*DebugStateTransitionResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DebugStateTransitionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *DebugStateTransitionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DebugStateTransitionResponse) GetSteps() []*VMTraceStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *DebugStateTransitionResponse) GetValidationError() *ValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason validation failed.
+	Code ValidationErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=tarorpc.ValidationErrorCode" json:"code,omitempty"`
+	// The human readable error message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	//
+	//The ID of the asset the error refers to, if known. For errors of a
+	//specific input of a state transition, this is the ID of the spent asset.
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	//
+	//The script key of the asset the error refers to, if known. For errors of a
+	//specific input of a state transition, this is the script key of the spent
+	//asset.
+	ScriptKey []byte `protobuf:"bytes,4,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// True if the error refers to a specific input of a state transition.
+	HasInputIndex bool `protobuf:"varint,5,opt,name=has_input_index,json=hasInputIndex,proto3" json:"has_input_index,omitempty"`
+	// The index of the input within the previous witnesses of the new asset.
+	InputIndex uint32 `protobuf:"varint,6,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	// True if the error refers to a specific proof within a proof file.
+	HasProofIndex bool `protobuf:"varint,7,opt,name=has_proof_index,json=hasProofIndex,proto3" json:"has_proof_index,omitempty"`
+	// The index of the failing proof within the proof file.
+	ProofIndex uint32 `protobuf:"varint,8,opt,name=proof_index,json=proofIndex,proto3" json:"proof_index,omitempty"`
+}
+
+func (x *ValidationError) Reset() {
+	*x = ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationError) ProtoMessage() {}
+
+func (x *ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
+func (*ValidationError) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ValidationError) GetCode() ValidationErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ValidationErrorCode_VALIDATION_ERROR_UNKNOWN
+}
+
+func (x *ValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationError) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ValidationError) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *ValidationError) GetHasInputIndex() bool {
+	if x != nil {
+		return x.HasInputIndex
+	}
+	return false
+}
+
+func (x *ValidationError) GetInputIndex() uint32 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+func (x *ValidationError) GetHasProofIndex() bool {
+	if x != nil {
+		return x.HasProofIndex
+	}
+	return false
+}
+
+func (x *ValidationError) GetProofIndex() uint32 {
+	if x != nil {
+		return x.ProofIndex
+	}
+	return 0
+}
+
+type AddrEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time the event was created in unix timestamp seconds.
+	CreationTimeUnixSeconds uint64 `protobuf:"varint,1,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
+	//
+	//The address the event was created for. Not set for wallet outputs found in
+	//address recovery mode that await the proof telling whether they belong to
+	//a lost address.
+	Addr *Addr `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The current status of the event.
+	Status AddrEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=tarorpc.AddrEventStatus" json:"status,omitempty"`
+	// The outpoint that contains the inbound asset transfer.
+	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The amount in satoshis that were transferred on chain along with the asset.
+	//This amount is independent of the requested asset amount, which can be
+	//looked up on the address.
+	UtxoAmtSat uint64 `protobuf:"varint,5,opt,name=utxo_amt_sat,json=utxoAmtSat,proto3" json:"utxo_amt_sat,omitempty"`
+	//
+	//The taproot sibling hash that was used to send to the Taproot output.
+	//NOTE: Not yet implemented.
+	TaprootSibling []byte `protobuf:"bytes,6,opt,name=taproot_sibling,json=taprootSibling,proto3" json:"taproot_sibling,omitempty"`
+	//
+	//The height at which the on-chain output was confirmed. If this is zero, it
+	//means the output is unconfirmed.
+	ConfirmationHeight uint32 `protobuf:"varint,7,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
+	//
+	//Indicates whether a proof file can be found for the address' asset ID and
+	//script key.
+	HasProof bool `protobuf:"varint,8,opt,name=has_proof,json=hasProof,proto3" json:"has_proof,omitempty"`
+	//
+	//The amount of the asset that was actually received. This is only known
+	//once the proof was imported and may differ from the requested amount of
+	//addresses that don't require an exact amount.
+	AssetAmount uint64 `protobuf:"varint,9,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+}
+
+func (x *AddrEvent) Reset() {
+	*x = AddrEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrEvent) ProtoMessage() {}
+
+func (x *AddrEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrEvent.ProtoReflect.Descriptor instead.
+func (*AddrEvent) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddrEvent) GetCreationTimeUnixSeconds() uint64 {
+	if x != nil {
+		return x.CreationTimeUnixSeconds
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetAddr() *Addr {
+	if x != nil {
+		return x.Addr
+	}
+	return nil
+}
+
+func (x *AddrEvent) GetStatus() AddrEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+func (x *AddrEvent) GetOutpoint() string {
+	if x != nil {
+		return x.Outpoint
+	}
+	return ""
+}
+
+func (x *AddrEvent) GetUtxoAmtSat() uint64 {
+	if x != nil {
+		return x.UtxoAmtSat
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetTaprootSibling() []byte {
+	if x != nil {
+		return x.TaprootSibling
+	}
+	return nil
+}
+
+func (x *AddrEvent) GetConfirmationHeight() uint32 {
+	if x != nil {
+		return x.ConfirmationHeight
+	}
+	return 0
+}
+
+func (x *AddrEvent) GetHasProof() bool {
+	if x != nil {
+		return x.HasProof
+	}
+	return false
+}
+
+func (x *AddrEvent) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+type AddrReceivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter receives by a specific address. Leave empty to get all receives.
+	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
+	// Filter receives by a specific status. Leave empty to get all receives.
+	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=tarorpc.AddrEventStatus" json:"filter_status,omitempty"`
+	//
+	//Filter receives by the label of their address. Leave empty to get all
+	//receives.
+	FilterLabel string `protobuf:"bytes,3,opt,name=filter_label,json=filterLabel,proto3" json:"filter_label,omitempty"`
+	//
+	//Filter receives by an external metadata key of their address. Leave empty
+	//to get all receives.
+	FilterExternalMetadataKey string `protobuf:"bytes,4,opt,name=filter_external_metadata_key,json=filterExternalMetadataKey,proto3" json:"filter_external_metadata_key,omitempty"`
+	//
+	//Filter receives by the external metadata value of the above key of their
+	//address. Leave empty to match any value.
+	FilterExternalMetadataValue string `protobuf:"bytes,5,opt,name=filter_external_metadata_value,json=filterExternalMetadataValue,proto3" json:"filter_external_metadata_value,omitempty"`
+}
+
+func (x *AddrReceivesRequest) Reset() {
+	*x = AddrReceivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_taro_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddrReceivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddrReceivesRequest) ProtoMessage() {}
+
+func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddrReceivesRequest.ProtoReflect.Descriptor instead.
+func (*AddrReceivesRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddrReceivesRequest) GetFilterAddr() string {
+	if x != nil {
+		return x.FilterAddr
+	}
+	return ""
+}
+
+func (x *AddrReceivesRequest) GetFilterStatus() AddrEventStatus {
+	if x != nil {
+		return x.FilterStatus
+	}
+	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+}
+
+func (x *AddrReceivesRequest) GetFilterLabel() string {
+	if x != nil {
+		return x.FilterLabel
+	}
+	return ""
+}
+
+func (x *AddrReceivesRequest) GetFilterExternalMetadataKey() string {
+	if x != nil {
+		return x.FilterExternalMetadataKey
+	}
+	return ""
+}
+
+func (x *AddrReceivesRequest) GetFilterExternalMetadataValue() string {
+	if x != nil {
+		return x.FilterExternalMetadataValue
+	}
+	return ""
+}
+
+type AddrReceivesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// The events that match the filter criteria.
+	Events []*AddrEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *DecodeAddrRequest) Reset() {
-	*x = DecodeAddrRequest{}
+func (x *AddrReceivesResponse) Reset() {
+	*x = AddrReceivesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[24]
+		mi := &file_taro_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodeAddrRequest) String() string {
+func (x *AddrReceivesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodeAddrRequest) ProtoMessage() {}
+func (*AddrReceivesResponse) ProtoMessage() {}
 
-func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[24]
+func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,44 +4360,50 @@ func (x *DecodeAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodeAddrRequest.ProtoReflect.Descriptor instead.
-func (*DecodeAddrRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AddrReceivesResponse.ProtoReflect.Descriptor instead.
+func (*AddrReceivesResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DecodeAddrRequest) GetAddr() string {
+func (x *AddrReceivesResponse) GetEvents() []*AddrEvent {
 	if x != nil {
-		return x.Addr
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type ProofFile struct {
+type SubscribeReceiveEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RawProof     []byte `protobuf:"bytes,1,opt,name=raw_proof,json=rawProof,proto3" json:"raw_proof,omitempty"`
-	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	// Filter receives by a specific address. Leave empty to get all receives.
+	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
+	//
+	//The unix timestamp in seconds from which on existing events should be sent
+	//with their current status before any new updates, for example to catch up
+	//after a reconnect. Only events whose status changed at or after that time
+	//are sent. Leave empty to only get updates that happen after subscribing.
+	StartTimestamp int64 `protobuf:"varint,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
 }
 
-func (x *ProofFile) Reset() {
-	*x = ProofFile{}
+func (x *SubscribeReceiveEventsRequest) Reset() {
+	*x = SubscribeReceiveEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[25]
+		mi := &file_taro_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProofFile) String() string {
+func (x *SubscribeReceiveEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProofFile) ProtoMessage() {}
+func (*SubscribeReceiveEventsRequest) ProtoMessage() {}
 
-func (x *ProofFile) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[25]
+func (x *SubscribeReceiveEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,50 +4414,63 @@ func (x *ProofFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProofFile.ProtoReflect.Descriptor instead.
-func (*ProofFile) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SubscribeReceiveEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeReceiveEventsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ProofFile) GetRawProof() []byte {
+func (x *SubscribeReceiveEventsRequest) GetFilterAddr() string {
 	if x != nil {
-		return x.RawProof
+		return x.FilterAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *ProofFile) GetGenesisPoint() string {
+func (x *SubscribeReceiveEventsRequest) GetStartTimestamp() int64 {
 	if x != nil {
-		return x.GenesisPoint
+		return x.StartTimestamp
 	}
-	return ""
+	return 0
 }
 
-type ProofVerifyResponse struct {
+type ExportAddrsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// If set, only addresses created after this Unix timestamp are exported.
+	CreatedAfter int64 `protobuf:"varint,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// If set, only addresses created before this Unix timestamp are exported.
+	CreatedBefore int64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// If set, only addresses with this label are exported.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//If set, only addresses with an external metadata entry with this key are
+	//exported.
+	ExternalMetadataKey string `protobuf:"bytes,4,opt,name=external_metadata_key,json=externalMetadataKey,proto3" json:"external_metadata_key,omitempty"`
+	//
+	//If set, only addresses with an external metadata entry with this value for
+	//the external_metadata_key are exported.
+	ExternalMetadataValue string `protobuf:"bytes,5,opt,name=external_metadata_value,json=externalMetadataValue,proto3" json:"external_metadata_value,omitempty"`
 }
 
-func (x *ProofVerifyResponse) Reset() {
-	*x = ProofVerifyResponse{}
+func (x *ExportAddrsRequest) Reset() {
+	*x = ExportAddrsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[26]
+		mi := &file_taro_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProofVerifyResponse) String() string {
+func (x *ExportAddrsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProofVerifyResponse) ProtoMessage() {}
+func (*ExportAddrsRequest) ProtoMessage() {}
 
-func (x *ProofVerifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[26]
+func (x *ExportAddrsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1915,44 +4481,74 @@ func (x *ProofVerifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProofVerifyResponse.ProtoReflect.Descriptor instead.
-func (*ProofVerifyResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ExportAddrsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAddrsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ProofVerifyResponse) GetValid() bool {
+func (x *ExportAddrsRequest) GetCreatedAfter() int64 {
 	if x != nil {
-		return x.Valid
+		return x.CreatedAfter
 	}
-	return false
+	return 0
 }
 
-type ExportProofRequest struct {
+func (x *ExportAddrsRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ExportAddrsRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExportAddrsRequest) GetExternalMetadataKey() string {
+	if x != nil {
+		return x.ExternalMetadataKey
+	}
+	return ""
+}
+
+func (x *ExportAddrsRequest) GetExternalMetadataValue() string {
+	if x != nil {
+		return x.ExternalMetadataValue
+	}
+	return ""
+}
+
+type ExportAddrsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The raw address backup.
+	AddrBackup []byte `protobuf:"bytes,1,opt,name=addr_backup,json=addrBackup,proto3" json:"addr_backup,omitempty"`
+	// The number of addresses contained in the backup.
+	NumAddrs uint32 `protobuf:"varint,2,opt,name=num_addrs,json=numAddrs,proto3" json:"num_addrs,omitempty"`
 }
 
-func (x *ExportProofRequest) Reset() {
-	*x = ExportProofRequest{}
+func (x *ExportAddrsResponse) Reset() {
+	*x = ExportAddrsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[27]
+		mi := &file_taro_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportProofRequest) String() string {
+func (x *ExportAddrsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportProofRequest) ProtoMessage() {}
+func (*ExportAddrsResponse) ProtoMessage() {}
 
-func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[27]
+func (x *ExportAddrsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,51 +4559,51 @@ func (x *ExportProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportProofRequest.ProtoReflect.Descriptor instead.
-func (*ExportProofRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ExportAddrsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAddrsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ExportProofRequest) GetAssetId() []byte {
+func (x *ExportAddrsResponse) GetAddrBackup() []byte {
 	if x != nil {
-		return x.AssetId
+		return x.AddrBackup
 	}
 	return nil
 }
 
-func (x *ExportProofRequest) GetScriptKey() []byte {
+func (x *ExportAddrsResponse) GetNumAddrs() uint32 {
 	if x != nil {
-		return x.ScriptKey
+		return x.NumAddrs
 	}
-	return nil
+	return 0
 }
 
-type ImportProofRequest struct {
+type ImportAddrsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProofFile    []byte `protobuf:"bytes,1,opt,name=proof_file,json=proofFile,proto3" json:"proof_file,omitempty"`
-	GenesisPoint string `protobuf:"bytes,2,opt,name=genesis_point,json=genesisPoint,proto3" json:"genesis_point,omitempty"`
+	// The raw address backup, as created by ExportAddrs.
+	AddrBackup []byte `protobuf:"bytes,1,opt,name=addr_backup,json=addrBackup,proto3" json:"addr_backup,omitempty"`
 }
 
-func (x *ImportProofRequest) Reset() {
-	*x = ImportProofRequest{}
+func (x *ImportAddrsRequest) Reset() {
+	*x = ImportAddrsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[28]
+		mi := &file_taro_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportProofRequest) String() string {
+func (x *ImportAddrsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportProofRequest) ProtoMessage() {}
+func (*ImportAddrsRequest) ProtoMessage() {}
 
-func (x *ImportProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[28]
+func (x *ImportAddrsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,48 +4614,46 @@ func (x *ImportProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportProofRequest.ProtoReflect.Descriptor instead.
-func (*ImportProofRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ImportAddrsRequest.ProtoReflect.Descriptor instead.
+func (*ImportAddrsRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ImportProofRequest) GetProofFile() []byte {
+func (x *ImportAddrsRequest) GetAddrBackup() []byte {
 	if x != nil {
-		return x.ProofFile
+		return x.AddrBackup
 	}
 	return nil
 }
 
-func (x *ImportProofRequest) GetGenesisPoint() string {
-	if x != nil {
-		return x.GenesisPoint
-	}
-	return ""
-}
-
-type ImportProofResponse struct {
+type ImportAddrsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The addresses that were imported.
+	ImportedAddrs []*Addr `protobuf:"bytes,1,rep,name=imported_addrs,json=importedAddrs,proto3" json:"imported_addrs,omitempty"`
+	// The number of addresses that were skipped as they were already known.
+	NumSkipped uint32 `protobuf:"varint,2,opt,name=num_skipped,json=numSkipped,proto3" json:"num_skipped,omitempty"`
 }
 
-func (x *ImportProofResponse) Reset() {
-	*x = ImportProofResponse{}
+func (x *ImportAddrsResponse) Reset() {
+	*x = ImportAddrsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[29]
+		mi := &file_taro_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportProofResponse) String() string {
+func (x *ImportAddrsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportProofResponse) ProtoMessage() {}
+func (*ImportAddrsResponse) ProtoMessage() {}
 
-func (x *ImportProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[29]
+func (x *ImportAddrsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2070,60 +4664,73 @@ func (x *ImportProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportProofResponse.ProtoReflect.Descriptor instead.
-func (*ImportProofResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ImportAddrsResponse.ProtoReflect.Descriptor instead.
+func (*ImportAddrsResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{60}
 }
 
-type AddrEvent struct {
+func (x *ImportAddrsResponse) GetImportedAddrs() []*Addr {
+	if x != nil {
+		return x.ImportedAddrs
+	}
+	return nil
+}
+
+func (x *ImportAddrsResponse) GetNumSkipped() uint32 {
+	if x != nil {
+		return x.NumSkipped
+	}
+	return 0
+}
+
+type SendAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time the event was created in unix timestamp seconds.
-	CreationTimeUnixSeconds uint64 `protobuf:"varint,1,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
-	// The address the event was created for.
-	Addr *Addr `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	// The current status of the event.
-	Status AddrEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=tarorpc.AddrEventStatus" json:"status,omitempty"`
-	// The outpoint that contains the inbound asset transfer.
-	Outpoint string `protobuf:"bytes,4,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	TaroAddr string `protobuf:"bytes,1,opt,name=taro_addr,json=taroAddr,proto3" json:"taro_addr,omitempty"`
 	//
-	//The amount in satoshis that were transferred on chain along with the asset.
-	//This amount is independent of the requested asset amount, which can be
-	//looked up on the address.
-	UtxoAmtSat uint64 `protobuf:"varint,5,opt,name=utxo_amt_sat,json=utxoAmtSat,proto3" json:"utxo_amt_sat,omitempty"`
+	//An optional script path spend of the input asset. If set, then an input
+	//whose script key commits to the given leaf is spent through that leaf
+	//instead of the key path.
+	TapscriptSpend *TapscriptSpend `protobuf:"bytes,2,opt,name=tapscript_spend,json=tapscriptSpend,proto3" json:"tapscript_spend,omitempty"`
 	//
-	//The taproot sibling hash that was used to send to the Taproot output.
-	//NOTE: Not yet implemented.
-	TaprootSibling []byte `protobuf:"bytes,6,opt,name=taproot_sibling,json=taprootSibling,proto3" json:"taproot_sibling,omitempty"`
+	//An optional override of the number of confirmations the transfer
+	//transaction needs before the transfer is considered complete.
+	NumConfs uint32 `protobuf:"varint,3,opt,name=num_confs,json=numConfs,proto3" json:"num_confs,omitempty"`
 	//
-	//The height at which the on-chain output was confirmed. If this is zero, it
-	//means the output is unconfirmed.
-	ConfirmationHeight uint32 `protobuf:"varint,7,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
+	//An optional free-form label of the transfer that can be used to map it to
+	//an entity of an external system.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
 	//
-	//Indicates whether a proof file can be found for the address' asset ID and
-	//script key.
-	HasProof bool `protobuf:"varint,8,opt,name=has_proof,json=hasProof,proto3" json:"has_proof,omitempty"`
+	//An optional set of key/value pairs that describe the transfer in the
+	//context of an external system.
+	ExternalMetadata map[string]string `protobuf:"bytes,5,rep,name=external_metadata,json=externalMetadata,proto3" json:"external_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The amount of the asset to send. This must be set for addresses that
+	//accept any amount and may be set for addresses that accept a minimum
+	//amount, in which case it must be at least the requested amount. It must
+	//be unset or match the requested amount for all other addresses.
+	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *AddrEvent) Reset() {
-	*x = AddrEvent{}
+func (x *SendAssetRequest) Reset() {
+	*x = SendAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[30]
+		mi := &file_taro_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrEvent) String() string {
+func (x *SendAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrEvent) ProtoMessage() {}
+func (*SendAssetRequest) ProtoMessage() {}
 
-func (x *AddrEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[30]
+func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,95 +4741,87 @@ func (x *AddrEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrEvent.ProtoReflect.Descriptor instead.
-func (*AddrEvent) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SendAssetRequest.ProtoReflect.Descriptor instead.
+func (*SendAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AddrEvent) GetCreationTimeUnixSeconds() uint64 {
+func (x *SendAssetRequest) GetTaroAddr() string {
 	if x != nil {
-		return x.CreationTimeUnixSeconds
+		return x.TaroAddr
 	}
-	return 0
+	return ""
 }
 
-func (x *AddrEvent) GetAddr() *Addr {
+func (x *SendAssetRequest) GetTapscriptSpend() *TapscriptSpend {
 	if x != nil {
-		return x.Addr
+		return x.TapscriptSpend
 	}
 	return nil
 }
 
-func (x *AddrEvent) GetStatus() AddrEventStatus {
+func (x *SendAssetRequest) GetNumConfs() uint32 {
 	if x != nil {
-		return x.Status
+		return x.NumConfs
 	}
-	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+	return 0
 }
 
-func (x *AddrEvent) GetOutpoint() string {
+func (x *SendAssetRequest) GetLabel() string {
 	if x != nil {
-		return x.Outpoint
+		return x.Label
 	}
 	return ""
 }
 
-func (x *AddrEvent) GetUtxoAmtSat() uint64 {
-	if x != nil {
-		return x.UtxoAmtSat
-	}
-	return 0
-}
-
-func (x *AddrEvent) GetTaprootSibling() []byte {
+func (x *SendAssetRequest) GetExternalMetadata() map[string]string {
 	if x != nil {
-		return x.TaprootSibling
+		return x.ExternalMetadata
 	}
 	return nil
 }
 
-func (x *AddrEvent) GetConfirmationHeight() uint32 {
+func (x *SendAssetRequest) GetAmount() uint64 {
 	if x != nil {
-		return x.ConfirmationHeight
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *AddrEvent) GetHasProof() bool {
-	if x != nil {
-		return x.HasProof
-	}
-	return false
-}
-
-type AddrReceivesRequest struct {
+type TapscriptSpend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Filter receives by a specific address. Leave empty to get all receives.
-	FilterAddr string `protobuf:"bytes,1,opt,name=filter_addr,json=filterAddr,proto3" json:"filter_addr,omitempty"`
-	// Filter receives by a specific status. Leave empty to get all receives.
-	FilterStatus AddrEventStatus `protobuf:"varint,2,opt,name=filter_status,json=filterStatus,proto3,enum=tarorpc.AddrEventStatus" json:"filter_status,omitempty"`
+	// The tapscript leaf of the input's script key that is spent.
+	Leaf *TapLeaf `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	//
+	//Any additional witness items the leaf script requires, such as hash
+	//preimages. They're placed on the witness stack below the signature.
+	WitnessItems [][]byte `protobuf:"bytes,2,rep,name=witness_items,json=witnessItems,proto3" json:"witness_items,omitempty"`
+	//
+	//If true, then no signature of the raw script key is placed on the
+	//witness stack, as the leaf script doesn't require one.
+	SkipSignature bool `protobuf:"varint,3,opt,name=skip_signature,json=skipSignature,proto3" json:"skip_signature,omitempty"`
 }
 
-func (x *AddrReceivesRequest) Reset() {
-	*x = AddrReceivesRequest{}
+func (x *TapscriptSpend) Reset() {
+	*x = TapscriptSpend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[31]
+		mi := &file_taro_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrReceivesRequest) String() string {
+func (x *TapscriptSpend) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrReceivesRequest) ProtoMessage() {}
+func (*TapscriptSpend) ProtoMessage() {}
 
-func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[31]
+func (x *TapscriptSpend) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,51 +4832,64 @@ func (x *AddrReceivesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrReceivesRequest.ProtoReflect.Descriptor instead.
-func (*AddrReceivesRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use TapscriptSpend.ProtoReflect.Descriptor instead.
+func (*TapscriptSpend) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *AddrReceivesRequest) GetFilterAddr() string {
+func (x *TapscriptSpend) GetLeaf() *TapLeaf {
 	if x != nil {
-		return x.FilterAddr
+		return x.Leaf
 	}
-	return ""
+	return nil
+}
+
+func (x *TapscriptSpend) GetWitnessItems() [][]byte {
+	if x != nil {
+		return x.WitnessItems
+	}
+	return nil
 }
 
-func (x *AddrReceivesRequest) GetFilterStatus() AddrEventStatus {
+func (x *TapscriptSpend) GetSkipSignature() bool {
 	if x != nil {
-		return x.FilterStatus
+		return x.SkipSignature
 	}
-	return AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN
+	return false
 }
 
-type AddrReceivesResponse struct {
+type BurnAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The events that match the filter criteria.
-	Events []*AddrEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// The ID of the asset to burn.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The amount of the asset to burn.
+	AmountToBurn int64 `protobuf:"varint,2,opt,name=amount_to_burn,json=amountToBurn,proto3" json:"amount_to_burn,omitempty"`
+	//
+	//An optional override of the number of confirmations the burn transaction
+	//needs before the burn is considered complete.
+	NumConfs uint32 `protobuf:"varint,3,opt,name=num_confs,json=numConfs,proto3" json:"num_confs,omitempty"`
 }
 
-func (x *AddrReceivesResponse) Reset() {
-	*x = AddrReceivesResponse{}
+func (x *BurnAssetRequest) Reset() {
+	*x = BurnAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[32]
+		mi := &file_taro_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddrReceivesResponse) String() string {
+func (x *BurnAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddrReceivesResponse) ProtoMessage() {}
+func (*BurnAssetRequest) ProtoMessage() {}
 
-func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[32]
+func (x *BurnAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2288,43 +4900,60 @@ func (x *AddrReceivesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddrReceivesResponse.ProtoReflect.Descriptor instead.
-func (*AddrReceivesResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use BurnAssetRequest.ProtoReflect.Descriptor instead.
+func (*BurnAssetRequest) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *AddrReceivesResponse) GetEvents() []*AddrEvent {
+func (x *BurnAssetRequest) GetAssetId() []byte {
 	if x != nil {
-		return x.Events
+		return x.AssetId
 	}
 	return nil
 }
 
-type SendAssetRequest struct {
+func (x *BurnAssetRequest) GetAmountToBurn() int64 {
+	if x != nil {
+		return x.AmountToBurn
+	}
+	return 0
+}
+
+func (x *BurnAssetRequest) GetNumConfs() uint32 {
+	if x != nil {
+		return x.NumConfs
+	}
+	return 0
+}
+
+type BurnAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaroAddr string `protobuf:"bytes,1,opt,name=taro_addr,json=taroAddr,proto3" json:"taro_addr,omitempty"`
+	// The transfer that burns the asset.
+	BurnTransfer *SendAssetResponse `protobuf:"bytes,1,opt,name=burn_transfer,json=burnTransfer,proto3" json:"burn_transfer,omitempty"`
+	// The provably unspendable script key the asset is burned to.
+	BurnKey []byte `protobuf:"bytes,2,opt,name=burn_key,json=burnKey,proto3" json:"burn_key,omitempty"`
 }
 
-func (x *SendAssetRequest) Reset() {
-	*x = SendAssetRequest{}
+func (x *BurnAssetResponse) Reset() {
+	*x = BurnAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[33]
+		mi := &file_taro_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendAssetRequest) String() string {
+func (x *BurnAssetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAssetRequest) ProtoMessage() {}
+func (*BurnAssetResponse) ProtoMessage() {}
 
-func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[33]
+func (x *BurnAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taro_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,16 +4964,23 @@ func (x *SendAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAssetRequest.ProtoReflect.Descriptor instead.
-func (*SendAssetRequest) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BurnAssetResponse.ProtoReflect.Descriptor instead.
+func (*BurnAssetResponse) Descriptor() ([]byte, []int) {
+	return file_taro_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *SendAssetRequest) GetTaroAddr() string {
+func (x *BurnAssetResponse) GetBurnTransfer() *SendAssetResponse {
 	if x != nil {
-		return x.TaroAddr
+		return x.BurnTransfer
 	}
-	return ""
+	return nil
+}
+
+func (x *BurnAssetResponse) GetBurnKey() []byte {
+	if x != nil {
+		return x.BurnKey
+	}
+	return nil
 }
 
 type PrevInputAsset struct {
@@ -2361,7 +4997,7 @@ type PrevInputAsset struct {
 func (x *PrevInputAsset) Reset() {
 	*x = PrevInputAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[34]
+		mi := &file_taro_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2374,7 +5010,7 @@ func (x *PrevInputAsset) String() string {
 func (*PrevInputAsset) ProtoMessage() {}
 
 func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[34]
+	mi := &file_taro_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2387,7 +5023,7 @@ func (x *PrevInputAsset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrevInputAsset.ProtoReflect.Descriptor instead.
 func (*PrevInputAsset) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{34}
+	return file_taro_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *PrevInputAsset) GetAnchorPoint() string {
@@ -2434,7 +5070,7 @@ type AssetOutput struct {
 func (x *AssetOutput) Reset() {
 	*x = AssetOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[35]
+		mi := &file_taro_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2447,7 +5083,7 @@ func (x *AssetOutput) String() string {
 func (*AssetOutput) ProtoMessage() {}
 
 func (x *AssetOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[35]
+	mi := &file_taro_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2460,7 +5096,7 @@ func (x *AssetOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetOutput.ProtoReflect.Descriptor instead.
 func (*AssetOutput) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{35}
+	return file_taro_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AssetOutput) GetAnchorPoint() string {
@@ -2519,7 +5155,7 @@ type TaroTransfer struct {
 func (x *TaroTransfer) Reset() {
 	*x = TaroTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[36]
+		mi := &file_taro_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2532,7 +5168,7 @@ func (x *TaroTransfer) String() string {
 func (*TaroTransfer) ProtoMessage() {}
 
 func (x *TaroTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[36]
+	mi := &file_taro_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2545,7 +5181,7 @@ func (x *TaroTransfer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaroTransfer.ProtoReflect.Descriptor instead.
 func (*TaroTransfer) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{36}
+	return file_taro_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TaroTransfer) GetOldTaroRoot() []byte {
@@ -2591,7 +5227,7 @@ type SendAssetResponse struct {
 func (x *SendAssetResponse) Reset() {
 	*x = SendAssetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_taro_proto_msgTypes[37]
+		mi := &file_taro_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2604,7 +5240,7 @@ func (x *SendAssetResponse) String() string {
 func (*SendAssetResponse) ProtoMessage() {}
 
 func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taro_proto_msgTypes[37]
+	mi := &file_taro_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2617,7 +5253,7 @@ func (x *SendAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendAssetResponse.ProtoReflect.Descriptor instead.
 func (*SendAssetResponse) Descriptor() ([]byte, []int) {
-	return file_taro_proto_rawDescGZIP(), []int{37}
+	return file_taro_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SendAssetResponse) GetTransferTxid() []byte {
@@ -2659,7 +5295,7 @@ var File_taro_proto protoreflect.FileDescriptor
 
 var file_taro_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x22, 0x9e, 0x04, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
 	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73,
 	0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
 	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
@@ -2672,209 +5308,351 @@ var file_taro_proto_rawDesc = []byte{
 	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
 	0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x30,
-	0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
-	0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78,
-	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x69,
-	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a,
-	0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75,
-	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0xe8, 0x01, 0x0a, 0x0b, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6f, 0x6f,
-	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x77, 0x5f, 0x66, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x61,
-	0x77, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x77,
-	0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x46,
-	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x69, 0x67, 0x22, 0xa9, 0x03, 0x0a, 0x05, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66,
-	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c,
-	0x79, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x36,
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x66, 0x61, 0x6d, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x6d,
-	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x22, 0x96,
-	0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x99, 0x03, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x57, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x15, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x13, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x1a, 0x57, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a,
-	0x18, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72,
-	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0d, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c,
-	0x64, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x6e, 0x63, 0x68,
-	0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6e, 0x65, 0x77, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x74, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61,
-	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x46, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65,
-	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70,
-	0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x19, 0x0a,
-	0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x24,
-	0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x6d, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x41, 0x6d, 0x74, 0x22, 0x0d, 0x0a,
-	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c,
-	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73,
-	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x53, 0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75,
-	0x62, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x04,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
-	0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
-	0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74,
-	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x4b, 0x65, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x22, 0x38, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x71, 0x0a, 0x0e,
-	0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14,
-	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x61, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x22,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x5c, 0x0a, 0x11,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x41, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0xe8,
+	0x01, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23,
+	0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x77,
+	0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x2c, 0x0a, 0x12, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x77, 0x65,
+	0x61, 0x6b, 0x65, 0x64, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x53, 0x69, 0x67, 0x22,
+	0xa9, 0x03, 0x0a, 0x05, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31,
+	0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x62, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x6e, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x7b, 0x0a, 0x11,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74,
+	0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x52, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x22, 0xa8, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x66, 0x61, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x4d, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x99, 0x03, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x6a, 0x0a, 0x15, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x57,
+	0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x98, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x12,
+	0x36, 0x0a, 0x17, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xd3, 0x03, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x74, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x46,
+	0x0a, 0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x59, 0x0a, 0x11,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x91, 0x01, 0x0a,
+	0x0f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f,
+	0x6c, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x61,
+	0x6d, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x41, 0x6d, 0x74,
+	0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x46, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xc4,
+	0x05, 0x0a, 0x04, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x70, 0x72,
+	0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x50, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d,
+	0x65, 0x6d, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x1a, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x02, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x36,
+	0x0a, 0x17, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61,
+	0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x22, 0xf9, 0x04, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x61, 0x6d,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x4b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x61, 0x6d, 0x74, 0x12, 0x3b, 0x0a, 0x10, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x66,
+	0x52, 0x0f, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x5a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x31, 0x0a,
+	0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3a, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x21, 0x0a, 0x07,
+	0x54, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
 	0x27, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x4d, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f,
@@ -2882,187 +5660,608 @@ var file_taro_proto_rawDesc = []byte{
 	0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f,
 	0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
 	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x62, 0x75, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x43,
+	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x6d, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x58, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x50, 0x72,
 	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73,
 	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73,
 	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
 	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x4b, 0x65, 0x79, 0x22, 0x58, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x15,
-	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x21, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x41, 0x6d, 0x74,
-	0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73,
-	0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x61,
-	0x70, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x75, 0x0a, 0x13, 0x41, 0x64,
-	0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x42, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
-	0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61,
-	0x72, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63,
-	0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6,
-	0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x6f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f,
-	0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0b, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0d,
-	0x6e, 0x65, 0x77, 0x5f, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
-	0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a,
-	0x70, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6e, 0x65,
-	0x77, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x22, 0xf6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13,
-	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f,
-	0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x11,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x6f,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x72, 0x6f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65,
-	0x65, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x73, 0x2a, 0x28, 0x0a, 0x09, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41,
-	0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42,
-	0x4c, 0x45, 0x10, 0x01, 0x2a, 0xd0, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x44, 0x44, 0x52, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x52, 0x45, 0x43, 0x45,
-	0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x32, 0xbb, 0x07, 0x0a, 0x04, 0x54, 0x61, 0x72, 0x6f,
-	0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
-	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x61, 0x72,
-	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61,
-	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
-	0x07, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
-	0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x37, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a,
-	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72,
-	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6b, 0x65, 0x65, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x65, 0x70, 0x22,
+	0x7d, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x9a,
+	0x01, 0x0a, 0x16, 0x54, 0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x0f,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x85, 0x01, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x67, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22,
+	0x43, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x22, 0x4f, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x58, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22,
+	0x38, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x53, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x51, 0x0a, 0x0b, 0x56, 0x4d, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbb, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x56,
+	0x4d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x12, 0x43, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9, 0x02, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x26, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0xf5, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x62, 0x6c,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x61, 0x70, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68,
+	0x61, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x13, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3f, 0x0a, 0x1c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x1e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x42, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x69, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x53, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x64, 0x64,
+	0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x41,
+	0x64, 0x64, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64,
+	0x64, 0x72, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x61, 0x64, 0x64, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x6c, 0x0a, 0x13, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e,
+	0x75, 0x6d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0xdf, 0x02, 0x0a, 0x10, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x40, 0x0a, 0x0f, 0x74,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x0e, 0x74,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x5c, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82, 0x01, 0x0a, 0x0e,
+	0x54, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x24,
+	0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x04,
+	0x6c, 0x65, 0x61, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x77, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x70, 0x0a, 0x10, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x75, 0x72,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x6f, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6e,
+	0x66, 0x73, 0x22, 0x6f, 0x0a, 0x11, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x75, 0x72, 0x6e, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x75, 0x72, 0x6e,
+	0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0b,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6c,
+	0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x6f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x72,
+	0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x6c,
+	0x64, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77,
+	0x5f, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x72, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x38, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x70, 0x72, 0x65,
+	0x76, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0xf6,
+	0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x6f, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x72, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x73, 0x2a, 0x28, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10,
+	0x01, 0x2a, 0x5b, 0x0a, 0x0c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4d,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x54, 0x5f, 0x4c,
+	0x45, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x2a, 0xb5,
+	0x11, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x2b, 0x0a, 0x27, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x53,
+	0x50, 0x4c, 0x49, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x2b, 0x0a,
+	0x27, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56,
+	0x4d, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x05, 0x12, 0x2d, 0x0a, 0x29, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x4c, 0x41, 0x47,
+	0x10, 0x06, 0x12, 0x38, 0x0a, 0x34, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x30, 0x0a, 0x2c,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x08, 0x12, 0x30,
+	0x0a, 0x2c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x50,
+	0x4c, 0x49, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x09,
+	0x12, 0x38, 0x0a, 0x34, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x57, 0x49, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x0a, 0x12, 0x36, 0x0a, 0x32, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56,
+	0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46,
+	0x10, 0x0b, 0x12, 0x2a, 0x0a, 0x26, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x10, 0x0c, 0x12, 0x2d,
+	0x0a, 0x29, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x36, 0x0a,
+	0x32, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43,
+	0x48, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x2c, 0x0a, 0x28, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48,
+	0x54, 0x10, 0x0f, 0x12, 0x2c, 0x0a, 0x28, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x10,
+	0x10, 0x12, 0x30, 0x0a, 0x2c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x43,
+	0x4f, 0x56, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x11, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45,
+	0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x12, 0x12, 0x29,
+	0x0a, 0x25, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c,
+	0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x13, 0x12, 0x2e, 0x0a, 0x2a, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x14, 0x12, 0x26, 0x0a, 0x22, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d,
+	0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
+	0x15, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x4e, 0x50, 0x55,
+	0x54, 0x53, 0x10, 0x16, 0x12, 0x30, 0x0a, 0x2c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x50, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x50,
+	0x52, 0x4f, 0x4f, 0x46, 0x10, 0x64, 0x12, 0x32, 0x0a, 0x2e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x52, 0x4b,
+	0x4c, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x65, 0x12, 0x33, 0x0a, 0x2f, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50,
+	0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x58, 0x43,
+	0x4c, 0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x53, 0x10, 0x66, 0x12,
+	0x33, 0x0a, 0x2f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x4f, 0x46, 0x10, 0x67, 0x12, 0x33, 0x0a, 0x2f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x68, 0x12, 0x32, 0x0a, 0x2e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52,
+	0x4f, 0x4f, 0x46, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x50, 0x53,
+	0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x69, 0x12, 0x2b, 0x0a,
+	0x27, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x10, 0x6a, 0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50,
+	0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x55, 0x4e, 0x54, 0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x6b, 0x12, 0x2e, 0x0a, 0x2a, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x6c, 0x12, 0x34, 0x0a, 0x2f, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0xc8,
+	0x01, 0x12, 0x3d, 0x0a, 0x38, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54,
+	0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0xc9, 0x01,
+	0x12, 0x35, 0x0a, 0x30, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x41, 0x4d,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0xca, 0x01, 0x12, 0x36, 0x0a, 0x31, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53,
+	0x50, 0x4c, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x4f, 0x52, 0x10, 0xcb, 0x01, 0x12,
+	0x33, 0x0a, 0x2e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45,
+	0x59, 0x10, 0xcc, 0x01, 0x12, 0x32, 0x0a, 0x2d, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x41,
+	0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xcd, 0x01, 0x12, 0x36, 0x0a, 0x31, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x5a, 0x45, 0x52, 0x4f,
+	0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xce, 0x01,
+	0x12, 0x32, 0x0a, 0x2d, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49,
+	0x54, 0x10, 0xcf, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x53, 0x10, 0xd0, 0x01,
+	0x12, 0x31, 0x0a, 0x2c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x10, 0xd1, 0x01, 0x12, 0x34, 0x0a, 0x2f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4d, 0x49,
+	0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0xd2, 0x01, 0x12, 0x35, 0x0a, 0x30, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0xd3, 0x01,
+	0x12, 0x34, 0x0a, 0x2f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x53, 0x49, 0x47, 0x10, 0xd4, 0x01, 0x2a, 0x9d, 0x02, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44,
+	0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x44, 0x44,
+	0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x44, 0x52,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x44, 0x44,
+	0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c,
+	0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x32, 0xe5, 0x0e, 0x0a, 0x04, 0x54, 0x61, 0x72, 0x6f, 0x12,
+	0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x19,
+	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x17, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12,
+	0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1c, 0x2e,
+	0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72,
+	0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x12, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74,
+	0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19,
+	0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x74, 0x61,
+	0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x1a,
+	0x25, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
 	0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x75,
+	0x72, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61, 0x72, 0x6f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x72,
+	0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x22,
+	0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x72, 0x6f, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3077,109 +6276,194 @@ func file_taro_proto_rawDescGZIP() []byte {
 	return file_taro_proto_rawDescData
 }
 
-var file_taro_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_taro_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_taro_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_taro_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_taro_proto_goTypes = []interface{}{
-	(AssetType)(0),                // 0: tarorpc.AssetType
-	(AddrEventStatus)(0),          // 1: tarorpc.AddrEventStatus
-	(*MintAssetRequest)(nil),      // 2: tarorpc.MintAssetRequest
-	(*MintAssetResponse)(nil),     // 3: tarorpc.MintAssetResponse
-	(*ListAssetRequest)(nil),      // 4: tarorpc.ListAssetRequest
-	(*AnchorInfo)(nil),            // 5: tarorpc.AnchorInfo
-	(*GenesisInfo)(nil),           // 6: tarorpc.GenesisInfo
-	(*AssetFamily)(nil),           // 7: tarorpc.AssetFamily
-	(*Asset)(nil),                 // 8: tarorpc.Asset
-	(*ListAssetResponse)(nil),     // 9: tarorpc.ListAssetResponse
-	(*ListBalancesRequest)(nil),   // 10: tarorpc.ListBalancesRequest
-	(*AssetBalance)(nil),          // 11: tarorpc.AssetBalance
-	(*AssetFamilyBalance)(nil),    // 12: tarorpc.AssetFamilyBalance
-	(*ListBalancesResponse)(nil),  // 13: tarorpc.ListBalancesResponse
-	(*ListTransfersRequest)(nil),  // 14: tarorpc.ListTransfersRequest
-	(*ListTransfersResponse)(nil), // 15: tarorpc.ListTransfersResponse
-	(*AssetTransfer)(nil),         // 16: tarorpc.AssetTransfer
-	(*AssetSpendDelta)(nil),       // 17: tarorpc.AssetSpendDelta
-	(*StopRequest)(nil),           // 18: tarorpc.StopRequest
-	(*StopResponse)(nil),          // 19: tarorpc.StopResponse
-	(*DebugLevelRequest)(nil),     // 20: tarorpc.DebugLevelRequest
-	(*DebugLevelResponse)(nil),    // 21: tarorpc.DebugLevelResponse
-	(*Addr)(nil),                  // 22: tarorpc.Addr
-	(*QueryAddrRequest)(nil),      // 23: tarorpc.QueryAddrRequest
-	(*QueryAddrResponse)(nil),     // 24: tarorpc.QueryAddrResponse
-	(*NewAddrRequest)(nil),        // 25: tarorpc.NewAddrRequest
-	(*DecodeAddrRequest)(nil),     // 26: tarorpc.DecodeAddrRequest
-	(*ProofFile)(nil),             // 27: tarorpc.ProofFile
-	(*ProofVerifyResponse)(nil),   // 28: tarorpc.ProofVerifyResponse
-	(*ExportProofRequest)(nil),    // 29: tarorpc.ExportProofRequest
-	(*ImportProofRequest)(nil),    // 30: tarorpc.ImportProofRequest
-	(*ImportProofResponse)(nil),   // 31: tarorpc.ImportProofResponse
-	(*AddrEvent)(nil),             // 32: tarorpc.AddrEvent
-	(*AddrReceivesRequest)(nil),   // 33: tarorpc.AddrReceivesRequest
-	(*AddrReceivesResponse)(nil),  // 34: tarorpc.AddrReceivesResponse
-	(*SendAssetRequest)(nil),      // 35: tarorpc.SendAssetRequest
-	(*PrevInputAsset)(nil),        // 36: tarorpc.PrevInputAsset
-	(*AssetOutput)(nil),           // 37: tarorpc.AssetOutput
-	(*TaroTransfer)(nil),          // 38: tarorpc.TaroTransfer
-	(*SendAssetResponse)(nil),     // 39: tarorpc.SendAssetResponse
-	nil,                           // 40: tarorpc.ListBalancesResponse.AssetBalancesEntry
-	nil,                           // 41: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
+	(AssetType)(0),                        // 0: tarorpc.AssetType
+	(AmountPolicy)(0),                     // 1: tarorpc.AmountPolicy
+	(ValidationErrorCode)(0),              // 2: tarorpc.ValidationErrorCode
+	(AddrEventStatus)(0),                  // 3: tarorpc.AddrEventStatus
+	(*MintAssetRequest)(nil),              // 4: tarorpc.MintAssetRequest
+	(*MintAssetResponse)(nil),             // 5: tarorpc.MintAssetResponse
+	(*ListAssetRequest)(nil),              // 6: tarorpc.ListAssetRequest
+	(*AnchorInfo)(nil),                    // 7: tarorpc.AnchorInfo
+	(*GenesisInfo)(nil),                   // 8: tarorpc.GenesisInfo
+	(*AssetFamily)(nil),                   // 9: tarorpc.AssetFamily
+	(*Asset)(nil),                         // 10: tarorpc.Asset
+	(*ListAssetResponse)(nil),             // 11: tarorpc.ListAssetResponse
+	(*ListCollectiblesRequest)(nil),       // 12: tarorpc.ListCollectiblesRequest
+	(*CollectibleOwner)(nil),              // 13: tarorpc.CollectibleOwner
+	(*Collectible)(nil),                   // 14: tarorpc.Collectible
+	(*CollectibleFamily)(nil),             // 15: tarorpc.CollectibleFamily
+	(*ListCollectiblesResponse)(nil),      // 16: tarorpc.ListCollectiblesResponse
+	(*ListBalancesRequest)(nil),           // 17: tarorpc.ListBalancesRequest
+	(*AssetBalance)(nil),                  // 18: tarorpc.AssetBalance
+	(*AssetFamilyBalance)(nil),            // 19: tarorpc.AssetFamilyBalance
+	(*ListBalancesResponse)(nil),          // 20: tarorpc.ListBalancesResponse
+	(*ListTransfersRequest)(nil),          // 21: tarorpc.ListTransfersRequest
+	(*ListTransfersResponse)(nil),         // 22: tarorpc.ListTransfersResponse
+	(*AssetTransfer)(nil),                 // 23: tarorpc.AssetTransfer
+	(*AssetSpendDelta)(nil),               // 24: tarorpc.AssetSpendDelta
+	(*StopRequest)(nil),                   // 25: tarorpc.StopRequest
+	(*StopResponse)(nil),                  // 26: tarorpc.StopResponse
+	(*DebugLevelRequest)(nil),             // 27: tarorpc.DebugLevelRequest
+	(*DebugLevelResponse)(nil),            // 28: tarorpc.DebugLevelResponse
+	(*Addr)(nil),                          // 29: tarorpc.Addr
+	(*QueryAddrRequest)(nil),              // 30: tarorpc.QueryAddrRequest
+	(*QueryAddrResponse)(nil),             // 31: tarorpc.QueryAddrResponse
+	(*NewAddrRequest)(nil),                // 32: tarorpc.NewAddrRequest
+	(*TapLeaf)(nil),                       // 33: tarorpc.TapLeaf
+	(*DecodeAddrRequest)(nil),             // 34: tarorpc.DecodeAddrRequest
+	(*ProofFile)(nil),                     // 35: tarorpc.ProofFile
+	(*ProofVerifyResponse)(nil),           // 36: tarorpc.ProofVerifyResponse
+	(*ExportProofRequest)(nil),            // 37: tarorpc.ExportProofRequest
+	(*ImportProofRequest)(nil),            // 38: tarorpc.ImportProofRequest
+	(*ImportProofResponse)(nil),           // 39: tarorpc.ImportProofResponse
+	(*CompactProofRequest)(nil),           // 40: tarorpc.CompactProofRequest
+	(*CompactProofResponse)(nil),          // 41: tarorpc.CompactProofResponse
+	(*TrustCheckpointRequest)(nil),        // 42: tarorpc.TrustCheckpointRequest
+	(*TrustCheckpointResponse)(nil),       // 43: tarorpc.TrustCheckpointResponse
+	(*AuditProofsRequest)(nil),            // 44: tarorpc.AuditProofsRequest
+	(*AssetProofAudit)(nil),               // 45: tarorpc.AssetProofAudit
+	(*AuditProofsResponse)(nil),           // 46: tarorpc.AuditProofsResponse
+	(*ListProofsRequest)(nil),             // 47: tarorpc.ListProofsRequest
+	(*ProofLocator)(nil),                  // 48: tarorpc.ProofLocator
+	(*ListProofsResponse)(nil),            // 49: tarorpc.ListProofsResponse
+	(*ExportProofsRequest)(nil),           // 50: tarorpc.ExportProofsRequest
+	(*ExportProofsResponse)(nil),          // 51: tarorpc.ExportProofsResponse
+	(*ImportProofsRequest)(nil),           // 52: tarorpc.ImportProofsRequest
+	(*ImportProofsResponse)(nil),          // 53: tarorpc.ImportProofsResponse
+	(*VMTraceStep)(nil),                   // 54: tarorpc.VMTraceStep
+	(*DebugStateTransitionResponse)(nil),  // 55: tarorpc.DebugStateTransitionResponse
+	(*ValidationError)(nil),               // 56: tarorpc.ValidationError
+	(*AddrEvent)(nil),                     // 57: tarorpc.AddrEvent
+	(*AddrReceivesRequest)(nil),           // 58: tarorpc.AddrReceivesRequest
+	(*AddrReceivesResponse)(nil),          // 59: tarorpc.AddrReceivesResponse
+	(*SubscribeReceiveEventsRequest)(nil), // 60: tarorpc.SubscribeReceiveEventsRequest
+	(*ExportAddrsRequest)(nil),            // 61: tarorpc.ExportAddrsRequest
+	(*ExportAddrsResponse)(nil),           // 62: tarorpc.ExportAddrsResponse
+	(*ImportAddrsRequest)(nil),            // 63: tarorpc.ImportAddrsRequest
+	(*ImportAddrsResponse)(nil),           // 64: tarorpc.ImportAddrsResponse
+	(*SendAssetRequest)(nil),              // 65: tarorpc.SendAssetRequest
+	(*TapscriptSpend)(nil),                // 66: tarorpc.TapscriptSpend
+	(*BurnAssetRequest)(nil),              // 67: tarorpc.BurnAssetRequest
+	(*BurnAssetResponse)(nil),             // 68: tarorpc.BurnAssetResponse
+	(*PrevInputAsset)(nil),                // 69: tarorpc.PrevInputAsset
+	(*AssetOutput)(nil),                   // 70: tarorpc.AssetOutput
+	(*TaroTransfer)(nil),                  // 71: tarorpc.TaroTransfer
+	(*SendAssetResponse)(nil),             // 72: tarorpc.SendAssetResponse
+	nil,                                   // 73: tarorpc.MintAssetRequest.ExternalMetadataEntry
+	nil,                                   // 74: tarorpc.ListBalancesResponse.AssetBalancesEntry
+	nil,                                   // 75: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
+	nil,                                   // 76: tarorpc.AssetTransfer.ExternalMetadataEntry
+	nil,                                   // 77: tarorpc.Addr.ExternalMetadataEntry
+	nil,                                   // 78: tarorpc.NewAddrRequest.ExternalMetadataEntry
+	nil,                                   // 79: tarorpc.SendAssetRequest.ExternalMetadataEntry
 }
 var file_taro_proto_depIdxs = []int32{
 	0,  // 0: tarorpc.MintAssetRequest.asset_type:type_name -> tarorpc.AssetType
-	6,  // 1: tarorpc.Asset.asset_genesis:type_name -> tarorpc.GenesisInfo
-	0,  // 2: tarorpc.Asset.asset_type:type_name -> tarorpc.AssetType
-	7,  // 3: tarorpc.Asset.asset_family:type_name -> tarorpc.AssetFamily
-	5,  // 4: tarorpc.Asset.chain_anchor:type_name -> tarorpc.AnchorInfo
-	8,  // 5: tarorpc.ListAssetResponse.assets:type_name -> tarorpc.Asset
-	6,  // 6: tarorpc.AssetBalance.asset_genesis:type_name -> tarorpc.GenesisInfo
-	0,  // 7: tarorpc.AssetBalance.asset_type:type_name -> tarorpc.AssetType
-	40, // 8: tarorpc.ListBalancesResponse.asset_balances:type_name -> tarorpc.ListBalancesResponse.AssetBalancesEntry
-	41, // 9: tarorpc.ListBalancesResponse.asset_family_balances:type_name -> tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
-	16, // 10: tarorpc.ListTransfersResponse.transfers:type_name -> tarorpc.AssetTransfer
-	17, // 11: tarorpc.AssetTransfer.asset_spend_deltas:type_name -> tarorpc.AssetSpendDelta
-	0,  // 12: tarorpc.Addr.asset_type:type_name -> tarorpc.AssetType
-	22, // 13: tarorpc.QueryAddrResponse.addrs:type_name -> tarorpc.Addr
-	22, // 14: tarorpc.AddrEvent.addr:type_name -> tarorpc.Addr
-	1,  // 15: tarorpc.AddrEvent.status:type_name -> tarorpc.AddrEventStatus
-	1,  // 16: tarorpc.AddrReceivesRequest.filter_status:type_name -> tarorpc.AddrEventStatus
-	32, // 17: tarorpc.AddrReceivesResponse.events:type_name -> tarorpc.AddrEvent
-	36, // 18: tarorpc.TaroTransfer.prev_inputs:type_name -> tarorpc.PrevInputAsset
-	37, // 19: tarorpc.TaroTransfer.new_outputs:type_name -> tarorpc.AssetOutput
-	38, // 20: tarorpc.SendAssetResponse.taro_transfer:type_name -> tarorpc.TaroTransfer
-	11, // 21: tarorpc.ListBalancesResponse.AssetBalancesEntry.value:type_name -> tarorpc.AssetBalance
-	12, // 22: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry.value:type_name -> tarorpc.AssetFamilyBalance
-	2,  // 23: tarorpc.Taro.MintAsset:input_type -> tarorpc.MintAssetRequest
-	4,  // 24: tarorpc.Taro.ListAssets:input_type -> tarorpc.ListAssetRequest
-	10, // 25: tarorpc.Taro.ListBalances:input_type -> tarorpc.ListBalancesRequest
-	14, // 26: tarorpc.Taro.ListTransfers:input_type -> tarorpc.ListTransfersRequest
-	18, // 27: tarorpc.Taro.StopDaemon:input_type -> tarorpc.StopRequest
-	20, // 28: tarorpc.Taro.DebugLevel:input_type -> tarorpc.DebugLevelRequest
-	23, // 29: tarorpc.Taro.QueryAddrs:input_type -> tarorpc.QueryAddrRequest
-	25, // 30: tarorpc.Taro.NewAddr:input_type -> tarorpc.NewAddrRequest
-	26, // 31: tarorpc.Taro.DecodeAddr:input_type -> tarorpc.DecodeAddrRequest
-	33, // 32: tarorpc.Taro.AddrReceives:input_type -> tarorpc.AddrReceivesRequest
-	27, // 33: tarorpc.Taro.VerifyProof:input_type -> tarorpc.ProofFile
-	29, // 34: tarorpc.Taro.ExportProof:input_type -> tarorpc.ExportProofRequest
-	30, // 35: tarorpc.Taro.ImportProof:input_type -> tarorpc.ImportProofRequest
-	35, // 36: tarorpc.Taro.SendAsset:input_type -> tarorpc.SendAssetRequest
-	3,  // 37: tarorpc.Taro.MintAsset:output_type -> tarorpc.MintAssetResponse
-	9,  // 38: tarorpc.Taro.ListAssets:output_type -> tarorpc.ListAssetResponse
-	13, // 39: tarorpc.Taro.ListBalances:output_type -> tarorpc.ListBalancesResponse
-	15, // 40: tarorpc.Taro.ListTransfers:output_type -> tarorpc.ListTransfersResponse
-	19, // 41: tarorpc.Taro.StopDaemon:output_type -> tarorpc.StopResponse
-	21, // 42: tarorpc.Taro.DebugLevel:output_type -> tarorpc.DebugLevelResponse
-	24, // 43: tarorpc.Taro.QueryAddrs:output_type -> tarorpc.QueryAddrResponse
-	22, // 44: tarorpc.Taro.NewAddr:output_type -> tarorpc.Addr
-	22, // 45: tarorpc.Taro.DecodeAddr:output_type -> tarorpc.Addr
-	34, // 46: tarorpc.Taro.AddrReceives:output_type -> tarorpc.AddrReceivesResponse
-	28, // 47: tarorpc.Taro.VerifyProof:output_type -> tarorpc.ProofVerifyResponse
-	27, // 48: tarorpc.Taro.ExportProof:output_type -> tarorpc.ProofFile
-	31, // 49: tarorpc.Taro.ImportProof:output_type -> tarorpc.ImportProofResponse
-	39, // 50: tarorpc.Taro.SendAsset:output_type -> tarorpc.SendAssetResponse
-	37, // [37:51] is the sub-list for method output_type
-	23, // [23:37] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	73, // 1: tarorpc.MintAssetRequest.external_metadata:type_name -> tarorpc.MintAssetRequest.ExternalMetadataEntry
+	8,  // 2: tarorpc.Asset.asset_genesis:type_name -> tarorpc.GenesisInfo
+	0,  // 3: tarorpc.Asset.asset_type:type_name -> tarorpc.AssetType
+	9,  // 4: tarorpc.Asset.asset_family:type_name -> tarorpc.AssetFamily
+	7,  // 5: tarorpc.Asset.chain_anchor:type_name -> tarorpc.AnchorInfo
+	10, // 6: tarorpc.ListAssetResponse.assets:type_name -> tarorpc.Asset
+	10, // 7: tarorpc.Collectible.asset:type_name -> tarorpc.Asset
+	13, // 8: tarorpc.Collectible.provenance:type_name -> tarorpc.CollectibleOwner
+	14, // 9: tarorpc.CollectibleFamily.collectibles:type_name -> tarorpc.Collectible
+	15, // 10: tarorpc.ListCollectiblesResponse.families:type_name -> tarorpc.CollectibleFamily
+	8,  // 11: tarorpc.AssetBalance.asset_genesis:type_name -> tarorpc.GenesisInfo
+	0,  // 12: tarorpc.AssetBalance.asset_type:type_name -> tarorpc.AssetType
+	74, // 13: tarorpc.ListBalancesResponse.asset_balances:type_name -> tarorpc.ListBalancesResponse.AssetBalancesEntry
+	75, // 14: tarorpc.ListBalancesResponse.asset_family_balances:type_name -> tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry
+	23, // 15: tarorpc.ListTransfersResponse.transfers:type_name -> tarorpc.AssetTransfer
+	24, // 16: tarorpc.AssetTransfer.asset_spend_deltas:type_name -> tarorpc.AssetSpendDelta
+	76, // 17: tarorpc.AssetTransfer.external_metadata:type_name -> tarorpc.AssetTransfer.ExternalMetadataEntry
+	0,  // 18: tarorpc.Addr.asset_type:type_name -> tarorpc.AssetType
+	77, // 19: tarorpc.Addr.external_metadata:type_name -> tarorpc.Addr.ExternalMetadataEntry
+	1,  // 20: tarorpc.Addr.amount_policy:type_name -> tarorpc.AmountPolicy
+	29, // 21: tarorpc.QueryAddrResponse.addrs:type_name -> tarorpc.Addr
+	33, // 22: tarorpc.NewAddrRequest.tapscript_leaves:type_name -> tarorpc.TapLeaf
+	78, // 23: tarorpc.NewAddrRequest.external_metadata:type_name -> tarorpc.NewAddrRequest.ExternalMetadataEntry
+	0,  // 24: tarorpc.NewAddrRequest.asset_type:type_name -> tarorpc.AssetType
+	1,  // 25: tarorpc.NewAddrRequest.amount_policy:type_name -> tarorpc.AmountPolicy
+	56, // 26: tarorpc.ProofVerifyResponse.validation_error:type_name -> tarorpc.ValidationError
+	45, // 27: tarorpc.AuditProofsResponse.audits:type_name -> tarorpc.AssetProofAudit
+	48, // 28: tarorpc.ListProofsResponse.proofs:type_name -> tarorpc.ProofLocator
+	54, // 29: tarorpc.DebugStateTransitionResponse.steps:type_name -> tarorpc.VMTraceStep
+	56, // 30: tarorpc.DebugStateTransitionResponse.validation_error:type_name -> tarorpc.ValidationError
+	2,  // 31: tarorpc.ValidationError.code:type_name -> tarorpc.ValidationErrorCode
+	29, // 32: tarorpc.AddrEvent.addr:type_name -> tarorpc.Addr
+	3,  // 33: tarorpc.AddrEvent.status:type_name -> tarorpc.AddrEventStatus
+	3,  // 34: tarorpc.AddrReceivesRequest.filter_status:type_name -> tarorpc.AddrEventStatus
+	57, // 35: tarorpc.AddrReceivesResponse.events:type_name -> tarorpc.AddrEvent
+	29, // 36: tarorpc.ImportAddrsResponse.imported_addrs:type_name -> tarorpc.Addr
+	66, // 37: tarorpc.SendAssetRequest.tapscript_spend:type_name -> tarorpc.TapscriptSpend
+	79, // 38: tarorpc.SendAssetRequest.external_metadata:type_name -> tarorpc.SendAssetRequest.ExternalMetadataEntry
+	33, // 39: tarorpc.TapscriptSpend.leaf:type_name -> tarorpc.TapLeaf
+	72, // 40: tarorpc.BurnAssetResponse.burn_transfer:type_name -> tarorpc.SendAssetResponse
+	69, // 41: tarorpc.TaroTransfer.prev_inputs:type_name -> tarorpc.PrevInputAsset
+	70, // 42: tarorpc.TaroTransfer.new_outputs:type_name -> tarorpc.AssetOutput
+	71, // 43: tarorpc.SendAssetResponse.taro_transfer:type_name -> tarorpc.TaroTransfer
+	18, // 44: tarorpc.ListBalancesResponse.AssetBalancesEntry.value:type_name -> tarorpc.AssetBalance
+	19, // 45: tarorpc.ListBalancesResponse.AssetFamilyBalancesEntry.value:type_name -> tarorpc.AssetFamilyBalance
+	4,  // 46: tarorpc.Taro.MintAsset:input_type -> tarorpc.MintAssetRequest
+	6,  // 47: tarorpc.Taro.ListAssets:input_type -> tarorpc.ListAssetRequest
+	12, // 48: tarorpc.Taro.ListCollectibles:input_type -> tarorpc.ListCollectiblesRequest
+	17, // 49: tarorpc.Taro.ListBalances:input_type -> tarorpc.ListBalancesRequest
+	21, // 50: tarorpc.Taro.ListTransfers:input_type -> tarorpc.ListTransfersRequest
+	25, // 51: tarorpc.Taro.StopDaemon:input_type -> tarorpc.StopRequest
+	27, // 52: tarorpc.Taro.DebugLevel:input_type -> tarorpc.DebugLevelRequest
+	30, // 53: tarorpc.Taro.QueryAddrs:input_type -> tarorpc.QueryAddrRequest
+	32, // 54: tarorpc.Taro.NewAddr:input_type -> tarorpc.NewAddrRequest
+	34, // 55: tarorpc.Taro.DecodeAddr:input_type -> tarorpc.DecodeAddrRequest
+	58, // 56: tarorpc.Taro.AddrReceives:input_type -> tarorpc.AddrReceivesRequest
+	60, // 57: tarorpc.Taro.SubscribeReceiveEvents:input_type -> tarorpc.SubscribeReceiveEventsRequest
+	61, // 58: tarorpc.Taro.ExportAddrs:input_type -> tarorpc.ExportAddrsRequest
+	63, // 59: tarorpc.Taro.ImportAddrs:input_type -> tarorpc.ImportAddrsRequest
+	35, // 60: tarorpc.Taro.VerifyProof:input_type -> tarorpc.ProofFile
+	37, // 61: tarorpc.Taro.ExportProof:input_type -> tarorpc.ExportProofRequest
+	38, // 62: tarorpc.Taro.ImportProof:input_type -> tarorpc.ImportProofRequest
+	40, // 63: tarorpc.Taro.CompactProof:input_type -> tarorpc.CompactProofRequest
+	42, // 64: tarorpc.Taro.TrustCheckpoint:input_type -> tarorpc.TrustCheckpointRequest
+	44, // 65: tarorpc.Taro.AuditProofs:input_type -> tarorpc.AuditProofsRequest
+	47, // 66: tarorpc.Taro.ListProofs:input_type -> tarorpc.ListProofsRequest
+	50, // 67: tarorpc.Taro.ExportProofs:input_type -> tarorpc.ExportProofsRequest
+	52, // 68: tarorpc.Taro.ImportProofs:input_type -> tarorpc.ImportProofsRequest
+	35, // 69: tarorpc.Taro.DebugStateTransition:input_type -> tarorpc.ProofFile
+	65, // 70: tarorpc.Taro.SendAsset:input_type -> tarorpc.SendAssetRequest
+	67, // 71: tarorpc.Taro.BurnAsset:input_type -> tarorpc.BurnAssetRequest
+	5,  // 72: tarorpc.Taro.MintAsset:output_type -> tarorpc.MintAssetResponse
+	11, // 73: tarorpc.Taro.ListAssets:output_type -> tarorpc.ListAssetResponse
+	16, // 74: tarorpc.Taro.ListCollectibles:output_type -> tarorpc.ListCollectiblesResponse
+	20, // 75: tarorpc.Taro.ListBalances:output_type -> tarorpc.ListBalancesResponse
+	22, // 76: tarorpc.Taro.ListTransfers:output_type -> tarorpc.ListTransfersResponse
+	26, // 77: tarorpc.Taro.StopDaemon:output_type -> tarorpc.StopResponse
+	28, // 78: tarorpc.Taro.DebugLevel:output_type -> tarorpc.DebugLevelResponse
+	31, // 79: tarorpc.Taro.QueryAddrs:output_type -> tarorpc.QueryAddrResponse
+	29, // 80: tarorpc.Taro.NewAddr:output_type -> tarorpc.Addr
+	29, // 81: tarorpc.Taro.DecodeAddr:output_type -> tarorpc.Addr
+	59, // 82: tarorpc.Taro.AddrReceives:output_type -> tarorpc.AddrReceivesResponse
+	57, // 83: tarorpc.Taro.SubscribeReceiveEvents:output_type -> tarorpc.AddrEvent
+	62, // 84: tarorpc.Taro.ExportAddrs:output_type -> tarorpc.ExportAddrsResponse
+	64, // 85: tarorpc.Taro.ImportAddrs:output_type -> tarorpc.ImportAddrsResponse
+	36, // 86: tarorpc.Taro.VerifyProof:output_type -> tarorpc.ProofVerifyResponse
+	35, // 87: tarorpc.Taro.ExportProof:output_type -> tarorpc.ProofFile
+	39, // 88: tarorpc.Taro.ImportProof:output_type -> tarorpc.ImportProofResponse
+	41, // 89: tarorpc.Taro.CompactProof:output_type -> tarorpc.CompactProofResponse
+	43, // 90: tarorpc.Taro.TrustCheckpoint:output_type -> tarorpc.TrustCheckpointResponse
+	46, // 91: tarorpc.Taro.AuditProofs:output_type -> tarorpc.AuditProofsResponse
+	49, // 92: tarorpc.Taro.ListProofs:output_type -> tarorpc.ListProofsResponse
+	51, // 93: tarorpc.Taro.ExportProofs:output_type -> tarorpc.ExportProofsResponse
+	53, // 94: tarorpc.Taro.ImportProofs:output_type -> tarorpc.ImportProofsResponse
+	55, // 95: tarorpc.Taro.DebugStateTransition:output_type -> tarorpc.DebugStateTransitionResponse
+	72, // 96: tarorpc.Taro.SendAsset:output_type -> tarorpc.SendAssetResponse
+	68, // 97: tarorpc.Taro.BurnAsset:output_type -> tarorpc.BurnAssetResponse
+	72, // [72:98] is the sub-list for method output_type
+	46, // [46:72] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_taro_proto_init() }
@@ -3200,8 +6484,200 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAssetResponse); i {
+		file_taro_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetFamily); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Asset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectiblesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectibleOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collectible); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectibleFamily); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectiblesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetFamilyBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,8 +6688,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssetRequest); i {
+		file_taro_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,8 +6700,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnchorInfo); i {
+		file_taro_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,8 +6712,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenesisInfo); i {
+		file_taro_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetSpendDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,8 +6724,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetFamily); i {
+		file_taro_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,8 +6736,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Asset); i {
+		file_taro_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,8 +6748,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssetResponse); i {
+		file_taro_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,8 +6760,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBalancesRequest); i {
+		file_taro_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,8 +6772,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetBalance); i {
+		file_taro_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,8 +6784,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetFamilyBalance); i {
+		file_taro_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,8 +6796,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBalancesResponse); i {
+		file_taro_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,8 +6808,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTransfersRequest); i {
+		file_taro_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,8 +6820,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTransfersResponse); i {
+		file_taro_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapLeaf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,8 +6832,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetTransfer); i {
+		file_taro_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,8 +6844,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetSpendDelta); i {
+		file_taro_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3380,8 +6856,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+		file_taro_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofVerifyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3392,8 +6868,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+		file_taro_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3404,8 +6880,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugLevelRequest); i {
+		file_taro_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3416,8 +6892,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugLevelResponse); i {
+		file_taro_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3428,8 +6904,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Addr); i {
+		file_taro_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3440,8 +6916,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddrRequest); i {
+		file_taro_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3452,8 +6928,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddrResponse); i {
+		file_taro_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustCheckpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3464,8 +6940,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAddrRequest); i {
+		file_taro_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustCheckpointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3476,8 +6952,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodeAddrRequest); i {
+		file_taro_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditProofsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3488,8 +6964,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofFile); i {
+		file_taro_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetProofAudit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3500,8 +6976,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofVerifyResponse); i {
+		file_taro_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditProofsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3512,8 +6988,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportProofRequest); i {
+		file_taro_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProofsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3524,8 +7000,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportProofRequest); i {
+		file_taro_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofLocator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3536,8 +7012,8 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportProofResponse); i {
+		file_taro_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProofsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3548,7 +7024,91 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProofsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProofsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProofsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProofsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VMTraceStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugStateTransitionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddrEvent); i {
 			case 0:
 				return &v.state
@@ -3560,7 +7120,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddrReceivesRequest); i {
 			case 0:
 				return &v.state
@@ -3572,7 +7132,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddrReceivesResponse); i {
 			case 0:
 				return &v.state
@@ -3584,7 +7144,67 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeReceiveEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAddrsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAddrsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddrsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddrsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendAssetRequest); i {
 			case 0:
 				return &v.state
@@ -3596,7 +7216,43 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapscriptSpend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_taro_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrevInputAsset); i {
 			case 0:
 				return &v.state
@@ -3608,7 +7264,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssetOutput); i {
 			case 0:
 				return &v.state
@@ -3620,7 +7276,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaroTransfer); i {
 			case 0:
 				return &v.state
@@ -3632,7 +7288,7 @@ func file_taro_proto_init() {
 				return nil
 			}
 		}
-		file_taro_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_taro_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendAssetResponse); i {
 			case 0:
 				return &v.state
@@ -3645,7 +7301,7 @@ func file_taro_proto_init() {
 			}
 		}
 	}
-	file_taro_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_taro_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*ListBalancesRequest_AssetId)(nil),
 		(*ListBalancesRequest_FamKey)(nil),
 	}
@@ -3654,8 +7310,8 @@ func file_taro_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_taro_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   40,
+			NumEnums:      4,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,11 @@ type TaroClient interface {
 	// tarocli: `assets list`
 	//ListAssets lists the set of assets owned by the target daemon.
 	ListAssets(ctx context.Context, in *ListAssetRequest, opts ...grpc.CallOption) (*ListAssetResponse, error)
+	// tarocli: `assets collectibles`
+	//ListCollectibles lists the collectibles owned by the target daemon, grouped
+	//by their family key, along with the provenance of each collectible as
+	//derived from its proof file.
+	ListCollectibles(ctx context.Context, in *ListCollectiblesRequest, opts ...grpc.CallOption) (*ListCollectiblesResponse, error)
 	// tarocli: `assets balance`
 	//ListBalances lists asset balances
 	ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error)
@@ -55,6 +60,21 @@ type TaroClient interface {
 	//List all receives for incoming asset transfers for addresses that were
 	//created previously.
 	AddrReceives(ctx context.Context, in *AddrReceivesRequest, opts ...grpc.CallOption) (*AddrReceivesResponse, error)
+	// tarocli: `addrs subscribe`
+	//SubscribeReceiveEvents subscribes to inbound asset transfers for addresses
+	//that were created previously. An event is sent each time the status of an
+	//inbound asset transfer changes.
+	SubscribeReceiveEvents(ctx context.Context, in *SubscribeReceiveEventsRequest, opts ...grpc.CallOption) (Taro_SubscribeReceiveEventsClient, error)
+	// tarocli: `addrs export`
+	//ExportAddrs exports the addresses of the address book, including the key
+	//locators of their internal and script keys, as a single address backup.
+	ExportAddrs(ctx context.Context, in *ExportAddrsRequest, opts ...grpc.CallOption) (*ExportAddrsResponse, error)
+	// tarocli: `addrs import`
+	//ImportAddrs imports all addresses of an address backup created by
+	//ExportAddrs. The imported addresses are watched for inbound transfers,
+	//which are reported through AddrReceives, even if the keys of the addresses
+	//aren't held by this node. Addresses that are already known are skipped.
+	ImportAddrs(ctx context.Context, in *ImportAddrsRequest, opts ...grpc.CallOption) (*ImportAddrsResponse, error)
 	// tarocli: `proofs verify`
 	//VerifyProof attempts to verify a given proof file that claims to be anchored
 	//at the specified genesis point.
@@ -68,11 +88,56 @@ type TaroClient interface {
 	//a new asset will be inserted on disk, spendable using the specified target
 	//script key, and internal key.
 	ImportProof(ctx context.Context, in *ImportProofRequest, opts ...grpc.CallOption) (*ImportProofResponse, error)
+	// tarocli: `proofs compact`
+	//CompactProof rewrites the archived proof file of an asset to only contain
+	//its most recent state transitions. The pruned history is replaced by a
+	//checkpoint whose root is marked as trusted by this daemon, so the compacted
+	//file can still be verified locally. Other parties can only verify the
+	//compacted file if they trust the checkpoint root as well.
+	CompactProof(ctx context.Context, in *CompactProofRequest, opts ...grpc.CallOption) (*CompactProofResponse, error)
+	// tarocli: `proofs trust`
+	//TrustCheckpoint marks a proof file checkpoint root as trusted. This allows
+	//the daemon to verify compacted proof files created by another party, for
+	//example the root returned by CompactProof on the sender's daemon. The root
+	//must only be imported if it comes from a party that is trusted to have
+	//verified the full pruned history of the asset.
+	TrustCheckpoint(ctx context.Context, in *TrustCheckpointRequest, opts ...grpc.CallOption) (*TrustCheckpointResponse, error)
+	// tarocli: `proofs audit`
+	//AuditProofs checks the integrity of the proof archive. Every stored proof
+	//file is decoded, verified and checked against the asset it is stored for.
+	//Every asset owned by the daemon must have a valid proof file whose final
+	//state matches the state of the asset in the database.
+	AuditProofs(ctx context.Context, in *AuditProofsRequest, opts ...grpc.CallOption) (*AuditProofsResponse, error)
+	// tarocli: `proofs list`
+	//ListProofs lists the proof files in the archive, optionally filtered by
+	//asset ID and/or family key.
+	ListProofs(ctx context.Context, in *ListProofsRequest, opts ...grpc.CallOption) (*ListProofsResponse, error)
+	// tarocli: `proofs exportall`
+	//ExportProofs exports the proof files in the archive, optionally filtered by
+	//asset ID and/or family key, as a single proof bundle. A proof bundle is a
+	//tar archive that contains a JSON manifest and one .taro file per proof.
+	ExportProofs(ctx context.Context, in *ExportProofsRequest, opts ...grpc.CallOption) (*ExportProofsResponse, error)
+	// tarocli: `proofs importall`
+	//ImportProofs imports all proof files of a proof bundle created by
+	//ExportProofs. Every proof file is verified before it is imported. Proof
+	//files of assets that are already known are skipped.
+	ImportProofs(ctx context.Context, in *ImportProofsRequest, opts ...grpc.CallOption) (*ImportProofsResponse, error)
+	// tarocli: `proofs debug`
+	//DebugStateTransition verifies all but the last proof of the given proof
+	//file, then executes the asset state transition of the last proof in the
+	//Taro VM with tracing enabled. The returned trace records every step of the
+	//execution, which helps to find out why a transfer is invalid.
+	DebugStateTransition(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*DebugStateTransitionResponse, error)
 	// tarocli: `assets send`
 	//SendAsset uses a passed taro address to attempt to complete an asset send.
 	//The method returns information w.r.t the on chain send, as well as the
 	//proof file information the receiver needs to fully receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
+	// tarocli: `assets burn`
+	//BurnAsset burns the given amount of an asset by sending it to a provably
+	//unspendable script key that is derived from the spent input. Anyone with
+	//the resulting proof file can verify the burn.
+	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
 }
 
 type taroClient struct {
@@ -101,6 +166,15 @@ func (c *taroClient) ListAssets(ctx context.Context, in *ListAssetRequest, opts
 	return out, nil
 }
 
+func (c *taroClient) ListCollectibles(ctx context.Context, in *ListCollectiblesRequest, opts ...grpc.CallOption) (*ListCollectiblesResponse, error) {
+	out := new(ListCollectiblesResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListCollectibles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error) {
 	out := new(ListBalancesResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListBalances", in, out, opts...)
@@ -173,6 +247,56 @@ func (c *taroClient) AddrReceives(ctx context.Context, in *AddrReceivesRequest,
 	return out, nil
 }
 
+func (c *taroClient) SubscribeReceiveEvents(ctx context.Context, in *SubscribeReceiveEventsRequest, opts ...grpc.CallOption) (Taro_SubscribeReceiveEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Taro_ServiceDesc.Streams[0], "/tarorpc.Taro/SubscribeReceiveEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taroSubscribeReceiveEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Taro_SubscribeReceiveEventsClient interface {
+	Recv() (*AddrEvent, error)
+	grpc.ClientStream
+}
+
+type taroSubscribeReceiveEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taroSubscribeReceiveEventsClient) Recv() (*AddrEvent, error) {
+	m := new(AddrEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taroClient) ExportAddrs(ctx context.Context, in *ExportAddrsRequest, opts ...grpc.CallOption) (*ExportAddrsResponse, error) {
+	out := new(ExportAddrsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ExportAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ImportAddrs(ctx context.Context, in *ImportAddrsRequest, opts ...grpc.CallOption) (*ImportAddrsResponse, error) {
+	out := new(ImportAddrsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ImportAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) VerifyProof(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*ProofVerifyResponse, error) {
 	out := new(ProofVerifyResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/VerifyProof", in, out, opts...)
@@ -200,6 +324,69 @@ func (c *taroClient) ImportProof(ctx context.Context, in *ImportProofRequest, op
 	return out, nil
 }
 
+func (c *taroClient) CompactProof(ctx context.Context, in *CompactProofRequest, opts ...grpc.CallOption) (*CompactProofResponse, error) {
+	out := new(CompactProofResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/CompactProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) TrustCheckpoint(ctx context.Context, in *TrustCheckpointRequest, opts ...grpc.CallOption) (*TrustCheckpointResponse, error) {
+	out := new(TrustCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/TrustCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) AuditProofs(ctx context.Context, in *AuditProofsRequest, opts ...grpc.CallOption) (*AuditProofsResponse, error) {
+	out := new(AuditProofsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/AuditProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ListProofs(ctx context.Context, in *ListProofsRequest, opts ...grpc.CallOption) (*ListProofsResponse, error) {
+	out := new(ListProofsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ListProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ExportProofs(ctx context.Context, in *ExportProofsRequest, opts ...grpc.CallOption) (*ExportProofsResponse, error) {
+	out := new(ExportProofsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ExportProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) ImportProofs(ctx context.Context, in *ImportProofsRequest, opts ...grpc.CallOption) (*ImportProofsResponse, error) {
+	out := new(ImportProofsResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/ImportProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taroClient) DebugStateTransition(ctx context.Context, in *ProofFile, opts ...grpc.CallOption) (*DebugStateTransitionResponse, error) {
+	out := new(DebugStateTransitionResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/DebugStateTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taroClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error) {
 	out := new(SendAssetResponse)
 	err := c.cc.Invoke(ctx, "/tarorpc.Taro/SendAsset", in, out, opts...)
@@ -209,6 +396,15 @@ func (c *taroClient) SendAsset(ctx context.Context, in *SendAssetRequest, opts .
 	return out, nil
 }
 
+func (c *taroClient) BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error) {
+	out := new(BurnAssetResponse)
+	err := c.cc.Invoke(ctx, "/tarorpc.Taro/BurnAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaroServer is the server API for Taro service.
 // All implementations must embed UnimplementedTaroServer
 // for forward compatibility
@@ -220,6 +416,11 @@ type TaroServer interface {
 	// tarocli: `assets list`
 	//ListAssets lists the set of assets owned by the target daemon.
 	ListAssets(context.Context, *ListAssetRequest) (*ListAssetResponse, error)
+	// tarocli: `assets collectibles`
+	//ListCollectibles lists the collectibles owned by the target daemon, grouped
+	//by their family key, along with the provenance of each collectible as
+	//derived from its proof file.
+	ListCollectibles(context.Context, *ListCollectiblesRequest) (*ListCollectiblesResponse, error)
 	// tarocli: `assets balance`
 	//ListBalances lists asset balances
 	ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error)
@@ -250,6 +451,21 @@ type TaroServer interface {
 	//List all receives for incoming asset transfers for addresses that were
 	//created previously.
 	AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error)
+	// tarocli: `addrs subscribe`
+	//SubscribeReceiveEvents subscribes to inbound asset transfers for addresses
+	//that were created previously. An event is sent each time the status of an
+	//inbound asset transfer changes.
+	SubscribeReceiveEvents(*SubscribeReceiveEventsRequest, Taro_SubscribeReceiveEventsServer) error
+	// tarocli: `addrs export`
+	//ExportAddrs exports the addresses of the address book, including the key
+	//locators of their internal and script keys, as a single address backup.
+	ExportAddrs(context.Context, *ExportAddrsRequest) (*ExportAddrsResponse, error)
+	// tarocli: `addrs import`
+	//ImportAddrs imports all addresses of an address backup created by
+	//ExportAddrs. The imported addresses are watched for inbound transfers,
+	//which are reported through AddrReceives, even if the keys of the addresses
+	//aren't held by this node. Addresses that are already known are skipped.
+	ImportAddrs(context.Context, *ImportAddrsRequest) (*ImportAddrsResponse, error)
 	// tarocli: `proofs verify`
 	//VerifyProof attempts to verify a given proof file that claims to be anchored
 	//at the specified genesis point.
@@ -263,11 +479,56 @@ type TaroServer interface {
 	//a new asset will be inserted on disk, spendable using the specified target
 	//script key, and internal key.
 	ImportProof(context.Context, *ImportProofRequest) (*ImportProofResponse, error)
+	// tarocli: `proofs compact`
+	//CompactProof rewrites the archived proof file of an asset to only contain
+	//its most recent state transitions. The pruned history is replaced by a
+	//checkpoint whose root is marked as trusted by this daemon, so the compacted
+	//file can still be verified locally. Other parties can only verify the
+	//compacted file if they trust the checkpoint root as well.
+	CompactProof(context.Context, *CompactProofRequest) (*CompactProofResponse, error)
+	// tarocli: `proofs trust`
+	//TrustCheckpoint marks a proof file checkpoint root as trusted. This allows
+	//the daemon to verify compacted proof files created by another party, for
+	//example the root returned by CompactProof on the sender's daemon. The root
+	//must only be imported if it comes from a party that is trusted to have
+	//verified the full pruned history of the asset.
+	TrustCheckpoint(context.Context, *TrustCheckpointRequest) (*TrustCheckpointResponse, error)
+	// tarocli: `proofs audit`
+	//AuditProofs checks the integrity of the proof archive. Every stored proof
+	//file is decoded, verified and checked against the asset it is stored for.
+	//Every asset owned by the daemon must have a valid proof file whose final
+	//state matches the state of the asset in the database.
+	AuditProofs(context.Context, *AuditProofsRequest) (*AuditProofsResponse, error)
+	// tarocli: `proofs list`
+	//ListProofs lists the proof files in the archive, optionally filtered by
+	//asset ID and/or family key.
+	ListProofs(context.Context, *ListProofsRequest) (*ListProofsResponse, error)
+	// tarocli: `proofs exportall`
+	//ExportProofs exports the proof files in the archive, optionally filtered by
+	//asset ID and/or family key, as a single proof bundle. A proof bundle is a
+	//tar archive that contains a JSON manifest and one .taro file per proof.
+	ExportProofs(context.Context, *ExportProofsRequest) (*ExportProofsResponse, error)
+	// tarocli: `proofs importall`
+	//ImportProofs imports all proof files of a proof bundle created by
+	//ExportProofs. Every proof file is verified before it is imported. Proof
+	//files of assets that are already known are skipped.
+	ImportProofs(context.Context, *ImportProofsRequest) (*ImportProofsResponse, error)
+	// tarocli: `proofs debug`
+	//DebugStateTransition verifies all but the last proof of the given proof
+	//file, then executes the asset state transition of the last proof in the
+	//Taro VM with tracing enabled. The returned trace records every step of the
+	//execution, which helps to find out why a transfer is invalid.
+	DebugStateTransition(context.Context, *ProofFile) (*DebugStateTransitionResponse, error)
 	// tarocli: `assets send`
 	//SendAsset uses a passed taro address to attempt to complete an asset send.
 	//The method returns information w.r.t the on chain send, as well as the
 	//proof file information the receiver needs to fully receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
+	// tarocli: `assets burn`
+	//BurnAsset burns the given amount of an asset by sending it to a provably
+	//unspendable script key that is derived from the spent input. Anyone with
+	//the resulting proof file can verify the burn.
+	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
 	mustEmbedUnimplementedTaroServer()
 }
 
@@ -281,6 +542,9 @@ func (UnimplementedTaroServer) MintAsset(context.Context, *MintAssetRequest) (*M
 func (UnimplementedTaroServer) ListAssets(context.Context, *ListAssetRequest) (*ListAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
 }
+func (UnimplementedTaroServer) ListCollectibles(context.Context, *ListCollectiblesRequest) (*ListCollectiblesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectibles not implemented")
+}
 func (UnimplementedTaroServer) ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBalances not implemented")
 }
@@ -305,6 +569,15 @@ func (UnimplementedTaroServer) DecodeAddr(context.Context, *DecodeAddrRequest) (
 func (UnimplementedTaroServer) AddrReceives(context.Context, *AddrReceivesRequest) (*AddrReceivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddrReceives not implemented")
 }
+func (UnimplementedTaroServer) SubscribeReceiveEvents(*SubscribeReceiveEventsRequest, Taro_SubscribeReceiveEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeReceiveEvents not implemented")
+}
+func (UnimplementedTaroServer) ExportAddrs(context.Context, *ExportAddrsRequest) (*ExportAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAddrs not implemented")
+}
+func (UnimplementedTaroServer) ImportAddrs(context.Context, *ImportAddrsRequest) (*ImportAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAddrs not implemented")
+}
 func (UnimplementedTaroServer) VerifyProof(context.Context, *ProofFile) (*ProofVerifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
 }
@@ -314,9 +587,33 @@ func (UnimplementedTaroServer) ExportProof(context.Context, *ExportProofRequest)
 func (UnimplementedTaroServer) ImportProof(context.Context, *ImportProofRequest) (*ImportProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportProof not implemented")
 }
+func (UnimplementedTaroServer) CompactProof(context.Context, *CompactProofRequest) (*CompactProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactProof not implemented")
+}
+func (UnimplementedTaroServer) TrustCheckpoint(context.Context, *TrustCheckpointRequest) (*TrustCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustCheckpoint not implemented")
+}
+func (UnimplementedTaroServer) AuditProofs(context.Context, *AuditProofsRequest) (*AuditProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditProofs not implemented")
+}
+func (UnimplementedTaroServer) ListProofs(context.Context, *ListProofsRequest) (*ListProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProofs not implemented")
+}
+func (UnimplementedTaroServer) ExportProofs(context.Context, *ExportProofsRequest) (*ExportProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportProofs not implemented")
+}
+func (UnimplementedTaroServer) ImportProofs(context.Context, *ImportProofsRequest) (*ImportProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProofs not implemented")
+}
+func (UnimplementedTaroServer) DebugStateTransition(context.Context, *ProofFile) (*DebugStateTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugStateTransition not implemented")
+}
 func (UnimplementedTaroServer) SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAsset not implemented")
 }
+func (UnimplementedTaroServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
+}
 func (UnimplementedTaroServer) mustEmbedUnimplementedTaroServer() {}
 
 // UnsafeTaroServer may be embedded to opt out of forward compatibility for this service.
@@ -366,6 +663,24 @@ func _Taro_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_ListCollectibles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectiblesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListCollectibles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListCollectibles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListCollectibles(ctx, req.(*ListCollectiblesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBalancesRequest)
 	if err := dec(in); err != nil {
@@ -510,6 +825,63 @@ func _Taro_AddrReceives_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_SubscribeReceiveEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeReceiveEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaroServer).SubscribeReceiveEvents(m, &taroSubscribeReceiveEventsServer{stream})
+}
+
+type Taro_SubscribeReceiveEventsServer interface {
+	Send(*AddrEvent) error
+	grpc.ServerStream
+}
+
+type taroSubscribeReceiveEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *taroSubscribeReceiveEventsServer) Send(m *AddrEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Taro_ExportAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ExportAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ExportAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ExportAddrs(ctx, req.(*ExportAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ImportAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ImportAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ImportAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ImportAddrs(ctx, req.(*ImportAddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProofFile)
 	if err := dec(in); err != nil {
@@ -564,6 +936,132 @@ func _Taro_ImportProof_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_CompactProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).CompactProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/CompactProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).CompactProof(ctx, req.(*CompactProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_TrustCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).TrustCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/TrustCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).TrustCheckpoint(ctx, req.(*TrustCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_AuditProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).AuditProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/AuditProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).AuditProofs(ctx, req.(*AuditProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ListProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ListProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ListProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ListProofs(ctx, req.(*ListProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ExportProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ExportProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ExportProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ExportProofs(ctx, req.(*ExportProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_ImportProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).ImportProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/ImportProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).ImportProofs(ctx, req.(*ImportProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Taro_DebugStateTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).DebugStateTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/DebugStateTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).DebugStateTransition(ctx, req.(*ProofFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Taro_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendAssetRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +1080,24 @@ func _Taro_SendAsset_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Taro_BurnAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaroServer).BurnAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tarorpc.Taro/BurnAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaroServer).BurnAsset(ctx, req.(*BurnAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Taro_ServiceDesc is the grpc.ServiceDesc for Taro service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -597,6 +1113,10 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAssets",
 			Handler:    _Taro_ListAssets_Handler,
 		},
+		{
+			MethodName: "ListCollectibles",
+			Handler:    _Taro_ListCollectibles_Handler,
+		},
 		{
 			MethodName: "ListBalances",
 			Handler:    _Taro_ListBalances_Handler,
@@ -629,6 +1149,14 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddrReceives",
 			Handler:    _Taro_AddrReceives_Handler,
 		},
+		{
+			MethodName: "ExportAddrs",
+			Handler:    _Taro_ExportAddrs_Handler,
+		},
+		{
+			MethodName: "ImportAddrs",
+			Handler:    _Taro_ImportAddrs_Handler,
+		},
 		{
 			MethodName: "VerifyProof",
 			Handler:    _Taro_VerifyProof_Handler,
@@ -641,11 +1169,49 @@ var Taro_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportProof",
 			Handler:    _Taro_ImportProof_Handler,
 		},
+		{
+			MethodName: "CompactProof",
+			Handler:    _Taro_CompactProof_Handler,
+		},
+		{
+			MethodName: "TrustCheckpoint",
+			Handler:    _Taro_TrustCheckpoint_Handler,
+		},
+		{
+			MethodName: "AuditProofs",
+			Handler:    _Taro_AuditProofs_Handler,
+		},
+		{
+			MethodName: "ListProofs",
+			Handler:    _Taro_ListProofs_Handler,
+		},
+		{
+			MethodName: "ExportProofs",
+			Handler:    _Taro_ExportProofs_Handler,
+		},
+		{
+			MethodName: "ImportProofs",
+			Handler:    _Taro_ImportProofs_Handler,
+		},
+		{
+			MethodName: "DebugStateTransition",
+			Handler:    _Taro_DebugStateTransition_Handler,
+		},
 		{
 			MethodName: "SendAsset",
 			Handler:    _Taro_SendAsset_Handler,
 		},
+		{
+			MethodName: "BurnAsset",
+			Handler:    _Taro_BurnAsset_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeReceiveEvents",
+			Handler:       _Taro_SubscribeReceiveEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "taro.proto",
 }
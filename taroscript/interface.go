@@ -8,22 +8,34 @@ import (
 	"github.com/lightninglabs/taro/commitment"
 )
 
+// ChainContext is the on-chain context an asset state transition is validated
+// in. It is needed to enforce the absolute and relative lock times of the
+// inputs of the state transition.
+type ChainContext struct {
+	// BlockHeight is the height of the block the anchor transaction of
+	// the state transition is (or will be) confirmed in.
+	BlockHeight uint32
+
+	// InputHeights maps the PrevID of each input of the state transition
+	// to the height of the block the input was confirmed in.
+	InputHeights map[asset.PrevID]uint32
+}
+
 // TxValidator is the interface used to validate an asset transfer
 // with the Taro VM.
 type TxValidator interface {
 	// Execute creates an instance of the Taro VM and validates
-	// an asset transfer, including the attached witnesses.
+	// an asset transfer, including the attached witnesses. If the chain
+	// context is nil, the lock times of the inputs aren't enforced.
 	Execute(newAsset *asset.Asset, splitAsset *commitment.SplitAsset,
-		prevAssets commitment.InputSet) error
+		prevAssets commitment.InputSet, chainCtx *ChainContext) error
 }
 
 // Signer is the interface used to compute the witness for a Taro virtual TX.
 type Signer interface {
 	// SignVirtualTx generates a signature according to the passed signing
-	// descriptor and TX.
-	//
-	// NOTE: We currently assume the signature requested is for the/ BIP 86
-	// spending type.
+	// descriptor and TX. The signature can either be for a key spend
+	// (BIP 86 or with a tapscript tweak) or for a script path spend.
 	SignVirtualTx(signDesc *lndclient.SignDescriptor, tx *wire.MsgTx,
 		prevOut *wire.TxOut) (*schnorr.Signature, error)
 }
@@ -0,0 +1,400 @@
+package taroscript
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+var (
+	// ErrMuSig2KeyMismatch is returned when the MuSig2 aggregate of the
+	// signer keys of a session doesn't match the script key of the input
+	// being spent.
+	ErrMuSig2KeyMismatch = errors.New("musig2: aggregate key doesn't " +
+		"match script key")
+
+	// ErrMuSig2SessionIncomplete is returned when the final witness of a
+	// MuSig2 session is requested before all partial signatures were
+	// combined.
+	ErrMuSig2SessionIncomplete = errors.New("musig2: not all partial " +
+		"signatures combined")
+
+	// ErrInvalidMuSig2Threshold is returned when the threshold of a MuSig2
+	// threshold script key is zero or exceeds the number of signers.
+	ErrInvalidMuSig2Threshold = errors.New("musig2: invalid threshold")
+)
+
+// muSig2Keys returns the x-only representation of the given signer keys in
+// their sorted order. This makes sure the resulting aggregate key doesn't
+// depend on the order the keys are specified in.
+func muSig2Keys(signerKeys []*btcec.PublicKey) ([]*btcec.PublicKey, error) {
+	if len(signerKeys) == 0 {
+		return nil, fmt.Errorf("musig2: no signer keys specified")
+	}
+
+	keys := make([]*btcec.PublicKey, len(signerKeys))
+	for i, signerKey := range signerKeys {
+		key, err := schnorr.ParsePubKey(
+			schnorr.SerializePubKey(signerKey),
+		)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(
+			schnorr.SerializePubKey(keys[i]),
+			schnorr.SerializePubKey(keys[j]),
+		) < 0
+	})
+
+	return keys, nil
+}
+
+// MuSig2AggregateKey returns the untweaked MuSig2 aggregate key of the given
+// signer keys. The aggregate key can directly be used as the internal key of
+// an anchor output, or as the raw key of a script key that is controlled by
+// all signers together.
+func MuSig2AggregateKey(signerKeys []*btcec.PublicKey) (*btcec.PublicKey,
+	error) {
+
+	keys, err := muSig2Keys(signerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateKey, _, _, err := musig2.AggregateKeys(keys, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateKey.FinalKey, nil
+}
+
+// NewMuSig2ScriptKey returns a BIP 86 style script key for the MuSig2
+// aggregate key of the given signer keys. The resulting script key can only be
+// spent if all signers sign together.
+func NewMuSig2ScriptKey(signerKeys []*btcec.PublicKey) (*asset.ScriptKey,
+	error) {
+
+	aggregateKey, err := MuSig2AggregateKey(signerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptKey := asset.NewScriptKeyBIP0086(keychain.KeyDescriptor{
+		PubKey: aggregateKey,
+	})
+	return &scriptKey, nil
+}
+
+// MuSig2ThresholdLeaf returns the tapscript leaf of a MuSig2 threshold script
+// key that can be spent by the given subset of signers. The leaf requires a
+// signature of the MuSig2 aggregate key of the subset.
+func MuSig2ThresholdLeaf(subsetKeys []*btcec.PublicKey) (txscript.TapLeaf,
+	error) {
+
+	aggregateKey, err := MuSig2AggregateKey(subsetKeys)
+	if err != nil {
+		return txscript.TapLeaf{}, err
+	}
+
+	leafScript, err := txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(aggregateKey)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		return txscript.TapLeaf{}, err
+	}
+
+	return txscript.NewBaseTapLeaf(leafScript), nil
+}
+
+// NewMuSig2ThresholdScriptKey returns a script key that can be spent by any
+// subset of threshold signers of the given signer keys. All signers together
+// can spend the key path of the MuSig2 aggregate key of all signers, while
+// every subset of threshold signers has a tapscript leaf that requires a
+// signature of the MuSig2 aggregate key of the subset.
+func NewMuSig2ThresholdScriptKey(signerKeys []*btcec.PublicKey,
+	threshold int) (*asset.ScriptKey, error) {
+
+	if threshold <= 0 || threshold > len(signerKeys) {
+		return nil, fmt.Errorf("%w: %d of %d", ErrInvalidMuSig2Threshold,
+			threshold, len(signerKeys))
+	}
+
+	// If all signers are required, then there's no need for any script
+	// leaves.
+	if threshold == len(signerKeys) {
+		return NewMuSig2ScriptKey(signerKeys)
+	}
+
+	keys, err := muSig2Keys(signerKeys)
+	if err != nil {
+		return nil, err
+	}
+	aggregateKey, err := MuSig2AggregateKey(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll now create a leaf for each combination of threshold signers.
+	var (
+		leaves     []txscript.TapLeaf
+		subset     = make([]*btcec.PublicKey, 0, threshold)
+		addSubsets func(start int) error
+	)
+	addSubsets = func(start int) error {
+		if len(subset) == threshold {
+			leaf, err := MuSig2ThresholdLeaf(subset)
+			if err != nil {
+				return err
+			}
+			leaves = append(leaves, leaf)
+			return nil
+		}
+
+		for i := start; i < len(keys); i++ {
+			subset = append(subset, keys[i])
+			if err := addSubsets(i + 1); err != nil {
+				return err
+			}
+			subset = subset[:len(subset)-1]
+		}
+
+		return nil
+	}
+	if err := addSubsets(0); err != nil {
+		return nil, err
+	}
+
+	scriptKey := asset.NewScriptKeyTapscript(keychain.KeyDescriptor{
+		PubKey: aggregateKey,
+	}, leaves...)
+	return &scriptKey, nil
+}
+
+// MuSig2Session is the interactive MuSig2 signing session of a single signer.
+// Every signer creates a session for the same message, sends its public nonce
+// to all other signers and registers theirs. Once all nonces are known, every
+// signer creates a partial signature and sends it to the others. After all
+// partial signatures are combined, the final witness is available.
+//
+// NOTE: The session uses the private key of the signer directly, so it can't
+// be used with keys that are only known to lnd.
+type MuSig2Session struct {
+	session *musig2.Session
+
+	msg [32]byte
+
+	// leafScript and controlBlock are only set for a script path spend.
+	leafScript   []byte
+	controlBlock []byte
+}
+
+// NewMuSig2Session creates a new MuSig2 signing session over the given input
+// of a Taro virtual transaction. If leaf is nil, the session signs for the key
+// path of the input's script key, which must be the MuSig2 aggregate key of
+// the signer keys, optionally tweaked with its tapscript tree. Otherwise, the
+// session signs for the given leaf of the script key's tapscript tree, which
+// must require a signature of the MuSig2 aggregate key of the signer keys. In
+// both cases, the resulting witness is a normal witness that the Taro VM
+// validates like any other.
+func NewMuSig2Session(privKey *btcec.PrivateKey,
+	signerKeys []*btcec.PublicKey, virtualTx *wire.MsgTx,
+	inputAsset *asset.Asset, idx uint32,
+	leaf *txscript.TapLeaf) (*MuSig2Session, error) {
+
+	keys, err := muSig2Keys(signerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptKey := inputAsset.ScriptKey
+
+	// For a script path spend, the leaf script is signed with the plain
+	// aggregate key, and the witness needs to prove the inclusion of the
+	// leaf in the tree of the script key.
+	if leaf != nil {
+		if scriptKey.TweakedScriptKey == nil {
+			return nil, ErrNoTapscriptTree
+		}
+		controlBlock, err := tapLeafControlBlock(
+			*scriptKey.RawKey.PubKey, scriptKey, *leaf,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sigHash, err := InputScriptSpendSigHash(
+			virtualTx, inputAsset, idx, leaf,
+			txscript.SigHashDefault,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		_, session, err := newMuSig2Session(privKey, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		muSig2Session := &MuSig2Session{
+			session:      session,
+			leafScript:   leaf.Script,
+			controlBlock: controlBlock,
+		}
+		copy(muSig2Session.msg[:], sigHash)
+
+		return muSig2Session, nil
+	}
+
+	// For a key path spend, the aggregate key is either tweaked BIP 86
+	// style or with the root of the script key's tapscript tree.
+	tweakOpt := musig2.WithBip86TweakCtx()
+	if scriptKey.TweakedScriptKey != nil && len(scriptKey.Tweak) > 0 {
+		tweakOpt = musig2.WithTaprootTweakCtx(scriptKey.Tweak)
+	}
+
+	muSig2Ctx, session, err := newMuSig2Session(privKey, keys, tweakOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	// As a sanity check, we'll make sure the signers actually control
+	// the script key, as the signature won't be valid otherwise.
+	combinedKey, err := muSig2Ctx.CombinedKey()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(
+		schnorr.SerializePubKey(combinedKey),
+		schnorr.SerializePubKey(scriptKey.PubKey),
+	) {
+		return nil, ErrMuSig2KeyMismatch
+	}
+
+	sigHash, err := InputKeySpendSigHash(
+		virtualTx, inputAsset, idx, txscript.SigHashDefault,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	muSig2Session := &MuSig2Session{
+		session: session,
+	}
+	copy(muSig2Session.msg[:], sigHash)
+
+	return muSig2Session, nil
+}
+
+// NewMuSig2AnchorSession creates a new MuSig2 signing session for the key path
+// spend of an anchor output whose internal key is the MuSig2 aggregate key of
+// the signer keys. The tapscript root is the root the internal key is tweaked
+// with, and the sighash the message of the Bitcoin transaction input spending
+// the anchor output.
+func NewMuSig2AnchorSession(privKey *btcec.PrivateKey,
+	signerKeys []*btcec.PublicKey, tapscriptRoot []byte,
+	sigHash [32]byte) (*MuSig2Session, error) {
+
+	keys, err := muSig2Keys(signerKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	tweakOpt := musig2.WithBip86TweakCtx()
+	if len(tapscriptRoot) > 0 {
+		tweakOpt = musig2.WithTaprootTweakCtx(tapscriptRoot)
+	}
+
+	_, session, err := newMuSig2Session(privKey, keys, tweakOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MuSig2Session{
+		session: session,
+		msg:     sigHash,
+	}, nil
+}
+
+// newMuSig2Session creates a new MuSig2 context and session for the given
+// signing key and sorted signer keys.
+func newMuSig2Session(privKey *btcec.PrivateKey, keys []*btcec.PublicKey,
+	ctxOpts ...musig2.ContextOption) (*musig2.Context, *musig2.Session,
+	error) {
+
+	// The keys are already sorted, so there's no need to let the context
+	// sort them again.
+	ctxOpts = append(ctxOpts, musig2.WithKnownSigners(keys))
+	muSig2Ctx, err := musig2.NewContext(privKey, false, ctxOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session, err := muSig2Ctx.NewSession()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return muSig2Ctx, session, nil
+}
+
+// PublicNonce returns the public nonce of the signer, which needs to be sent
+// to all other signers of the session.
+func (m *MuSig2Session) PublicNonce() [musig2.PubNonceSize]byte {
+	return m.session.PublicNonce()
+}
+
+// RegisterNonce registers the public nonce of another signer. It returns true
+// once the nonces of all signers are known.
+func (m *MuSig2Session) RegisterNonce(
+	nonce [musig2.PubNonceSize]byte) (bool, error) {
+
+	return m.session.RegisterPubNonce(nonce)
+}
+
+// PartialSign creates the partial signature of the signer, which needs to be
+// sent to all other signers of the session. This can only be called once all
+// nonces are registered.
+func (m *MuSig2Session) PartialSign() (*musig2.PartialSignature, error) {
+	return m.session.Sign(m.msg)
+}
+
+// CombineSig combines the partial signature of another signer. It returns true
+// once the partial signatures of all signers are combined into the final
+// signature.
+func (m *MuSig2Session) CombineSig(
+	sig *musig2.PartialSignature) (bool, error) {
+
+	return m.session.CombineSig(sig)
+}
+
+// Witness returns the final witness of the session, once all partial
+// signatures are combined.
+func (m *MuSig2Session) Witness() (wire.TxWitness, error) {
+	finalSig := m.session.FinalSig()
+	if finalSig == nil {
+		return nil, ErrMuSig2SessionIncomplete
+	}
+
+	if m.controlBlock != nil {
+		return wire.TxWitness{
+			finalSig.Serialize(), m.leafScript, m.controlBlock,
+		}, nil
+	}
+
+	return wire.TxWitness{finalSig.Serialize()}, nil
+}
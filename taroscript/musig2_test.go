@@ -0,0 +1,269 @@
+package taroscript_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/internal/test"
+	"github.com/lightninglabs/taro/taroscript"
+	"github.com/stretchr/testify/require"
+)
+
+// muSig2Spend prepares a full value spend of asset1 of the spend scenario and
+// creates its witness by running interactive MuSig2 sessions for all the given
+// signers in-process. The spend is then completed with the resulting witness.
+func muSig2Spend(t *testing.T, state *spendData,
+	signerPrivKeys []*btcec.PrivateKey,
+	leaf *txscript.TapLeaf) (*taroscript.SpendDelta, error) {
+
+	t.Helper()
+
+	spend := taroscript.SpendDelta{
+		InputAssets: state.asset1InputAssets,
+	}
+	spendPrepared := taroscript.PrepareAssetCompleteSpend(
+		state.address1, state.asset1PrevID, spend,
+	)
+	virtualTx, _, err := taroscript.VirtualTx(
+		&spendPrepared.NewAsset, spendPrepared.InputAssets,
+	)
+	require.NoError(t, err)
+
+	signerKeys := make([]*btcec.PublicKey, len(signerPrivKeys))
+	for i, privKey := range signerPrivKeys {
+		signerKeys[i] = privKey.PubKey()
+	}
+
+	// Every signer creates its own session over the same virtual
+	// transaction.
+	sessions := make([]*taroscript.MuSig2Session, len(signerPrivKeys))
+	for i, privKey := range signerPrivKeys {
+		sessions[i], err = taroscript.NewMuSig2Session(
+			privKey, signerKeys, virtualTx, &state.asset1, 0, leaf,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Round one: exchange the public nonces.
+	for i, session := range sessions {
+		for j, otherSession := range sessions {
+			if i == j {
+				continue
+			}
+
+			_, err := session.RegisterNonce(
+				otherSession.PublicNonce(),
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	// Round two: exchange the partial signatures.
+	partialSigs := make([]*musig2.PartialSignature, len(sessions))
+	for i, session := range sessions {
+		partialSigs[i], err = session.PartialSign()
+		require.NoError(t, err)
+	}
+	var witness wire.TxWitness
+	for i, session := range sessions {
+		// The witness isn't available before all partial signatures
+		// are combined.
+		_, err := session.Witness()
+		require.ErrorIs(t, err, taroscript.ErrMuSig2SessionIncomplete)
+
+		for j, partialSig := range partialSigs {
+			if i == j {
+				continue
+			}
+
+			_, err := session.CombineSig(partialSig)
+			require.NoError(t, err)
+		}
+
+		sessionWitness, err := session.Witness()
+		require.NoError(t, err)
+
+		// All signers must end up with the very same witness.
+		if witness != nil {
+			require.Equal(t, witness, sessionWitness)
+		}
+		witness = sessionWitness
+	}
+
+	return taroscript.AttachAssetWitnesses(
+		*spendPrepared, map[asset.PrevID]wire.TxWitness{
+			state.asset1PrevID: witness,
+		}, state.validator, nil,
+	)
+}
+
+// TestMuSig2AssetSpend tests that assets with MuSig2 script keys can be spent
+// through interactive signing sessions.
+func TestMuSig2AssetSpend(t *testing.T) {
+	t.Parallel()
+
+	privKeys := []*btcec.PrivateKey{
+		test.RandPrivKey(t), test.RandPrivKey(t), test.RandPrivKey(t),
+	}
+	pubKeys := []*btcec.PublicKey{
+		privKeys[0].PubKey(), privKeys[1].PubKey(), privKeys[2].PubKey(),
+	}
+
+	t.Run("n-of-n key spend", func(t *testing.T) {
+		state := initSpendScenario(t)
+		scriptKey, err := taroscript.NewMuSig2ScriptKey(pubKeys)
+		require.NoError(t, err)
+		useScriptKey(&state, *scriptKey)
+
+		spend, err := muSig2Spend(t, &state, privKeys, nil)
+		require.NoError(t, err)
+		require.Len(t, spend.NewAsset.PrevWitnesses[0].TxWitness, 1)
+	})
+
+	t.Run("threshold key spend", func(t *testing.T) {
+		state := initSpendScenario(t)
+		scriptKey, err := taroscript.NewMuSig2ThresholdScriptKey(
+			pubKeys, 2,
+		)
+		require.NoError(t, err)
+		require.Len(t, scriptKey.TapLeaves, 3)
+		useScriptKey(&state, *scriptKey)
+
+		spend, err := muSig2Spend(t, &state, privKeys, nil)
+		require.NoError(t, err)
+		require.Len(t, spend.NewAsset.PrevWitnesses[0].TxWitness, 1)
+	})
+
+	t.Run("threshold script spend", func(t *testing.T) {
+		state := initSpendScenario(t)
+		scriptKey, err := taroscript.NewMuSig2ThresholdScriptKey(
+			pubKeys, 2,
+		)
+		require.NoError(t, err)
+		useScriptKey(&state, *scriptKey)
+
+		// The key order of the subset doesn't matter.
+		leaf, err := taroscript.MuSig2ThresholdLeaf(
+			[]*btcec.PublicKey{pubKeys[2], pubKeys[0]},
+		)
+		require.NoError(t, err)
+
+		spend, err := muSig2Spend(
+			t, &state, []*btcec.PrivateKey{privKeys[0], privKeys[2]},
+			&leaf,
+		)
+		require.NoError(t, err)
+		require.Len(t, spend.NewAsset.PrevWitnesses[0].TxWitness, 3)
+	})
+
+	t.Run("threshold script spend with wrong signers", func(t *testing.T) {
+		state := initSpendScenario(t)
+		scriptKey, err := taroscript.NewMuSig2ThresholdScriptKey(
+			pubKeys, 2,
+		)
+		require.NoError(t, err)
+		useScriptKey(&state, *scriptKey)
+
+		leaf, err := taroscript.MuSig2ThresholdLeaf(
+			[]*btcec.PublicKey{pubKeys[0], pubKeys[1]},
+		)
+		require.NoError(t, err)
+
+		// The signature of a different subset doesn't satisfy the
+		// leaf script.
+		_, err = muSig2Spend(
+			t, &state, []*btcec.PrivateKey{privKeys[0], privKeys[2]},
+			&leaf,
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("missing signer for key spend", func(t *testing.T) {
+		state := initSpendScenario(t)
+		scriptKey, err := taroscript.NewMuSig2ScriptKey(pubKeys)
+		require.NoError(t, err)
+		useScriptKey(&state, *scriptKey)
+
+		_, err = muSig2Spend(t, &state, privKeys[:2], nil)
+		require.ErrorIs(t, err, taroscript.ErrMuSig2KeyMismatch)
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := taroscript.NewMuSig2ThresholdScriptKey(pubKeys, 4)
+		require.ErrorIs(t, err, taroscript.ErrInvalidMuSig2Threshold)
+
+		_, err = taroscript.NewMuSig2ThresholdScriptKey(pubKeys, 0)
+		require.ErrorIs(t, err, taroscript.ErrInvalidMuSig2Threshold)
+	})
+}
+
+// TestMuSig2AggregateKey tests that the MuSig2 aggregate key doesn't depend on
+// the order of the signer keys, and that an anchor session produces a valid
+// signature for the tweaked aggregate key.
+func TestMuSig2AggregateKey(t *testing.T) {
+	t.Parallel()
+
+	privKeys := []*btcec.PrivateKey{
+		test.RandPrivKey(t), test.RandPrivKey(t),
+	}
+	pubKeys := []*btcec.PublicKey{
+		privKeys[0].PubKey(), privKeys[1].PubKey(),
+	}
+
+	aggregateKey, err := taroscript.MuSig2AggregateKey(pubKeys)
+	require.NoError(t, err)
+	reversedKey, err := taroscript.MuSig2AggregateKey(
+		[]*btcec.PublicKey{pubKeys[1], pubKeys[0]},
+	)
+	require.NoError(t, err)
+	require.True(t, aggregateKey.IsEqual(reversedKey))
+
+	// An anchor output uses the aggregate key as its internal key, tweaked
+	// with the Taro commitment root.
+	tapscriptRoot := test.RandBytes(32)
+	outputKey := txscript.ComputeTaprootOutputKey(
+		aggregateKey, tapscriptRoot,
+	)
+
+	var sigHash [32]byte
+	copy(sigHash[:], test.RandBytes(32))
+
+	sessions := make([]*taroscript.MuSig2Session, len(privKeys))
+	for i, privKey := range privKeys {
+		sessions[i], err = taroscript.NewMuSig2AnchorSession(
+			privKey, pubKeys, tapscriptRoot, sigHash,
+		)
+		require.NoError(t, err)
+	}
+
+	haveAll, err := sessions[0].RegisterNonce(sessions[1].PublicNonce())
+	require.NoError(t, err)
+	require.True(t, haveAll)
+	haveAll, err = sessions[1].RegisterNonce(sessions[0].PublicNonce())
+	require.NoError(t, err)
+	require.True(t, haveAll)
+
+	_, err = sessions[0].PartialSign()
+	require.NoError(t, err)
+	sig1, err := sessions[1].PartialSign()
+	require.NoError(t, err)
+
+	haveAll, err = sessions[0].CombineSig(sig1)
+	require.NoError(t, err)
+	require.True(t, haveAll)
+
+	witness, err := sessions[0].Witness()
+	require.NoError(t, err)
+	require.Len(t, witness, 1)
+
+	sig, err := schnorr.ParseSignature(witness[0])
+	require.NoError(t, err)
+	require.True(t, sig.Verify(sigHash[:], outputKey))
+}
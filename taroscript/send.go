@@ -56,6 +56,21 @@ var (
 	ErrMissingTaroCommitment = errors.New(
 		"send: Taro commitment not found",
 	)
+
+	// ErrUnboundFamilyAddr is an error returned when we attempt to
+	// construct a send to a family address that wasn't bound to the asset
+	// ID of the input asset yet.
+	ErrUnboundFamilyAddr = errors.New(
+		"send: family address not bound to input asset ID",
+	)
+
+	// ErrScriptVersionMismatch is an error returned when we attempt to
+	// construct a send to an address that expects a different script
+	// version than the one of the input asset. The sent asset keeps the
+	// script version of the input, so the receiver wouldn't find it.
+	ErrScriptVersionMismatch = errors.New(
+		"send: address script version doesn't match input asset",
+	)
 )
 
 const (
@@ -93,6 +108,11 @@ type SpendDelta struct {
 
 	// NOTE: This is nil unless the InputAsset is being split.
 	SplitCommitment *commitment.SplitCommitment
+
+	// TapscriptSpends maps the PrevIDs of inputs that should be spent
+	// through the script path of their script key to the details of that
+	// spend. All other inputs are spent through the key path.
+	TapscriptSpends map[asset.PrevID]*TapscriptSpend
 }
 
 // SpendCommitments stores the Taro commitment for each receiver
@@ -127,6 +147,12 @@ func (s *SpendDelta) Copy() SpendDelta {
 		newDelta.Locators = locators
 	}
 
+	if s.TapscriptSpends != nil {
+		tapscriptSpends := make(map[asset.PrevID]*TapscriptSpend)
+		maps.Copy(tapscriptSpends, s.TapscriptSpends)
+		newDelta.TapscriptSpends = tapscriptSpends
+	}
+
 	return newDelta
 }
 
@@ -228,6 +254,31 @@ func AreValidIndexes(locators SpendLocators) (bool, error) {
 	return taroOnlySpend, nil
 }
 
+// BindFamilyAddr binds a family address, which accepts any asset ID within
+// the asset family, to the asset ID of the given input asset. The returned
+// address can then be used to construct the send like for any other address.
+// Addresses that already pin an asset ID are returned unchanged.
+func BindFamilyAddr(addr address.Taro,
+	inputAsset *asset.Asset) (*address.Taro, error) {
+
+	if !addr.IsFamilyAddr() {
+		return &addr, nil
+	}
+
+	// The input asset must be part of the asset family of the address.
+	assetID := inputAsset.ID()
+	if inputAsset.FamilyKey == nil ||
+		!inputAsset.FamilyKey.FamKey.IsEqual(addr.FamilyKey) {
+
+		return nil, fmt.Errorf("input asset_id=%x is not part of "+
+			"family %x: %w", assetID[:],
+			addr.FamilyKey.SerializeCompressed(),
+			ErrMissingInputAsset)
+	}
+
+	return addr.BindGenesis(inputAsset.Genesis)
+}
+
 // IsValidInput verifies that the Taro commitment of the input contains an
 // asset that could be spent to the given Taro address.
 func IsValidInput(input *commitment.TaroCommitment,
@@ -241,6 +292,12 @@ func IsValidInput(input *commitment.TaroCommitment,
 		return nil, fullValue, address.ErrMismatchedHRP
 	}
 
+	// A family address must first be bound to the asset ID of the input
+	// asset with BindFamilyAddr.
+	if addr.IsFamilyAddr() {
+		return nil, fullValue, ErrUnboundFamilyAddr
+	}
+
 	// The top-level Taro tree must have a non-empty asset tree at the leaf
 	// specified in the address.
 	inputCommitments := input.Commitments()
@@ -268,6 +325,10 @@ func IsValidInput(input *commitment.TaroCommitment,
 			ErrMissingInputAsset)
 	}
 
+	if inputAsset.ScriptVersion != addr.ScriptVersion {
+		return nil, fullValue, ErrScriptVersionMismatch
+	}
+
 	// For Normal assets, we also check that the input asset amount is
 	// at least as large as the amount specified in the address.
 	// If the input amount is exactly the amount specified in the address,
@@ -281,6 +342,12 @@ func IsValidInput(input *commitment.TaroCommitment,
 			fullValue = true
 		}
 	} else {
+		// Collectibles can only be sent whole, so the address must
+		// request exactly the amount of the input.
+		if inputAsset.Amount != addr.Amount {
+			return nil, fullValue, commitment.ErrCollectibleSplit
+		}
+
 		// Collectible assets always require the spending split to use an
 		// unspendable zero-value root split.
 		fullValue = true
@@ -332,9 +399,15 @@ func PrepareAssetSplitSpend(addr address.Taro, prevInput asset.PrevID,
 	receiverLocator.Amount = addr.Amount
 	updatedDelta.Locators[receiverStateKey] = receiverLocator
 
-	// Enforce an unspendable root split if the split sends the full value
-	// of the input asset or if the split sends a collectible.
-	if (senderLocator.Amount == 0 || inputAsset.Type == asset.Collectible) &&
+	// Enforce an unspendable root split if the split sends a collectible
+	// or the full value of the input asset.
+	if inputAsset.Type == asset.Collectible &&
+		(senderLocator.Amount != 0 ||
+			senderLocator.ScriptKey != asset.NUMSCompressedKey) {
+
+		return nil, commitment.ErrCollectibleSplit
+	}
+	if senderLocator.Amount == 0 &&
 		senderLocator.ScriptKey != asset.NUMSCompressedKey {
 
 		return nil, commitment.ErrInvalidScriptKey
@@ -394,10 +467,11 @@ func PrepareAssetCompleteSpend(addr address.Taro, prevInput asset.PrevID,
 
 // CompleteAssetSpend updates the new Asset by creating a signature over the
 // asset transfer, verifying the transfer with the Taro VM, and attaching that
-// signature to the new Asset.
+// signature to the new Asset. The chain context is passed to the Taro VM to
+// enforce the lock times of the inputs, if it is nil they aren't checked.
 func CompleteAssetSpend(internalKey btcec.PublicKey, prevInput asset.PrevID,
-	delta SpendDelta, signer Signer,
-	validator TxValidator) (*SpendDelta, error) {
+	delta SpendDelta, signer Signer, validator TxValidator,
+	chainCtx *ChainContext) (*SpendDelta, error) {
 
 	updatedDelta := delta.Copy()
 
@@ -410,32 +484,70 @@ func CompleteAssetSpend(internalKey btcec.PublicKey, prevInput asset.PrevID,
 	}
 
 	// For each input asset leaf, we need to produce a witness.
-	// Update the input of the virtual TX, generate a witness,
-	// and attach it to the copy of the new Asset.
-	validatedAsset := updatedDelta.NewAsset.Copy()
-	prevWitnessCount := len(updatedDelta.NewAsset.PrevWitnesses)
-
-	for idx := 0; idx < prevWitnessCount; idx++ {
-		prevAssetID := updatedDelta.NewAsset.PrevWitnesses[idx].PrevID
+	// Update the input of the virtual TX and generate a witness for it.
+	witnesses := make(map[asset.PrevID]wire.TxWitness)
+	for idx, prevWitness := range updatedDelta.NewAsset.PrevWitnesses {
+		prevAssetID := prevWitness.PrevID
 		prevAsset := updatedDelta.InputAssets[*prevAssetID]
 		virtualTxCopy := VirtualTxWithInput(
 			virtualTx, prevAsset, uint32(idx), nil,
 		)
 
-		newWitness, err := SignTaprootKeySpend(
-			internalKey, virtualTxCopy, prevAsset, 0, signer,
-		)
+		var newWitness *wire.TxWitness
+		spend, ok := updatedDelta.TapscriptSpends[*prevAssetID]
+		if ok {
+			newWitness, err = SignTapscriptSpend(
+				internalKey, virtualTxCopy, prevAsset, 0,
+				spend, signer,
+			)
+		} else {
+			newWitness, err = SignTaprootKeySpend(
+				internalKey, virtualTxCopy, prevAsset, 0,
+				signer,
+			)
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		validatedAsset.PrevWitnesses[idx].TxWitness = *newWitness
+		witnesses[*prevAssetID] = *newWitness
+	}
+
+	return AttachAssetWitnesses(updatedDelta, witnesses, validator, chainCtx)
+}
+
+// AttachAssetWitnesses attaches the given witnesses of each input to the new
+// Asset, and verifies the transfer with the Taro VM. This completes a spend
+// for which the witnesses were produced by other means than the Signer of
+// CompleteAssetSpend, for example through interactive MuSig2 signing
+// sessions. The chain context is passed to the Taro VM to enforce the lock
+// times of the inputs, if it is nil they aren't checked.
+func AttachAssetWitnesses(delta SpendDelta,
+	witnesses map[asset.PrevID]wire.TxWitness, validator TxValidator,
+	chainCtx *ChainContext) (*SpendDelta, error) {
+
+	updatedDelta := delta.Copy()
+
+	validatedAsset := updatedDelta.NewAsset.Copy()
+	for idx, prevWitness := range validatedAsset.PrevWitnesses {
+		if prevWitness.PrevID == nil {
+			return nil, ErrNoInputs
+		}
+
+		witness, ok := witnesses[*prevWitness.PrevID]
+		if !ok {
+			return nil, fmt.Errorf("%w: no witness for input %v",
+				ErrNoInputs, prevWitness.PrevID.OutPoint)
+		}
+
+		validatedAsset.PrevWitnesses[idx].TxWitness = witness
 	}
 
 	// Create an instance of the Taro VM and validate the transfer.
 	verifySpend := func(splitAsset *commitment.SplitAsset) error {
 		err := validator.Execute(
 			validatedAsset, splitAsset, updatedDelta.InputAssets,
+			chainCtx,
 		)
 		if err != nil {
 			return err
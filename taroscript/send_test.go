@@ -186,7 +186,8 @@ func updateScenarioAssets(t *testing.T, state *spendData) {
 	// Assets to cover both asset types and all three asset values.
 	asset1, err := asset.New(
 		state.genesis1, state.normalAmt1, locktime,
-		relLocktime, asset.NewScriptKeyBIP0086(state.spenderDescriptor),
+		relLocktime, asset.ScriptV0,
+		asset.NewScriptKeyBIP0086(state.spenderDescriptor),
 		nil,
 	)
 	require.NoError(t, err)
@@ -194,7 +195,8 @@ func updateScenarioAssets(t *testing.T, state *spendData) {
 
 	asset1CollectFamily, err := asset.New(
 		state.genesis1collect, state.collectAmt, locktime,
-		relLocktime, asset.NewScriptKeyBIP0086(state.spenderDescriptor),
+		relLocktime, asset.ScriptV0,
+		asset.NewScriptKeyBIP0086(state.spenderDescriptor),
 		&state.familyKey,
 	)
 	require.NoError(t, err)
@@ -202,7 +204,8 @@ func updateScenarioAssets(t *testing.T, state *spendData) {
 
 	asset2, err := asset.New(
 		state.genesis1, state.normalAmt2, locktime,
-		relLocktime, asset.NewScriptKeyBIP0086(state.spenderDescriptor),
+		relLocktime, asset.ScriptV0,
+		asset.NewScriptKeyBIP0086(state.spenderDescriptor),
 		nil,
 	)
 	require.NoError(t, err)
@@ -236,6 +239,50 @@ func updateScenarioAssets(t *testing.T, state *spendData) {
 	}
 }
 
+// useTapscriptScriptKey updates asset1 of the spend scenario so its script
+// key commits to a tapscript tree with two leaves: one that requires a
+// signature of the spender key and one that is a plain hash lock. The two
+// leaves and the preimage of the hash lock are returned.
+func useTapscriptScriptKey(t *testing.T, state *spendData) (txscript.TapLeaf,
+	txscript.TapLeaf, []byte) {
+
+	t.Helper()
+
+	sigScript, err := txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(&state.spenderPubKey)).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	require.NoError(t, err)
+	sigLeaf := txscript.NewBaseTapLeaf(sigScript)
+
+	preimage := []byte("taro tapscript preimage")
+	preimageHash := sha256.Sum256(preimage)
+	hashScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_SHA256).
+		AddData(preimageHash[:]).
+		AddOp(txscript.OP_EQUAL).
+		Script()
+	require.NoError(t, err)
+	hashLeaf := txscript.NewBaseTapLeaf(hashScript)
+
+	scriptKey := asset.NewScriptKeyTapscript(
+		state.spenderDescriptor, sigLeaf, hashLeaf,
+	)
+	useScriptKey(state, scriptKey)
+
+	return sigLeaf, hashLeaf, preimage
+}
+
+// useScriptKey updates asset1 of the spend scenario to use the given script
+// key.
+func useScriptKey(state *spendData, scriptKey asset.ScriptKey) {
+	state.asset1.ScriptKey = scriptKey
+	state.asset1PrevID.ScriptKey = asset.ToSerialized(scriptKey.PubKey)
+	state.asset1InputAssets = commitment.InputSet{
+		state.asset1PrevID: &state.asset1,
+	}
+}
+
 func updateScenarioCommitments(t *testing.T, state *spendData) {
 	t.Helper()
 
@@ -306,6 +353,7 @@ func createGenesisProof(t *testing.T, state *spendData) {
 	asset2GenesisProof := proof.Proof{
 		PrevOut:       state.asset2GenesisTx.TxIn[0].PreviousOutPoint,
 		BlockHeader:   *blockHeader,
+		BlockHeight:   1,
 		AnchorTx:      state.asset2GenesisTx,
 		TxMerkleProof: *txMerkleProof,
 		Asset:         state.asset2,
@@ -346,7 +394,8 @@ func createSpend(t *testing.T, state *spendData, spend taroscript.SpendDelta,
 
 	spendCompleted, err := taroscript.CompleteAssetSpend(
 		state.spenderPubKey, state.asset2PrevID,
-		*spendPrepared, state.signer, state.validator,
+		*spendPrepared, state.signer,
+		state.validator, nil,
 	)
 	require.NoError(t, err)
 
@@ -384,6 +433,7 @@ func createProofParams(t *testing.T, genesisTxIn wire.TxIn, state spendData,
 	merkleRoot := merkleTree[len(merkleTree)-1]
 	genesisHash := state.asset2GenesisProof.BlockHeader.BlockHash()
 	blockHeader := wire.NewBlockHeader(0, &genesisHash, merkleRoot, 0, 0)
+	blockHeight := state.asset2GenesisProof.BlockHeight + 1
 
 	receiverLocator := spendCompleted.
 		Locators[receiverStateKey]
@@ -413,6 +463,7 @@ func createProofParams(t *testing.T, genesisTxIn wire.TxIn, state spendData,
 				Header:       *blockHeader,
 				Transactions: []*wire.MsgTx{spendTx},
 			},
+			BlockHeight: blockHeight,
 			Tx:          spendTx,
 			TxIndex:     0,
 			OutputIndex: 0,
@@ -435,6 +486,7 @@ func createProofParams(t *testing.T, genesisTxIn wire.TxIn, state spendData,
 				Header:       *blockHeader,
 				Transactions: []*wire.MsgTx{spendTx},
 			},
+			BlockHeight: blockHeight,
 			Tx:          spendTx,
 			TxIndex:     0,
 			OutputIndex: 1,
@@ -981,7 +1033,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 				Genesis = state.genesis1collect
 			_, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			spendPrepared.InputAssets[state.asset1PrevID].
 				Genesis = state.genesis1
@@ -1003,7 +1056,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 				PrevID.OutPoint.Index = 1337
 			_, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			return err
 		},
@@ -1024,7 +1078,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 			)
 			_, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			return err
 		},
@@ -1046,7 +1101,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey,
 				state.asset1CollectFamilyPrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1071,7 +1127,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 			unvalidatedAsset := spendPrepared.NewAsset
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1098,7 +1155,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 			unvalidatedAsset := spendPrepared.NewAsset
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1126,7 +1184,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 			unvalidatedAsset := spendPrepared.NewAsset
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1157,7 +1216,8 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey,
 				state.asset1CollectFamilyPrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1169,6 +1229,150 @@ var completeAssetSpendTestCases = []completeAssetSpendTestCase{
 		},
 		err: nil,
 	},
+	{
+		name: "validate tapscript script key with key spend",
+		f: func(t *testing.T) error {
+			state := initSpendScenario(t)
+			_, _, _ = useTapscriptScriptKey(t, &state)
+			spend := taroscript.SpendDelta{
+				InputAssets: state.asset1InputAssets,
+			}
+			spendPrepared := taroscript.PrepareAssetCompleteSpend(
+				state.address1, state.asset1PrevID, spend,
+			)
+			_, err := taroscript.CompleteAssetSpend(
+				state.spenderPubKey, state.asset1PrevID,
+				*spendPrepared, state.signer,
+				state.validator, nil,
+			)
+			return err
+		},
+		err: nil,
+	},
+	{
+		name: "validate tapscript signature leaf spend",
+		f: func(t *testing.T) error {
+			state := initSpendScenario(t)
+			sigLeaf, _, _ := useTapscriptScriptKey(t, &state)
+			spend := taroscript.SpendDelta{
+				InputAssets: state.asset1InputAssets,
+			}
+			spendPrepared := taroscript.PrepareAssetCompleteSpend(
+				state.address1, state.asset1PrevID, spend,
+			)
+			spendPrepared.TapscriptSpends = map[asset.PrevID]*taroscript.TapscriptSpend{
+				state.asset1PrevID: {
+					Leaf: sigLeaf,
+				},
+			}
+			spendCompleted, err := taroscript.CompleteAssetSpend(
+				state.spenderPubKey, state.asset1PrevID,
+				*spendPrepared, state.signer,
+				state.validator, nil,
+			)
+			require.NoError(t, err)
+
+			// The witness must be a script path spend of the
+			// signature leaf, with a control block for a two leaf
+			// tree.
+			witness := spendCompleted.NewAsset.PrevWitnesses[0].
+				TxWitness
+			require.Len(t, witness, 3)
+			require.Equal(t, sigLeaf.Script, witness[1])
+			require.Len(t, witness[2], 65)
+			return nil
+		},
+		err: nil,
+	},
+	{
+		name: "validate tapscript hash lock leaf spend",
+		f: func(t *testing.T) error {
+			state := initSpendScenario(t)
+			_, hashLeaf, preimage := useTapscriptScriptKey(
+				t, &state,
+			)
+			spend := taroscript.SpendDelta{
+				InputAssets: state.asset1InputAssets,
+			}
+			spendPrepared := taroscript.PrepareAssetCompleteSpend(
+				state.address1, state.asset1PrevID, spend,
+			)
+			spendPrepared.TapscriptSpends = map[asset.PrevID]*taroscript.TapscriptSpend{
+				state.asset1PrevID: {
+					Leaf:          hashLeaf,
+					WitnessItems:  [][]byte{preimage},
+					SkipSignature: true,
+				},
+			}
+			_, err := taroscript.CompleteAssetSpend(
+				state.spenderPubKey, state.asset1PrevID,
+				*spendPrepared, state.signer,
+				state.validator, nil,
+			)
+			return err
+		},
+		err: nil,
+	},
+	{
+		name: "validate tapscript hash lock leaf with bad preimage",
+		f: func(t *testing.T) error {
+			state := initSpendScenario(t)
+			_, hashLeaf, _ := useTapscriptScriptKey(t, &state)
+			spend := taroscript.SpendDelta{
+				InputAssets: state.asset1InputAssets,
+			}
+			spendPrepared := taroscript.PrepareAssetCompleteSpend(
+				state.address1, state.asset1PrevID, spend,
+			)
+			spendPrepared.TapscriptSpends = map[asset.PrevID]*taroscript.TapscriptSpend{
+				state.asset1PrevID: {
+					Leaf:          hashLeaf,
+					WitnessItems:  [][]byte{{1, 2, 3}},
+					SkipSignature: true,
+				},
+			}
+			_, err := taroscript.CompleteAssetSpend(
+				state.spenderPubKey, state.asset1PrevID,
+				*spendPrepared, state.signer,
+				state.validator, nil,
+			)
+
+			var vmErr vm.Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(
+				t, vm.ErrInvalidTransferWitness, vmErr.Kind,
+			)
+			return nil
+		},
+		err: nil,
+	},
+	{
+		name: "validate tapscript spend of unknown leaf",
+		f: func(t *testing.T) error {
+			state := initSpendScenario(t)
+			_, _, _ = useTapscriptScriptKey(t, &state)
+			spend := taroscript.SpendDelta{
+				InputAssets: state.asset1InputAssets,
+			}
+			spendPrepared := taroscript.PrepareAssetCompleteSpend(
+				state.address1, state.asset1PrevID, spend,
+			)
+			spendPrepared.TapscriptSpends = map[asset.PrevID]*taroscript.TapscriptSpend{
+				state.asset1PrevID: {
+					Leaf: txscript.NewBaseTapLeaf(
+						[]byte{txscript.OP_TRUE},
+					),
+				},
+			}
+			_, err := taroscript.CompleteAssetSpend(
+				state.spenderPubKey, state.asset1PrevID,
+				*spendPrepared, state.signer,
+				state.validator, nil,
+			)
+			return err
+		},
+		err: taroscript.ErrUnknownTapLeaf,
+	},
 }
 
 // TestCreateSpendCommitments tests edge cases around creating TaroCommitments
@@ -1206,7 +1410,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 			)
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1243,7 +1448,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 			)
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1286,7 +1492,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1323,7 +1530,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey,
 				state.asset1CollectFamilyPrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1362,7 +1570,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 			)
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1402,7 +1611,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1443,7 +1653,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1487,7 +1698,8 @@ var createSpendCommitmentsTestCases = []createSpendCommitmentsTestCase{
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey,
 				state.asset1CollectFamilyPrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1551,7 +1763,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 			)
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1594,7 +1807,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 			)
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1640,7 +1854,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey,
 				state.asset1CollectFamilyPrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1698,7 +1913,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 			)
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset1PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1756,7 +1972,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1810,7 +2027,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey, state.asset2PrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1867,7 +2085,8 @@ var createSpendOutputsTestCases = []createSpendOutputsTestCase{
 			spendCompleted, err := taroscript.CompleteAssetSpend(
 				state.spenderPubKey,
 				state.asset1CollectFamilyPrevID,
-				*spendPrepared, state.signer, state.validator,
+				*spendPrepared, state.signer,
+				state.validator, nil,
 			)
 			require.NoError(t, err)
 
@@ -1963,22 +2182,22 @@ func TestProofVerify(t *testing.T) {
 
 	// Create a proof for each receiver and verify it.
 	senderBlob, _, err := proof.AppendTransition(
-		genesisProofBlob, &proofParams[0],
+		genesisProofBlob, &proofParams[0], proof.MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	senderFile := proof.NewEmptyFile(proof.V0)
 	require.NoError(t, senderFile.Decode(bytes.NewReader(senderBlob)))
-	_, err = senderFile.Verify(context.TODO())
+	_, err = senderFile.Verify(context.TODO(), proof.MockHeaderVerifier)
 	require.NoError(t, err)
 
 	receiverBlob, _, err := proof.AppendTransition(
-		genesisProofBlob, &proofParams[1],
+		genesisProofBlob, &proofParams[1], proof.MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	receiverFile, err := proof.NewFile(proof.V0)
 	require.NoError(t, err)
 	require.NoError(t, receiverFile.Decode(bytes.NewReader(receiverBlob)))
-	_, err = receiverFile.Verify(context.TODO())
+	_, err = receiverFile.Verify(context.TODO(), proof.MockHeaderVerifier)
 	require.NoError(t, err)
 }
 
@@ -2031,22 +2250,22 @@ func TestProofVerifyFullValueSplit(t *testing.T) {
 
 	// Create a proof for each receiver and verify it.
 	senderBlob, _, err := proof.AppendTransition(
-		genesisProofBlob, &proofParams[0],
+		genesisProofBlob, &proofParams[0], proof.MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	senderFile, err := proof.NewFile(proof.V0)
 	require.NoError(t, err)
 	require.NoError(t, senderFile.Decode(bytes.NewReader(senderBlob)))
-	_, err = senderFile.Verify(context.TODO())
+	_, err = senderFile.Verify(context.TODO(), proof.MockHeaderVerifier)
 	require.NoError(t, err)
 
 	receiverBlob, _, err := proof.AppendTransition(
-		genesisProofBlob, &proofParams[1],
+		genesisProofBlob, &proofParams[1], proof.MockHeaderVerifier,
 	)
 	require.NoError(t, err)
 	receiverFile := proof.NewEmptyFile(proof.V0)
 	require.NoError(t, receiverFile.Decode(bytes.NewReader(receiverBlob)))
-	_, err = receiverFile.Verify(context.TODO())
+	_, err = receiverFile.Verify(context.TODO(), proof.MockHeaderVerifier)
 	require.NoError(t, err)
 }
 
@@ -2199,6 +2418,21 @@ var addressValidInputTestCases = []addressValidInputTestCase{
 		},
 		err: taroscript.ErrMissingInputAsset,
 	},
+	{
+		name: "collectible with partial amount",
+		f: func(t *testing.T) (*asset.Asset, *asset.Asset, error) {
+			state := initSpendScenario(t)
+			addr := state.address1CollectFamily
+			addr.Amount = 2
+			inputAsset, fullValue, err := taroscript.IsValidInput(
+				&state.asset1CollectFamilyTaroTree, addr,
+				state.spenderScriptKey, address.TestNet3Taro,
+			)
+			require.False(t, fullValue)
+			return &state.asset1CollectFamily, inputAsset, err
+		},
+		err: commitment.ErrCollectibleSplit,
+	},
 	{
 		name: "normal with bad sender script key",
 		f: func(t *testing.T) (*asset.Asset, *asset.Asset, error) {
@@ -2237,6 +2471,96 @@ var addressValidInputTestCases = []addressValidInputTestCase{
 		},
 		err: address.ErrMismatchedHRP,
 	},
+	{
+		name: "normal with mismatched script version",
+		f: func(t *testing.T) (*asset.Asset, *asset.Asset, error) {
+			state := initSpendScenario(t)
+			addr := state.address1
+			addr.ScriptVersion = asset.ScriptV1
+			inputAsset, fullValue, err := taroscript.IsValidInput(
+				&state.asset2TaroTree, addr,
+				state.spenderScriptKey, address.MainNetTaro,
+			)
+			require.False(t, fullValue)
+			return &state.asset2, inputAsset, err
+		},
+		err: taroscript.ErrScriptVersionMismatch,
+	},
+}
+
+// TestBindFamilyAddr tests that a send to a family address can be constructed
+// once the address is bound to the asset ID of an input asset of the family.
+func TestBindFamilyAddr(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+
+	famAddr, err := address.NewFamily(
+		&state.familyKey.FamKey, asset.Collectible,
+		state.receiverPubKey, state.receiverPubKey, state.collectAmt,
+		&address.TestNet3Taro,
+	)
+	require.NoError(t, err)
+
+	// An unbound family address can't be used to construct a send.
+	_, _, err = taroscript.IsValidInput(
+		&state.asset1CollectFamilyTaroTree, *famAddr,
+		state.spenderScriptKey, address.TestNet3Taro,
+	)
+	require.ErrorIs(t, err, taroscript.ErrUnboundFamilyAddr)
+
+	// Assets outside the family can't be bound to the address.
+	_, err = taroscript.BindFamilyAddr(*famAddr, &state.asset1)
+	require.ErrorIs(t, err, taroscript.ErrMissingInputAsset)
+
+	// Addresses that already pin an asset ID are returned unchanged.
+	addr, err := taroscript.BindFamilyAddr(state.address1, &state.asset1)
+	require.NoError(t, err)
+	require.Equal(t, state.address1, *addr)
+
+	// Once bound to the asset of the family, the address pins its asset ID
+	// and the send can be constructed as usual.
+	boundAddr, err := taroscript.BindFamilyAddr(
+		*famAddr, &state.asset1CollectFamily,
+	)
+	require.NoError(t, err)
+	require.Equal(t, state.asset1CollectFamily.ID(), boundAddr.ID())
+
+	inputAsset, fullValue, err := taroscript.IsValidInput(
+		&state.asset1CollectFamilyTaroTree, *boundAddr,
+		state.spenderScriptKey, address.TestNet3Taro,
+	)
+	require.NoError(t, err)
+	require.True(t, fullValue)
+	require.True(t, state.asset1CollectFamily.DeepEqual(inputAsset))
+
+	spend := taroscript.SpendDelta{
+		InputAssets: state.asset1CollectFamilyInputAssets,
+	}
+	spendPrepared := taroscript.PrepareAssetCompleteSpend(
+		*boundAddr, state.asset1CollectFamilyPrevID, spend,
+	)
+	spendCompleted, err := taroscript.CompleteAssetSpend(
+		state.spenderPubKey, state.asset1CollectFamilyPrevID,
+		*spendPrepared, state.signer, state.validator, nil,
+	)
+	require.NoError(t, err)
+
+	spendCommitments, err := taroscript.CreateSpendCommitments(
+		&state.asset1CollectFamilyTaroTree,
+		state.asset1CollectFamilyPrevID, *spendCompleted, *boundAddr,
+		state.spenderScriptKey,
+	)
+	require.NoError(t, err)
+
+	senderStateKey := asset.AssetCommitmentKey(
+		boundAddr.ID(), &state.spenderScriptKey, false,
+	)
+	checkSpendCommitments(
+		t, senderStateKey, boundAddr.AssetCommitmentKey(),
+		state.asset1CollectFamilyPrevID, spendCompleted,
+		spendCommitments, false,
+	)
 }
 
 // TestPayToAddrScript tests edge cases around creating a P2TR script with
@@ -2262,6 +2586,7 @@ func TestPayToAddrScript(t *testing.T) {
 	// units.
 	inputAsset1, err := asset.New(
 		gen, uint64(normalAmt1), 1, 1,
+		asset.ScriptV0,
 		asset.NewScriptKeyBIP0086(ownerDescriptor), nil,
 	)
 	require.NoError(t, err)
@@ -0,0 +1,144 @@
+package taroscript
+
+import (
+	"context"
+	"errors"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/mssmt"
+)
+
+// The virtual transaction of a state transition always has a single input and
+// a single output, so the sighash types supported for asset witnesses are
+// defined in terms of the input MS-SMT and the asset outputs instead:
+//
+//   - SIGHASH_DEFAULT and SIGHASH_ALL commit to the root of the input MS-SMT,
+//     and therefore to all inputs, as well as to the output of the virtual
+//     transaction, which for an asset split is the split commitment root and
+//     therefore commits to all outputs.
+//   - SIGHASH_SINGLE only commits to the asset carrying the witness, which for
+//     an asset split is the root asset, but not to the other outputs of the
+//     split commitment. Other parties can add outputs, as long as the root
+//     asset stays the same.
+//   - SIGHASH_ANYONECANPAY replaces the input MS-SMT with one that only
+//     contains the input being signed, and doesn't commit to the position of
+//     the input nor to the inputs referenced by the new asset. Other parties
+//     can add inputs to the state transition. It can be combined with either
+//     SIGHASH_ALL or SIGHASH_SINGLE.
+//
+// SIGHASH_NONE isn't supported, as it would allow anyone to redirect the
+// asset to an arbitrary output.
+var (
+	// ErrUnsupportedSigHashType represents an error case where a signature
+	// over a virtual transaction uses an unsupported sighash type.
+	ErrUnsupportedSigHashType = errors.New("unsupported sighash type")
+)
+
+// IsValidSigHashType returns true if the given sighash type can be used for
+// signatures over a virtual transaction.
+func IsValidSigHashType(hashType txscript.SigHashType) bool {
+	switch hashType {
+	case txscript.SigHashDefault, txscript.SigHashAll,
+		txscript.SigHashSingle,
+		txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+		txscript.SigHashSingle | txscript.SigHashAnyOneCanPay:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// SigHashVirtualTx returns a copy of the virtual transaction of a state
+// transition, adjusted to only contain what a signature of the given sighash
+// type for the input at the given index commits to. The result can be passed
+// to InputKeySpendSigHash and InputScriptSpendSigHash along with the same
+// sighash type.
+func SigHashVirtualTx(virtualTx *wire.MsgTx, newAsset, input *asset.Asset,
+	idx uint32, hashType txscript.SigHashType) (*wire.MsgTx, error) {
+
+	if !IsValidSigHashType(hashType) {
+		return nil, ErrUnsupportedSigHashType
+	}
+
+	txCopy := virtualTx.Copy()
+
+	// With SIGHASH_ANYONECANPAY, the virtual prev out only commits to the
+	// input being signed, so other inputs can be added.
+	if hashType&txscript.SigHashAnyOneCanPay != 0 {
+		if int(idx) >= len(newAsset.PrevWitnesses) ||
+			newAsset.PrevWitnesses[idx].PrevID == nil {
+
+			return nil, ErrNoInputs
+		}
+
+		inputTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		leaf, err := input.Leaf()
+		if err != nil {
+			return nil, err
+		}
+
+		// TODO(bhandras): thread the context through.
+		ctx := context.TODO()
+		prevID := newAsset.PrevWitnesses[idx].PrevID
+		_, err = inputTree.Insert(ctx, prevID.Hash(), leaf)
+		if err != nil {
+			return nil, err
+		}
+		treeRoot, err := inputTree.Root(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		txCopy.TxIn[zeroIndex].PreviousOutPoint = *virtualTxInPrevOut(
+			treeRoot,
+		)
+	}
+
+	// With SIGHASH_SINGLE, the virtual output only commits to the asset
+	// carrying the witness, not to the other outputs of its split. With
+	// SIGHASH_ANYONECANPAY, it doesn't commit to the inputs referenced by
+	// the new asset either.
+	var (
+		anyoneCanPay = hashType&txscript.SigHashAnyOneCanPay != 0
+		single       = hashType&^txscript.SigHashAnyOneCanPay ==
+			txscript.SigHashSingle
+		isSplit = newAsset.SplitCommitmentRoot != nil
+	)
+	if anyoneCanPay || (single && isSplit) {
+		outputAsset := newAsset.Copy()
+		if single {
+			outputAsset.SplitCommitmentRoot = nil
+		}
+		if anyoneCanPay {
+			outputAsset.PrevWitnesses = nil
+		}
+
+		txOut, err := virtualTxOut(outputAsset)
+		if err != nil {
+			return nil, err
+		}
+		txCopy.TxOut[zeroIndex] = txOut
+	}
+
+	return txCopy, nil
+}
+
+// VirtualTxWithInputSigHash returns a copy of the `virtualTx` amended to
+// include all input-specific details a signature of the given sighash type
+// commits to. With SIGHASH_ANYONECANPAY, the position of the input isn't
+// committed to, so inputs can be added in front of it.
+func VirtualTxWithInputSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
+	idx uint32, witness wire.TxWitness,
+	hashType txscript.SigHashType) *wire.MsgTx {
+
+	txCopy := VirtualTxWithInput(virtualTx, input, idx, witness)
+	if hashType&txscript.SigHashAnyOneCanPay != 0 {
+		txCopy.TxIn[zeroIndex].PreviousOutPoint.Index = zeroIndex
+	}
+
+	return txCopy
+}
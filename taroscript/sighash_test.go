@@ -0,0 +1,132 @@
+package taroscript_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/taroscript"
+	"github.com/stretchr/testify/require"
+)
+
+var sigHashVectorsFileName = filepath.Join(
+	"testdata", "sighash_vectors.json",
+)
+
+// sigHashVectorInput is an input of a sighash test vector.
+type sigHashVectorInput struct {
+	OutPoint string `json:"out_point"`
+	Asset    string `json:"asset"`
+}
+
+// sigHashVector is a test vector for the signature hash of a key path spend
+// of a virtual transaction input with a given sighash type.
+type sigHashVector struct {
+	Name          string               `json:"name"`
+	NewAsset      string               `json:"new_asset"`
+	Inputs        []sigHashVectorInput `json:"inputs"`
+	InputIndex    uint32               `json:"input_index"`
+	HashType      uint32               `json:"hash_type"`
+	SigHash       string               `json:"sig_hash"`
+	ExpectedError string               `json:"expected_error"`
+}
+
+func decodeVectorAsset(t *testing.T, assetHex string) *asset.Asset {
+	assetBytes, err := hex.DecodeString(assetHex)
+	require.NoError(t, err)
+
+	var a asset.Asset
+	require.NoError(t, a.Decode(bytes.NewReader(assetBytes)))
+
+	return &a
+}
+
+func decodeVectorOutPoint(t *testing.T, outPoint string) wire.OutPoint {
+	parts := strings.Split(outPoint, ":")
+	require.Len(t, parts, 2)
+
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	require.NoError(t, err)
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	require.NoError(t, err)
+
+	return wire.OutPoint{Hash: *hash, Index: uint32(index)}
+}
+
+// TestSigHashVectors tests the signature hashes of virtual transaction inputs
+// for all sighash types against the test vectors.
+func TestSigHashVectors(t *testing.T) {
+	t.Parallel()
+
+	vectorsJSON, err := os.ReadFile(sigHashVectorsFileName)
+	require.NoError(t, err)
+
+	var vectors []sigHashVector
+	require.NoError(t, json.Unmarshal(vectorsJSON, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, vector := range vectors {
+		vector := vector
+		t.Run(vector.Name, func(t *testing.T) {
+			newAsset := decodeVectorAsset(t, vector.NewAsset)
+
+			var signedInput *asset.Asset
+			inputs := make(commitment.InputSet, len(vector.Inputs))
+			for _, input := range vector.Inputs {
+				inputAsset := decodeVectorAsset(t, input.Asset)
+				prevID := asset.PrevID{
+					OutPoint: decodeVectorOutPoint(
+						t, input.OutPoint,
+					),
+					ID: inputAsset.Genesis.ID(),
+					ScriptKey: asset.ToSerialized(
+						inputAsset.ScriptKey.PubKey,
+					),
+				}
+				inputs[prevID] = inputAsset
+
+				witnesses := newAsset.PrevWitnesses
+				idx := vector.InputIndex
+				if *witnesses[idx].PrevID == prevID {
+					signedInput = inputAsset
+				}
+			}
+			require.NotNil(t, signedInput)
+
+			virtualTx, _, err := taroscript.VirtualTx(
+				newAsset, inputs,
+			)
+			require.NoError(t, err)
+
+			hashType := txscript.SigHashType(vector.HashType)
+			sigHashTx, err := taroscript.SigHashVirtualTx(
+				virtualTx, newAsset, signedInput,
+				vector.InputIndex, hashType,
+			)
+			if vector.ExpectedError != "" {
+				require.EqualError(t, err, vector.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			sigHash, err := taroscript.InputKeySpendSigHash(
+				sigHashTx, signedInput, vector.InputIndex,
+				hashType,
+			)
+			require.NoError(t, err)
+			require.Equal(
+				t, vector.SigHash, hex.EncodeToString(sigHash),
+			)
+		})
+	}
+}
@@ -31,6 +31,17 @@ var (
 	// ErrInvalidScriptVersion represents an error case where an asset input
 	// commits to an invalid script version.
 	ErrInvalidScriptVersion = errors.New("invalid script version")
+
+	// ErrNoTapscriptTree represents an error case where a script path
+	// spend is requested for an input whose script key doesn't commit to
+	// a tapscript tree.
+	ErrNoTapscriptTree = errors.New("script key has no tapscript tree")
+
+	// ErrUnknownTapLeaf represents an error case where a script path spend
+	// is requested for a leaf that isn't part of the script key's
+	// tapscript tree.
+	ErrUnknownTapLeaf = errors.New("tap leaf not found in script key " +
+		"tapscript tree")
 )
 
 const (
@@ -259,7 +270,9 @@ func VirtualTxWithInput(virtualTx *wire.MsgTx, input *asset.Asset,
 func InputAssetPrevOut(prevAsset asset.Asset) (*wire.TxOut, error) {
 	var pkScript []byte
 	switch prevAsset.ScriptVersion {
-	case asset.ScriptV0:
+	// Both script versions commit to a tweaked Taproot output key, they
+	// only differ in how the leaf scripts are executed.
+	case asset.ScriptV0, asset.ScriptV1:
 		var err error
 		pkScript, err = PayToTaprootScript(prevAsset.ScriptKey.PubKey)
 		if err != nil {
@@ -292,37 +305,53 @@ func InputPrevOutFetcher(prevAsset asset.Asset) (*txscript.CannedPrevOutputFetch
 
 // InputKeySpendSigHash returns the signature hash of a virtual transaction for
 // a specific Taro input that can be spent through the key path. This is the
-// message over which signatures are generated over.
+// message over which signatures are generated over. For any sighash type other
+// than SIGHASH_DEFAULT and SIGHASH_ALL, the virtual transaction must be
+// created with SigHashVirtualTx first.
 func InputKeySpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
-	idx uint32) ([]byte, error) {
+	idx uint32, hashType txscript.SigHashType) ([]byte, error) {
+
+	if !IsValidSigHashType(hashType) {
+		return nil, ErrUnsupportedSigHashType
+	}
 
-	virtualTxCopy := VirtualTxWithInput(virtualTx, input, idx, nil)
+	virtualTxCopy := VirtualTxWithInputSigHash(
+		virtualTx, input, idx, nil, hashType,
+	)
 	prevOutFetcher, err := InputPrevOutFetcher(*input)
 	if err != nil {
 		return nil, err
 	}
 	sigHashes := txscript.NewTxSigHashes(virtualTxCopy, prevOutFetcher)
 	return txscript.CalcTaprootSignatureHash(
-		sigHashes, txscript.SigHashDefault, virtualTxCopy, zeroIndex,
-		prevOutFetcher,
+		sigHashes, hashType, virtualTxCopy, zeroIndex, prevOutFetcher,
 	)
 }
 
 // InputScriptSpendSigHash returns the signature hash of a virtual transaction
 // for a specific Taro input that can be spent through the script path. This is
-// the message over which signatures are generated over.
+// the message over which signatures are generated over. For any sighash type
+// other than SIGHASH_DEFAULT and SIGHASH_ALL, the virtual transaction must be
+// created with SigHashVirtualTx first.
 func InputScriptSpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
-	idx uint32, tapLeaf *txscript.TapLeaf) ([]byte, error) {
+	idx uint32, tapLeaf *txscript.TapLeaf,
+	hashType txscript.SigHashType) ([]byte, error) {
+
+	if !IsValidSigHashType(hashType) {
+		return nil, ErrUnsupportedSigHashType
+	}
 
-	virtualTxCopy := VirtualTxWithInput(virtualTx, input, idx, nil)
+	virtualTxCopy := VirtualTxWithInputSigHash(
+		virtualTx, input, idx, nil, hashType,
+	)
 	prevOutFetcher, err := InputPrevOutFetcher(*input)
 	if err != nil {
 		return nil, err
 	}
 	sigHashes := txscript.NewTxSigHashes(virtualTxCopy, prevOutFetcher)
 	return txscript.CalcTapscriptSignaturehash(
-		sigHashes, txscript.SigHashDefault, virtualTxCopy, zeroIndex,
-		prevOutFetcher, *tapLeaf,
+		sigHashes, hashType, virtualTxCopy, zeroIndex, prevOutFetcher,
+		*tapLeaf,
 	)
 }
 
@@ -352,9 +381,115 @@ func SignTaprootKeySpend(internalKey btcec.PublicKey, virtualTx *wire.MsgTx,
 		InputIndex: idx,
 	}
 
+	// If the script key commits to a tapscript tree, then the internal key
+	// needs to be tweaked with the root of that tree instead.
+	scriptKey := inputAsset.ScriptKey
+	if scriptKey.TweakedScriptKey != nil && len(scriptKey.Tweak) > 0 {
+		spendDesc.SignMethod = input.TaprootKeySpendSignMethod
+		spendDesc.TapTweak = scriptKey.Tweak
+	}
+
 	sig, err := txSigner.SignVirtualTx(&spendDesc, virtualTx, prevOut)
 	if err != nil {
 		return nil, err
 	}
 	return &wire.TxWitness{sig.Serialize()}, nil
 }
+
+// TapscriptSpend describes how a Taro input whose script key commits to a
+// tapscript tree should be spent through the script path.
+type TapscriptSpend struct {
+	// Leaf is the tapscript leaf of the script key's tree that is spent.
+	Leaf txscript.TapLeaf
+
+	// WitnessItems are any additional witness elements the leaf script
+	// requires, such as a hash preimage. They're placed on the witness
+	// stack below the signature.
+	WitnessItems [][]byte
+
+	// SkipSignature indicates that the leaf script doesn't require a
+	// signature from the raw script key, so only the witness items are
+	// placed on the stack.
+	SkipSignature bool
+}
+
+// tapLeafControlBlock returns the serialized control block that proves the
+// inclusion of the given leaf in the tapscript tree of the script key.
+func tapLeafControlBlock(internalKey btcec.PublicKey, scriptKey asset.ScriptKey,
+	leaf txscript.TapLeaf) ([]byte, error) {
+
+	if scriptKey.TweakedScriptKey == nil {
+		return nil, ErrNoTapscriptTree
+	}
+	tapTree := scriptKey.TapscriptTree()
+	if tapTree == nil {
+		return nil, ErrNoTapscriptTree
+	}
+
+	// We'll need an inclusion proof of the leaf in the tree to construct
+	// the control block.
+	leafIdx, ok := tapTree.LeafProofIndex[leaf.TapHash()]
+	if !ok {
+		return nil, ErrUnknownTapLeaf
+	}
+	controlBlock := tapTree.LeafMerkleProofs[leafIdx].ToControlBlock(
+		&internalKey,
+	)
+
+	return controlBlock.ToBytes()
+}
+
+// SignTapscriptSpend computes a signature over a Taro virtual transaction
+// spending a Taro input through the script path of its script key, and
+// assembles the full script path witness for it. The witness is attached to
+// a Taro output asset before state transition validation.
+func SignTapscriptSpend(internalKey btcec.PublicKey, virtualTx *wire.MsgTx,
+	inputAsset *asset.Asset, idx int, spend *TapscriptSpend,
+	txSigner Signer) (*wire.TxWitness, error) {
+
+	controlBlockBytes, err := tapLeafControlBlock(
+		internalKey, inputAsset.ScriptKey, spend.Leaf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	witness := make(wire.TxWitness, 0, len(spend.WitnessItems)+3)
+	witness = append(witness, spend.WitnessItems...)
+
+	if spend.SkipSignature {
+		witness = append(witness, spend.Leaf.Script, controlBlockBytes)
+		return &witness, nil
+	}
+
+	// Compute a virtual prevOut from the input asset for the signer.
+	prevOut, err := InputAssetPrevOut(*inputAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the signing descriptor for a script path signature over the
+	// Taro virtual TX, using the untweaked internal key as the signing
+	// key.
+	spendDesc := lndclient.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: &internalKey,
+		},
+		WitnessScript: spend.Leaf.Script,
+		SignMethod:    input.TaprootScriptSpendSignMethod,
+		Output:        prevOut,
+		HashType:      txscript.SigHashDefault,
+		InputIndex:    idx,
+	}
+
+	sig, err := txSigner.SignVirtualTx(&spendDesc, virtualTx, prevOut)
+	if err != nil {
+		return nil, err
+	}
+
+	witness = append(
+		witness, sig.Serialize(), spend.Leaf.Script, controlBlockBytes,
+	)
+
+	return &witness, nil
+}
@@ -8,24 +8,22 @@ import (
 )
 
 // ValidatorV0 is an implementation of the taroscript.TxValidator interface
-// that supports Taro script version 0.
-type ValidatorV0 struct{}
+// that supports Taro script version 0, and version 1 if enabled through the VM
+// options.
+type ValidatorV0 struct {
+	// VMOptions are the options each VM instance is created with.
+	VMOptions []vm.EngineOption
+}
 
 // Execute creates and runs an instance of the Taro script V0 VM.
 func (v *ValidatorV0) Execute(newAsset *asset.Asset,
 	splitAsset *commitment.SplitAsset,
-	prevAssets commitment.InputSet) error {
-
-	engine, err := vm.New(newAsset, splitAsset, prevAssets)
-	if err != nil {
-		return err
-	}
-
-	if err = engine.Execute(); err != nil {
-		return err
-	}
+	prevAssets commitment.InputSet,
+	chainCtx *taroscript.ChainContext) error {
 
-	return nil
+	return vm.ValidateStateTransition(
+		newAsset, splitAsset, prevAssets, chainCtx, v.VMOptions...,
+	)
 }
 
 // A compile time assertion to ensure ValidatorV0 meets the
@@ -26,8 +26,8 @@ func NewLndRpcVirtualTxSigner(lnd *lndclient.LndServices) *LndRpcVirtualTxSigner
 // SignVirtualTx generates a signature according to the passed signing
 // descriptor and virtual TX.
 //
-// NOTE: We currently assume the signature requested is for the BIP 86 spending
-// type, and that the passed key is the internal key.
+// NOTE: We currently assume that the passed key is the raw, untweaked script
+// key, so any tweak is expected to be part of the signing descriptor.
 func (l *LndRpcVirtualTxSigner) SignVirtualTx(signDesc *lndclient.SignDescriptor,
 	tx *wire.MsgTx, prevOut *wire.TxOut) (*schnorr.Signature, error) {
 
@@ -3,6 +3,7 @@ package vm
 import (
 	"fmt"
 
+	"github.com/lightninglabs/taro/asset"
 	"github.com/lightninglabs/taro/taroscript"
 )
 
@@ -32,8 +33,8 @@ const (
 	ErrAmountMismatch
 
 	// ErrInvalidSigHashFlag represents an error case where an asset witness
-	// contains signatures created with any sighash flag other than
-	// SIGHASH_DEFAULT.
+	// contains signatures created with a sighash flag that isn't valid for
+	// virtual transactions, or signatures with different sighash flags.
 	ErrInvalidSigHashFlag
 
 	// ErrInvalidGenesisStateTransition represents an error case where an
@@ -61,6 +62,39 @@ const (
 	// ErrInvalidRootAsset represents an error case where the root asset
 	// of an asset split has zero value but a spendable script key.
 	ErrInvalidRootAsset
+
+	// ErrLockTimeNotReached represents an error case where an asset input
+	// is spent in a block below the absolute lock time of the input.
+	ErrLockTimeNotReached
+
+	// ErrRelativeLockTimeNotReached represents an error case where an
+	// asset input is spent before the relative lock time of the input has
+	// passed since the input was confirmed.
+	ErrRelativeLockTimeNotReached
+
+	// ErrMissingInputHeight represents an error case where an asset input
+	// has a relative lock time, but the confirmation height of the input
+	// is unknown.
+	ErrMissingInputHeight
+
+	// ErrInvalidAssetOpcode represents an error case where an asset-aware
+	// opcode of a ScriptV1 leaf script is not preceded by a valid
+	// argument.
+	ErrInvalidAssetOpcode
+
+	// ErrAssetCovenantViolation represents an error case where the asset
+	// created by a state transition doesn't satisfy an asset-aware opcode
+	// of a ScriptV1 leaf script.
+	ErrAssetCovenantViolation
+
+	// ErrCollectibleMerge represents an error case where a state transition
+	// attempts to merge several collectible inputs into a single asset.
+	ErrCollectibleMerge
+
+	// ErrCollectibleSplit represents an error case where a state
+	// transition attempts to split a collectible instead of moving it
+	// whole to a single split output.
+	ErrCollectibleSplit
 )
 
 // Wrap select errors related to virtual TX handling to provide more
@@ -105,15 +139,46 @@ func (k ErrorKind) String() string {
 		return "invalid split commitment proof"
 	case ErrInvalidRootAsset:
 		return "invalid zero-value root asset"
+	case ErrLockTimeNotReached:
+		return "asset input lock time not reached"
+	case ErrRelativeLockTimeNotReached:
+		return "asset input relative lock time not reached"
+	case ErrMissingInputHeight:
+		return "missing confirmation height of asset input"
+	case ErrInvalidAssetOpcode:
+		return "invalid asset opcode argument"
+	case ErrAssetCovenantViolation:
+		return "asset covenant violated"
+	case ErrCollectibleMerge:
+		return "collectibles cannot be merged"
+	case ErrCollectibleSplit:
+		return "collectibles cannot be split"
 	default:
 		return "unknown"
 	}
 }
 
+// ErrorContext identifies the input of a state transition an Error refers to.
+type ErrorContext struct {
+	// InputIndex is the index of the input within the previous witnesses
+	// of the new asset.
+	InputIndex uint32
+
+	// AssetID is the ID of the asset spent by the input.
+	AssetID asset.ID
+
+	// ScriptKey is the script key of the asset spent by the input.
+	ScriptKey asset.SerializedKey
+}
+
 // Error represents an error returned by the Taro VM.
 type Error struct {
 	Kind  ErrorKind
 	Inner error
+
+	// Context is the input of the state transition the error refers to,
+	// if the error is specific to one of the inputs.
+	Context *ErrorContext
 }
 
 // newErrKind returns a new error of a particular kind.
@@ -127,6 +192,26 @@ func newErrInner(kind ErrorKind, inner error) Error {
 	return Error{Kind: kind, Inner: inner}
 }
 
+// withInputContext attaches the given input of the state transition to a VM
+// error, unless the error already refers to an input. Other errors are
+// returned as is.
+func withInputContext(err error, inputIdx uint32,
+	prevAsset *asset.Asset) error {
+
+	vmErr, ok := err.(Error)
+	if !ok || vmErr.Context != nil {
+		return err
+	}
+
+	vmErr.Context = &ErrorContext{
+		InputIndex: inputIdx,
+		AssetID:    prevAsset.Genesis.ID(),
+		ScriptKey:  asset.ToSerialized(prevAsset.ScriptKey.PubKey),
+	}
+
+	return vmErr
+}
+
 // Error returns a human readable version of the error. This implements the
 // main error interface.
 func (e Error) Error() string {
@@ -146,3 +231,15 @@ func (e Error) String() string {
 func (e Error) Unwrap() error {
 	return e.Inner
 }
+
+// Is returns true if the target is a VM error of the same kind, regardless of
+// the input it refers to. This allows callers to match errors returned by the
+// VM with errors.Is(err, Error{Kind: kind}).
+func (e Error) Is(target error) bool {
+	targetErr, ok := target.(Error)
+	if !ok {
+		return false
+	}
+
+	return e.Kind == targetErr.Kind
+}
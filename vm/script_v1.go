@@ -0,0 +1,486 @@
+package vm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+)
+
+// The asset-aware opcodes of ScriptV1 re-use the upgradable OP_NOP opcodes of
+// tapscript, just like OP_CHECKLOCKTIMEVERIFY did for legacy scripts. Each of
+// them reads its argument from the top of the stack when it is executed and
+// leaves the stack untouched, so the argument usually needs to be dropped
+// afterwards:
+//
+//	<asset id> OP_CHECKASSETIDVERIFY OP_DROP
+//
+// As the opcodes are evaluated during the execution of the leaf script, the
+// ones in branches of conditionals that aren't taken are not enforced.
+//
+// The opcodes introspect the asset created by the state transition that
+// carries the witness. For an asset split, that is the root asset of the
+// split, which usually is the change output of a transfer.
+const (
+	// OpCheckAssetIDVerify fails the script if the ID of the new asset
+	// doesn't match the 32-byte asset ID argument.
+	OpCheckAssetIDVerify = txscript.OP_NOP4
+
+	// OpCheckMinAmountVerify fails the script if the amount of the new
+	// asset is below the argument, which is an unsigned little-endian
+	// integer of up to 8 bytes, as pushed by the small integer opcodes.
+	OpCheckMinAmountVerify = txscript.OP_NOP5
+
+	// OpCheckScriptKeyVerify fails the script if the script key of the new
+	// asset doesn't match the 32-byte x-only public key argument. An empty
+	// argument (OP_0) refers to the script key of the spent input, which
+	// allows a script to require change to be sent back to the same key.
+	OpCheckScriptKeyVerify = txscript.OP_NOP6
+)
+
+// scriptV1VerifyFlags are the script flags ScriptV1 leaf scripts are executed
+// with. As the asset-aware opcodes are upgradable NOPs to txscript, they must
+// not be discouraged.
+const scriptV1VerifyFlags = txscript.StandardVerifyFlags &^
+	txscript.ScriptDiscourageUpgradableNops
+
+// WithScriptV1 enables the experimental script version 1. Unless the VM is
+// created with this option, inputs committing to ScriptV1 are rejected with
+// ErrInvalidScriptVersion.
+func WithScriptV1() EngineOption {
+	return func(vm *Engine) {
+		vm.scriptV1 = true
+	}
+}
+
+// assetOp is an asset-aware opcode of a leaf script, along with the argument
+// it was executed with.
+type assetOp struct {
+	opcode byte
+	arg    []byte
+}
+
+// String returns a human readable representation of the asset opcode.
+func (o assetOp) String() string {
+	return fmt.Sprintf("%s(%x)", assetOpName(o.opcode), o.arg)
+}
+
+// assetOpName returns the name of an asset-aware opcode.
+func assetOpName(opcode byte) string {
+	switch opcode {
+	case OpCheckAssetIDVerify:
+		return "OP_CHECKASSETIDVERIFY"
+	case OpCheckMinAmountVerify:
+		return "OP_CHECKMINAMOUNTVERIFY"
+	case OpCheckScriptKeyVerify:
+		return "OP_CHECKSCRIPTKEYVERIFY"
+	default:
+		return fmt.Sprintf("OP_UNKNOWN%d", opcode)
+	}
+}
+
+// isAssetOp returns true if the opcode is one of the asset-aware opcodes.
+func isAssetOp(opcode byte) bool {
+	switch opcode {
+	case OpCheckAssetIDVerify, OpCheckMinAmountVerify,
+		OpCheckScriptKeyVerify:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// assetOpContext is the part of a state transition the asset-aware opcodes
+// are evaluated against.
+type assetOpContext struct {
+	// assetID is the ID of the new asset.
+	assetID asset.ID
+
+	// amount is the amount of the new asset.
+	amount uint64
+
+	// scriptKey is the script key of the new asset.
+	scriptKey *btcec.PublicKey
+
+	// prevScriptKey is the script key of the spent input.
+	prevScriptKey *btcec.PublicKey
+}
+
+// leafScriptFromWitness returns the leaf script of a script path spend, or nil
+// for a key path spend. Just like BIP-341, the last witness element is treated
+// as the annex if there are at least two elements and it starts with 0x50.
+func leafScriptFromWitness(witness wire.TxWitness) []byte {
+	if len(witness) >= 2 && len(witness[len(witness)-1]) > 0 &&
+		witness[len(witness)-1][0] == txscript.TaprootAnnexTag {
+
+		witness = witness[:len(witness)-1]
+	}
+
+	if len(witness) < 2 {
+		return nil
+	}
+
+	return witness[len(witness)-2]
+}
+
+// checkAssetOp evaluates a single asset-aware opcode against the given
+// context.
+func checkAssetOp(op assetOp, ctx *assetOpContext) error {
+	switch op.opcode {
+	case OpCheckAssetIDVerify:
+		if len(op.arg) != sha256.Size {
+			return newErrKind(ErrInvalidAssetOpcode)
+		}
+		if !bytes.Equal(op.arg, ctx.assetID[:]) {
+			return newErrKind(ErrAssetCovenantViolation)
+		}
+
+	case OpCheckMinAmountVerify:
+		if len(op.arg) > 8 {
+			return newErrKind(ErrInvalidAssetOpcode)
+		}
+		var amountBytes [8]byte
+		copy(amountBytes[:], op.arg)
+		minAmount := binary.LittleEndian.Uint64(amountBytes[:])
+		if ctx.amount < minAmount {
+			return newErrKind(ErrAssetCovenantViolation)
+		}
+
+	case OpCheckScriptKeyVerify:
+		var expectedKey *btcec.PublicKey
+		switch len(op.arg) {
+		case 0:
+			expectedKey = ctx.prevScriptKey
+
+		case schnorr.PubKeyBytesLen:
+			var err error
+			expectedKey, err = schnorr.ParsePubKey(op.arg)
+			if err != nil {
+				return newErrInner(ErrInvalidAssetOpcode, err)
+			}
+
+		default:
+			return newErrKind(ErrInvalidAssetOpcode)
+		}
+
+		if !bytes.Equal(
+			schnorr.SerializePubKey(expectedKey),
+			schnorr.SerializePubKey(ctx.scriptKey),
+		) {
+
+			return newErrKind(ErrAssetCovenantViolation)
+		}
+
+	default:
+		return newErrKind(ErrInvalidAssetOpcode)
+	}
+
+	return nil
+}
+
+// condState is the state of a conditional branch of a leaf script.
+type condState uint8
+
+const (
+	// condFalse is a branch that isn't executed.
+	condFalse condState = iota
+
+	// condTrue is a branch that is executed.
+	condTrue
+
+	// condSkip is a branch nested in a branch that isn't executed.
+	condSkip
+)
+
+// tapscriptLeafIdx is the index of the leaf script among the scripts of the
+// engine for a script path spend. The engine first executes the signature
+// script, which is empty for a witness program, and the public key script. Once
+// the witness program is verified, the leaf script is appended as the last
+// script.
+const tapscriptLeafIdx = 2
+
+// assetOpEvaluator evaluates the asset-aware opcodes of a leaf script while
+// the script is executed by txscript. It is called before each step of the
+// script engine, and tracks the conditional branches of the leaf script in the
+// same way txscript does, so only the opcodes that are actually executed are
+// evaluated.
+type assetOpEvaluator struct {
+	// opcodes are the opcodes of the leaf script by their index.
+	opcodes []byte
+
+	// hasAssetOps is true if the leaf script contains any asset-aware
+	// opcode.
+	hasAssetOps bool
+
+	// leafReached is true once the engine started executing the leaf
+	// script.
+	leafReached bool
+
+	// condStack is the stack of conditional branches the next opcode is
+	// nested in.
+	condStack []condState
+
+	// ctx is the state transition the opcodes are evaluated against.
+	ctx *assetOpContext
+
+	// onEval is called for each evaluated asset-aware opcode, along with
+	// the result of the evaluation.
+	onEval func(op assetOp, err error)
+}
+
+// newAssetOpEvaluator creates a new evaluator for the asset-aware opcodes of
+// the given leaf script.
+func newAssetOpEvaluator(leafScript []byte, ctx *assetOpContext,
+	onEval func(op assetOp, err error)) (*assetOpEvaluator, error) {
+
+	var (
+		opcodes     []byte
+		hasAssetOps bool
+	)
+	tokenizer := txscript.MakeScriptTokenizer(0, leafScript)
+	for tokenizer.Next() {
+		opcodes = append(opcodes, tokenizer.Opcode())
+		hasAssetOps = hasAssetOps || isAssetOp(tokenizer.Opcode())
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, newErrInner(ErrInvalidTransferWitness, err)
+	}
+
+	return &assetOpEvaluator{
+		opcodes:     opcodes,
+		hasAssetOps: hasAssetOps,
+		ctx:         ctx,
+		onEval:      onEval,
+	}, nil
+}
+
+// nextLeafOpcode returns the opcode the engine executes next, if it is part of
+// the leaf script. The opcode reported by the engine must match the opcode of
+// the leaf script at the same index, otherwise the evaluator can't tell which
+// opcodes are executed and an error is returned.
+func (e *assetOpEvaluator) nextLeafOpcode(engine *txscript.Engine) (byte,
+	bool, error) {
+
+	// The program counter is formatted as script index and opcode index,
+	// followed by the disassembly of the opcode, starting with its name.
+	pc, err := engine.DisasmPC()
+	if err != nil {
+		return 0, false, newErrInner(ErrInvalidTransferWitness, err)
+	}
+	var (
+		scriptIdx, opcodeIdx int
+		opcodeName           string
+	)
+	_, err = fmt.Sscanf(
+		pc, "%02x:%04x: %s", &scriptIdx, &opcodeIdx, &opcodeName,
+	)
+	if err != nil {
+		return 0, false, newErrInner(ErrInvalidTransferWitness, err)
+	}
+
+	switch {
+	case scriptIdx < tapscriptLeafIdx:
+		return 0, false, nil
+
+	case scriptIdx > tapscriptLeafIdx || opcodeIdx >= len(e.opcodes):
+		return 0, false, newErrInner(
+			ErrInvalidTransferWitness, fmt.Errorf("unexpected "+
+				"program counter %v", pc),
+		)
+	}
+
+	// Before the first opcode of the leaf script is executed, we make sure
+	// the engine executes the same script we scanned.
+	if !e.leafReached {
+		script, err := engine.DisasmScript(tapscriptLeafIdx)
+		if err != nil {
+			return 0, false, newErrInner(
+				ErrInvalidTransferWitness, err,
+			)
+		}
+		if strings.Count(script, "\n") != len(e.opcodes) {
+			return 0, false, newErrInner(
+				ErrInvalidTransferWitness, fmt.Errorf("leaf "+
+					"script mismatch"),
+			)
+		}
+
+		e.leafReached = true
+	}
+
+	opcode, ok := txscript.OpcodeByName[opcodeName]
+	if !ok || opcode != e.opcodes[opcodeIdx] {
+		return 0, false, newErrInner(
+			ErrInvalidTransferWitness, fmt.Errorf("opcode %v "+
+				"doesn't match leaf script", pc),
+		)
+	}
+
+	return opcode, true, nil
+}
+
+// finish is called after the engine executed the script successfully. A leaf
+// script with asset-aware opcodes must have been executed, otherwise its
+// opcodes weren't enforced.
+func (e *assetOpEvaluator) finish() error {
+	if e.hasAssetOps && !e.leafReached {
+		return newErrInner(
+			ErrInvalidTransferWitness, fmt.Errorf("leaf script "+
+				"with asset opcodes not executed"),
+		)
+	}
+
+	return nil
+}
+
+// executing returns true if the current branch of the leaf script is executed.
+func (e *assetOpEvaluator) executing() bool {
+	for _, cond := range e.condStack {
+		if cond != condTrue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// beforeStep is called before the engine executes its next opcode. If the
+// opcode is an executed asset-aware opcode, it is evaluated against the state
+// transition with the argument on top of the stack.
+func (e *assetOpEvaluator) beforeStep(engine *txscript.Engine) error {
+	opcode, ok, err := e.nextLeafOpcode(engine)
+	if err != nil || !ok {
+		return err
+	}
+
+	stack := engine.GetStack()
+	switch {
+	// Just like txscript, we track the branches of the conditionals. The
+	// minimal if rule of tapscript makes sure the condition is either
+	// empty or a single 0x01 byte, otherwise the engine fails the script
+	// when executing the opcode.
+	case opcode == txscript.OP_IF || opcode == txscript.OP_NOTIF:
+		cond := condSkip
+		if e.executing() {
+			cond = condFalse
+			if len(stack) > 0 && len(stack[len(stack)-1]) > 0 {
+				cond = condTrue
+			}
+			if opcode == txscript.OP_NOTIF {
+				cond = condTrue - cond
+			}
+		}
+		e.condStack = append(e.condStack, cond)
+
+	case opcode == txscript.OP_ELSE:
+		if len(e.condStack) > 0 {
+			top := &e.condStack[len(e.condStack)-1]
+			if *top != condSkip {
+				*top = condTrue - *top
+			}
+		}
+
+	case opcode == txscript.OP_ENDIF:
+		if len(e.condStack) > 0 {
+			e.condStack = e.condStack[:len(e.condStack)-1]
+		}
+
+	case isAssetOp(opcode) && e.executing():
+		if len(stack) == 0 {
+			err := newErrKind(ErrInvalidAssetOpcode)
+			e.onEval(assetOp{opcode: opcode}, err)
+			return err
+		}
+
+		op := assetOp{
+			opcode: opcode,
+			arg:    stack[len(stack)-1],
+		}
+		err := checkAssetOp(op, e.ctx)
+		e.onEval(op, err)
+
+		return err
+	}
+
+	return nil
+}
+
+// leafAssetOpEvaluator returns the evaluator of the asset-aware opcodes of the
+// leaf script of a ScriptV1 witness. Key path spends don't reveal a script, so
+// there's no evaluator for them.
+func (vm *Engine) leafAssetOpEvaluator(inputIdx uint32, witness *asset.Witness,
+	prevAsset *asset.Asset) (*assetOpEvaluator, error) {
+
+	leafScript := leafScriptFromWitness(witness.TxWitness)
+	if leafScript == nil {
+		vm.trace.record(
+			TraceStepAssetOps, nil,
+			fmt.Sprintf("input=%d, key path spend", inputIdx),
+		)
+		return nil, nil
+	}
+
+	ctx := &assetOpContext{
+		assetID:       vm.newAsset.Genesis.ID(),
+		amount:        vm.newAsset.Amount,
+		scriptKey:     vm.newAsset.ScriptKey.PubKey,
+		prevScriptKey: prevAsset.ScriptKey.PubKey,
+	}
+	evaluator, err := newAssetOpEvaluator(
+		leafScript, ctx, func(op assetOp, err error) {
+			vm.trace.record(
+				TraceStepAssetOps, err,
+				fmt.Sprintf("input=%d, opcode=%v", inputIdx,
+					op),
+			)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluator, nil
+}
+
+// validateWitnessV1 attempts to validate a new asset's witness based on Taro
+// script version 1. On top of the rules of version 0, the asset-aware opcodes
+// executed by the leaf script of a script path spend are enforced.
+func (vm *Engine) validateWitnessV1(virtualTx *wire.MsgTx, inputIdx uint32,
+	witness *asset.Witness, prevAsset *asset.Asset) error {
+
+	if prevAsset.ScriptVersion != asset.ScriptV1 || !vm.scriptV1 {
+		return ErrInvalidScriptVersion
+	}
+
+	evaluator, err := vm.leafAssetOpEvaluator(
+		inputIdx, witness, prevAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if evaluator == nil {
+		return vm.validateTaprootWitness(
+			virtualTx, inputIdx, witness, prevAsset,
+			scriptV1VerifyFlags, nil,
+		)
+	}
+
+	err = vm.validateTaprootWitness(
+		virtualTx, inputIdx, witness, prevAsset, scriptV1VerifyFlags,
+		evaluator.beforeStep,
+	)
+	if err != nil {
+		return err
+	}
+
+	return evaluator.finish()
+}
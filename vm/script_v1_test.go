@@ -0,0 +1,284 @@
+package vm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/taroscript"
+	"github.com/stretchr/testify/require"
+)
+
+var scriptV1VectorsFileName = filepath.Join(
+	"testdata", "script_v1_vectors.json",
+)
+
+// scriptV1Vector is a test vector for the asset-aware opcodes of ScriptV1.
+type scriptV1Vector struct {
+	Name          string `json:"name"`
+	LeafScript    string `json:"leaf_script"`
+	AssetID       string `json:"asset_id"`
+	Amount        uint64 `json:"amount"`
+	ScriptKey     string `json:"script_key"`
+	PrevScriptKey string `json:"prev_script_key"`
+	ExpectedError string `json:"expected_error"`
+}
+
+// TestScriptV1Vectors executes the leaf scripts in the test vectors and
+// evaluates their asset-aware opcodes against the state transition context.
+func TestScriptV1Vectors(t *testing.T) {
+	t.Parallel()
+
+	vectorsJSON, err := os.ReadFile(scriptV1VectorsFileName)
+	require.NoError(t, err)
+
+	var vectors []scriptV1Vector
+	require.NoError(t, json.Unmarshal(vectorsJSON, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, vector := range vectors {
+		vector := vector
+		t.Run(vector.Name, func(t *testing.T) {
+			leafScript, err := hex.DecodeString(vector.LeafScript)
+			require.NoError(t, err)
+
+			ctx := &assetOpContext{
+				amount: vector.Amount,
+			}
+			assetID, err := hex.DecodeString(vector.AssetID)
+			require.NoError(t, err)
+			copy(ctx.assetID[:], assetID)
+
+			ctx.scriptKey = parseXOnlyKey(t, vector.ScriptKey)
+			ctx.prevScriptKey = parseXOnlyKey(t, vector.PrevScriptKey)
+
+			err = execLeafScript(t, leafScript, ctx)
+			if vector.ExpectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var vmErr Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(
+				t, vector.ExpectedError, vmErr.Kind.String(),
+			)
+		})
+	}
+}
+
+// parseXOnlyKey parses a hex encoded x-only public key.
+func parseXOnlyKey(t *testing.T, keyHex string) *btcec.PublicKey {
+	keyBytes, err := hex.DecodeString(keyHex)
+	require.NoError(t, err)
+
+	key, err := schnorr.ParsePubKey(keyBytes)
+	require.NoError(t, err)
+
+	return key
+}
+
+// execLeafScript executes the given leaf script as the script path spend of a
+// taproot output and evaluates the asset-aware opcodes it executes against the
+// given context.
+func execLeafScript(t *testing.T, leafScript []byte,
+	ctx *assetOpContext) error {
+
+	internalKey := randKey(t).PubKey()
+	tapLeaf := txscript.NewBaseTapLeaf(leafScript)
+	tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	tapTreeRoot := tapTree.RootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(
+		internalKey, tapTreeRoot[:],
+	)
+	pkScript, err := taroscript.PayToTaprootScript(outputKey)
+	require.NoError(t, err)
+
+	controlBlock := tapTree.LeafMerkleProofs[0].ToControlBlock(
+		internalKey,
+	)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		Witness: wire.TxWitness{leafScript, controlBlockBytes},
+	})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: pkScript})
+
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, 1000)
+	engine, err := txscript.NewEngine(
+		pkScript, tx, 0, scriptV1VerifyFlags, nil,
+		txscript.NewTxSigHashes(tx, prevOutFetcher), 1000,
+		prevOutFetcher,
+	)
+	require.NoError(t, err)
+
+	evaluator, err := newAssetOpEvaluator(
+		leafScript, ctx, func(assetOp, error) {},
+	)
+	require.NoError(t, err)
+
+	vm := &Engine{}
+	err = vm.executeScript(engine, 0, evaluator.beforeStep)
+	if err != nil {
+		return err
+	}
+
+	return evaluator.finish()
+}
+
+// scriptV1StateTransition returns a state transition that spends an asset
+// through a leaf script requiring the new asset to be sent back to the script
+// key of the input. If sameKey is false, the new asset violates the covenant.
+func scriptV1StateTransition(t *testing.T, version asset.ScriptVersion,
+	sameKey bool) (*asset.Asset, commitment.InputSet) {
+
+	privKey := randKey(t)
+	leafScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddOp(OpCheckScriptKeyVerify).
+		AddOp(txscript.OP_DROP).
+		AddInt64(1).
+		AddOp(OpCheckMinAmountVerify).
+		AddOp(txscript.OP_DROP).
+		AddData(schnorr.SerializePubKey(privKey.PubKey())).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	require.NoError(t, err)
+	tapLeaf := txscript.NewBaseTapLeaf(leafScript)
+	tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	tapTreeRoot := tapTree.RootNode.TapHash()
+	scriptKey := txscript.ComputeTaprootOutputKey(
+		privKey.PubKey(), tapTreeRoot[:],
+	)
+
+	inputAsset := randAsset(t, asset.Normal, *scriptKey)
+	inputAsset.ScriptVersion = version
+	prevID := &asset.PrevID{
+		ID:        inputAsset.Genesis.ID(),
+		ScriptKey: asset.ToSerialized(inputAsset.ScriptKey.PubKey),
+	}
+
+	newAsset := inputAsset.Copy()
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: prevID,
+	}}
+	if !sameKey {
+		newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+	}
+
+	inputs := commitment.InputSet{
+		*prevID: inputAsset,
+	}
+	virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+	require.NoError(t, err)
+	newAsset.PrevWitnesses[0].TxWitness = genTaprootScriptSpend(
+		t, *privKey, virtualTx, inputAsset, 0, tapTree, &tapLeaf,
+	)
+
+	return newAsset, inputs
+}
+
+// TestVMScriptV1 tests the execution of state transitions spending inputs that
+// commit to ScriptV1.
+func TestVMScriptV1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		version asset.ScriptVersion
+		sameKey bool
+		enable  bool
+		err     error
+	}{{
+		name:    "covenant satisfied",
+		version: asset.ScriptV1,
+		sameKey: true,
+		enable:  true,
+	}, {
+		name:    "covenant violated",
+		version: asset.ScriptV1,
+		enable:  true,
+		err:     newErrKind(ErrAssetCovenantViolation),
+	}, {
+		name:    "script v1 disabled",
+		version: asset.ScriptV1,
+		sameKey: true,
+		err:     ErrInvalidScriptVersion,
+	}, {
+		name:    "asset opcodes discouraged in script v0",
+		version: asset.ScriptV0,
+		sameKey: true,
+		enable:  true,
+		err:     newErrKind(ErrInvalidTransferWitness),
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			newAsset, inputs := scriptV1StateTransition(
+				t, testCase.version, testCase.sameKey,
+			)
+			opts := []EngineOption{WithTrace()}
+			if testCase.enable {
+				opts = append(opts, WithScriptV1())
+			}
+			vm, err := New(newAsset, nil, inputs, nil, opts...)
+			require.NoError(t, err)
+
+			err = vm.Execute()
+			switch expectedErr := testCase.err.(type) {
+			case nil:
+				require.NoError(t, err)
+
+			case Error:
+				var vmErr Error
+				require.ErrorAs(t, err, &vmErr)
+				require.Equal(t, expectedErr.Kind, vmErr.Kind)
+
+			default:
+				require.ErrorIs(t, err, expectedErr)
+			}
+		})
+	}
+
+	t.Run("key path spend", func(t *testing.T) {
+		privKey := randKey(t)
+		scriptKey := txscript.ComputeTaprootKeyNoScript(
+			privKey.PubKey(),
+		)
+		inputAsset := randAsset(t, asset.Normal, *scriptKey)
+		inputAsset.ScriptVersion = asset.ScriptV1
+		prevID := &asset.PrevID{
+			ID:        inputAsset.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(scriptKey),
+		}
+
+		newAsset := inputAsset.Copy()
+		newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+		newAsset.PrevWitnesses = []asset.Witness{{
+			PrevID: prevID,
+		}}
+		inputs := commitment.InputSet{
+			*prevID: inputAsset,
+		}
+		virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+		require.NoError(t, err)
+		newAsset.PrevWitnesses[0].TxWitness = genTaprootKeySpend(
+			t, *privKey, virtualTx, inputAsset, 0,
+		)
+
+		vm, err := New(newAsset, nil, inputs, nil, WithScriptV1())
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+	})
+}
@@ -0,0 +1,423 @@
+package vm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/taroscript"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	sigHashAllAnyoneCanPay = txscript.SigHashAll |
+		txscript.SigHashAnyOneCanPay
+
+	sigHashSingleAnyoneCanPay = txscript.SigHashSingle |
+		txscript.SigHashAnyOneCanPay
+)
+
+// genTaprootKeySpendSigHash creates a key path spend witness for the given
+// input, using the given sighash type.
+func genTaprootKeySpendSigHash(t *testing.T, privKey btcec.PrivateKey,
+	virtualTx *wire.MsgTx, newAsset, input *asset.Asset, idx uint32,
+	hashType txscript.SigHashType) wire.TxWitness {
+
+	t.Helper()
+
+	sigHashTx, err := taroscript.SigHashVirtualTx(
+		virtualTx, newAsset, input, idx, hashType,
+	)
+	require.NoError(t, err)
+	sigHash, err := taroscript.InputKeySpendSigHash(
+		sigHashTx, input, idx, hashType,
+	)
+	require.NoError(t, err)
+
+	taprootPrivKey := txscript.TweakTaprootPrivKey(privKey, nil)
+	sig, err := schnorr.Sign(taprootPrivKey, sigHash)
+	require.NoError(t, err)
+
+	sigBytes := sig.Serialize()
+	if hashType != txscript.SigHashDefault {
+		sigBytes = append(sigBytes, byte(hashType))
+	}
+
+	return wire.TxWitness{sigBytes}
+}
+
+// mergeMutation is a change other parties make to a state transition merging
+// two inputs after the first input was signed.
+type mergeMutation uint8
+
+const (
+	// mergeUnchanged leaves the state transition unchanged.
+	mergeUnchanged mergeMutation = iota
+
+	// mergeReplaceInput replaces the second input with another one of the
+	// same amount.
+	mergeReplaceInput
+
+	// mergeReorderInputs swaps the order of the inputs.
+	mergeReorderInputs
+)
+
+// sigHashMergeStateTransition creates a state transition that merges two
+// inputs into a single asset. The first input is signed with the given sighash
+// type before the mutation is applied, the second one after.
+func sigHashMergeStateTransition(t *testing.T, hashType txscript.SigHashType,
+	mutation mergeMutation) (*asset.Asset, commitment.InputSet) {
+
+	privKey1, privKey2 := randKey(t), randKey(t)
+	scriptKey1 := txscript.ComputeTaprootKeyNoScript(privKey1.PubKey())
+	scriptKey2 := txscript.ComputeTaprootKeyNoScript(privKey2.PubKey())
+
+	input1 := randAsset(t, asset.Normal, *scriptKey1)
+	input1.Amount = 10
+	input2 := input1.Copy()
+	input2.ScriptKey = asset.NewScriptKey(scriptKey2)
+	input2.Amount = 5
+
+	assetID := input1.Genesis.ID()
+	prevID1 := asset.PrevID{
+		OutPoint:  wire.OutPoint{Index: 1},
+		ID:        assetID,
+		ScriptKey: asset.ToSerialized(scriptKey1),
+	}
+	prevID2 := asset.PrevID{
+		OutPoint:  wire.OutPoint{Index: 2},
+		ID:        assetID,
+		ScriptKey: asset.ToSerialized(scriptKey2),
+	}
+
+	newAsset := input1.Copy()
+	newAsset.Amount = input1.Amount + input2.Amount
+	newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &prevID1,
+	}, {
+		PrevID: &prevID2,
+	}}
+
+	inputs := commitment.InputSet{
+		prevID1: input1,
+		prevID2: input2,
+	}
+	virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+	require.NoError(t, err)
+	witness1 := genTaprootKeySpendSigHash(
+		t, *privKey1, virtualTx, newAsset, input1, 0, hashType,
+	)
+
+	idx1, idx2 := 0, 1
+	switch mutation {
+	case mergeReplaceInput:
+		delete(inputs, prevID2)
+		prevID2.OutPoint.Index = 3
+		inputs[prevID2] = input2
+
+	case mergeReorderInputs:
+		newAsset.PrevWitnesses[0].PrevID = &prevID2
+		newAsset.PrevWitnesses[1].PrevID = &prevID1
+		idx1, idx2 = 1, 0
+	}
+
+	virtualTx, _, err = taroscript.VirtualTx(newAsset, inputs)
+	require.NoError(t, err)
+	witness2 := genTaprootKeySpend(
+		t, *privKey2, virtualTx, input2, uint32(idx2),
+	)
+
+	newAsset.PrevWitnesses[idx1].TxWitness = witness1
+	newAsset.PrevWitnesses[idx2].TxWitness = witness2
+
+	return newAsset, inputs
+}
+
+// sigHashSplitStateTransition creates a state transition that splits an input
+// into a change output and two other outputs, signed with the given sighash
+// type. If replaceOutput is true, one of the other outputs is replaced after
+// the input was signed.
+func sigHashSplitStateTransition(t *testing.T, hashType txscript.SigHashType,
+	replaceOutput bool) (*asset.Asset, commitment.SplitSet,
+	commitment.InputSet) {
+
+	privKey := randKey(t)
+	scriptKey := txscript.ComputeTaprootKeyNoScript(privKey.PubKey())
+
+	genesisOutPoint := wire.OutPoint{}
+	genesisAsset := randAsset(t, asset.Normal, *scriptKey)
+	genesisAsset.Amount = 3
+
+	assetID := genesisAsset.Genesis.ID()
+	rootLocator := &commitment.SplitLocator{
+		OutputIndex: 0,
+		AssetID:     assetID,
+		ScriptKey:   asset.ToSerialized(genesisAsset.ScriptKey.PubKey),
+		Amount:      1,
+	}
+	newLocator := func() *commitment.SplitLocator {
+		return &commitment.SplitLocator{
+			OutputIndex: 2,
+			AssetID:     assetID,
+			ScriptKey:   asset.ToSerialized(randKey(t).PubKey()),
+			Amount:      1,
+		}
+	}
+	externalLocators := []*commitment.SplitLocator{{
+		OutputIndex: 1,
+		AssetID:     assetID,
+		ScriptKey:   asset.ToSerialized(randKey(t).PubKey()),
+		Amount:      1,
+	}, newLocator()}
+
+	splitCommitment, err := commitment.NewSplitCommitment(
+		genesisAsset, genesisOutPoint, rootLocator, externalLocators...,
+	)
+	require.NoError(t, err)
+
+	virtualTx, _, err := taroscript.VirtualTx(
+		splitCommitment.RootAsset, splitCommitment.PrevAssets,
+	)
+	require.NoError(t, err)
+	witness := genTaprootKeySpendSigHash(
+		t, *privKey, virtualTx, splitCommitment.RootAsset, genesisAsset,
+		0, hashType,
+	)
+
+	if replaceOutput {
+		externalLocators[1] = newLocator()
+		splitCommitment, err = commitment.NewSplitCommitment(
+			genesisAsset, genesisOutPoint, rootLocator,
+			externalLocators...,
+		)
+		require.NoError(t, err)
+	}
+	splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = witness
+
+	return splitCommitment.RootAsset, splitCommitment.SplitAssets,
+		splitCommitment.PrevAssets
+}
+
+// TestVMSigHashTypes tests which changes to a state transition other parties
+// can make after an input was signed with each of the supported sighash
+// types.
+func TestVMSigHashTypes(t *testing.T) {
+	t.Parallel()
+
+	hashTypes := []txscript.SigHashType{
+		txscript.SigHashDefault,
+		txscript.SigHashAll,
+		txscript.SigHashSingle,
+		sigHashAllAnyoneCanPay,
+		sigHashSingleAnyoneCanPay,
+	}
+
+	testCases := []struct {
+		name string
+
+		// execute creates the state transition for the given sighash
+		// type and executes it.
+		execute func(*testing.T, txscript.SigHashType) error
+
+		// valid is the set of sighash types that are still valid after
+		// the change.
+		valid []txscript.SigHashType
+	}{{
+		name: "merge unchanged",
+		execute: func(t *testing.T,
+			hashType txscript.SigHashType) error {
+
+			newAsset, inputs := sigHashMergeStateTransition(
+				t, hashType, mergeUnchanged,
+			)
+			return ValidateStateTransition(
+				newAsset, nil, inputs, nil,
+			)
+		},
+		valid: hashTypes,
+	}, {
+		name: "merge with replaced input",
+		execute: func(t *testing.T,
+			hashType txscript.SigHashType) error {
+
+			newAsset, inputs := sigHashMergeStateTransition(
+				t, hashType, mergeReplaceInput,
+			)
+			return ValidateStateTransition(
+				newAsset, nil, inputs, nil,
+			)
+		},
+		valid: []txscript.SigHashType{
+			sigHashAllAnyoneCanPay, sigHashSingleAnyoneCanPay,
+		},
+	}, {
+		name: "merge with reordered inputs",
+		execute: func(t *testing.T,
+			hashType txscript.SigHashType) error {
+
+			newAsset, inputs := sigHashMergeStateTransition(
+				t, hashType, mergeReorderInputs,
+			)
+			return ValidateStateTransition(
+				newAsset, nil, inputs, nil,
+			)
+		},
+		valid: []txscript.SigHashType{
+			sigHashAllAnyoneCanPay, sigHashSingleAnyoneCanPay,
+		},
+	}, {
+		name: "split unchanged",
+		execute: func(t *testing.T,
+			hashType txscript.SigHashType) error {
+
+			return executeSplit(sigHashSplitStateTransition(
+				t, hashType, false,
+			))
+		},
+		valid: hashTypes,
+	}, {
+		name: "split with replaced output",
+		execute: func(t *testing.T,
+			hashType txscript.SigHashType) error {
+
+			return executeSplit(sigHashSplitStateTransition(
+				t, hashType, true,
+			))
+		},
+		valid: []txscript.SigHashType{
+			txscript.SigHashSingle, sigHashSingleAnyoneCanPay,
+		},
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		for _, hashType := range hashTypes {
+			hashType := hashType
+			name := fmt.Sprintf(
+				"%s/%#x", testCase.name, hashType,
+			)
+			t.Run(name, func(t *testing.T) {
+				err := testCase.execute(t, hashType)
+				for _, validType := range testCase.valid {
+					if validType == hashType {
+						require.NoError(t, err)
+						return
+					}
+				}
+
+				var vmErr Error
+				require.ErrorAs(t, err, &vmErr)
+				require.Equal(
+					t, ErrInvalidTransferWitness,
+					vmErr.Kind,
+				)
+			})
+		}
+	}
+}
+
+// executeSplit executes a split state transition for each of its splits.
+func executeSplit(newAsset *asset.Asset, splitSet commitment.SplitSet,
+	inputs commitment.InputSet) error {
+
+	for _, splitAsset := range maps.Values(splitSet) {
+		err := ValidateStateTransition(newAsset, splitAsset, inputs, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TestWitnessSigHashType tests that only valid and consistent sighash types
+// are accepted in asset witnesses.
+func TestWitnessSigHashType(t *testing.T) {
+	t.Parallel()
+
+	sig, err := schnorr.Sign(randKey(t), make([]byte, 32))
+	require.NoError(t, err)
+	sigBytes := sig.Serialize()
+	withType := func(hashType txscript.SigHashType) []byte {
+		return append(append([]byte{}, sigBytes...), byte(hashType))
+	}
+	controlBlock := make([]byte, 65)
+
+	testCases := []struct {
+		name     string
+		witness  wire.TxWitness
+		hashType txscript.SigHashType
+		valid    bool
+	}{{
+		name:     "default",
+		witness:  wire.TxWitness{sigBytes},
+		hashType: txscript.SigHashDefault,
+		valid:    true,
+	}, {
+		name:     "single anyone can pay",
+		witness:  wire.TxWitness{withType(sigHashSingleAnyoneCanPay)},
+		hashType: sigHashSingleAnyoneCanPay,
+		valid:    true,
+	}, {
+		name:    "explicit default",
+		witness: wire.TxWitness{withType(txscript.SigHashDefault)},
+	}, {
+		name:    "none",
+		witness: wire.TxWitness{withType(txscript.SigHashNone)},
+	}, {
+		name: "none anyone can pay",
+		witness: wire.TxWitness{withType(
+			txscript.SigHashNone | txscript.SigHashAnyOneCanPay,
+		)},
+	}, {
+		name:    "unknown",
+		witness: wire.TxWitness{withType(0x04)},
+	}, {
+		name: "mixed script path",
+		witness: wire.TxWitness{
+			withType(txscript.SigHashAll), sigBytes, []byte{},
+			controlBlock,
+		},
+	}, {
+		name: "consistent script path",
+		witness: wire.TxWitness{
+			withType(txscript.SigHashSingle),
+			withType(txscript.SigHashSingle), []byte{},
+			controlBlock,
+		},
+		hashType: txscript.SigHashSingle,
+		valid:    true,
+	}, {
+		name: "control block ignored",
+		witness: wire.TxWitness{
+			sigBytes, []byte{}, withType(txscript.SigHashNone),
+		},
+		hashType: txscript.SigHashDefault,
+		valid:    true,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			hashType, err := witnessSigHashType(testCase.witness)
+			if !testCase.valid {
+				var vmErr Error
+				require.ErrorAs(t, err, &vmErr)
+				require.Equal(
+					t, ErrInvalidSigHashFlag, vmErr.Kind,
+				)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.hashType, hashType)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package vm
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/taro/asset"
+)
+
+const (
+	// TraceStepGenesis is the step that validates a genesis state
+	// transition.
+	TraceStepGenesis = "genesis"
+
+	// TraceStepSplit is the step that validates an asset split against
+	// the split commitment root of its root asset.
+	TraceStepSplit = "split_validation"
+
+	// TraceStepAssetParams is the step that checks that a new asset
+	// matches the static parameters of one of its inputs.
+	TraceStepAssetParams = "asset_params"
+
+	// TraceStepVirtualTx is the step that constructs the virtual
+	// transaction representing the state transition.
+	TraceStepVirtualTx = "virtual_tx"
+
+	// TraceStepLockTimes is the step that checks the lock times of the
+	// inputs.
+	TraceStepLockTimes = "lock_times"
+
+	// TraceStepInputTree is the step that checks the sum of the input tree
+	// against the output amount to prevent inflation.
+	TraceStepInputTree = "input_tree"
+
+	// TraceStepAssetOps is the step that evaluates a single asset-aware
+	// opcode of a ScriptV1 leaf script.
+	TraceStepAssetOps = "asset_opcodes"
+
+	// TraceStepScript is a single step of the txscript engine validating
+	// the witness of an input.
+	TraceStepScript = "script"
+)
+
+// TraceStep is a single step recorded while the VM executes a state
+// transition.
+type TraceStep struct {
+	// Name is the name of the executed step, one of the TraceStep*
+	// constants.
+	Name string
+
+	// Details holds human readable details of the step, such as the values
+	// that were checked.
+	Details []string
+
+	// Err is the error the step failed with, or nil if it succeeded.
+	Err error
+}
+
+// Trace records the steps the VM executes while validating a state transition,
+// which helps to debug failed transfers.
+type Trace struct {
+	// Steps is the list of executed steps, in order.
+	Steps []TraceStep
+}
+
+// record appends a new step to the trace. It's a no-op on a nil trace, so the
+// VM doesn't need to check whether tracing is enabled.
+func (t *Trace) record(name string, err error, details ...string) {
+	if t == nil {
+		return
+	}
+
+	t.Steps = append(t.Steps, TraceStep{
+		Name:    name,
+		Details: details,
+		Err:     err,
+	})
+}
+
+// Err returns the error of the first failed step of the trace, or nil if all
+// steps succeeded.
+func (t *Trace) Err() error {
+	if t == nil {
+		return nil
+	}
+
+	for _, step := range t.Steps {
+		if step.Err != nil {
+			return step.Err
+		}
+	}
+
+	return nil
+}
+
+// fmtPrevID formats a prev ID for the details of a trace step.
+func fmtPrevID(prevID *asset.PrevID) string {
+	if prevID == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("outpoint=%v, asset_id=%x, script_key=%x",
+		prevID.OutPoint, prevID.ID[:], prevID.ScriptKey[:])
+}
+
+// EngineOption is a functional option that modifies the behavior of the VM.
+type EngineOption func(*Engine)
+
+// WithTrace enables tracing of the steps the VM executes. The resulting trace
+// can be obtained through Engine.Trace after the execution.
+func WithTrace() EngineOption {
+	return func(vm *Engine) {
+		vm.trace = &Trace{}
+	}
+}
@@ -0,0 +1,268 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/commitment"
+	"github.com/lightninglabs/taro/taroscript"
+)
+
+// StateTransition is a self-contained Taro asset state transition that can be
+// validated offline, without access to a Taro daemon or the chain.
+type StateTransition struct {
+	// NewAsset is the asset created by the state transition. If the state
+	// transition creates an asset split, this is the root asset of the
+	// split.
+	NewAsset *asset.Asset
+
+	// SplitAsset is the optional asset split committed to within the split
+	// commitment root of NewAsset.
+	SplitAsset *commitment.SplitAsset
+
+	// PrevAssets maps the inputs of NewAsset by their PrevID to the asset
+	// they spend.
+	PrevAssets commitment.InputSet
+
+	// ChainCtx is the optional on-chain context of the state transition.
+	// If it's nil, the lock times of the inputs aren't enforced.
+	ChainCtx *taroscript.ChainContext
+}
+
+// Validate executes the state transition in a new instance of the Taro VM and
+// returns an error if it's invalid.
+func (s *StateTransition) Validate(opts ...EngineOption) error {
+	return ValidateStateTransition(
+		s.NewAsset, s.SplitAsset, s.PrevAssets, s.ChainCtx, opts...,
+	)
+}
+
+// ValidateStateTransition executes the given state transition in a new
+// instance of the Taro VM and returns an error if it's invalid. This is the
+// stable entry point for validating state transitions outside of the daemon.
+func ValidateStateTransition(newAsset *asset.Asset,
+	splitAsset *commitment.SplitAsset, prevAssets commitment.InputSet,
+	chainCtx *taroscript.ChainContext, opts ...EngineOption) error {
+
+	if newAsset == nil {
+		return fmt.Errorf("new asset must be specified")
+	}
+
+	engine, err := New(newAsset, splitAsset, prevAssets, chainCtx, opts...)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+// jsonPrevAsset is the JSON representation of an input of a state transition.
+type jsonPrevAsset struct {
+	// OutPoint is the outpoint of the anchor transaction output that held
+	// the input, formatted as txid:index.
+	OutPoint string `json:"out_point"`
+
+	// Asset is the hex encoded TLV serialization of the input.
+	Asset string `json:"asset"`
+
+	// AnchorHeight is the height of the block the input was confirmed in,
+	// if it's known. It's only used if the state transition has a block
+	// height.
+	AnchorHeight *uint32 `json:"anchor_height,omitempty"`
+}
+
+// jsonStateTransition is the JSON representation of a state transition. All
+// assets are hex encoded TLV serializations.
+type jsonStateTransition struct {
+	NewAsset         string          `json:"new_asset"`
+	SplitAsset       string          `json:"split_asset,omitempty"`
+	SplitOutputIndex uint32          `json:"split_output_index,omitempty"`
+	PrevAssets       []jsonPrevAsset `json:"prev_assets"`
+	BlockHeight      uint32          `json:"block_height,omitempty"`
+}
+
+// encodeAssetHex returns the hex encoded TLV serialization of an asset.
+func encodeAssetHex(a *asset.Asset) (string, error) {
+	var buf bytes.Buffer
+	if err := a.Encode(&buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// decodeAssetHex decodes an asset from its hex encoded TLV serialization.
+func decodeAssetHex(assetHex string) (*asset.Asset, error) {
+	assetBytes, err := hex.DecodeString(assetHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var a asset.Asset
+	if err := a.Decode(bytes.NewReader(assetBytes)); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// parseOutPoint parses an outpoint formatted as txid:index.
+func parseOutPoint(outPoint string) (wire.OutPoint, error) {
+	parts := strings.Split(outPoint, ":")
+	if len(parts) != 2 {
+		return wire.OutPoint{}, fmt.Errorf("outpoint should be of "+
+			"the form txid:index: %v", outPoint)
+	}
+
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return wire.OutPoint{}, fmt.Errorf("invalid txid: %w", err)
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return wire.OutPoint{}, fmt.Errorf("invalid output index: %w",
+			err)
+	}
+
+	return wire.OutPoint{
+		Hash:  *hash,
+		Index: uint32(index),
+	}, nil
+}
+
+// MarshalJSON encodes the state transition as JSON.
+func (s *StateTransition) MarshalJSON() ([]byte, error) {
+	if s.NewAsset == nil {
+		return nil, fmt.Errorf("new asset must be specified")
+	}
+
+	var (
+		jsonTransition jsonStateTransition
+		err            error
+	)
+	jsonTransition.NewAsset, err = encodeAssetHex(s.NewAsset)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode new asset: %w", err)
+	}
+
+	if s.SplitAsset != nil {
+		jsonTransition.SplitAsset, err = encodeAssetHex(
+			&s.SplitAsset.Asset,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode split asset: "+
+				"%w", err)
+		}
+		jsonTransition.SplitOutputIndex = s.SplitAsset.OutputIndex
+	}
+
+	if s.ChainCtx != nil {
+		jsonTransition.BlockHeight = s.ChainCtx.BlockHeight
+	}
+
+	jsonTransition.PrevAssets = make([]jsonPrevAsset, 0, len(s.PrevAssets))
+	for prevID, prevAsset := range s.PrevAssets {
+		prevAssetHex, err := encodeAssetHex(prevAsset)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode input %v: %w",
+				prevID.OutPoint, err)
+		}
+
+		jsonPrevAsset := jsonPrevAsset{
+			OutPoint: prevID.OutPoint.String(),
+			Asset:    prevAssetHex,
+		}
+		if s.ChainCtx != nil {
+			inputHeights := s.ChainCtx.InputHeights
+			if anchorHeight, ok := inputHeights[prevID]; ok {
+				jsonPrevAsset.AnchorHeight = &anchorHeight
+			}
+		}
+		jsonTransition.PrevAssets = append(
+			jsonTransition.PrevAssets, jsonPrevAsset,
+		)
+	}
+
+	return json.Marshal(&jsonTransition)
+}
+
+// UnmarshalJSON decodes the state transition from JSON. The PrevID of each
+// input is derived from its outpoint along with the ID and script key of the
+// spent asset. The chain context is only set if a block height is given.
+func (s *StateTransition) UnmarshalJSON(data []byte) error {
+	var jsonTransition jsonStateTransition
+	if err := json.Unmarshal(data, &jsonTransition); err != nil {
+		return err
+	}
+
+	if jsonTransition.NewAsset == "" {
+		return fmt.Errorf("new asset must be specified")
+	}
+	newAsset, err := decodeAssetHex(jsonTransition.NewAsset)
+	if err != nil {
+		return fmt.Errorf("unable to decode new asset: %w", err)
+	}
+
+	var splitAsset *commitment.SplitAsset
+	if jsonTransition.SplitAsset != "" {
+		split, err := decodeAssetHex(jsonTransition.SplitAsset)
+		if err != nil {
+			return fmt.Errorf("unable to decode split asset: %w",
+				err)
+		}
+		splitAsset = &commitment.SplitAsset{
+			Asset:       *split,
+			OutputIndex: jsonTransition.SplitOutputIndex,
+		}
+	}
+
+	var chainCtx *taroscript.ChainContext
+	if jsonTransition.BlockHeight != 0 {
+		chainCtx = &taroscript.ChainContext{
+			BlockHeight:  jsonTransition.BlockHeight,
+			InputHeights: make(map[asset.PrevID]uint32),
+		}
+	}
+
+	prevAssets := make(commitment.InputSet, len(jsonTransition.PrevAssets))
+	for idx, jsonPrevAsset := range jsonTransition.PrevAssets {
+		outPoint, err := parseOutPoint(jsonPrevAsset.OutPoint)
+		if err != nil {
+			return fmt.Errorf("invalid input %d: %w", idx, err)
+		}
+		prevAsset, err := decodeAssetHex(jsonPrevAsset.Asset)
+		if err != nil {
+			return fmt.Errorf("unable to decode input %d: %w", idx,
+				err)
+		}
+
+		prevID := asset.PrevID{
+			OutPoint: outPoint,
+			ID:       prevAsset.Genesis.ID(),
+			ScriptKey: asset.ToSerialized(
+				prevAsset.ScriptKey.PubKey,
+			),
+		}
+		prevAssets[prevID] = prevAsset
+		if chainCtx != nil && jsonPrevAsset.AnchorHeight != nil {
+			anchorHeight := *jsonPrevAsset.AnchorHeight
+			chainCtx.InputHeights[prevID] = anchorHeight
+		}
+	}
+
+	*s = StateTransition{
+		NewAsset:   newAsset,
+		SplitAsset: splitAsset,
+		PrevAssets: prevAssets,
+		ChainCtx:   chainCtx,
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package vm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lightninglabs/taro/asset"
+	"github.com/lightninglabs/taro/taroscript"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+// assertAssetEncodingEqual asserts that two assets have the same TLV encoding.
+// Some fields, like the internal key of a family key, aren't part of the
+// encoding, so the assets can't be compared directly.
+func assertAssetEncodingEqual(t *testing.T, expected, actual *asset.Asset) {
+	t.Helper()
+
+	expectedHex, err := encodeAssetHex(expected)
+	require.NoError(t, err)
+	actualHex, err := encodeAssetHex(actual)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedHex, actualHex)
+}
+
+// assertTransitionRoundTrip encodes the state transition as JSON, decodes it
+// again and returns the decoded state transition.
+func assertTransitionRoundTrip(t *testing.T,
+	transition *StateTransition) *StateTransition {
+
+	t.Helper()
+
+	transitionJSON, err := json.Marshal(transition)
+	require.NoError(t, err)
+
+	var decoded StateTransition
+	require.NoError(t, json.Unmarshal(transitionJSON, &decoded))
+
+	assertAssetEncodingEqual(t, transition.NewAsset, decoded.NewAsset)
+	require.Len(t, decoded.PrevAssets, len(transition.PrevAssets))
+	for prevID, prevAsset := range transition.PrevAssets {
+		require.Contains(t, decoded.PrevAssets, prevID)
+		assertAssetEncodingEqual(
+			t, prevAsset, decoded.PrevAssets[prevID],
+		)
+	}
+	require.Equal(t, transition.ChainCtx, decoded.ChainCtx)
+
+	if transition.SplitAsset == nil {
+		require.Nil(t, decoded.SplitAsset)
+	} else {
+		require.Equal(
+			t, transition.SplitAsset.OutputIndex,
+			decoded.SplitAsset.OutputIndex,
+		)
+		assertAssetEncodingEqual(
+			t, &transition.SplitAsset.Asset,
+			&decoded.SplitAsset.Asset,
+		)
+	}
+
+	return &decoded
+}
+
+// TestStateTransitionJSON tests that state transitions can be validated after
+// a round trip through their JSON encoding.
+func TestStateTransitionJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("normal state transition", func(t *testing.T) {
+		newAsset, _, inputs := normalStateTransition(t)
+		decoded := assertTransitionRoundTrip(t, &StateTransition{
+			NewAsset:   newAsset,
+			PrevAssets: inputs,
+		})
+		require.NoError(t, decoded.Validate())
+	})
+
+	t.Run("split state transition", func(t *testing.T) {
+		newAsset, splitSet, inputs := splitStateTransition(t)
+		for _, splitAsset := range maps.Values(splitSet) {
+			transition := &StateTransition{
+				NewAsset:   newAsset,
+				SplitAsset: splitAsset,
+				PrevAssets: inputs,
+			}
+			decoded := assertTransitionRoundTrip(t, transition)
+			require.NoError(t, decoded.Validate())
+		}
+	})
+
+	t.Run("lock time enforced", func(t *testing.T) {
+		newAsset, inputs, prevID := lockedStateTransition(t, 0, 6)
+		chainCtx := func(height uint32) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: height,
+				InputHeights: map[asset.PrevID]uint32{
+					prevID: 1000,
+				},
+			}
+		}
+
+		decoded := assertTransitionRoundTrip(t, &StateTransition{
+			NewAsset:   newAsset,
+			PrevAssets: inputs,
+			ChainCtx:   chainCtx(1005),
+		})
+		var vmErr Error
+		require.ErrorAs(t, decoded.Validate(), &vmErr)
+		require.Equal(t, ErrRelativeLockTimeNotReached, vmErr.Kind)
+
+		decoded = assertTransitionRoundTrip(t, &StateTransition{
+			NewAsset:   newAsset,
+			PrevAssets: inputs,
+			ChainCtx:   chainCtx(1006),
+		})
+		require.NoError(t, decoded.Validate())
+
+		// An input without a known anchor height can't be checked
+		// against its relative lock time, even at a height of zero.
+		decoded = assertTransitionRoundTrip(t, &StateTransition{
+			NewAsset:   newAsset,
+			PrevAssets: inputs,
+			ChainCtx: &taroscript.ChainContext{
+				BlockHeight:  1006,
+				InputHeights: map[asset.PrevID]uint32{},
+			},
+		})
+		require.ErrorAs(t, decoded.Validate(), &vmErr)
+		require.Equal(t, ErrMissingInputHeight, vmErr.Kind)
+
+		decoded = assertTransitionRoundTrip(t, &StateTransition{
+			NewAsset:   newAsset,
+			PrevAssets: inputs,
+			ChainCtx: &taroscript.ChainContext{
+				BlockHeight: 6,
+				InputHeights: map[asset.PrevID]uint32{
+					prevID: 0,
+				},
+			},
+		})
+		require.NoError(t, decoded.Validate())
+	})
+
+	t.Run("missing input", func(t *testing.T) {
+		newAsset, _, _ := normalStateTransition(t)
+		err := ValidateStateTransition(newAsset, nil, nil, nil)
+		require.ErrorIs(t, err, ErrNoInputs)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		var decoded StateTransition
+		require.Error(t, json.Unmarshal([]byte(`{}`), &decoded))
+		require.Error(t, json.Unmarshal([]byte(`{
+			"new_asset": "00",
+			"prev_assets": []
+		}`), &decoded))
+	})
+}
@@ -3,6 +3,7 @@ package vm
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/txscript"
@@ -27,18 +28,43 @@ type Engine struct {
 	// prevAssets maps newAsset's inputs by the hash of their PrevID to
 	// their asset.
 	prevAssets commitment.InputSet
+
+	// chainCtx is the optional on-chain context of the state transition
+	// used to enforce the lock times of the inputs.
+	chainCtx *taroscript.ChainContext
+
+	// trace records the executed steps if tracing is enabled.
+	trace *Trace
+
+	// scriptV1 indicates whether the experimental script version 1 is
+	// enabled.
+	scriptV1 bool
 }
 
 // New returns a new virtual machine capable of executing and verifying Taro
-// asset state transitions.
+// asset state transitions. If the chain context is nil, the absolute and
+// relative lock times of the inputs aren't enforced.
 func New(newAsset *asset.Asset, splitAsset *commitment.SplitAsset,
-	prevAssets commitment.InputSet) (*Engine, error) {
+	prevAssets commitment.InputSet, chainCtx *taroscript.ChainContext,
+	opts ...EngineOption) (*Engine, error) {
 
-	return &Engine{
+	vm := &Engine{
 		newAsset:   newAsset,
 		splitAsset: splitAsset,
 		prevAssets: prevAssets,
-	}, nil
+		chainCtx:   chainCtx,
+	}
+	for _, opt := range opts {
+		opt(vm)
+	}
+
+	return vm, nil
+}
+
+// Trace returns the trace of the executed steps, or nil if tracing isn't
+// enabled.
+func (vm *Engine) Trace() *Trace {
+	return vm.trace
 }
 
 // matchesPrevGenesis determines whether certain key parameters of the new
@@ -101,6 +127,80 @@ func matchesAssetParams(newAsset, prevAsset *asset.Asset,
 	return nil
 }
 
+// matchesAssetParams ensures that a new asset continues to adhere to the
+// static parameters of its predecessor, recording the check in the trace.
+func (vm *Engine) matchesAssetParams(newAsset, prevAsset *asset.Asset,
+	prevAssetWitness *asset.Witness) error {
+
+	err := matchesAssetParams(newAsset, prevAsset, prevAssetWitness)
+	vm.trace.record(
+		TraceStepAssetParams, err,
+		"prev_id: "+fmtPrevID(prevAssetWitness.PrevID),
+		fmt.Sprintf("new_asset_id=%x, prev_asset_id=%x",
+			newAsset.Genesis.ID(), prevAsset.Genesis.ID()),
+		fmt.Sprintf("new_type=%v, prev_type=%v", newAsset.Type,
+			prevAsset.Type),
+	)
+
+	return err
+}
+
+// validateLockTimes ensures that none of the inputs of the state transition
+// are spent before their absolute or relative lock time has passed.
+func (vm *Engine) validateLockTimes() error {
+	// Without any chain context, there's nothing we can check the lock
+	// times against.
+	if vm.chainCtx == nil {
+		return nil
+	}
+
+	blockHeight := uint64(vm.chainCtx.BlockHeight)
+	for inputIdx, witness := range vm.newAsset.PrevWitnesses {
+		if witness.PrevID == nil {
+			continue
+		}
+
+		prevID := *witness.PrevID
+		prevAsset, ok := vm.prevAssets[prevID]
+		if !ok {
+			continue
+		}
+
+		err := vm.validateInputLockTimes(prevID, prevAsset, blockHeight)
+		if err != nil {
+			return withInputContext(err, uint32(inputIdx), prevAsset)
+		}
+	}
+
+	return nil
+}
+
+// validateInputLockTimes ensures that the given input of the state transition
+// isn't spent before its absolute or relative lock time has passed.
+func (vm *Engine) validateInputLockTimes(prevID asset.PrevID,
+	prevAsset *asset.Asset, blockHeight uint64) error {
+
+	if prevAsset.LockTime != 0 && blockHeight < prevAsset.LockTime {
+		return newErrKind(ErrLockTimeNotReached)
+	}
+
+	if prevAsset.RelativeLockTime == 0 {
+		return nil
+	}
+
+	inputHeight, ok := vm.chainCtx.InputHeights[prevID]
+	if !ok {
+		return newErrKind(ErrMissingInputHeight)
+	}
+
+	unlockHeight := uint64(inputHeight) + prevAsset.RelativeLockTime
+	if blockHeight < unlockHeight {
+		return newErrKind(ErrRelativeLockTimeNotReached)
+	}
+
+	return nil
+}
+
 // validateSplit attempts to validate an asset resulting from a split on its
 // input. This is done by verifying the asset split is committed to within the
 // new asset's split commitment root through its split commitment proof.
@@ -136,13 +236,33 @@ func (vm *Engine) validateSplit() error {
 	if !ok {
 		return ErrNoInputs
 	}
-	err := matchesAssetParams(
-		&vm.splitAsset.Asset, prevAsset, &rootWitness,
+
+	err := vm.validateSplitInput(prevAsset, &rootWitness, &splitWitness)
+	return withInputContext(err, 0, prevAsset)
+}
+
+// validateSplitInput validates the split asset against the input of the root
+// asset it inherits its prev ID from.
+func (vm *Engine) validateSplitInput(prevAsset *asset.Asset, rootWitness,
+	splitWitness *asset.Witness) error {
+
+	err := vm.matchesAssetParams(
+		&vm.splitAsset.Asset, prevAsset, rootWitness,
 	)
 	if err != nil {
 		return err
 	}
 
+	// A collectible can't be split, it can only be moved whole to a
+	// single split output, leaving behind a zero-value root asset. The
+	// split asset is either that output or the root asset itself.
+	if vm.newAsset.Type == asset.Collectible &&
+		(vm.newAsset.Amount != 0 || (vm.splitAsset.Amount != 0 &&
+			vm.splitAsset.Amount != prevAsset.Amount)) {
+
+		return newErrKind(ErrCollectibleSplit)
+	}
+
 	// If the split requires a zero-value root asset, the root asset must
 	// be unspendable. Non-inflation of the split is enforced elsewhere, at
 	// the end of vm.Execute().
@@ -187,44 +307,52 @@ func (vm *Engine) validateSplit() error {
 func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	witness *asset.Witness, prevAsset *asset.Asset) error {
 
-	// We only support version 0 scripts atm.
 	if prevAsset.ScriptVersion != asset.ScriptV0 {
 		return ErrInvalidScriptVersion
 	}
 
+	return vm.validateTaprootWitness(
+		virtualTx, inputIdx, witness, prevAsset,
+		txscript.StandardVerifyFlags, nil,
+	)
+}
+
+// validateTaprootWitness validates a new asset's witness by executing it with
+// the given script flags in the Tapscript VM against the virtual transaction
+// represented by the state transition. The optional hook is called before
+// each step of the script execution.
+func (vm *Engine) validateTaprootWitness(virtualTx *wire.MsgTx,
+	inputIdx uint32, witness *asset.Witness, prevAsset *asset.Asset,
+	flags txscript.ScriptFlags, hook scriptStepHook) error {
+
 	// An input MUST have a prev out and also a valid witness.
 	if witness.PrevID == nil || len(witness.TxWitness) == 0 {
 		return newErrKind(ErrInvalidTransferWitness)
 	}
 
 	// The parameters of the new and old asset much match exactly.
-	err := matchesAssetParams(vm.newAsset, prevAsset, witness)
+	err := vm.matchesAssetParams(vm.newAsset, prevAsset, witness)
 	if err != nil {
 		return err
 	}
 
-	for _, witnessItem := range witness.TxWitness {
-		// Signatures can either be 64, with SIGHASH_DEFAULT, or 65
-		// bytes otherwise.
-		//
-		// TODO(roasbeef): remove? will go thru normal sig parse
-		// checks, untested as is
-		// TODO: This is wrong
-		if len(witnessItem) == 65 {
-			_, err = schnorr.ParseSignature(witnessItem[1:])
-			if err != nil {
-				// Not a valid signature, so it must be some
-				// arbitrary data push.
-				continue
-			}
-			return newErrKind(ErrInvalidSigHashFlag)
-		}
+	// All signatures of the witness must use the same sighash type, as it
+	// determines the virtual transaction they're validated against.
+	hashType, err := witnessSigHashType(witness.TxWitness)
+	if err != nil {
+		return err
+	}
+	sigHashTx, err := taroscript.SigHashVirtualTx(
+		virtualTx, vm.newAsset, prevAsset, inputIdx, hashType,
+	)
+	if err != nil {
+		return err
 	}
 
 	// Update the virtual transaction input with details for the specific
 	// Taro input and proceed to validate its witness.
-	virtualTxCopy := taroscript.VirtualTxWithInput(
-		virtualTx, prevAsset, inputIdx, witness.TxWitness,
+	virtualTxCopy := taroscript.VirtualTxWithInputSigHash(
+		sigHashTx, prevAsset, inputIdx, witness.TxWitness, hashType,
 	)
 
 	prevOutFetcher, err := taroscript.InputPrevOutFetcher(*prevAsset)
@@ -246,19 +374,142 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	// execute it using the normal Tapscript VM, which does most of the
 	// heavy lifting here.
 	engine, err := txscript.NewEngine(
-		prevOut.PkScript, virtualTxCopy, 0, txscript.StandardVerifyFlags,
-		nil, sigHashes, prevOut.Value, prevOutFetcher,
+		prevOut.PkScript, virtualTxCopy, 0, flags, nil, sigHashes,
+		prevOut.Value, prevOutFetcher,
 	)
 	if err != nil {
 		return newErrInner(ErrInvalidTransferWitness, err)
 	}
-	if err := engine.Execute(); err != nil {
+	err = vm.executeScript(engine, inputIdx, hook)
+	switch {
+	// Errors of the hook are already specific to the VM, so we don't wrap
+	// them.
+	case errors.As(err, new(Error)):
+		return err
+
+	case err != nil:
 		return newErrInner(ErrInvalidTransferWitness, err)
 	}
 
 	return nil
 }
 
+// witnessSigHashType returns the sighash type used by the signatures of an
+// asset witness. Signatures are either 64 bytes long with SIGHASH_DEFAULT, or
+// 65 bytes long with the sighash type appended. All signatures must use the
+// same sighash type, which must be valid for virtual transactions.
+func witnessSigHashType(txWitness wire.TxWitness) (txscript.SigHashType,
+	error) {
+
+	// Only the signatures on the witness stack are subject to the sighash
+	// check. For a script path spend, the last two elements are the leaf
+	// script and the control block, neither of which is a signature, even
+	// though the control block of a two leaf tree is also 65 bytes long.
+	sigItems := txWitness
+	if len(sigItems) >= 2 {
+		sigItems = sigItems[:len(sigItems)-2]
+	}
+
+	var (
+		hashType txscript.SigHashType
+		foundSig bool
+	)
+	for _, witnessItem := range sigItems {
+		var itemHashType txscript.SigHashType
+		switch len(witnessItem) {
+		case schnorr.SignatureSize:
+			itemHashType = txscript.SigHashDefault
+
+		case schnorr.SignatureSize + 1:
+			itemHashType = txscript.SigHashType(
+				witnessItem[schnorr.SignatureSize],
+			)
+
+		default:
+			continue
+		}
+
+		_, err := schnorr.ParseSignature(
+			witnessItem[:schnorr.SignatureSize],
+		)
+		if err != nil {
+			// Not a valid signature, so it must be some arbitrary
+			// data push.
+			continue
+		}
+
+		// An explicit SIGHASH_DEFAULT byte is invalid, just like in
+		// BIP 341.
+		if len(witnessItem) == schnorr.SignatureSize+1 &&
+			itemHashType == txscript.SigHashDefault {
+
+			return 0, newErrKind(ErrInvalidSigHashFlag)
+		}
+		if !taroscript.IsValidSigHashType(itemHashType) {
+			return 0, newErrKind(ErrInvalidSigHashFlag)
+		}
+		if foundSig && itemHashType != hashType {
+			return 0, newErrKind(ErrInvalidSigHashFlag)
+		}
+
+		hashType, foundSig = itemHashType, true
+	}
+
+	return hashType, nil
+}
+
+// scriptStepHook is called before each step of a txscript engine. If it
+// returns an error, the script execution fails with that error.
+type scriptStepHook func(engine *txscript.Engine) error
+
+// executeScript executes the given txscript engine. If tracing is enabled or a
+// hook is given, the script is stepped through one opcode at a time to call
+// the hook and record each step along with the resulting stack.
+func (vm *Engine) executeScript(engine *txscript.Engine, inputIdx uint32,
+	hook scriptStepHook) error {
+
+	if vm.trace == nil && hook == nil {
+		return engine.Execute()
+	}
+
+	for done := false; !done; {
+		if hook != nil {
+			if err := hook(engine); err != nil {
+				return err
+			}
+		}
+
+		opcode, err := engine.DisasmPC()
+		if err != nil {
+			opcode = fmt.Sprintf("<unknown: %v>", err)
+		}
+
+		done, err = engine.Step()
+
+		details := []string{
+			fmt.Sprintf("input=%d, opcode=%s", inputIdx, opcode),
+		}
+		for i, item := range engine.GetStack() {
+			details = append(
+				details, fmt.Sprintf("stack[%d]=%x", i, item),
+			)
+		}
+		vm.trace.record(TraceStepScript, err, details...)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err := engine.CheckErrorCondition(true)
+	vm.trace.record(
+		TraceStepScript, err,
+		fmt.Sprintf("input=%d, final stack check", inputIdx),
+	)
+
+	return err
+}
+
 // validateStateTransition attempts to validate a normal state transition where
 // an asset (normal or collectible) is fully consumed without splits. This is
 // done by verifying each input has a valid witness generated over the virtual
@@ -271,7 +522,7 @@ func (vm *Engine) validateStateTransition(virtualTx *wire.MsgTx) error {
 	case vm.newAsset.Type == asset.Collectible &&
 		len(vm.newAsset.PrevWitnesses) > 1:
 
-		return newErrKind(ErrInvalidTransferWitness)
+		return newErrKind(ErrCollectibleMerge)
 	}
 
 	for i, witness := range vm.newAsset.PrevWitnesses {
@@ -281,16 +532,23 @@ func (vm *Engine) validateStateTransition(virtualTx *wire.MsgTx) error {
 			return ErrNoInputs
 		}
 
+		var err error
 		switch prevAsset.ScriptVersion {
 		case asset.ScriptV0:
-			err := vm.validateWitnessV0(
+			err = vm.validateWitnessV0(
 				virtualTx, uint32(i), &witness, prevAsset,
 			)
-			if err != nil {
-				return err
-			}
+
+		case asset.ScriptV1:
+			err = vm.validateWitnessV1(
+				virtualTx, uint32(i), &witness, prevAsset,
+			)
+
 		default:
-			return ErrInvalidScriptVersion
+			err = ErrInvalidScriptVersion
+		}
+		if err != nil {
+			return withInputContext(err, uint32(i), prevAsset)
 		}
 	}
 
@@ -303,17 +561,29 @@ func (vm *Engine) Execute() error {
 	// A genesis asset should have a single witness and a PrevID of all
 	// zeros and empty witness and split commitment proof.
 	if vm.newAsset.HasGenesisWitness() {
+		var err error
 		if vm.splitAsset != nil || len(vm.prevAssets) > 0 {
-			return newErrKind(ErrInvalidGenesisStateTransition)
+			err = newErrKind(ErrInvalidGenesisStateTransition)
 		}
-		return nil
+		vm.trace.record(TraceStepGenesis, err)
+
+		return err
 	}
 
 	// If we have an asset split, then we need to validate the state
 	// transition by verifying the split commitment proof before verify the
 	// final asset witness.
 	if vm.splitAsset != nil {
-		if err := vm.validateSplit(); err != nil {
+		err := vm.validateSplit()
+		vm.trace.record(
+			TraceStepSplit, err,
+			fmt.Sprintf("split_asset_id=%x, output_index=%d, "+
+				"amount=%d, script_key=%x",
+				vm.splitAsset.Genesis.ID(),
+				vm.splitAsset.OutputIndex, vm.splitAsset.Amount,
+				vm.splitAsset.ScriptKey.PubKey.SerializeCompressed()),
+		)
+		if err != nil {
 			return err
 		}
 	}
@@ -324,6 +594,20 @@ func (vm *Engine) Execute() error {
 	virtualTx, inputTree, err := taroscript.VirtualTx(
 		vm.newAsset, vm.prevAssets,
 	)
+	if vm.trace != nil {
+		var details []string
+		if err == nil {
+			details = []string{
+				fmt.Sprintf("txid=%v", virtualTx.TxHash()),
+				fmt.Sprintf("input_prev_out=%v",
+					virtualTx.TxIn[0].PreviousOutPoint),
+				fmt.Sprintf("output_value=%d, output_pk_script=%x",
+					virtualTx.TxOut[0].Value,
+					virtualTx.TxOut[0].PkScript),
+			}
+		}
+		vm.trace.record(TraceStepVirtualTx, err, details...)
+	}
 	if err != nil {
 		if errors.Is(err, taroscript.ErrInputMismatch) {
 			return ErrInputMismatch
@@ -334,13 +618,36 @@ func (vm *Engine) Execute() error {
 		return err
 	}
 
+	// None of the inputs may be spent before their lock times expired.
+	err = vm.validateLockTimes()
+	if vm.trace != nil {
+		details := "not enforced"
+		if vm.chainCtx != nil {
+			details = fmt.Sprintf("block_height=%d",
+				vm.chainCtx.BlockHeight)
+		}
+		vm.trace.record(TraceStepLockTimes, err, details)
+	}
+	if err != nil {
+		return err
+	}
+
 	// Enforce that assets aren't being inflated.
 	treeRoot, err := inputTree.Root(context.Background())
 	if err != nil {
 		return err
 	}
 	if treeRoot.NodeSum() != uint64(virtualTx.TxOut[0].Value) {
-		return newErrKind(ErrAmountMismatch)
+		err = newErrKind(ErrAmountMismatch)
+	}
+	vm.trace.record(
+		TraceStepInputTree, err,
+		fmt.Sprintf("root=%v, sum=%d, output_value=%d",
+			treeRoot.NodeHash(), treeRoot.NodeSum(),
+			virtualTx.TxOut[0].Value),
+	)
+	if err != nil {
+		return err
 	}
 
 	// Finally, we'll validate the asset witness.
@@ -75,7 +75,8 @@ func randAsset(t *testing.T, assetType asset.Type,
 	}
 
 	a, err := asset.New(
-		genesis, units, 0, 0, asset.NewScriptKey(&scriptKey), familyKey,
+		genesis, units, 0, 0, asset.ScriptV0,
+		asset.NewScriptKey(&scriptKey), familyKey,
 	)
 	require.NoError(t, err)
 	return a
@@ -90,7 +91,7 @@ func genTaprootKeySpend(t *testing.T, privKey btcec.PrivateKey,
 		virtualTx, input, idx, nil,
 	)
 	sigHash, err := taroscript.InputKeySpendSigHash(
-		virtualTxCopy, input, idx,
+		virtualTxCopy, input, idx, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 
@@ -116,7 +117,7 @@ func genTaprootScriptSpend(t *testing.T, privKey btcec.PrivateKey,
 
 	virtualTxCopy := taroscript.VirtualTxWithInput(virtualTx, input, idx, nil)
 	sigHash, err := taroscript.InputScriptSpendSigHash(
-		virtualTxCopy, input, idx, tapLeaf,
+		virtualTxCopy, input, idx, tapLeaf, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 	sig, err := schnorr.Sign(&privKey, sigHash)
@@ -187,6 +188,29 @@ func collectibleStateTransition(t *testing.T) (*asset.Asset,
 	return newAsset, nil, inputs
 }
 
+// collectibleMergeStateTransition creates a state transition that attempts to
+// merge two collectible inputs into a single asset.
+func collectibleMergeStateTransition(t *testing.T) (*asset.Asset,
+	commitment.SplitSet, commitment.InputSet) {
+
+	newAsset, _, inputs := collectibleStateTransition(t)
+
+	prevAsset := inputs[*newAsset.PrevWitnesses[0].PrevID]
+	prevID2 := asset.PrevID{
+		OutPoint:  wire.OutPoint{Index: 1},
+		ID:        prevAsset.Genesis.ID(),
+		ScriptKey: asset.ToSerialized(prevAsset.ScriptKey.PubKey),
+	}
+	inputs[prevID2] = prevAsset.Copy()
+	newAsset.Amount = 2
+	newAsset.PrevWitnesses = append(newAsset.PrevWitnesses, asset.Witness{
+		PrevID:    &prevID2,
+		TxWitness: newAsset.PrevWitnesses[0].TxWitness,
+	})
+
+	return newAsset, nil, inputs
+}
+
 // TODO(roasbeef): need to add 1:1 spend
 func normalStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
 	commitment.InputSet) {
@@ -412,6 +436,19 @@ func splitCollectibleStateTransition(t *testing.T,
 	}
 }
 
+// partialCollectibleSplitStateTransition creates a split state transition of
+// a collectible that leaves part of the collectible in the root asset.
+func partialCollectibleSplitStateTransition(t *testing.T) (*asset.Asset,
+	commitment.SplitSet, commitment.InputSet) {
+
+	rootAsset, splitSet, inputs := splitCollectibleStateTransition(
+		t, true,
+	)(t)
+	rootAsset.Amount = 1
+
+	return rootAsset, splitSet, inputs
+}
+
 func TestVM(t *testing.T) {
 	t.Parallel()
 
@@ -480,13 +517,25 @@ func TestVM(t *testing.T) {
 			f:    splitCollectibleStateTransition(t, true),
 			err:  nil,
 		},
+		{
+			name: "invalid collectible merge",
+			f:    collectibleMergeStateTransition,
+			err:  newErrKind(ErrCollectibleMerge),
+		},
+		{
+			name: "invalid partial collectible split",
+			f:    partialCollectibleSplitStateTransition,
+			err:  newErrKind(ErrCollectibleSplit),
+		},
 	}
 
 	for _, testCase := range testCases {
 		success := t.Run(testCase.name, func(t *testing.T) {
 			newAsset, splitSet, inputSet := testCase.f(t)
 			verify := func(splitAsset *commitment.SplitAsset) error {
-				vm, err := New(newAsset, splitAsset, inputSet)
+				vm, err := New(
+					newAsset, splitAsset, inputSet, nil,
+				)
 				if err != nil {
 					if testCase.err != nil {
 						require.Equal(
@@ -500,7 +549,7 @@ func TestVM(t *testing.T) {
 			}
 			if len(splitSet) == 0 {
 				err := verify(nil)
-				require.Equal(t, testCase.err, err)
+				require.ErrorIs(t, err, testCase.err)
 				return
 			}
 
@@ -513,7 +562,7 @@ func TestVM(t *testing.T) {
 			})
 			for _, splitAsset := range splitAssets {
 				err := verify(splitAsset)
-				require.Equal(t, testCase.err, err)
+				require.ErrorIs(t, err, testCase.err)
 				if err != nil {
 					return
 				}
@@ -524,3 +573,260 @@ func TestVM(t *testing.T) {
 		}
 	}
 }
+
+// lockedStateTransition creates a valid state transition that spends a single
+// collectible input with the given absolute and relative lock times.
+func lockedStateTransition(t *testing.T, lockTime,
+	relativeLockTime uint64) (*asset.Asset, commitment.InputSet,
+	asset.PrevID) {
+
+	privKey := randKey(t)
+	scriptKey := txscript.ComputeTaprootKeyNoScript(privKey.PubKey())
+
+	genesisAsset := randAsset(t, asset.Collectible, *scriptKey)
+	genesisAsset.LockTime = lockTime
+	genesisAsset.RelativeLockTime = relativeLockTime
+
+	prevID := asset.PrevID{
+		OutPoint:  wire.OutPoint{},
+		ID:        genesisAsset.Genesis.ID(),
+		ScriptKey: asset.ToSerialized(genesisAsset.ScriptKey.PubKey),
+	}
+	newAsset := genesisAsset.Copy()
+	newAsset.ScriptKey = asset.NewScriptKey(randKey(t).PubKey())
+	newAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &prevID,
+	}}
+
+	inputs := commitment.InputSet{prevID: genesisAsset}
+	virtualTx, _, err := taroscript.VirtualTx(newAsset, inputs)
+	require.NoError(t, err)
+	newAsset.PrevWitnesses[0].TxWitness = genTaprootKeySpend(
+		t, *privKey, virtualTx, genesisAsset, 0,
+	)
+
+	return newAsset, inputs, prevID
+}
+
+// TestVMLockTimes tests that the VM rejects state transitions that spend an
+// input before its absolute or relative lock time expired.
+func TestVMLockTimes(t *testing.T) {
+	t.Parallel()
+
+	const (
+		lockTime         = 1000
+		relativeLockTime = 6
+		inputHeight      = 998
+	)
+
+	testCases := []struct {
+		name             string
+		lockTime         uint64
+		relativeLockTime uint64
+		chainCtx         func(asset.PrevID) *taroscript.ChainContext
+		err              error
+	}{{
+		name:     "no chain context",
+		lockTime: lockTime,
+		chainCtx: func(asset.PrevID) *taroscript.ChainContext {
+			return nil
+		},
+	}, {
+		name:     "lock time not reached",
+		lockTime: lockTime,
+		chainCtx: func(asset.PrevID) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: lockTime - 1,
+			}
+		},
+		err: newErrKind(ErrLockTimeNotReached),
+	}, {
+		name:     "lock time reached",
+		lockTime: lockTime,
+		chainCtx: func(asset.PrevID) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: lockTime,
+			}
+		},
+	}, {
+		name:             "relative lock time not reached",
+		relativeLockTime: relativeLockTime,
+		chainCtx: func(p asset.PrevID) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: inputHeight + relativeLockTime - 1,
+				InputHeights: map[asset.PrevID]uint32{
+					p: inputHeight,
+				},
+			}
+		},
+		err: newErrKind(ErrRelativeLockTimeNotReached),
+	}, {
+		name:             "relative lock time reached",
+		relativeLockTime: relativeLockTime,
+		chainCtx: func(p asset.PrevID) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: inputHeight + relativeLockTime,
+				InputHeights: map[asset.PrevID]uint32{
+					p: inputHeight,
+				},
+			}
+		},
+	}, {
+		name:             "missing input height",
+		relativeLockTime: relativeLockTime,
+		chainCtx: func(asset.PrevID) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: inputHeight + relativeLockTime,
+			}
+		},
+		err: newErrKind(ErrMissingInputHeight),
+	}, {
+		name:             "both lock times",
+		lockTime:         lockTime,
+		relativeLockTime: relativeLockTime,
+		chainCtx: func(p asset.PrevID) *taroscript.ChainContext {
+			return &taroscript.ChainContext{
+				BlockHeight: lockTime,
+				InputHeights: map[asset.PrevID]uint32{
+					p: inputHeight,
+				},
+			}
+		},
+		err: newErrKind(ErrRelativeLockTimeNotReached),
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			newAsset, inputs, prevID := lockedStateTransition(
+				t, testCase.lockTime, testCase.relativeLockTime,
+			)
+
+			engine, err := New(
+				newAsset, nil, inputs, testCase.chainCtx(prevID),
+			)
+			require.NoError(t, err)
+
+			err = engine.Execute()
+			if testCase.err == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			// Lock time errors refer to the input that isn't
+			// spendable yet.
+			var vmErr Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(t, testCase.err.(Error).Kind, vmErr.Kind)
+			require.Equal(t, &ErrorContext{
+				InputIndex: 0,
+				AssetID:    prevID.ID,
+				ScriptKey:  prevID.ScriptKey,
+			}, vmErr.Context)
+		})
+	}
+}
+
+// TestVMTrace tests that a traced execution records each step of the state
+// transition validation, including the failing one.
+func TestVMTrace(t *testing.T) {
+	t.Parallel()
+
+	stepNames := func(trace *Trace) []string {
+		names := make([]string, 0, len(trace.Steps))
+		for _, step := range trace.Steps {
+			if len(names) > 0 && names[len(names)-1] == step.Name {
+				continue
+			}
+			names = append(names, step.Name)
+		}
+		return names
+	}
+
+	t.Run("tracing disabled", func(t *testing.T) {
+		newAsset, inputs, _ := lockedStateTransition(t, 0, 0)
+		vm, err := New(newAsset, nil, inputs, nil)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+		require.Nil(t, vm.Trace())
+	})
+
+	t.Run("valid state transition", func(t *testing.T) {
+		newAsset, inputs, _ := lockedStateTransition(t, 0, 0)
+		vm, err := New(newAsset, nil, inputs, nil, WithTrace())
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+
+		trace := vm.Trace()
+		require.NoError(t, trace.Err())
+		require.Equal(t, []string{
+			TraceStepVirtualTx, TraceStepLockTimes,
+			TraceStepInputTree, TraceStepAssetParams,
+			TraceStepScript,
+		}, stepNames(trace))
+	})
+
+	t.Run("valid split state transition", func(t *testing.T) {
+		newAsset, splitSet, inputs := splitStateTransition(t)
+		for _, splitAsset := range splitSet {
+			vm, err := New(
+				newAsset, splitAsset, inputs, nil, WithTrace(),
+			)
+			require.NoError(t, err)
+			require.NoError(t, vm.Execute())
+
+			trace := vm.Trace()
+			require.NoError(t, trace.Err())
+			require.Equal(t, []string{
+				TraceStepAssetParams, TraceStepSplit,
+				TraceStepVirtualTx, TraceStepLockTimes,
+				TraceStepInputTree, TraceStepAssetParams,
+				TraceStepScript,
+			}, stepNames(trace))
+		}
+	})
+
+	t.Run("invalid witness", func(t *testing.T) {
+		newAsset, inputs, prevID := lockedStateTransition(t, 0, 0)
+		sig := newAsset.PrevWitnesses[0].TxWitness[0]
+		sig[len(sig)-1] ^= 0x01
+
+		vm, err := New(newAsset, nil, inputs, nil, WithTrace())
+		require.NoError(t, err)
+
+		err = vm.Execute()
+		var vmErr Error
+		require.ErrorAs(t, err, &vmErr)
+		require.Equal(t, ErrInvalidTransferWitness, vmErr.Kind)
+		require.Equal(t, &ErrorContext{
+			InputIndex: 0,
+			AssetID:    prevID.ID,
+			ScriptKey:  prevID.ScriptKey,
+		}, vmErr.Context)
+
+		// The failing script step should be the last one recorded.
+		trace := vm.Trace()
+		lastStep := trace.Steps[len(trace.Steps)-1]
+		require.Equal(t, TraceStepScript, lastStep.Name)
+		require.Error(t, lastStep.Err)
+		require.Equal(t, lastStep.Err, trace.Err())
+	})
+
+	t.Run("inflation", func(t *testing.T) {
+		newAsset, inputs, _ := lockedStateTransition(t, 0, 0)
+		newAsset.Type = asset.Normal
+		newAsset.Amount = 2
+		for _, input := range inputs {
+			input.Type = asset.Normal
+		}
+
+		vm, err := New(newAsset, nil, inputs, nil, WithTrace())
+		require.NoError(t, err)
+		require.Equal(t, newErrKind(ErrAmountMismatch), vm.Execute())
+
+		trace := vm.Trace()
+		lastStep := trace.Steps[len(trace.Steps)-1]
+		require.Equal(t, TraceStepInputTree, lastStep.Name)
+		require.Equal(t, newErrKind(ErrAmountMismatch), lastStep.Err)
+	})
+}